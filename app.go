@@ -17,10 +17,13 @@ import (
 	"hrpro/internal/departments"
 	"hrpro/internal/employees"
 	"hrpro/internal/handlers"
+	"hrpro/internal/holidays"
 	"hrpro/internal/leave"
 	"hrpro/internal/payroll"
+	"hrpro/internal/payslips"
 	"hrpro/internal/reports"
 	"hrpro/internal/repositories"
+	"hrpro/internal/schedules"
 	"hrpro/internal/services"
 	"hrpro/internal/settings"
 	"hrpro/internal/users"
@@ -70,7 +73,10 @@ type App struct {
 	employeesHandler   *handlers.EmployeesHandler
 	departmentsHandler *handlers.DepartmentsHandler
 	leaveHandler       *handlers.LeaveHandler
+	holidaysHandler    *handlers.HolidaysHandler
+	schedulesHandler   *handlers.WorkSchedulesHandler
 	payrollHandler     *handlers.PayrollHandler
+	payslipsHandler    *handlers.PayslipsHandler
 	usersHandler       *handlers.UsersHandler
 	auditHandler       *handlers.AuditHandler
 	dashboardHandler   *handlers.DashboardHandler
@@ -164,6 +170,7 @@ func (a *App) bootstrap(ctx context.Context) error {
 
 	employeesRepo := employees.NewRepository(database)
 	employeesService := employees.NewService(employeesRepo)
+	employeesService.SetAuditRecorder(auditService)
 	employeesHandler := handlers.NewEmployeesHandler(authService, employeesService)
 	departmentsRepo := departments.NewRepository(database)
 	departmentsService := departments.NewService(departmentsRepo)
@@ -172,9 +179,21 @@ func (a *App) bootstrap(ctx context.Context) error {
 	leaveService := leave.NewService(leaveRepo)
 	leaveService.SetAuditRecorder(auditService)
 	leaveHandler := handlers.NewLeaveHandler(authService, leaveService)
+	holidaysRepo := holidays.NewRepository(database)
+	holidaysService := holidays.NewService(holidaysRepo)
+	holidaysService.SetAuditRecorder(auditService)
+	holidaysHandler := handlers.NewHolidaysHandler(authService, holidaysService)
+	leaveService.SetHolidayProvider(holidaysService)
+	schedulesRepo := schedules.NewRepository(database)
+	schedulesService := schedules.NewService(schedulesRepo)
+	schedulesService.SetAuditRecorder(auditService)
+	schedulesHandler := handlers.NewWorkSchedulesHandler(authService, schedulesService)
+	leaveService.SetWorkScheduleProvider(schedulesService)
 	attendanceRepo := attendance.NewRepository(database)
 	attendanceService := attendance.NewService(attendanceRepo, leaveService)
 	attendanceService.SetAuditRecorder(auditService)
+	attendanceService.SetWorkScheduleProvider(schedulesService)
+	attendanceService.SetHolidayProvider(holidaysService)
 	attendanceHandler := handlers.NewAttendanceHandler(authService, attendanceService)
 	appDataDir, err := os.UserConfigDir()
 	if err != nil {
@@ -200,12 +219,21 @@ func (a *App) bootstrap(ctx context.Context) error {
 	reportsRepo := reports.NewRepository(database)
 	reportsService := reports.NewService(reportsRepo)
 	reportsService.SetFormattingProvider(settingsService)
+	reportsService.SetHolidayProvider(holidaysService)
 	reportsHandler := handlers.NewReportsHandler(authService, reportsService)
 	payrollRepo := payroll.NewRepository(database)
 	payrollService := payroll.NewService(payrollRepo)
 	payrollService.SetAuditRecorder(auditService)
 	payrollService.SetFormattingProvider(settingsService)
+	payrollService.SetTaxRulesProvider(settingsService)
+	payrollService.SetPolicyProvider(settingsService)
+	payrollService.SetHolidayProvider(holidaysService)
 	payrollHandler := handlers.NewPayrollHandler(authService, payrollService)
+	reportsService.SetIntegrityProvider(payrollService)
+
+	payslipsService := payslips.NewService(payrollService, settingsService)
+	payslipsService.SetAuditRecorder(auditService)
+	payslipsHandler := handlers.NewPayslipsHandler(authService, payslipsService)
 	usersRepo := users.NewRepository(database)
 	usersService := users.NewService(usersRepo)
 	usersService.SetAuditRecorder(auditService)
@@ -224,7 +252,10 @@ func (a *App) bootstrap(ctx context.Context) error {
 	a.employeesHandler = employeesHandler
 	a.departmentsHandler = departmentsHandler
 	a.leaveHandler = leaveHandler
+	a.holidaysHandler = holidaysHandler
+	a.schedulesHandler = schedulesHandler
 	a.payrollHandler = payrollHandler
+	a.payslipsHandler = payslipsHandler
 	a.usersHandler = usersHandler
 	a.auditHandler = auditHandler
 	a.dashboardHandler = dashboardHandler
@@ -437,6 +468,18 @@ func (a *App) RemoveEmployeeContract(request handlers.RemoveEmployeeContractRequ
 	return a.employeesHandler.RemoveEmployeeContract(ctx, request)
 }
 
+func (a *App) RecordSalaryChange(request handlers.RecordSalaryChangeRequest) (*employees.SalaryChange, error) {
+	ctx, cancel := context.WithTimeout(a.ctx, 10*time.Second)
+	defer cancel()
+	return a.employeesHandler.RecordSalaryChange(ctx, request)
+}
+
+func (a *App) ListSalaryHistory(request handlers.ListSalaryHistoryRequest) ([]employees.SalaryChange, error) {
+	ctx, cancel := context.WithTimeout(a.ctx, 10*time.Second)
+	defer cancel()
+	return a.employeesHandler.ListSalaryHistory(ctx, request)
+}
+
 func (a *App) CreateDepartment(request handlers.CreateDepartmentRequest) (*departments.Department, error) {
 	ctx, cancel := context.WithTimeout(a.ctx, 10*time.Second)
 	defer cancel()
@@ -509,6 +552,90 @@ func (a *App) UnlockDate(request handlers.UnlockDateRequest) error {
 	return a.leaveHandler.UnlockDate(ctx, request)
 }
 
+func (a *App) ListHolidays(request handlers.ListHolidaysRequest) ([]holidays.Holiday, error) {
+	ctx, cancel := context.WithTimeout(a.ctx, 10*time.Second)
+	defer cancel()
+	return a.holidaysHandler.ListHolidays(ctx, request)
+}
+
+func (a *App) ListHolidayCalendar(request handlers.HolidayCalendarRequest) ([]holidays.HolidayOccurrence, error) {
+	ctx, cancel := context.WithTimeout(a.ctx, 10*time.Second)
+	defer cancel()
+	return a.holidaysHandler.ListHolidayCalendar(ctx, request)
+}
+
+func (a *App) CreateHoliday(request handlers.CreateHolidayRequest) (*holidays.Holiday, error) {
+	ctx, cancel := context.WithTimeout(a.ctx, 10*time.Second)
+	defer cancel()
+	return a.holidaysHandler.CreateHoliday(ctx, request)
+}
+
+func (a *App) UpdateHoliday(request handlers.UpdateHolidayRequest) (*holidays.Holiday, error) {
+	ctx, cancel := context.WithTimeout(a.ctx, 10*time.Second)
+	defer cancel()
+	return a.holidaysHandler.UpdateHoliday(ctx, request)
+}
+
+func (a *App) SetHolidayActive(request handlers.SetHolidayActiveRequest) (*holidays.Holiday, error) {
+	ctx, cancel := context.WithTimeout(a.ctx, 10*time.Second)
+	defer cancel()
+	return a.holidaysHandler.SetHolidayActive(ctx, request)
+}
+
+func (a *App) DeleteHoliday(request handlers.DeleteHolidayRequest) error {
+	ctx, cancel := context.WithTimeout(a.ctx, 10*time.Second)
+	defer cancel()
+	return a.holidaysHandler.DeleteHoliday(ctx, request)
+}
+
+func (a *App) ImportHolidays(request handlers.ImportHolidaysRequest) (*holidays.HolidayImportResult, error) {
+	ctx, cancel := context.WithTimeout(a.ctx, 20*time.Second)
+	defer cancel()
+	return a.holidaysHandler.ImportHolidays(ctx, request)
+}
+
+func (a *App) ListWorkSchedules(request handlers.ListWorkSchedulesRequest) ([]schedules.WorkSchedule, error) {
+	ctx, cancel := context.WithTimeout(a.ctx, 10*time.Second)
+	defer cancel()
+	return a.schedulesHandler.ListWorkSchedules(ctx, request)
+}
+
+func (a *App) CreateWorkSchedule(request handlers.CreateWorkScheduleRequest) (*schedules.WorkSchedule, error) {
+	ctx, cancel := context.WithTimeout(a.ctx, 10*time.Second)
+	defer cancel()
+	return a.schedulesHandler.CreateWorkSchedule(ctx, request)
+}
+
+func (a *App) UpdateWorkSchedule(request handlers.UpdateWorkScheduleRequest) (*schedules.WorkSchedule, error) {
+	ctx, cancel := context.WithTimeout(a.ctx, 10*time.Second)
+	defer cancel()
+	return a.schedulesHandler.UpdateWorkSchedule(ctx, request)
+}
+
+func (a *App) SetWorkScheduleActive(request handlers.SetWorkScheduleActiveRequest) (*schedules.WorkSchedule, error) {
+	ctx, cancel := context.WithTimeout(a.ctx, 10*time.Second)
+	defer cancel()
+	return a.schedulesHandler.SetWorkScheduleActive(ctx, request)
+}
+
+func (a *App) ListEmployeeWorkSchedules(request handlers.ListEmployeeWorkSchedulesRequest) ([]schedules.EmployeeWorkSchedule, error) {
+	ctx, cancel := context.WithTimeout(a.ctx, 10*time.Second)
+	defer cancel()
+	return a.schedulesHandler.ListEmployeeWorkSchedules(ctx, request)
+}
+
+func (a *App) AssignWorkSchedule(request handlers.AssignWorkScheduleRequest) (*schedules.EmployeeWorkSchedule, error) {
+	ctx, cancel := context.WithTimeout(a.ctx, 10*time.Second)
+	defer cancel()
+	return a.schedulesHandler.AssignWorkSchedule(ctx, request)
+}
+
+func (a *App) DeleteEmployeeWorkSchedule(request handlers.DeleteEmployeeWorkScheduleRequest) error {
+	ctx, cancel := context.WithTimeout(a.ctx, 10*time.Second)
+	defer cancel()
+	return a.schedulesHandler.DeleteEmployeeWorkSchedule(ctx, request)
+}
+
 func (a *App) GetMyLeaveBalance(request handlers.LeaveBalanceRequest) (*leave.LeaveBalance, error) {
 	ctx, cancel := context.WithTimeout(a.ctx, 10*time.Second)
 	defer cancel()
@@ -527,6 +654,12 @@ func (a *App) UpsertEntitlement(request handlers.UpsertEntitlementRequest) (*lea
 	return a.leaveHandler.UpsertEntitlement(ctx, request)
 }
 
+func (a *App) PreviewLeaveDays(request handlers.ApplyLeaveRequest) (*leave.LeaveDaysPreview, error) {
+	ctx, cancel := context.WithTimeout(a.ctx, 10*time.Second)
+	defer cancel()
+	return a.leaveHandler.PreviewLeaveDays(ctx, request)
+}
+
 func (a *App) ApplyLeave(request handlers.ApplyLeaveRequest) (*leave.LeaveRequest, error) {
 	ctx, cancel := context.WithTimeout(a.ctx, 10*time.Second)
 	defer cancel()
@@ -593,24 +726,336 @@ func (a *App) UpdatePayrollEntryAmounts(request handlers.UpdatePayrollEntryAmoun
 	return a.payrollHandler.UpdatePayrollEntryAmounts(ctx, request)
 }
 
-func (a *App) ApprovePayrollBatch(request handlers.PayrollBatchActionRequest) (*payroll.PayrollBatch, error) {
+func (a *App) ImportPayrollEntryAmounts(request handlers.ImportPayrollEntryAmountsRequest) (*payroll.EntryAmountsImportResult, error) {
+	ctx, cancel := context.WithTimeout(a.ctx, 20*time.Second)
+	defer cancel()
+	return a.payrollHandler.ImportPayrollEntryAmounts(ctx, request)
+}
+
+func (a *App) ApprovePayrollBatch(request handlers.PayrollApprovalRequest) (*payroll.PayrollBatch, error) {
 	ctx, cancel := context.WithTimeout(a.ctx, 10*time.Second)
 	defer cancel()
 	return a.payrollHandler.ApprovePayrollBatch(ctx, request)
 }
 
+func (a *App) RejectPayrollBatch(request handlers.PayrollApprovalRequest) (*payroll.PayrollBatch, error) {
+	ctx, cancel := context.WithTimeout(a.ctx, 10*time.Second)
+	defer cancel()
+	return a.payrollHandler.RejectPayrollBatch(ctx, request)
+}
+
+func (a *App) ReopenPayrollBatch(request handlers.PayrollBatchReasonRequest) (*payroll.PayrollBatch, error) {
+	ctx, cancel := context.WithTimeout(a.ctx, 10*time.Second)
+	defer cancel()
+	return a.payrollHandler.ReopenPayrollBatch(ctx, request)
+}
+
+func (a *App) ReversePayrollBatch(request handlers.PayrollBatchReasonRequest) (*payroll.PayrollBatch, error) {
+	ctx, cancel := context.WithTimeout(a.ctx, 10*time.Second)
+	defer cancel()
+	return a.payrollHandler.ReversePayrollBatch(ctx, request)
+}
+
 func (a *App) LockPayrollBatch(request handlers.PayrollBatchActionRequest) (*payroll.PayrollBatch, error) {
 	ctx, cancel := context.WithTimeout(a.ctx, 10*time.Second)
 	defer cancel()
 	return a.payrollHandler.LockPayrollBatch(ctx, request)
 }
 
+func (a *App) VerifyPayrollBatchIntegrity(request handlers.PayrollBatchActionRequest) (*payroll.BatchIntegrityReport, error) {
+	ctx, cancel := context.WithTimeout(a.ctx, 20*time.Second)
+	defer cancel()
+	return a.payrollHandler.VerifyPayrollBatchIntegrity(ctx, request)
+}
+
 func (a *App) ExportPayrollBatchCSV(request handlers.PayrollBatchActionRequest) (*payroll.CSVExport, error) {
 	ctx, cancel := context.WithTimeout(a.ctx, 10*time.Second)
 	defer cancel()
 	return a.payrollHandler.ExportPayrollBatchCSV(ctx, request)
 }
 
+func (a *App) ListPayComponents(request handlers.ListPayComponentsRequest) ([]payroll.PayComponent, error) {
+	ctx, cancel := context.WithTimeout(a.ctx, 10*time.Second)
+	defer cancel()
+	return a.payrollHandler.ListPayComponents(ctx, request)
+}
+
+func (a *App) CreatePayComponent(request handlers.CreatePayComponentRequest) (*payroll.PayComponent, error) {
+	ctx, cancel := context.WithTimeout(a.ctx, 10*time.Second)
+	defer cancel()
+	return a.payrollHandler.CreatePayComponent(ctx, request)
+}
+
+func (a *App) UpdatePayComponent(request handlers.UpdatePayComponentRequest) (*payroll.PayComponent, error) {
+	ctx, cancel := context.WithTimeout(a.ctx, 10*time.Second)
+	defer cancel()
+	return a.payrollHandler.UpdatePayComponent(ctx, request)
+}
+
+func (a *App) SetPayComponentActive(request handlers.SetPayComponentActiveRequest) (*payroll.PayComponent, error) {
+	ctx, cancel := context.WithTimeout(a.ctx, 10*time.Second)
+	defer cancel()
+	return a.payrollHandler.SetPayComponentActive(ctx, request)
+}
+
+func (a *App) AddPayrollEntryLine(request handlers.AddPayrollEntryLineRequest) (*payroll.PayrollEntry, error) {
+	ctx, cancel := context.WithTimeout(a.ctx, 10*time.Second)
+	defer cancel()
+	return a.payrollHandler.AddPayrollEntryLine(ctx, request)
+}
+
+func (a *App) RemovePayrollEntryLine(request handlers.RemovePayrollEntryLineRequest) (*payroll.PayrollEntry, error) {
+	ctx, cancel := context.WithTimeout(a.ctx, 10*time.Second)
+	defer cancel()
+	return a.payrollHandler.RemovePayrollEntryLine(ctx, request)
+}
+
+func (a *App) ListContributionSchemes(request handlers.ListContributionSchemesRequest) ([]payroll.ContributionScheme, error) {
+	ctx, cancel := context.WithTimeout(a.ctx, 10*time.Second)
+	defer cancel()
+	return a.payrollHandler.ListContributionSchemes(ctx, request)
+}
+
+func (a *App) CreateContributionScheme(request handlers.CreateContributionSchemeRequest) (*payroll.ContributionScheme, error) {
+	ctx, cancel := context.WithTimeout(a.ctx, 10*time.Second)
+	defer cancel()
+	return a.payrollHandler.CreateContributionScheme(ctx, request)
+}
+
+func (a *App) UpdateContributionScheme(request handlers.UpdateContributionSchemeRequest) (*payroll.ContributionScheme, error) {
+	ctx, cancel := context.WithTimeout(a.ctx, 10*time.Second)
+	defer cancel()
+	return a.payrollHandler.UpdateContributionScheme(ctx, request)
+}
+
+func (a *App) SetContributionSchemeActive(request handlers.SetContributionSchemeActiveRequest) (*payroll.ContributionScheme, error) {
+	ctx, cancel := context.WithTimeout(a.ctx, 10*time.Second)
+	defer cancel()
+	return a.payrollHandler.SetContributionSchemeActive(ctx, request)
+}
+
+func (a *App) ListContributionSchemeMembers(request handlers.ListContributionSchemeMembersRequest) ([]payroll.ContributionSchemeMember, error) {
+	ctx, cancel := context.WithTimeout(a.ctx, 10*time.Second)
+	defer cancel()
+	return a.payrollHandler.ListContributionSchemeMembers(ctx, request)
+}
+
+func (a *App) SetContributionSchemeMember(request handlers.SetContributionSchemeMemberRequest) (*payroll.ContributionSchemeMember, error) {
+	ctx, cancel := context.WithTimeout(a.ctx, 10*time.Second)
+	defer cancel()
+	return a.payrollHandler.SetContributionSchemeMember(ctx, request)
+}
+
+func (a *App) ExportContributionScheduleCSV(request handlers.ExportContributionScheduleRequest) (*payroll.CSVExport, error) {
+	ctx, cancel := context.WithTimeout(a.ctx, 20*time.Second)
+	defer cancel()
+	return a.payrollHandler.ExportContributionScheduleCSV(ctx, request)
+}
+
+func (a *App) ListEmployeePaymentMethods(request handlers.ListEmployeePaymentMethodsRequest) ([]payroll.EmployeePaymentMethod, error) {
+	ctx, cancel := context.WithTimeout(a.ctx, 10*time.Second)
+	defer cancel()
+	return a.payrollHandler.ListEmployeePaymentMethods(ctx, request)
+}
+
+func (a *App) SetEmployeePaymentMethods(request handlers.SetEmployeePaymentMethodsRequest) ([]payroll.EmployeePaymentMethod, error) {
+	ctx, cancel := context.WithTimeout(a.ctx, 10*time.Second)
+	defer cancel()
+	return a.payrollHandler.SetEmployeePaymentMethods(ctx, request)
+}
+
+func (a *App) ListPaymentFileFormats(request handlers.ListPaymentFileFormatsRequest) ([]payroll.PaymentFileFormatInfo, error) {
+	ctx, cancel := context.WithTimeout(a.ctx, 10*time.Second)
+	defer cancel()
+	return a.payrollHandler.ListPaymentFileFormats(ctx, request)
+}
+
+func (a *App) ExportPaymentFile(request handlers.ExportPaymentFileRequest) (*payroll.CSVExport, error) {
+	ctx, cancel := context.WithTimeout(a.ctx, 20*time.Second)
+	defer cancel()
+	return a.payrollHandler.ExportPaymentFile(ctx, request)
+}
+
+func (a *App) ListGLAccountMappings(request handlers.ListGLAccountMappingsRequest) ([]payroll.GLAccountMapping, error) {
+	ctx, cancel := context.WithTimeout(a.ctx, 10*time.Second)
+	defer cancel()
+	return a.payrollHandler.ListGLAccountMappings(ctx, request)
+}
+
+func (a *App) CreateGLAccountMapping(request handlers.CreateGLAccountMappingRequest) (*payroll.GLAccountMapping, error) {
+	ctx, cancel := context.WithTimeout(a.ctx, 10*time.Second)
+	defer cancel()
+	return a.payrollHandler.CreateGLAccountMapping(ctx, request)
+}
+
+func (a *App) UpdateGLAccountMapping(request handlers.UpdateGLAccountMappingRequest) (*payroll.GLAccountMapping, error) {
+	ctx, cancel := context.WithTimeout(a.ctx, 10*time.Second)
+	defer cancel()
+	return a.payrollHandler.UpdateGLAccountMapping(ctx, request)
+}
+
+func (a *App) DeleteGLAccountMapping(request handlers.DeleteGLAccountMappingRequest) error {
+	ctx, cancel := context.WithTimeout(a.ctx, 10*time.Second)
+	defer cancel()
+	return a.payrollHandler.DeleteGLAccountMapping(ctx, request)
+}
+
+func (a *App) GetPayrollJournal(request handlers.PayrollJournalRequest) (*payroll.PayrollJournal, error) {
+	ctx, cancel := context.WithTimeout(a.ctx, 20*time.Second)
+	defer cancel()
+	return a.payrollHandler.GetPayrollJournal(ctx, request)
+}
+
+func (a *App) ExportPayrollJournal(request handlers.ExportPayrollJournalRequest) (*payroll.CSVExport, error) {
+	ctx, cancel := context.WithTimeout(a.ctx, 20*time.Second)
+	defer cancel()
+	return a.payrollHandler.ExportPayrollJournal(ctx, request)
+}
+
+func (a *App) ListProjects(request handlers.ListProjectsRequest) ([]payroll.Project, error) {
+	ctx, cancel := context.WithTimeout(a.ctx, 10*time.Second)
+	defer cancel()
+	return a.payrollHandler.ListProjects(ctx, request)
+}
+
+func (a *App) CreateProject(request handlers.CreateProjectRequest) (*payroll.Project, error) {
+	ctx, cancel := context.WithTimeout(a.ctx, 10*time.Second)
+	defer cancel()
+	return a.payrollHandler.CreateProject(ctx, request)
+}
+
+func (a *App) UpdateProject(request handlers.UpdateProjectRequest) (*payroll.Project, error) {
+	ctx, cancel := context.WithTimeout(a.ctx, 10*time.Second)
+	defer cancel()
+	return a.payrollHandler.UpdateProject(ctx, request)
+}
+
+func (a *App) SetProjectActive(request handlers.SetProjectActiveRequest) (*payroll.Project, error) {
+	ctx, cancel := context.WithTimeout(a.ctx, 10*time.Second)
+	defer cancel()
+	return a.payrollHandler.SetProjectActive(ctx, request)
+}
+
+func (a *App) ListEmployeeProjectAllocations(request handlers.ListEmployeeProjectAllocationsRequest) ([]payroll.EmployeeProjectAllocation, error) {
+	ctx, cancel := context.WithTimeout(a.ctx, 10*time.Second)
+	defer cancel()
+	return a.payrollHandler.ListEmployeeProjectAllocations(ctx, request)
+}
+
+func (a *App) SetEmployeeProjectAllocations(request handlers.SetEmployeeProjectAllocationsRequest) ([]payroll.EmployeeProjectAllocation, error) {
+	ctx, cancel := context.WithTimeout(a.ctx, 10*time.Second)
+	defer cancel()
+	return a.payrollHandler.SetEmployeeProjectAllocations(ctx, request)
+}
+
+func (a *App) GetProjectChargingReport(request handlers.ProjectChargingReportRequest) (*payroll.ProjectChargingReport, error) {
+	ctx, cancel := context.WithTimeout(a.ctx, 20*time.Second)
+	defer cancel()
+	return a.payrollHandler.GetProjectChargingReport(ctx, request)
+}
+
+func (a *App) GetAnnualTaxSummary(request handlers.AnnualTaxSummaryRequest) (*payroll.AnnualTaxSummary, error) {
+	ctx, cancel := context.WithTimeout(a.ctx, 20*time.Second)
+	defer cancel()
+	return a.payrollHandler.GetAnnualTaxSummary(ctx, request)
+}
+
+func (a *App) SimulatePayroll(request handlers.SimulatePayrollRequest) (*payroll.PayrollSimulation, error) {
+	ctx, cancel := context.WithTimeout(a.ctx, 20*time.Second)
+	defer cancel()
+	return a.payrollHandler.SimulatePayroll(ctx, request)
+}
+
+func (a *App) ExportProjectChargingCSV(request handlers.ProjectChargingReportRequest) (*payroll.CSVExport, error) {
+	ctx, cancel := context.WithTimeout(a.ctx, 20*time.Second)
+	defer cancel()
+	return a.payrollHandler.ExportProjectChargingCSV(ctx, request)
+}
+
+func (a *App) GetPayrollVarianceReport(request handlers.PayrollVarianceRequest) (*payroll.VarianceReport, error) {
+	ctx, cancel := context.WithTimeout(a.ctx, 10*time.Second)
+	defer cancel()
+	return a.payrollHandler.GetPayrollVarianceReport(ctx, request)
+}
+
+func (a *App) AcknowledgePayrollVariance(request handlers.PayrollBatchActionRequest) (*payroll.PayrollBatch, error) {
+	ctx, cancel := context.WithTimeout(a.ctx, 10*time.Second)
+	defer cancel()
+	return a.payrollHandler.AcknowledgePayrollVariance(ctx, request)
+}
+
+func (a *App) ExportPayrollVarianceCSV(request handlers.PayrollVarianceRequest) (*payroll.CSVExport, error) {
+	ctx, cancel := context.WithTimeout(a.ctx, 20*time.Second)
+	defer cancel()
+	return a.payrollHandler.ExportPayrollVarianceCSV(ctx, request)
+}
+
+func (a *App) CalculateRetroArrears(request handlers.RetroArrearsRequest) ([]payroll.RetroArrears, error) {
+	ctx, cancel := context.WithTimeout(a.ctx, 10*time.Second)
+	defer cancel()
+	return a.payrollHandler.CalculateRetroArrears(ctx, request)
+}
+
+func (a *App) ListLoans(request handlers.ListLoansRequest) ([]payroll.EmployeeLoan, error) {
+	ctx, cancel := context.WithTimeout(a.ctx, 10*time.Second)
+	defer cancel()
+	return a.payrollHandler.ListLoans(ctx, request)
+}
+
+func (a *App) CreateLoan(request handlers.CreateLoanRequest) (*payroll.EmployeeLoan, error) {
+	ctx, cancel := context.WithTimeout(a.ctx, 10*time.Second)
+	defer cancel()
+	return a.payrollHandler.CreateLoan(ctx, request)
+}
+
+func (a *App) CancelLoan(request handlers.LoanActionRequest) (*payroll.EmployeeLoan, error) {
+	ctx, cancel := context.WithTimeout(a.ctx, 10*time.Second)
+	defer cancel()
+	return a.payrollHandler.CancelLoan(ctx, request)
+}
+
+func (a *App) GetLoanLedger(request handlers.LoanActionRequest) (*payroll.LoanLedger, error) {
+	ctx, cancel := context.WithTimeout(a.ctx, 10*time.Second)
+	defer cancel()
+	return a.payrollHandler.GetLoanLedger(ctx, request)
+}
+
+func (a *App) ExportLoanLedgerCSV(request handlers.ExportLoanLedgerRequest) (*payroll.CSVExport, error) {
+	ctx, cancel := context.WithTimeout(a.ctx, 20*time.Second)
+	defer cancel()
+	return a.payrollHandler.ExportLoanLedgerCSV(ctx, request)
+}
+
+func (a *App) RenderPayslipPDF(request handlers.RenderPayslipPDFRequest) (*payslips.FileExport, error) {
+	ctx, cancel := context.WithTimeout(a.ctx, 20*time.Second)
+	defer cancel()
+	return a.payslipsHandler.RenderPayslipPDF(ctx, request)
+}
+
+func (a *App) RenderBatchPayslipsZip(request handlers.RenderBatchPayslipsRequest) (*payslips.FileExport, error) {
+	ctx, cancel := context.WithTimeout(a.ctx, 20*time.Second)
+	defer cancel()
+	return a.payslipsHandler.RenderBatchPayslipsZip(ctx, request)
+}
+
+func (a *App) RenderTaxCertificatePDF(request handlers.RenderTaxCertificateRequest) (*payslips.FileExport, error) {
+	ctx, cancel := context.WithTimeout(a.ctx, 20*time.Second)
+	defer cancel()
+	return a.payslipsHandler.RenderTaxCertificatePDF(ctx, request)
+}
+
+func (a *App) RenderTaxCertificatesZip(request handlers.AnnualTaxSummaryRequest) (*payslips.FileExport, error) {
+	ctx, cancel := context.WithTimeout(a.ctx, 20*time.Second)
+	defer cancel()
+	return a.payslipsHandler.RenderTaxCertificatesZip(ctx, request)
+}
+
+func (a *App) ExportAnnualTaxReturnCSV(request handlers.AnnualTaxSummaryRequest) (*payslips.FileExport, error) {
+	ctx, cancel := context.WithTimeout(a.ctx, 20*time.Second)
+	defer cancel()
+	return a.payslipsHandler.ExportAnnualTaxReturnCSV(ctx, request)
+}
+
 func (a *App) SaveFileWithDialog(request SaveFileWithDialogRequest) (*SaveFileWithDialogResult, error) {
 	filename := strings.TrimSpace(request.SuggestedFilename)
 	if filename == "" {
@@ -622,13 +1067,29 @@ func (a *App) SaveFileWithDialog(request SaveFileWithDialogRequest) (*SaveFileWi
 		DefaultFilename: filename,
 	}
 
-	if strings.Contains(strings.ToLower(request.MimeType), "text/csv") {
+	mimeType := strings.ToLower(request.MimeType)
+	switch {
+	case strings.Contains(mimeType, "text/csv"):
 		options.Filters = []runtime.FileFilter{
 			{
 				DisplayName: "CSV Files (*.csv)",
 				Pattern:     "*.csv",
 			},
 		}
+	case strings.Contains(mimeType, "application/pdf"):
+		options.Filters = []runtime.FileFilter{
+			{
+				DisplayName: "PDF Files (*.pdf)",
+				Pattern:     "*.pdf",
+			},
+		}
+	case strings.Contains(mimeType, "application/zip"):
+		options.Filters = []runtime.FileFilter{
+			{
+				DisplayName: "ZIP Archives (*.zip)",
+				Pattern:     "*.zip",
+			},
+		}
 	}
 
 	path, err := runtime.SaveFileDialog(a.ctx, options)
@@ -836,6 +1297,18 @@ func (a *App) RemoveCompanyLogo(request handlers.GetSettingsRequest) (*settings.
 	return a.settingsHandler.RemoveCompanyLogo(ctx, request)
 }
 
+func (a *App) GetPayrollTaxSettings(request handlers.GetSettingsRequest) (*settings.PayrollTaxSettings, error) {
+	ctx, cancel := context.WithTimeout(a.ctx, 10*time.Second)
+	defer cancel()
+	return a.settingsHandler.GetPayrollTaxSettings(ctx, request)
+}
+
+func (a *App) SavePayrollTaxSettings(request handlers.SavePayrollTaxSettingsRequest) (*settings.PayrollTaxSettings, error) {
+	ctx, cancel := context.WithTimeout(a.ctx, 10*time.Second)
+	defer cancel()
+	return a.settingsHandler.SavePayrollTaxSettings(ctx, request)
+}
+
 func (a *App) GetCompanyLogo(request handlers.GetCompanyLogoRequest) (*settings.CompanyLogo, error) {
 	ctx, cancel := context.WithTimeout(a.ctx, 20*time.Second)
 	defer cancel()
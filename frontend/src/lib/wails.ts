@@ -5,9 +5,11 @@ import type { AttendanceRecord, AttendanceRow, LunchSummary, PostAbsentToLeaveRe
 import type { Department, ListDepartmentsQuery, ListDepartmentsResult, UpsertDepartmentInput } from '../types/departments'
 import type { DashboardSummary } from '../types/dashboard'
 import type { Employee, ListEmployeesQuery, ListEmployeesResult, UpsertEmployeeInput } from '../types/employees'
+import type { Holiday, HolidayImportResult, HolidayOccurrence, HolidayUpsertInput } from '../types/holidays'
 import type {
   ApplyLeaveInput,
   LeaveBalance,
+  LeaveDaysPreview,
   LeaveEntitlement,
   LeaveLockedDate,
   LeaveRequest,
@@ -17,11 +19,27 @@ import type {
   UpsertEntitlementInput,
 } from '../types/leave'
 import type {
+  AnnualTaxSummary,
+  BatchIntegrityReport,
+  CreatePayrollBatchInput,
+  EmployeeProjectAllocation,
+  EntryAmountsImportResult,
+  FileExportResult,
+  GLAccountMapping,
+  GLAccountMappingInput,
   ListPayrollBatchesFilter,
   ListPayrollBatchesResult,
   PayrollBatch,
   PayrollBatchDetail,
   PayrollEntry,
+  PayrollJournal,
+  PayrollJournalFormat,
+  PayrollSimulation,
+  PayrollSimulationInput,
+  Project,
+  ProjectAllocationInput,
+  ProjectChargingReport,
+  ProjectUpsertInput,
   UpdatePayrollEntryAmountsInput,
 } from '../types/payroll'
 import type {
@@ -38,6 +56,7 @@ import type {
   PayrollBatchesReportFilter,
   PayrollBatchesReportResult,
 } from '../types/reports'
+import type { AssignWorkScheduleInput, EmployeeWorkSchedule, WorkSchedule, WorkScheduleUpsertInput } from '../types/schedules'
 import type { AppSettings, CompanyLogo, CompanyProfile, SaveCompanyProfileInput, UpdateSettingsInput } from '../types/settings'
 import type { DatabaseConfigInput, StartupHealth } from '../types/startup'
 import type { CreateUserInput, ListUsersQuery, ListUsersResult, ManagedUser, UpdateUserInput } from '../types/users'
@@ -94,10 +113,26 @@ type WailsAppBinding = {
   LockDate: (input: { accessToken: string; date: string; reason: string }) => Promise<LeaveLockedDate>
   UnlockDate: (input: { accessToken: string; date: string }) => Promise<void>
 
+  ListHolidays: (input: { accessToken: string; activeOnly: boolean }) => Promise<Holiday[]>
+  ListHolidayCalendar: (input: { accessToken: string; year: number }) => Promise<HolidayOccurrence[]>
+  CreateHoliday: (input: { accessToken: string; payload: HolidayUpsertInput }) => Promise<Holiday>
+  UpdateHoliday: (input: { accessToken: string; id: number; payload: HolidayUpsertInput }) => Promise<Holiday>
+  SetHolidayActive: (input: { accessToken: string; id: number; active: boolean }) => Promise<Holiday>
+  DeleteHoliday: (input: { accessToken: string; id: number }) => Promise<void>
+  ImportHolidays: (input: { accessToken: string; year: number; data: string }) => Promise<HolidayImportResult>
+  ListWorkSchedules: (input: { accessToken: string; activeOnly: boolean }) => Promise<WorkSchedule[]>
+  CreateWorkSchedule: (input: { accessToken: string; payload: WorkScheduleUpsertInput }) => Promise<WorkSchedule>
+  UpdateWorkSchedule: (input: { accessToken: string; id: number; payload: WorkScheduleUpsertInput }) => Promise<WorkSchedule>
+  SetWorkScheduleActive: (input: { accessToken: string; id: number; active: boolean }) => Promise<WorkSchedule>
+  ListEmployeeWorkSchedules: (input: { accessToken: string; employeeId: number }) => Promise<EmployeeWorkSchedule[]>
+  AssignWorkSchedule: (input: { accessToken: string; payload: AssignWorkScheduleInput }) => Promise<EmployeeWorkSchedule>
+  DeleteEmployeeWorkSchedule: (input: { accessToken: string; id: number }) => Promise<void>
+
   GetMyLeaveBalance: (input: { accessToken: string; year: number }) => Promise<LeaveBalance>
   GetLeaveBalance: (input: { accessToken: string; employeeId: number; year: number }) => Promise<LeaveBalance>
   UpsertEntitlement: (input: { accessToken: string; payload: UpsertEntitlementInput }) => Promise<LeaveEntitlement>
 
+  PreviewLeaveDays: (input: { accessToken: string; payload: ApplyLeaveInput }) => Promise<LeaveDaysPreview>
   ApplyLeave: (input: { accessToken: string; payload: ApplyLeaveInput }) => Promise<LeaveRequest>
   ListMyLeaveRequests: (input: { accessToken: string; filter?: ListLeaveRequestsFilter }) => Promise<LeaveRequest[]>
   ListAllLeaveRequests: (input: { accessToken: string; filter?: ListLeaveRequestsFilter }) => Promise<LeaveRequest[]>
@@ -106,7 +141,7 @@ type WailsAppBinding = {
   CancelLeave: (input: { accessToken: string; id: number }) => Promise<LeaveRequest>
 
   ListPayrollBatches: (input: { accessToken: string; filter: ListPayrollBatchesFilter }) => Promise<ListPayrollBatchesResult>
-  CreatePayrollBatch: (input: { accessToken: string; payload: { month: string } }) => Promise<PayrollBatch>
+  CreatePayrollBatch: (input: { accessToken: string; payload: CreatePayrollBatchInput }) => Promise<PayrollBatch>
   GetPayrollBatch: (input: { accessToken: string; batchId: number }) => Promise<PayrollBatchDetail>
   GeneratePayrollEntries: (input: { accessToken: string; batchId: number }) => Promise<void>
   UpdatePayrollEntryAmounts: (input: {
@@ -114,9 +149,42 @@ type WailsAppBinding = {
     entryId: number
     payload: UpdatePayrollEntryAmountsInput
   }) => Promise<PayrollEntry>
-  ApprovePayrollBatch: (input: { accessToken: string; batchId: number }) => Promise<PayrollBatch>
+  ApprovePayrollBatch: (input: { accessToken: string; batchId: number; comment: string }) => Promise<PayrollBatch>
+  RejectPayrollBatch: (input: { accessToken: string; batchId: number; comment: string }) => Promise<PayrollBatch>
+  ReopenPayrollBatch: (input: { accessToken: string; batchId: number; reason: string }) => Promise<PayrollBatch>
+  ReversePayrollBatch: (input: { accessToken: string; batchId: number; reason: string }) => Promise<PayrollBatch>
   LockPayrollBatch: (input: { accessToken: string; batchId: number }) => Promise<PayrollBatch>
+  VerifyPayrollBatchIntegrity: (input: { accessToken: string; batchId: number }) => Promise<BatchIntegrityReport>
+  ImportPayrollEntryAmounts: (input: { accessToken: string; batchId: number; data: string }) => Promise<EntryAmountsImportResult>
   ExportPayrollBatchCSV: (input: { accessToken: string; batchId: number }) => Promise<CSVExportResult>
+  ListGLAccountMappings: (input: { accessToken: string }) => Promise<GLAccountMapping[]>
+  CreateGLAccountMapping: (input: { accessToken: string; payload: GLAccountMappingInput }) => Promise<GLAccountMapping>
+  UpdateGLAccountMapping: (input: { accessToken: string; id: number; payload: GLAccountMappingInput }) => Promise<GLAccountMapping>
+  DeleteGLAccountMapping: (input: { accessToken: string; id: number }) => Promise<void>
+  GetPayrollJournal: (input: { accessToken: string; batchId: number }) => Promise<PayrollJournal>
+  ExportPayrollJournal: (input: { accessToken: string; batchId: number; format: PayrollJournalFormat }) => Promise<CSVExportResult>
+  ListProjects: (input: { accessToken: string; activeOnly: boolean }) => Promise<Project[]>
+  CreateProject: (input: { accessToken: string; payload: ProjectUpsertInput }) => Promise<Project>
+  UpdateProject: (input: { accessToken: string; id: number; payload: ProjectUpsertInput }) => Promise<Project>
+  SetProjectActive: (input: { accessToken: string; id: number; active: boolean }) => Promise<Project>
+  ListEmployeeProjectAllocations: (input: { accessToken: string; employeeId: number }) => Promise<EmployeeProjectAllocation[]>
+  SetEmployeeProjectAllocations: (input: {
+    accessToken: string
+    employeeId: number
+    allocations: ProjectAllocationInput[]
+  }) => Promise<EmployeeProjectAllocation[]>
+  GetProjectChargingReport: (input: { accessToken: string; month: string; projectId: number }) => Promise<ProjectChargingReport>
+  ExportProjectChargingCSV: (input: { accessToken: string; month: string; projectId: number }) => Promise<CSVExportResult>
+  GetAnnualTaxSummary: (input: { accessToken: string; year: number; startMonth: number }) => Promise<AnnualTaxSummary>
+  RenderTaxCertificatePDF: (input: {
+    accessToken: string
+    year: number
+    startMonth: number
+    employeeId: number
+  }) => Promise<FileExportResult>
+  RenderTaxCertificatesZip: (input: { accessToken: string; year: number; startMonth: number }) => Promise<FileExportResult>
+  ExportAnnualTaxReturnCSV: (input: { accessToken: string; year: number; startMonth: number }) => Promise<FileExportResult>
+  SimulatePayroll: (input: { accessToken: string; payload: PayrollSimulationInput }) => Promise<PayrollSimulation>
   SaveFileWithDialog: (input: {
     suggestedFilename: string
     dataBytes: number[]
@@ -324,6 +392,62 @@ export class WailsGateway implements AppGateway {
     await getAppBinding().UnlockDate({ accessToken, date })
   }
 
+  async listHolidays(accessToken: string, activeOnly: boolean): Promise<Holiday[]> {
+    return getAppBinding().ListHolidays({ accessToken, activeOnly })
+  }
+
+  async listHolidayCalendar(accessToken: string, year: number): Promise<HolidayOccurrence[]> {
+    return getAppBinding().ListHolidayCalendar({ accessToken, year })
+  }
+
+  async createHoliday(accessToken: string, payload: HolidayUpsertInput): Promise<Holiday> {
+    return getAppBinding().CreateHoliday({ accessToken, payload })
+  }
+
+  async updateHoliday(accessToken: string, id: number, payload: HolidayUpsertInput): Promise<Holiday> {
+    return getAppBinding().UpdateHoliday({ accessToken, id, payload })
+  }
+
+  async setHolidayActive(accessToken: string, id: number, active: boolean): Promise<Holiday> {
+    return getAppBinding().SetHolidayActive({ accessToken, id, active })
+  }
+
+  async deleteHoliday(accessToken: string, id: number): Promise<void> {
+    await getAppBinding().DeleteHoliday({ accessToken, id })
+  }
+
+  async importHolidays(accessToken: string, year: number, data: string): Promise<HolidayImportResult> {
+    return getAppBinding().ImportHolidays({ accessToken, year, data })
+  }
+
+  async listWorkSchedules(accessToken: string, activeOnly: boolean): Promise<WorkSchedule[]> {
+    return getAppBinding().ListWorkSchedules({ accessToken, activeOnly })
+  }
+
+  async createWorkSchedule(accessToken: string, payload: WorkScheduleUpsertInput): Promise<WorkSchedule> {
+    return getAppBinding().CreateWorkSchedule({ accessToken, payload })
+  }
+
+  async updateWorkSchedule(accessToken: string, id: number, payload: WorkScheduleUpsertInput): Promise<WorkSchedule> {
+    return getAppBinding().UpdateWorkSchedule({ accessToken, id, payload })
+  }
+
+  async setWorkScheduleActive(accessToken: string, id: number, active: boolean): Promise<WorkSchedule> {
+    return getAppBinding().SetWorkScheduleActive({ accessToken, id, active })
+  }
+
+  async listEmployeeWorkSchedules(accessToken: string, employeeId: number): Promise<EmployeeWorkSchedule[]> {
+    return getAppBinding().ListEmployeeWorkSchedules({ accessToken, employeeId })
+  }
+
+  async assignWorkSchedule(accessToken: string, payload: AssignWorkScheduleInput): Promise<EmployeeWorkSchedule> {
+    return getAppBinding().AssignWorkSchedule({ accessToken, payload })
+  }
+
+  async deleteEmployeeWorkSchedule(accessToken: string, id: number): Promise<void> {
+    await getAppBinding().DeleteEmployeeWorkSchedule({ accessToken, id })
+  }
+
   async getMyLeaveBalance(accessToken: string, year: number): Promise<LeaveBalance> {
     return getAppBinding().GetMyLeaveBalance({ accessToken, year })
   }
@@ -336,6 +460,10 @@ export class WailsGateway implements AppGateway {
     return getAppBinding().UpsertEntitlement({ accessToken, payload })
   }
 
+  async previewLeaveDays(accessToken: string, payload: ApplyLeaveInput): Promise<LeaveDaysPreview> {
+    return getAppBinding().PreviewLeaveDays({ accessToken, payload })
+  }
+
   async applyLeave(accessToken: string, payload: ApplyLeaveInput): Promise<LeaveRequest> {
     return getAppBinding().ApplyLeave({ accessToken, payload })
   }
@@ -364,8 +492,8 @@ export class WailsGateway implements AppGateway {
     return getAppBinding().ListPayrollBatches({ accessToken, filter })
   }
 
-  async createPayrollBatch(accessToken: string, month: string): Promise<PayrollBatch> {
-    return getAppBinding().CreatePayrollBatch({ accessToken, payload: { month } })
+  async createPayrollBatch(accessToken: string, payload: CreatePayrollBatchInput): Promise<PayrollBatch> {
+    return getAppBinding().CreatePayrollBatch({ accessToken, payload })
   }
 
   async getPayrollBatch(accessToken: string, batchId: number): Promise<PayrollBatchDetail> {
@@ -384,18 +512,123 @@ export class WailsGateway implements AppGateway {
     return getAppBinding().UpdatePayrollEntryAmounts({ accessToken, entryId, payload })
   }
 
-  async approvePayrollBatch(accessToken: string, batchId: number): Promise<PayrollBatch> {
-    return getAppBinding().ApprovePayrollBatch({ accessToken, batchId })
+  async approvePayrollBatch(accessToken: string, batchId: number, comment = ''): Promise<PayrollBatch> {
+    return getAppBinding().ApprovePayrollBatch({ accessToken, batchId, comment })
+  }
+
+  async rejectPayrollBatch(accessToken: string, batchId: number, comment: string): Promise<PayrollBatch> {
+    return getAppBinding().RejectPayrollBatch({ accessToken, batchId, comment })
+  }
+
+  async reopenPayrollBatch(accessToken: string, batchId: number, reason: string): Promise<PayrollBatch> {
+    return getAppBinding().ReopenPayrollBatch({ accessToken, batchId, reason })
+  }
+
+  async reversePayrollBatch(accessToken: string, batchId: number, reason: string): Promise<PayrollBatch> {
+    return getAppBinding().ReversePayrollBatch({ accessToken, batchId, reason })
   }
 
   async lockPayrollBatch(accessToken: string, batchId: number): Promise<PayrollBatch> {
     return getAppBinding().LockPayrollBatch({ accessToken, batchId })
   }
 
+  async verifyPayrollBatchIntegrity(accessToken: string, batchId: number): Promise<BatchIntegrityReport> {
+    return getAppBinding().VerifyPayrollBatchIntegrity({ accessToken, batchId })
+  }
+
+  async importPayrollEntryAmounts(accessToken: string, batchId: number, data: string): Promise<EntryAmountsImportResult> {
+    return getAppBinding().ImportPayrollEntryAmounts({ accessToken, batchId, data })
+  }
+
   async exportPayrollBatchCSV(accessToken: string, batchId: number): Promise<CSVExportResult> {
     return getAppBinding().ExportPayrollBatchCSV({ accessToken, batchId })
   }
 
+  async listGLAccountMappings(accessToken: string): Promise<GLAccountMapping[]> {
+    return getAppBinding().ListGLAccountMappings({ accessToken })
+  }
+
+  async createGLAccountMapping(accessToken: string, payload: GLAccountMappingInput): Promise<GLAccountMapping> {
+    return getAppBinding().CreateGLAccountMapping({ accessToken, payload })
+  }
+
+  async updateGLAccountMapping(accessToken: string, id: number, payload: GLAccountMappingInput): Promise<GLAccountMapping> {
+    return getAppBinding().UpdateGLAccountMapping({ accessToken, id, payload })
+  }
+
+  async deleteGLAccountMapping(accessToken: string, id: number): Promise<void> {
+    await getAppBinding().DeleteGLAccountMapping({ accessToken, id })
+  }
+
+  async getPayrollJournal(accessToken: string, batchId: number): Promise<PayrollJournal> {
+    return getAppBinding().GetPayrollJournal({ accessToken, batchId })
+  }
+
+  async exportPayrollJournal(accessToken: string, batchId: number, format: PayrollJournalFormat): Promise<CSVExportResult> {
+    return getAppBinding().ExportPayrollJournal({ accessToken, batchId, format })
+  }
+
+  async listProjects(accessToken: string, activeOnly: boolean): Promise<Project[]> {
+    return getAppBinding().ListProjects({ accessToken, activeOnly })
+  }
+
+  async createProject(accessToken: string, payload: ProjectUpsertInput): Promise<Project> {
+    return getAppBinding().CreateProject({ accessToken, payload })
+  }
+
+  async updateProject(accessToken: string, id: number, payload: ProjectUpsertInput): Promise<Project> {
+    return getAppBinding().UpdateProject({ accessToken, id, payload })
+  }
+
+  async setProjectActive(accessToken: string, id: number, active: boolean): Promise<Project> {
+    return getAppBinding().SetProjectActive({ accessToken, id, active })
+  }
+
+  async listEmployeeProjectAllocations(accessToken: string, employeeId: number): Promise<EmployeeProjectAllocation[]> {
+    return getAppBinding().ListEmployeeProjectAllocations({ accessToken, employeeId })
+  }
+
+  async setEmployeeProjectAllocations(
+    accessToken: string,
+    employeeId: number,
+    allocations: ProjectAllocationInput[],
+  ): Promise<EmployeeProjectAllocation[]> {
+    return getAppBinding().SetEmployeeProjectAllocations({ accessToken, employeeId, allocations })
+  }
+
+  async getProjectChargingReport(accessToken: string, month: string, projectId: number): Promise<ProjectChargingReport> {
+    return getAppBinding().GetProjectChargingReport({ accessToken, month, projectId })
+  }
+
+  async exportProjectChargingCSV(accessToken: string, month: string, projectId: number): Promise<CSVExportResult> {
+    return getAppBinding().ExportProjectChargingCSV({ accessToken, month, projectId })
+  }
+
+  async getAnnualTaxSummary(accessToken: string, year: number, startMonth: number): Promise<AnnualTaxSummary> {
+    return getAppBinding().GetAnnualTaxSummary({ accessToken, year, startMonth })
+  }
+
+  async renderTaxCertificatePDF(
+    accessToken: string,
+    year: number,
+    startMonth: number,
+    employeeId: number,
+  ): Promise<FileExportResult> {
+    return getAppBinding().RenderTaxCertificatePDF({ accessToken, year, startMonth, employeeId })
+  }
+
+  async renderTaxCertificatesZip(accessToken: string, year: number, startMonth: number): Promise<FileExportResult> {
+    return getAppBinding().RenderTaxCertificatesZip({ accessToken, year, startMonth })
+  }
+
+  async exportAnnualTaxReturnCSV(accessToken: string, year: number, startMonth: number): Promise<FileExportResult> {
+    return getAppBinding().ExportAnnualTaxReturnCSV({ accessToken, year, startMonth })
+  }
+
+  async simulatePayroll(accessToken: string, payload: PayrollSimulationInput): Promise<PayrollSimulation> {
+    return getAppBinding().SimulatePayroll({ accessToken, payload })
+  }
+
   async saveFileWithDialog(
     suggestedFilename: string,
     dataBytes: number[],
@@ -52,6 +52,12 @@ function toLabel(status: AttendanceStatus): string {
   return 'Leave'
 }
 
+function expectedDayLabel(expectedDay: number): string {
+  if (expectedDay <= 0) return 'Not scheduled'
+  if (expectedDay < 1) return 'Half day'
+  return 'Full day'
+}
+
 export function AttendancePage() {
   const router = useRouter()
   const session = router.options.context.auth.getSnapshot()
@@ -141,6 +147,18 @@ export function AttendancePage() {
         minWidth: 140,
         renderCell: ({ row }) => <Chip size="small" label={toLabel(row.status)} color={statusChipColor(row.status)} />,
       },
+      {
+        field: 'expectedDay',
+        headerName: 'Scheduled',
+        minWidth: 140,
+        renderCell: ({ row }) => (
+          <Chip
+            size="small"
+            variant={row.expectedDay > 0 ? 'filled' : 'outlined'}
+            label={expectedDayLabel(row.expectedDay)}
+          />
+        ),
+      },
       {
         field: 'isLocked',
         headerName: 'Lock',
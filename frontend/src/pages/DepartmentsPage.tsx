@@ -27,17 +27,20 @@ import type { Department, UpsertDepartmentInput } from '../types/departments'
 type FormState = {
   name: string
   description: string
+  costCentre: string
 }
 
 const initialFormState: FormState = {
   name: '',
   description: '',
+  costCentre: '',
 }
 
 function toPayload(state: FormState): UpsertDepartmentInput {
   return {
     name: state.name,
     description: state.description || undefined,
+    costCentre: state.costCentre || undefined,
   }
 }
 
@@ -118,7 +121,11 @@ export function DepartmentsPage() {
 
   const onEdit = (department: Department) => {
     setEditing(department)
-    setFormState({ name: department.name, description: department.description ?? '' })
+    setFormState({
+      name: department.name,
+      description: department.description ?? '',
+      costCentre: department.costCentre ?? '',
+    })
     setFormError('')
     setIsFormOpen(true)
   }
@@ -148,6 +155,13 @@ export function DepartmentsPage() {
         flex: 1.2,
         valueGetter: (params) => params.row.description ?? '-',
       },
+      {
+        field: 'costCentre',
+        headerName: 'Cost Centre',
+        minWidth: 140,
+        flex: 0.5,
+        valueGetter: (params) => params.row.costCentre ?? '-',
+      },
       { field: 'employeeCount', headerName: 'Employee Count', minWidth: 160, flex: 0.6 },
       {
         field: 'actions',
@@ -242,6 +256,14 @@ export function DepartmentsPage() {
               minRows={3}
               fullWidth
             />
+            <TextField
+              label="Cost Centre"
+              value={formState.costCentre}
+              onChange={(event) => setFormState((prev) => ({ ...prev, costCentre: event.target.value }))}
+              inputProps={{ maxLength: 40 }}
+              helperText="Used on payroll journal lines for this department"
+              fullWidth
+            />
           </Stack>
         </DialogContent>
         <DialogActions>
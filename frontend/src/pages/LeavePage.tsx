@@ -1,4 +1,4 @@
-import { useMemo, useState } from 'react'
+import { type ChangeEvent, useMemo, useRef, useState } from 'react'
 import {
   Alert,
   Box,
@@ -25,34 +25,97 @@ import { useRouter } from '@tanstack/react-router'
 
 import { AppShell } from '../components/AppShell'
 import { isHROrAdminRole } from '../auth/roles'
-import type { ApplyLeaveInput, LeaveRequest } from '../types/leave'
+import type { Holiday, HolidayImportError, HolidayKind, HolidayUpsertInput } from '../types/holidays'
+import type { ApplyLeaveInput, LeaveDayPart, LeaveRequest } from '../types/leave'
+import type { WorkSchedule, WorkScheduleDay, WorkScheduleUpsertInput } from '../types/schedules'
 
-function calculatePreviewWorkingDays(startDate: string, endDate: string): number {
-  if (!startDate || !endDate) {
-    return 0
+const monthNames = ['Jan', 'Feb', 'Mar', 'Apr', 'May', 'Jun', 'Jul', 'Aug', 'Sep', 'Oct', 'Nov', 'Dec']
+
+const emptyHolidayForm: HolidayUpsertInput = { name: '', kind: 'fixed', month: 1, day: 1, easterOffset: 0, holidayDate: '' }
+
+const weekdayNames = ['Sun', 'Mon', 'Tue', 'Wed', 'Thu', 'Fri', 'Sat']
+
+const emptyScheduleForm: WorkScheduleUpsertInput = {
+  name: '',
+  hoursPerDay: 8,
+  isDefault: false,
+  days: [1, 2, 3, 4, 5].map((weekday) => ({ weekday, fraction: 1 })),
+}
+
+function toDateOnly(value: string): string {
+  return value ? value.slice(0, 10) : ''
+}
+
+type SingleDayPart = LeaveDayPart | 'hours'
+
+const emptyApplyForm: ApplyLeaveInput = { leaveTypeId: 0, startDate: '', endDate: '', startDayPart: 'full', endDayPart: 'full', reason: '' }
+
+// applyPayload builds the request from the form. A single day takes the whole day, a half or some hours; a longer
+// request may start in the afternoon and end in the morning.
+function applyPayload(form: ApplyLeaveInput, singleDayPart: SingleDayPart, hours: string): ApplyLeaveInput {
+  const base = { leaveTypeId: form.leaveTypeId, startDate: form.startDate, endDate: form.endDate, reason: form.reason }
+  if (!form.startDate || form.startDate !== form.endDate) {
+    return { ...base, startDayPart: form.startDayPart ?? 'full', endDayPart: form.endDayPart ?? 'full' }
   }
+  if (singleDayPart === 'hours') {
+    return { ...base, hours: Number(hours) }
+  }
+  return { ...base, startDayPart: singleDayPart, endDayPart: singleDayPart }
+}
 
-  const start = new Date(`${startDate}T00:00:00`)
-  const end = new Date(`${endDate}T00:00:00`)
-  if (Number.isNaN(start.getTime()) || Number.isNaN(end.getTime()) || end < start) {
-    return 0
+function dayPartLabel(part: LeaveDayPart | undefined): string {
+  if (part === 'am') {
+    return ' (morning)'
   }
+  if (part === 'pm') {
+    return ' (afternoon)'
+  }
+  return ''
+}
 
-  let days = 0
-  const cursor = new Date(start)
-  while (cursor <= end) {
-    const weekday = cursor.getDay()
-    if (weekday !== 0 && weekday !== 6) {
-      days += 1
-    }
-    cursor.setDate(cursor.getDate() + 1)
+function leaveRangeLabel(request: LeaveRequest): string {
+  const start = toDateOnly(request.startDate)
+  const end = toDateOnly(request.endDate)
+  if (request.hours) {
+    return `${start} (${request.hours}h)`
+  }
+  if (start === end) {
+    return `${start}${dayPartLabel(request.startDayPart)}`
   }
+  return `${start}${dayPartLabel(request.startDayPart)} to ${end}${dayPartLabel(request.endDayPart)}`
+}
 
-  return days
+function describeHoliday(holiday: Holiday): string {
+  if (holiday.kind === 'fixed') {
+    return `Every ${holiday.day} ${monthNames[(holiday.month ?? 1) - 1]}`
+  }
+  if (holiday.kind === 'easter') {
+    const offset = holiday.easterOffset ?? 0
+    if (offset === 0) return 'Easter Sunday'
+    return `${Math.abs(offset)} day${Math.abs(offset) === 1 ? '' : 's'} ${offset < 0 ? 'before' : 'after'} Easter Sunday`
+  }
+  return `Once, ${toDateOnly(holiday.holidayDate ?? '')}`
 }
 
-function toDateOnly(value: string): string {
-  return value ? value.slice(0, 10) : ''
+function holidayPayload(form: HolidayUpsertInput): HolidayUpsertInput {
+  if (form.kind === 'fixed') {
+    return { name: form.name, kind: form.kind, month: form.month, day: form.day }
+  }
+  if (form.kind === 'easter') {
+    return { name: form.name, kind: form.kind, easterOffset: form.easterOffset }
+  }
+  return { name: form.name, kind: form.kind, holidayDate: form.holidayDate }
+}
+
+function describeScheduleDays(days: WorkScheduleDay[]): string {
+  if (days.length === 0) {
+    return 'No working days'
+  }
+  return days.map((day) => (day.fraction < 1 ? `${weekdayNames[day.weekday]} (half)` : weekdayNames[day.weekday])).join(', ')
+}
+
+function scheduleDayFraction(days: WorkScheduleDay[], weekday: number): number {
+  return days.find((day) => day.weekday === weekday)?.fraction ?? 0
 }
 
 function statusColor(status: LeaveRequest['status']): 'default' | 'success' | 'warning' | 'error' {
@@ -72,12 +135,12 @@ export function LeavePage() {
   const [tab, setTab] = useState(0)
   const [snackbar, setSnackbar] = useState<{ message: string; severity: 'success' | 'error' } | null>(null)
 
-  const [applyForm, setApplyForm] = useState<ApplyLeaveInput>({
-    leaveTypeId: 0,
-    startDate: '',
-    endDate: '',
-    reason: '',
-  })
+  const [applyForm, setApplyForm] = useState<ApplyLeaveInput>(emptyApplyForm)
+  const [singleDayPart, setSingleDayPart] = useState<SingleDayPart>('full')
+  const [applyHours, setApplyHours] = useState('')
+  const isSingleDay = Boolean(applyForm.startDate) && applyForm.startDate === applyForm.endDate
+  const applyInput = applyPayload(applyForm, singleDayPart, applyHours)
+  const hoursValid = !isSingleDay || singleDayPart !== 'hours' || Number(applyHours) > 0
 
   const [queueStatusFilter, setQueueStatusFilter] = useState('Pending')
   const [queueDateFrom, setQueueDateFrom] = useState('')
@@ -87,10 +150,36 @@ export function LeavePage() {
   const [lockDate, setLockDate] = useState('')
   const [lockReason, setLockReason] = useState('')
 
-  const previewDays = useMemo(
-    () => calculatePreviewWorkingDays(applyForm.startDate, applyForm.endDate),
-    [applyForm.startDate, applyForm.endDate],
-  )
+  const [holidayYear, setHolidayYear] = useState<number>(new Date().getFullYear())
+  const [holidayForm, setHolidayForm] = useState<HolidayUpsertInput>(emptyHolidayForm)
+  const [holidayImportErrors, setHolidayImportErrors] = useState<HolidayImportError[]>([])
+  const holidayImportInputRef = useRef<HTMLInputElement>(null)
+
+  const [scheduleForm, setScheduleForm] = useState<WorkScheduleUpsertInput>(emptyScheduleForm)
+  const [editingScheduleId, setEditingScheduleId] = useState<number | null>(null)
+  const [assignEmployeeId, setAssignEmployeeId] = useState(0)
+  const [assignScheduleId, setAssignScheduleId] = useState(0)
+  const [assignEffectiveFrom, setAssignEffectiveFrom] = useState('')
+
+  const previewQuery = useQuery({
+    queryKey: [
+      'leave',
+      'preview',
+      applyInput.startDate,
+      applyInput.endDate,
+      applyInput.startDayPart,
+      applyInput.endDayPart,
+      applyInput.hours,
+    ],
+    queryFn: () => router.options.context.api.previewLeaveDays(accessToken, applyInput),
+    enabled:
+      Boolean(accessToken) &&
+      Boolean(applyForm.startDate) &&
+      Boolean(applyForm.endDate) &&
+      applyForm.endDate >= applyForm.startDate &&
+      hoursValid,
+  })
+  const previewDays = previewQuery.data?.workingDays ?? 0
 
   const leaveTypesQuery = useQuery({
     queryKey: ['leave', 'types'],
@@ -127,18 +216,60 @@ export function LeavePage() {
     enabled: Boolean(accessToken) && isAdminOrHR,
   })
 
+  const holidaysQuery = useQuery({
+    queryKey: ['leave', 'holidays'],
+    queryFn: () => router.options.context.api.listHolidays(accessToken, false),
+    enabled: Boolean(accessToken) && isAdminOrHR,
+  })
+
+  const holidayCalendarQuery = useQuery({
+    queryKey: ['leave', 'holiday-calendar', holidayYear],
+    queryFn: () => router.options.context.api.listHolidayCalendar(accessToken, holidayYear),
+    enabled: Boolean(accessToken) && isAdminOrHR,
+  })
+
+  const holidayDates = useMemo(() => {
+    const dates = new Map<number, string>()
+    for (const item of holidayCalendarQuery.data ?? []) {
+      dates.set(item.holidayId, item.date)
+    }
+    return dates
+  }, [holidayCalendarQuery.data])
+
+  const visibleHolidays = useMemo(
+    () =>
+      (holidaysQuery.data ?? []).filter(
+        (item) => item.kind !== 'one_off' || toDateOnly(item.holidayDate ?? '').startsWith(String(holidayYear)),
+      ),
+    [holidaysQuery.data, holidayYear],
+  )
+
+  const workSchedulesQuery = useQuery({
+    queryKey: ['leave', 'work-schedules'],
+    queryFn: () => router.options.context.api.listWorkSchedules(accessToken, false),
+    enabled: Boolean(accessToken) && isAdminOrHR,
+  })
+
+  const scheduleEmployeesQuery = useQuery({
+    queryKey: ['leave', 'schedule-employees'],
+    queryFn: () => router.options.context.api.listEmployees(accessToken, { page: 1, pageSize: 100 }),
+    enabled: Boolean(accessToken) && isAdminOrHR && tab === 5,
+  })
+
+  const employeeSchedulesQuery = useQuery({
+    queryKey: ['leave', 'employee-work-schedules', assignEmployeeId],
+    queryFn: () => router.options.context.api.listEmployeeWorkSchedules(accessToken, assignEmployeeId),
+    enabled: Boolean(accessToken) && isAdminOrHR && assignEmployeeId > 0,
+  })
+
   const applyMutation = useMutation({
-    mutationFn: () =>
-      router.options.context.api.applyLeave(accessToken, {
-        leaveTypeId: applyForm.leaveTypeId,
-        startDate: applyForm.startDate,
-        endDate: applyForm.endDate,
-        reason: applyForm.reason,
-      }),
+    mutationFn: () => router.options.context.api.applyLeave(accessToken, applyInput),
     onSuccess: async () => {
       await router.options.context.queryClient.invalidateQueries({ queryKey: ['leave', 'my-requests'] })
       await router.options.context.queryClient.invalidateQueries({ queryKey: ['leave', 'my-balance'] })
-      setApplyForm({ leaveTypeId: 0, startDate: '', endDate: '', reason: '' })
+      setApplyForm(emptyApplyForm)
+      setSingleDayPart('full')
+      setApplyHours('')
       setSnackbar({ message: 'Leave request submitted', severity: 'success' })
     },
     onError: (error: Error) => {
@@ -208,10 +339,163 @@ export function LeavePage() {
     },
   })
 
+  const refreshHolidays = async () => {
+    await router.options.context.queryClient.invalidateQueries({ queryKey: ['leave', 'holidays'] })
+    await router.options.context.queryClient.invalidateQueries({ queryKey: ['leave', 'holiday-calendar'] })
+  }
+
+  const createHolidayMutation = useMutation({
+    mutationFn: () => router.options.context.api.createHoliday(accessToken, holidayPayload(holidayForm)),
+    onSuccess: async () => {
+      await refreshHolidays()
+      setHolidayForm(emptyHolidayForm)
+      setSnackbar({ message: 'Holiday added', severity: 'success' })
+    },
+    onError: (error: Error) => {
+      setSnackbar({ message: error.message || 'Failed to add holiday', severity: 'error' })
+    },
+  })
+
+  const setHolidayActiveMutation = useMutation({
+    mutationFn: ({ id, active }: { id: number; active: boolean }) =>
+      router.options.context.api.setHolidayActive(accessToken, id, active),
+    onSuccess: async (holiday) => {
+      await refreshHolidays()
+      setSnackbar({ message: holiday.active ? 'Holiday activated' : 'Holiday deactivated', severity: 'success' })
+    },
+    onError: (error: Error) => {
+      setSnackbar({ message: error.message || 'Failed to update holiday', severity: 'error' })
+    },
+  })
+
+  const deleteHolidayMutation = useMutation({
+    mutationFn: (id: number) => router.options.context.api.deleteHoliday(accessToken, id),
+    onSuccess: async () => {
+      await refreshHolidays()
+      setSnackbar({ message: 'Holiday deleted', severity: 'success' })
+    },
+    onError: (error: Error) => {
+      setSnackbar({ message: error.message || 'Failed to delete holiday', severity: 'error' })
+    },
+  })
+
+  const importHolidaysMutation = useMutation({
+    mutationFn: (data: string) => router.options.context.api.importHolidays(accessToken, holidayYear, data),
+    onSuccess: async (result) => {
+      setHolidayImportErrors(result.errors)
+      if (result.errors.length > 0) {
+        return
+      }
+      await refreshHolidays()
+      setSnackbar({
+        message: `Imported ${result.rows} holidays for ${result.year}: ${result.created} added, ${result.skipped} already present`,
+        severity: 'success',
+      })
+    },
+    onError: (error: Error) => {
+      setSnackbar({ message: error.message || 'Failed to import holidays', severity: 'error' })
+    },
+  })
+
+  const refreshWorkSchedules = async () => {
+    await router.options.context.queryClient.invalidateQueries({ queryKey: ['leave', 'work-schedules'] })
+    await router.options.context.queryClient.invalidateQueries({ queryKey: ['leave', 'employee-work-schedules'] })
+    await router.options.context.queryClient.invalidateQueries({ queryKey: ['leave', 'preview'] })
+  }
+
+  const saveScheduleMutation = useMutation({
+    mutationFn: () =>
+      editingScheduleId
+        ? router.options.context.api.updateWorkSchedule(accessToken, editingScheduleId, scheduleForm)
+        : router.options.context.api.createWorkSchedule(accessToken, scheduleForm),
+    onSuccess: async () => {
+      await refreshWorkSchedules()
+      setSnackbar({ message: editingScheduleId ? 'Work schedule updated' : 'Work schedule added', severity: 'success' })
+      setScheduleForm(emptyScheduleForm)
+      setEditingScheduleId(null)
+    },
+    onError: (error: Error) => {
+      setSnackbar({ message: error.message || 'Failed to save work schedule', severity: 'error' })
+    },
+  })
+
+  const setScheduleActiveMutation = useMutation({
+    mutationFn: ({ id, active }: { id: number; active: boolean }) =>
+      router.options.context.api.setWorkScheduleActive(accessToken, id, active),
+    onSuccess: async (schedule) => {
+      await refreshWorkSchedules()
+      setSnackbar({ message: schedule.active ? 'Work schedule activated' : 'Work schedule deactivated', severity: 'success' })
+    },
+    onError: (error: Error) => {
+      setSnackbar({ message: error.message || 'Failed to update work schedule', severity: 'error' })
+    },
+  })
+
+  const assignScheduleMutation = useMutation({
+    mutationFn: () =>
+      router.options.context.api.assignWorkSchedule(accessToken, {
+        employeeId: assignEmployeeId,
+        scheduleId: assignScheduleId,
+        effectiveFrom: assignEffectiveFrom,
+      }),
+    onSuccess: async () => {
+      await refreshWorkSchedules()
+      setAssignEffectiveFrom('')
+      setSnackbar({ message: 'Work schedule assigned', severity: 'success' })
+    },
+    onError: (error: Error) => {
+      setSnackbar({ message: error.message || 'Failed to assign work schedule', severity: 'error' })
+    },
+  })
+
+  const deleteAssignmentMutation = useMutation({
+    mutationFn: (id: number) => router.options.context.api.deleteEmployeeWorkSchedule(accessToken, id),
+    onSuccess: async () => {
+      await refreshWorkSchedules()
+      setSnackbar({ message: 'Work schedule assignment removed', severity: 'success' })
+    },
+    onError: (error: Error) => {
+      setSnackbar({ message: error.message || 'Failed to remove assignment', severity: 'error' })
+    },
+  })
+
+  const setScheduleDay = (weekday: number, fraction: number) => {
+    setScheduleForm((prev) => ({
+      ...prev,
+      days: [...prev.days.filter((day) => day.weekday !== weekday), ...(fraction > 0 ? [{ weekday, fraction }] : [])].sort(
+        (a, b) => a.weekday - b.weekday,
+      ),
+    }))
+  }
+
+  const editSchedule = (schedule: WorkSchedule) => {
+    setEditingScheduleId(schedule.id)
+    setScheduleForm({ name: schedule.name, hoursPerDay: schedule.hoursPerDay, isDefault: schedule.isDefault, days: schedule.days })
+  }
+
+  const handleHolidayImportFile = async (event: ChangeEvent<HTMLInputElement>) => {
+    const file = event.target.files?.[0]
+    event.target.value = ''
+    if (!file) return
+    importHolidaysMutation.mutate(await file.text())
+  }
+
+  const canSubmitHoliday =
+    holidayForm.name.trim() !== '' &&
+    (holidayForm.kind !== 'one_off' || Boolean(holidayForm.holidayDate)) &&
+    !createHolidayMutation.isPending
+
+  const canSubmitSchedule =
+    scheduleForm.name.trim() !== '' && scheduleForm.days.length > 0 && scheduleForm.hoursPerDay > 0 && !saveScheduleMutation.isPending
+
+  const canAssignSchedule =
+    assignEmployeeId > 0 && assignScheduleId > 0 && Boolean(assignEffectiveFrom) && !assignScheduleMutation.isPending
+
   const canSubmitApply =
     applyForm.leaveTypeId > 0 &&
     Boolean(applyForm.startDate) &&
     Boolean(applyForm.endDate) &&
+    hoursValid &&
     previewDays > 0 &&
     !applyMutation.isPending
 
@@ -233,6 +517,32 @@ export function LeavePage() {
                 <Chip label={`Available: ${myBalanceQuery.data.availableDays}`} color="primary" />
               </Stack>
             ) : null}
+            {myBalanceQuery.data && myBalanceQuery.data.types.length > 0 ? (
+              <Table size="small" sx={{ mt: 2 }}>
+                <TableHead>
+                  <TableRow>
+                    <TableCell>Leave Type</TableCell>
+                    <TableCell>Total</TableCell>
+                    <TableCell>Reserved</TableCell>
+                    <TableCell>Approved</TableCell>
+                    <TableCell>Pending</TableCell>
+                    <TableCell>Available</TableCell>
+                  </TableRow>
+                </TableHead>
+                <TableBody>
+                  {myBalanceQuery.data.types.map((item) => (
+                    <TableRow key={item.leaveTypeId}>
+                      <TableCell>{item.leaveTypeName}</TableCell>
+                      <TableCell>{item.totalDays}</TableCell>
+                      <TableCell>{item.reservedDays}</TableCell>
+                      <TableCell>{item.approvedDays}</TableCell>
+                      <TableCell>{item.pendingDays}</TableCell>
+                      <TableCell>{item.availableDays}</TableCell>
+                    </TableRow>
+                  ))}
+                </TableBody>
+              </Table>
+            ) : null}
           </CardContent>
         </Card>
 
@@ -242,6 +552,8 @@ export function LeavePage() {
             <Tab label="My Requests" />
             {isAdminOrHR ? <Tab label="Admin Queue" /> : null}
             {isAdminOrHR ? <Tab label="Locked Dates" /> : null}
+            {isAdminOrHR ? <Tab label="Public Holidays" /> : null}
+            {isAdminOrHR ? <Tab label="Work Schedules" /> : null}
           </Tabs>
 
           <CardContent>
@@ -281,6 +593,56 @@ export function LeavePage() {
                   />
                 </Stack>
 
+                {isSingleDay ? (
+                  <Stack direction={{ xs: 'column', sm: 'row' }} spacing={2}>
+                    <TextField
+                      select
+                      label="Duration"
+                      value={singleDayPart}
+                      onChange={(event) => setSingleDayPart(event.target.value as SingleDayPart)}
+                      fullWidth
+                    >
+                      <MenuItem value="full">Full day</MenuItem>
+                      <MenuItem value="am">Morning</MenuItem>
+                      <MenuItem value="pm">Afternoon</MenuItem>
+                      <MenuItem value="hours">Hours</MenuItem>
+                    </TextField>
+                    {singleDayPart === 'hours' ? (
+                      <TextField
+                        label="Hours"
+                        type="number"
+                        value={applyHours}
+                        onChange={(event) => setApplyHours(event.target.value)}
+                        inputProps={{ min: 0.25, max: 24, step: 0.25 }}
+                        fullWidth
+                      />
+                    ) : null}
+                  </Stack>
+                ) : (
+                  <Stack direction={{ xs: 'column', sm: 'row' }} spacing={2}>
+                    <TextField
+                      select
+                      label="First Day"
+                      value={applyForm.startDayPart ?? 'full'}
+                      onChange={(event) => setApplyForm((prev) => ({ ...prev, startDayPart: event.target.value as LeaveDayPart }))}
+                      fullWidth
+                    >
+                      <MenuItem value="full">Full day</MenuItem>
+                      <MenuItem value="pm">Afternoon only</MenuItem>
+                    </TextField>
+                    <TextField
+                      select
+                      label="Last Day"
+                      value={applyForm.endDayPart ?? 'full'}
+                      onChange={(event) => setApplyForm((prev) => ({ ...prev, endDayPart: event.target.value as LeaveDayPart }))}
+                      fullWidth
+                    >
+                      <MenuItem value="full">Full day</MenuItem>
+                      <MenuItem value="am">Morning only</MenuItem>
+                    </TextField>
+                  </Stack>
+                )}
+
                 <TextField
                   label="Reason"
                   value={applyForm.reason}
@@ -290,9 +652,13 @@ export function LeavePage() {
                   fullWidth
                 />
 
+                {previewQuery.error ? <Alert severity="warning">{previewQuery.error.message}</Alert> : null}
+
                 <Box sx={{ display: 'flex', justifyContent: 'space-between', alignItems: 'center' }}>
                   <Typography variant="body2" color="text.secondary">
-                    Working days preview: <strong>{previewDays}</strong>
+                    Working days preview (days off in your work schedule and public holidays excluded, half days and hours
+                    charged against your daily hours):{' '}
+                    <strong>{previewQuery.isFetching ? '...' : previewDays}</strong>
                   </Typography>
                   <Button variant="contained" onClick={() => applyMutation.mutate()} disabled={!canSubmitApply}>
                     {applyMutation.isPending ? 'Submitting...' : 'Submit Leave Request'}
@@ -323,8 +689,14 @@ export function LeavePage() {
                     {(myRequestsQuery.data ?? []).map((request) => (
                       <TableRow key={request.id}>
                         <TableCell>{request.leaveTypeName}</TableCell>
-                        <TableCell>{toDateOnly(request.startDate)}</TableCell>
-                        <TableCell>{toDateOnly(request.endDate)}</TableCell>
+                        <TableCell>
+                          {toDateOnly(request.startDate)}
+                          {request.hours ? ` (${request.hours}h)` : dayPartLabel(request.startDayPart)}
+                        </TableCell>
+                        <TableCell>
+                          {toDateOnly(request.endDate)}
+                          {request.hours ? '' : dayPartLabel(request.endDayPart)}
+                        </TableCell>
                         <TableCell>{request.workingDays}</TableCell>
                         <TableCell>
                           <Chip size="small" color={statusColor(request.status)} label={request.status} />
@@ -396,9 +768,7 @@ export function LeavePage() {
                       <TableRow key={request.id}>
                         <TableCell>{request.employeeName}</TableCell>
                         <TableCell>{request.leaveTypeName}</TableCell>
-                        <TableCell>
-                          {toDateOnly(request.startDate)} to {toDateOnly(request.endDate)}
-                        </TableCell>
+                        <TableCell>{leaveRangeLabel(request)}</TableCell>
                         <TableCell>
                           <Chip size="small" color={statusColor(request.status)} label={request.status} />
                         </TableCell>
@@ -494,6 +864,357 @@ export function LeavePage() {
                 </Table>
               </Stack>
             ) : null}
+
+            {isAdminOrHR && tab === 4 ? (
+              <Stack spacing={2}>
+                <Typography variant="h6">Public Holidays</Typography>
+                <Typography variant="body2" color="text.secondary">
+                  Public holidays are not working days for leave, attendance reports and payroll proration. Moveable
+                  holidays such as Eid are added once a year, by hand or from a CSV (Date, Name) or ICS calendar file.
+                </Typography>
+                <Stack direction={{ xs: 'column', sm: 'row' }} spacing={2}>
+                  <TextField
+                    type="number"
+                    label="Year"
+                    value={holidayYear}
+                    onChange={(event) => setHolidayYear(Number(event.target.value) || new Date().getFullYear())}
+                    sx={{ maxWidth: 160 }}
+                  />
+                  <Button
+                    variant="outlined"
+                    onClick={() => holidayImportInputRef.current?.click()}
+                    disabled={importHolidaysMutation.isPending}
+                  >
+                    {importHolidaysMutation.isPending ? 'Importing...' : `Import ${holidayYear} Holidays`}
+                  </Button>
+                  <input
+                    ref={holidayImportInputRef}
+                    type="file"
+                    accept=".csv,.ics,text/csv,text/calendar"
+                    hidden
+                    onChange={handleHolidayImportFile}
+                  />
+                </Stack>
+
+                {holidayImportErrors.length > 0 ? (
+                  <Alert severity="error" onClose={() => setHolidayImportErrors([])}>
+                    No holidays were imported. Fix these rows and import the file again.
+                    {holidayImportErrors.map((rowError) => (
+                      <Typography key={`${rowError.row}-${rowError.message}`} variant="body2">
+                        Row {rowError.row}: {rowError.message}
+                      </Typography>
+                    ))}
+                  </Alert>
+                ) : null}
+
+                <Stack direction={{ xs: 'column', sm: 'row' }} spacing={2}>
+                  <TextField
+                    label="Name"
+                    value={holidayForm.name}
+                    onChange={(event) => setHolidayForm((prev) => ({ ...prev, name: event.target.value }))}
+                    sx={{ minWidth: 220 }}
+                  />
+                  <TextField
+                    select
+                    label="Repeats"
+                    value={holidayForm.kind}
+                    onChange={(event) => setHolidayForm((prev) => ({ ...prev, kind: event.target.value as HolidayKind }))}
+                    sx={{ minWidth: 180 }}
+                  >
+                    <MenuItem value="fixed">Every year, same date</MenuItem>
+                    <MenuItem value="easter">Every year, from Easter</MenuItem>
+                    <MenuItem value="one_off">Once</MenuItem>
+                  </TextField>
+                  {holidayForm.kind === 'fixed' ? (
+                    <>
+                      <TextField
+                        select
+                        label="Month"
+                        value={holidayForm.month ?? 1}
+                        onChange={(event) => setHolidayForm((prev) => ({ ...prev, month: Number(event.target.value) }))}
+                        sx={{ minWidth: 110 }}
+                      >
+                        {monthNames.map((name, index) => (
+                          <MenuItem key={name} value={index + 1}>
+                            {name}
+                          </MenuItem>
+                        ))}
+                      </TextField>
+                      <TextField
+                        type="number"
+                        label="Day"
+                        value={holidayForm.day ?? 1}
+                        onChange={(event) => setHolidayForm((prev) => ({ ...prev, day: Number(event.target.value) }))}
+                        inputProps={{ min: 1, max: 31 }}
+                        sx={{ maxWidth: 100 }}
+                      />
+                    </>
+                  ) : null}
+                  {holidayForm.kind === 'easter' ? (
+                    <TextField
+                      type="number"
+                      label="Days from Easter Sunday"
+                      helperText="Good Friday is -2, Easter Monday is 1"
+                      value={holidayForm.easterOffset ?? 0}
+                      onChange={(event) => setHolidayForm((prev) => ({ ...prev, easterOffset: Number(event.target.value) }))}
+                      inputProps={{ min: -60, max: 60 }}
+                      sx={{ maxWidth: 220 }}
+                    />
+                  ) : null}
+                  {holidayForm.kind === 'one_off' ? (
+                    <TextField
+                      label="Date"
+                      type="date"
+                      value={holidayForm.holidayDate ?? ''}
+                      onChange={(event) => setHolidayForm((prev) => ({ ...prev, holidayDate: event.target.value }))}
+                      InputLabelProps={{ shrink: true }}
+                    />
+                  ) : null}
+                  <Button variant="contained" onClick={() => createHolidayMutation.mutate()} disabled={!canSubmitHoliday}>
+                    {createHolidayMutation.isPending ? 'Adding...' : 'Add Holiday'}
+                  </Button>
+                </Stack>
+
+                {visibleHolidays.length === 0 ? <Alert severity="info">No public holidays for this year.</Alert> : null}
+
+                <Table size="small">
+                  <TableHead>
+                    <TableRow>
+                      <TableCell>Name</TableCell>
+                      <TableCell>Rule</TableCell>
+                      <TableCell>Date in {holidayYear}</TableCell>
+                      <TableCell>Status</TableCell>
+                      <TableCell>Actions</TableCell>
+                    </TableRow>
+                  </TableHead>
+                  <TableBody>
+                    {visibleHolidays.map((holiday) => (
+                      <TableRow key={holiday.id}>
+                        <TableCell>{holiday.name}</TableCell>
+                        <TableCell>{describeHoliday(holiday)}</TableCell>
+                        <TableCell>{holidayDates.get(holiday.id) ?? '-'}</TableCell>
+                        <TableCell>
+                          <Chip
+                            size="small"
+                            color={holiday.active ? 'success' : 'default'}
+                            label={holiday.active ? 'Active' : 'Inactive'}
+                          />
+                        </TableCell>
+                        <TableCell>
+                          <Stack direction="row" spacing={1}>
+                            <Button
+                              size="small"
+                              onClick={() => setHolidayActiveMutation.mutate({ id: holiday.id, active: !holiday.active })}
+                              disabled={setHolidayActiveMutation.isPending}
+                            >
+                              {holiday.active ? 'Deactivate' : 'Activate'}
+                            </Button>
+                            <Button
+                              size="small"
+                              color="error"
+                              onClick={() => deleteHolidayMutation.mutate(holiday.id)}
+                              disabled={deleteHolidayMutation.isPending}
+                            >
+                              Delete
+                            </Button>
+                          </Stack>
+                        </TableCell>
+                      </TableRow>
+                    ))}
+                  </TableBody>
+                </Table>
+              </Stack>
+            ) : null}
+            {isAdminOrHR && tab === 5 ? (
+              <Stack spacing={2}>
+                <Typography variant="h6">Work Schedules</Typography>
+                <Typography variant="body2" color="text.secondary">
+                  A work schedule sets the weekdays an employee works, full or half days, and the hours of a full day. Leave
+                  is charged and attendance is expected only on scheduled days. Employees without an assignment follow the
+                  default schedule.
+                </Typography>
+
+                <Stack direction={{ xs: 'column', sm: 'row' }} spacing={2}>
+                  <TextField
+                    label="Name"
+                    value={scheduleForm.name}
+                    onChange={(event) => setScheduleForm((prev) => ({ ...prev, name: event.target.value }))}
+                    sx={{ minWidth: 220 }}
+                  />
+                  <TextField
+                    type="number"
+                    label="Hours per Day"
+                    value={scheduleForm.hoursPerDay}
+                    onChange={(event) => setScheduleForm((prev) => ({ ...prev, hoursPerDay: Number(event.target.value) }))}
+                    inputProps={{ min: 0.5, max: 24, step: 0.5 }}
+                    sx={{ maxWidth: 160 }}
+                  />
+                  <TextField
+                    select
+                    label="Default"
+                    value={scheduleForm.isDefault ? 'yes' : 'no'}
+                    onChange={(event) => setScheduleForm((prev) => ({ ...prev, isDefault: event.target.value === 'yes' }))}
+                    sx={{ minWidth: 120 }}
+                  >
+                    <MenuItem value="no">No</MenuItem>
+                    <MenuItem value="yes">Yes</MenuItem>
+                  </TextField>
+                </Stack>
+
+                <Stack direction={{ xs: 'column', sm: 'row' }} spacing={1}>
+                  {weekdayNames.map((name, weekday) => (
+                    <TextField
+                      key={name}
+                      select
+                      size="small"
+                      label={name}
+                      value={scheduleDayFraction(scheduleForm.days, weekday)}
+                      onChange={(event) => setScheduleDay(weekday, Number(event.target.value))}
+                      sx={{ minWidth: 110 }}
+                    >
+                      <MenuItem value={0}>Off</MenuItem>
+                      <MenuItem value={1}>Full day</MenuItem>
+                      <MenuItem value={0.5}>Half day</MenuItem>
+                    </TextField>
+                  ))}
+                </Stack>
+
+                <Stack direction="row" spacing={1}>
+                  <Button variant="contained" onClick={() => saveScheduleMutation.mutate()} disabled={!canSubmitSchedule}>
+                    {saveScheduleMutation.isPending ? 'Saving...' : editingScheduleId ? 'Save Schedule' : 'Add Schedule'}
+                  </Button>
+                  {editingScheduleId ? (
+                    <Button
+                      onClick={() => {
+                        setEditingScheduleId(null)
+                        setScheduleForm(emptyScheduleForm)
+                      }}
+                    >
+                      Cancel
+                    </Button>
+                  ) : null}
+                </Stack>
+
+                <Table size="small">
+                  <TableHead>
+                    <TableRow>
+                      <TableCell>Name</TableCell>
+                      <TableCell>Working Days</TableCell>
+                      <TableCell>Hours per Day</TableCell>
+                      <TableCell>Status</TableCell>
+                      <TableCell>Actions</TableCell>
+                    </TableRow>
+                  </TableHead>
+                  <TableBody>
+                    {(workSchedulesQuery.data ?? []).map((schedule) => (
+                      <TableRow key={schedule.id}>
+                        <TableCell>{schedule.name}</TableCell>
+                        <TableCell>{describeScheduleDays(schedule.days)}</TableCell>
+                        <TableCell>{schedule.hoursPerDay}</TableCell>
+                        <TableCell>
+                          <Stack direction="row" spacing={1}>
+                            <Chip
+                              size="small"
+                              color={schedule.active ? 'success' : 'default'}
+                              label={schedule.active ? 'Active' : 'Inactive'}
+                            />
+                            {schedule.isDefault ? <Chip size="small" color="primary" label="Default" /> : null}
+                          </Stack>
+                        </TableCell>
+                        <TableCell>
+                          <Stack direction="row" spacing={1}>
+                            <Button size="small" onClick={() => editSchedule(schedule)}>
+                              Edit
+                            </Button>
+                            <Button
+                              size="small"
+                              onClick={() => setScheduleActiveMutation.mutate({ id: schedule.id, active: !schedule.active })}
+                              disabled={setScheduleActiveMutation.isPending || schedule.isDefault}
+                            >
+                              {schedule.active ? 'Deactivate' : 'Activate'}
+                            </Button>
+                          </Stack>
+                        </TableCell>
+                      </TableRow>
+                    ))}
+                  </TableBody>
+                </Table>
+
+                <Typography variant="h6">Employee Schedules</Typography>
+                <Stack direction={{ xs: 'column', sm: 'row' }} spacing={2}>
+                  <TextField
+                    select
+                    label="Employee"
+                    value={assignEmployeeId || ''}
+                    onChange={(event) => setAssignEmployeeId(Number(event.target.value))}
+                    sx={{ minWidth: 240 }}
+                  >
+                    {(scheduleEmployeesQuery.data?.items ?? []).map((employee) => (
+                      <MenuItem key={employee.id} value={employee.id}>{`${employee.firstName} ${employee.lastName}`}</MenuItem>
+                    ))}
+                  </TextField>
+                  <TextField
+                    select
+                    label="Schedule"
+                    value={assignScheduleId || ''}
+                    onChange={(event) => setAssignScheduleId(Number(event.target.value))}
+                    sx={{ minWidth: 220 }}
+                  >
+                    {(workSchedulesQuery.data ?? [])
+                      .filter((schedule) => schedule.active)
+                      .map((schedule) => (
+                        <MenuItem key={schedule.id} value={schedule.id}>
+                          {schedule.name}
+                        </MenuItem>
+                      ))}
+                  </TextField>
+                  <TextField
+                    label="Effective From"
+                    type="date"
+                    value={assignEffectiveFrom}
+                    onChange={(event) => setAssignEffectiveFrom(event.target.value)}
+                    InputLabelProps={{ shrink: true }}
+                  />
+                  <Button variant="contained" onClick={() => assignScheduleMutation.mutate()} disabled={!canAssignSchedule}>
+                    {assignScheduleMutation.isPending ? 'Assigning...' : 'Assign'}
+                  </Button>
+                </Stack>
+
+                {assignEmployeeId > 0 && (employeeSchedulesQuery.data ?? []).length === 0 ? (
+                  <Alert severity="info">This employee follows the default schedule.</Alert>
+                ) : null}
+
+                {(employeeSchedulesQuery.data ?? []).length > 0 ? (
+                  <Table size="small">
+                    <TableHead>
+                      <TableRow>
+                        <TableCell>Schedule</TableCell>
+                        <TableCell>Effective From</TableCell>
+                        <TableCell>Actions</TableCell>
+                      </TableRow>
+                    </TableHead>
+                    <TableBody>
+                      {(employeeSchedulesQuery.data ?? []).map((assignment) => (
+                        <TableRow key={assignment.id}>
+                          <TableCell>{assignment.scheduleName}</TableCell>
+                          <TableCell>{toDateOnly(assignment.effectiveFrom)}</TableCell>
+                          <TableCell>
+                            <Button
+                              size="small"
+                              color="error"
+                              onClick={() => deleteAssignmentMutation.mutate(assignment.id)}
+                              disabled={deleteAssignmentMutation.isPending}
+                            >
+                              Remove
+                            </Button>
+                          </TableCell>
+                        </TableRow>
+                      ))}
+                    </TableBody>
+                  </Table>
+                ) : null}
+              </Stack>
+            ) : null}
           </CardContent>
         </Card>
       </Stack>
@@ -1,4 +1,4 @@
-import { useMemo, useState } from 'react'
+import { useMemo, useRef, useState, type ChangeEvent } from 'react'
 import {
   Alert,
   Box,
@@ -22,7 +22,7 @@ import { AppShell } from '../components/AppShell'
 import { isFinanceOrAdminRole } from '../auth/roles'
 import { saveExportWithDialog } from '../lib/exportSave'
 import { defaultAppSettings, formatPayrollAmount } from '../lib/settings'
-import type { PayrollBatchStatus, PayrollEntry } from '../types/payroll'
+import type { BatchIntegrityReport, EntryImportRowError, PayrollBatchStatus, PayrollEntry } from '../types/payroll'
 
 function formatDate(value?: string): string {
   if (!value) return '-'
@@ -56,6 +56,9 @@ export function PayrollBatchDetailPage() {
   const batchId = Number(params.batchId)
   const [confirm, setConfirm] = useState<null | 'generate' | 'approve' | 'lock'>(null)
   const [snackbar, setSnackbar] = useState<{ message: string; severity: 'success' | 'error' | 'info' } | null>(null)
+  const [importErrors, setImportErrors] = useState<EntryImportRowError[]>([])
+  const [integrityReport, setIntegrityReport] = useState<BatchIntegrityReport | null>(null)
+  const importInputRef = useRef<HTMLInputElement>(null)
 
   const detailQuery = useQuery({
     queryKey: ['payroll', 'batch', batchId],
@@ -108,6 +111,16 @@ export function PayrollBatchDetailPage() {
     },
   })
 
+  const verifyMutation = useMutation({
+    mutationFn: () => router.options.context.api.verifyPayrollBatchIntegrity(accessToken, batchId),
+    onSuccess: (report) => {
+      setIntegrityReport(report)
+    },
+    onError: (error: Error) => {
+      setSnackbar({ message: error.message || 'Failed to verify batch', severity: 'error' })
+    },
+  })
+
   const exportMutation = useMutation({
     mutationFn: () => router.options.context.api.exportPayrollBatchCSV(accessToken, batchId),
     onSuccess: async (result) => {
@@ -123,6 +136,31 @@ export function PayrollBatchDetailPage() {
     },
   })
 
+  const importMutation = useMutation({
+    mutationFn: (data: string) => router.options.context.api.importPayrollEntryAmounts(accessToken, batchId, data),
+    onSuccess: async (result) => {
+      if (result.errors.length > 0) {
+        setImportErrors(result.errors)
+        return
+      }
+      await refreshDetail()
+      setSnackbar({
+        message: `Imported ${result.rows} rows: ${result.updated} updated, ${result.unchanged} unchanged`,
+        severity: 'success',
+      })
+    },
+    onError: (error: Error) => {
+      setSnackbar({ message: error.message || 'Failed to import amounts', severity: 'error' })
+    },
+  })
+
+  const handleImportFile = async (event: ChangeEvent<HTMLInputElement>) => {
+    const file = event.target.files?.[0]
+    event.target.value = ''
+    if (!file) return
+    importMutation.mutate(await file.text())
+  }
+
   const updateEntryMutation = useMutation({
     mutationFn: ({
       entryId,
@@ -133,7 +171,7 @@ export function PayrollBatchDetailPage() {
       entryId: number
       allowancesTotal: number
       deductionsTotal: number
-      taxTotal: number
+      taxTotal?: number
     }) =>
       router.options.context.api.updatePayrollEntryAmounts(accessToken, entryId, {
         allowancesTotal,
@@ -151,6 +189,8 @@ export function PayrollBatchDetailPage() {
 
   const batchStatus = detailQuery.data?.batch.status
   const canEditEntries = canManagePayroll && batchStatus === 'Draft'
+  // Tax is PAYE while a tax table applies to the batch month; otherwise it is entered by hand.
+  const canEditTax = canEditEntries && detailQuery.data?.taxComputed === false
 
   const columns = useMemo<GridColDef<PayrollEntry>[]>(
     () => [
@@ -183,7 +223,7 @@ export function PayrollBatchDetailPage() {
         headerName: 'Tax',
         minWidth: 110,
         type: 'number',
-        editable: canEditEntries,
+        editable: canEditTax,
         valueFormatter: (params) => formatPayrollAmount(toNumber(params.value), appSettings),
       },
       {
@@ -201,7 +241,7 @@ export function PayrollBatchDetailPage() {
         valueFormatter: (params) => formatPayrollAmount(toNumber(params.value), appSettings),
       },
     ],
-    [appSettings, canEditEntries],
+    [appSettings, canEditEntries, canEditTax],
   )
 
   const processRowUpdate = async (newRow: PayrollEntry, oldRow: PayrollEntry): Promise<PayrollEntry> => {
@@ -221,7 +261,7 @@ export function PayrollBatchDetailPage() {
       entryId: newRow.id,
       allowancesTotal,
       deductionsTotal,
-      taxTotal,
+      taxTotal: canEditTax ? taxTotal : undefined,
     })
 
     return {
@@ -295,6 +335,18 @@ export function PayrollBatchDetailPage() {
                   >
                     {detailQuery.data.entries.length > 0 ? 'Regenerate Entries' : 'Generate Entries'}
                   </Button>
+                  <Button
+                    variant="outlined"
+                    onClick={() => importInputRef.current?.click()}
+                    disabled={
+                      detailQuery.data.batch.status !== 'Draft' ||
+                      detailQuery.data.entries.length === 0 ||
+                      importMutation.isPending
+                    }
+                  >
+                    Import Amounts
+                  </Button>
+                  <input ref={importInputRef} type="file" accept=".csv,text/csv" hidden onChange={handleImportFile} />
                   <Button
                     variant="contained"
                     color="success"
@@ -311,6 +363,13 @@ export function PayrollBatchDetailPage() {
                   >
                     Lock
                   </Button>
+                  <Button
+                    variant="outlined"
+                    onClick={() => verifyMutation.mutate()}
+                    disabled={detailQuery.data.batch.status !== 'Locked' || verifyMutation.isPending}
+                  >
+                    Verify Integrity
+                  </Button>
                   <Button
                     variant="outlined"
                     onClick={() => exportMutation.mutate()}
@@ -379,6 +438,56 @@ export function PayrollBatchDetailPage() {
         </DialogActions>
       </Dialog>
 
+      <Dialog open={importErrors.length > 0} onClose={() => setImportErrors([])} maxWidth="sm" fullWidth>
+        <DialogTitle>Import Rejected</DialogTitle>
+        <DialogContent>
+          <Typography variant="body2" color="text.secondary" sx={{ mb: 1.5 }}>
+            No amounts were changed. Fix these rows and import the file again.
+          </Typography>
+          <Stack spacing={0.5}>
+            {importErrors.map((rowError) => (
+              <Typography key={`${rowError.row}-${rowError.message}`} variant="body2">
+                Row {rowError.row}
+                {rowError.employeeId ? ` (employee ${rowError.employeeId})` : ''}: {rowError.message}
+              </Typography>
+            ))}
+          </Stack>
+        </DialogContent>
+        <DialogActions>
+          <Button onClick={() => setImportErrors([])}>Close</Button>
+        </DialogActions>
+      </Dialog>
+
+      <Dialog open={integrityReport !== null} onClose={() => setIntegrityReport(null)} maxWidth="sm" fullWidth>
+        <DialogTitle>Batch Integrity</DialogTitle>
+        <DialogContent>
+          {integrityReport ? (
+            <Stack spacing={1}>
+              <Alert severity={integrityReport.status === 'Verified' ? 'success' : 'warning'}>
+                {integrityReport.status === 'Verified' ? 'The batch matches the seal recorded when it was locked.' : null}
+                {integrityReport.status === 'Drift' ? 'The batch no longer matches the seal recorded when it was locked.' : null}
+                {integrityReport.status === 'Unsealed' ? 'This batch was locked before integrity seals were recorded.' : null}
+                {integrityReport.status === 'Not Locked' ? 'Only locked batches are sealed.' : null}
+              </Alert>
+              {integrityReport.drift.map((item) => (
+                <Typography key={item} variant="body2">
+                  {item}
+                </Typography>
+              ))}
+              <Typography variant="caption" color="text.secondary" sx={{ wordBreak: 'break-all' }}>
+                Stored: {integrityReport.storedHash || '-'}
+              </Typography>
+              <Typography variant="caption" color="text.secondary" sx={{ wordBreak: 'break-all' }}>
+                Computed: {integrityReport.computedHash || '-'}
+              </Typography>
+            </Stack>
+          ) : null}
+        </DialogContent>
+        <DialogActions>
+          <Button onClick={() => setIntegrityReport(null)}>Close</Button>
+        </DialogActions>
+      </Dialog>
+
       <Snackbar open={Boolean(snackbar)} autoHideDuration={3000} onClose={() => setSnackbar(null)}>
         {snackbar ? <Alert severity={snackbar.severity}>{snackbar.message}</Alert> : <span />}
       </Snackbar>
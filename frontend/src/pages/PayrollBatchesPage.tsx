@@ -73,7 +73,7 @@ export function PayrollBatchesPage() {
   })
 
   const createBatchMutation = useMutation({
-    mutationFn: () => router.options.context.api.createPayrollBatch(accessToken, createMonth),
+    mutationFn: () => router.options.context.api.createPayrollBatch(accessToken, { month: createMonth }),
     onSuccess: async () => {
       await router.options.context.queryClient.invalidateQueries({ queryKey: ['payroll', 'batches'] })
       setOpenCreate(false)
@@ -347,6 +347,13 @@ export function ReportsPage() {
         flex: 0.8,
         valueFormatter: (params) => formatPayrollAmount(Number(params.value ?? 0), appSettings),
       },
+      {
+        field: 'integrityStatus',
+        headerName: 'Integrity',
+        minWidth: 120,
+        flex: 0.6,
+        valueFormatter: (params) => String(params.value || '-'),
+      },
     ],
     [appSettings],
   )
@@ -367,7 +374,7 @@ export function ReportsPage() {
     <AppShell title="Reports">
       <Stack spacing={2.5}>
         <Typography variant="body2" color="text.secondary">
-          Unmarked count in attendance summary uses calendar days in range (weekends included).
+          Unmarked count in attendance summary counts the days each employee is scheduled to work in range (days off and public holidays excluded).
         </Typography>
 
         <Paper sx={{ p: 1.2 }}>
@@ -31,6 +31,7 @@ import { useRouter } from '@tanstack/react-router'
 import { isAdminRole } from '../auth/roles'
 import { AppDataGrid, AppDataGridToolbar } from '../components/AppDataGrid'
 import { AppShell } from '../components/AppShell'
+import type { Employee } from '../types/employees'
 import type { CreateUserInput, ManagedUser, UpdateUserInput, UserRole } from '../types/users'
 
 const roleOptions: Array<{ value: UserRole; label: string }> = [
@@ -94,6 +95,13 @@ export function UsersPage() {
     enabled: Boolean(accessToken) && canManage,
   })
 
+  const employeesQuery = useQuery({
+    queryKey: ['users', 'employees-options'],
+    queryFn: () => router.options.context.api.listEmployees(accessToken, { page: 1, pageSize: 100 }),
+    enabled: Boolean(accessToken) && canManage,
+  })
+  const employees: Employee[] = employeesQuery.data?.items ?? []
+
   const createMutation = useMutation({
     mutationFn: (payload: CreateUserInput) => router.options.context.api.createUser(accessToken, payload),
     onSuccess: async () => {
@@ -157,6 +165,13 @@ export function UsersPage() {
         flex: 0.7,
         valueFormatter: (params) => formatRole(String(params.value ?? '')),
       },
+      {
+        field: 'employeeName',
+        headerName: 'Employee',
+        minWidth: 180,
+        flex: 0.9,
+        valueFormatter: (params) => String(params.value ?? '') || 'Not linked',
+      },
       {
         field: 'isActive',
         headerName: 'Status',
@@ -203,7 +218,11 @@ export function UsersPage() {
             label="Edit"
             onClick={() => {
               setEditTarget(row)
-              setEditState({ username: row.username, role: (row.role.replace(/\s+/g, '_').toLowerCase() as UserRole) || 'viewer' })
+              setEditState({
+                username: row.username,
+                role: (row.role.replace(/\s+/g, '_').toLowerCase() as UserRole) || 'viewer',
+                employeeId: row.employeeId,
+              })
               setEditErrors({})
             }}
             disabled={!canManage}
@@ -338,6 +357,21 @@ export function UsersPage() {
                 </MenuItem>
               ))}
             </TextField>
+            <TextField
+              select
+              label="Linked Employee"
+              value={createState.employeeId ?? ''}
+              onChange={(event) =>
+                setCreateState((prev) => ({ ...prev, employeeId: event.target.value ? Number(event.target.value) : undefined }))
+              }
+              helperText="Self-service leave and attendance use this employee record"
+              fullWidth
+            >
+              <MenuItem value="">Not linked</MenuItem>
+              {employees.map((employee) => (
+                <MenuItem key={employee.id} value={employee.id}>{`${employee.firstName} ${employee.lastName}`}</MenuItem>
+              ))}
+            </TextField>
           </Stack>
         </DialogContent>
         <DialogActions>
@@ -382,6 +416,21 @@ export function UsersPage() {
                 </MenuItem>
               ))}
             </TextField>
+            <TextField
+              select
+              label="Linked Employee"
+              value={editState.employeeId ?? ''}
+              onChange={(event) =>
+                setEditState((prev) => ({ ...prev, employeeId: event.target.value ? Number(event.target.value) : undefined }))
+              }
+              helperText="Self-service leave and attendance use this employee record"
+              fullWidth
+            >
+              <MenuItem value="">Not linked</MenuItem>
+              {employees.map((employee) => (
+                <MenuItem key={employee.id} value={employee.id}>{`${employee.firstName} ${employee.lastName}`}</MenuItem>
+              ))}
+            </TextField>
           </Stack>
         </DialogContent>
         <DialogActions>
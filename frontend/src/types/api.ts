@@ -4,9 +4,11 @@ import type { ListAuditLogsQuery, ListAuditLogsResult } from './audit'
 import type { Department, ListDepartmentsQuery, ListDepartmentsResult, UpsertDepartmentInput } from './departments'
 import type { DashboardSummary } from './dashboard'
 import type { Employee, ListEmployeesQuery, ListEmployeesResult, UpsertEmployeeInput } from './employees'
+import type { Holiday, HolidayImportResult, HolidayOccurrence, HolidayUpsertInput } from './holidays'
 import type {
   ApplyLeaveInput,
   LeaveBalance,
+  LeaveDaysPreview,
   LeaveEntitlement,
   LeaveLockedDate,
   LeaveRequest,
@@ -16,11 +18,27 @@ import type {
   UpsertEntitlementInput,
 } from './leave'
 import type {
+  AnnualTaxSummary,
+  BatchIntegrityReport,
+  CreatePayrollBatchInput,
+  EmployeeProjectAllocation,
+  EntryAmountsImportResult,
+  FileExportResult,
+  GLAccountMapping,
+  GLAccountMappingInput,
   ListPayrollBatchesFilter,
   ListPayrollBatchesResult,
   PayrollBatch,
   PayrollBatchDetail,
   PayrollEntry,
+  PayrollJournal,
+  PayrollJournalFormat,
+  PayrollSimulation,
+  PayrollSimulationInput,
+  Project,
+  ProjectAllocationInput,
+  ProjectChargingReport,
+  ProjectUpsertInput,
   UpdatePayrollEntryAmountsInput,
 } from './payroll'
 import type {
@@ -37,6 +55,7 @@ import type {
   PayrollBatchesReportFilter,
   PayrollBatchesReportResult,
 } from './reports'
+import type { AssignWorkScheduleInput, EmployeeWorkSchedule, WorkSchedule, WorkScheduleUpsertInput } from './schedules'
 import type { AppSettings, CompanyLogo, CompanyProfile, SaveCompanyProfileInput, UpdateSettingsInput } from './settings'
 import type { DatabaseConfigInput, StartupHealth } from './startup'
 import type { CreateUserInput, ListUsersQuery, ListUsersResult, ManagedUser, UpdateUserInput } from './users'
@@ -81,10 +100,27 @@ export type AppGateway = {
   lockDate: (accessToken: string, date: string, reason: string) => Promise<LeaveLockedDate>
   unlockDate: (accessToken: string, date: string) => Promise<void>
 
+  listHolidays: (accessToken: string, activeOnly: boolean) => Promise<Holiday[]>
+  listHolidayCalendar: (accessToken: string, year: number) => Promise<HolidayOccurrence[]>
+  createHoliday: (accessToken: string, payload: HolidayUpsertInput) => Promise<Holiday>
+  updateHoliday: (accessToken: string, id: number, payload: HolidayUpsertInput) => Promise<Holiday>
+  setHolidayActive: (accessToken: string, id: number, active: boolean) => Promise<Holiday>
+  deleteHoliday: (accessToken: string, id: number) => Promise<void>
+  importHolidays: (accessToken: string, year: number, data: string) => Promise<HolidayImportResult>
+
+  listWorkSchedules: (accessToken: string, activeOnly: boolean) => Promise<WorkSchedule[]>
+  createWorkSchedule: (accessToken: string, payload: WorkScheduleUpsertInput) => Promise<WorkSchedule>
+  updateWorkSchedule: (accessToken: string, id: number, payload: WorkScheduleUpsertInput) => Promise<WorkSchedule>
+  setWorkScheduleActive: (accessToken: string, id: number, active: boolean) => Promise<WorkSchedule>
+  listEmployeeWorkSchedules: (accessToken: string, employeeId: number) => Promise<EmployeeWorkSchedule[]>
+  assignWorkSchedule: (accessToken: string, payload: AssignWorkScheduleInput) => Promise<EmployeeWorkSchedule>
+  deleteEmployeeWorkSchedule: (accessToken: string, id: number) => Promise<void>
+
   getMyLeaveBalance: (accessToken: string, year: number) => Promise<LeaveBalance>
   getLeaveBalance: (accessToken: string, employeeId: number, year: number) => Promise<LeaveBalance>
   upsertEntitlement: (accessToken: string, payload: UpsertEntitlementInput) => Promise<LeaveEntitlement>
 
+  previewLeaveDays: (accessToken: string, payload: ApplyLeaveInput) => Promise<LeaveDaysPreview>
   applyLeave: (accessToken: string, payload: ApplyLeaveInput) => Promise<LeaveRequest>
   listMyLeaveRequests: (accessToken: string, filter?: ListLeaveRequestsFilter) => Promise<LeaveRequest[]>
   listAllLeaveRequests: (accessToken: string, filter?: ListLeaveRequestsFilter) => Promise<LeaveRequest[]>
@@ -93,7 +129,7 @@ export type AppGateway = {
   cancelLeave: (accessToken: string, id: number) => Promise<LeaveRequest>
 
   listPayrollBatches: (accessToken: string, filter: ListPayrollBatchesFilter) => Promise<ListPayrollBatchesResult>
-  createPayrollBatch: (accessToken: string, month: string) => Promise<PayrollBatch>
+  createPayrollBatch: (accessToken: string, payload: CreatePayrollBatchInput) => Promise<PayrollBatch>
   getPayrollBatch: (accessToken: string, batchId: number) => Promise<PayrollBatchDetail>
   generatePayrollEntries: (accessToken: string, batchId: number) => Promise<void>
   updatePayrollEntryAmounts: (
@@ -101,9 +137,37 @@ export type AppGateway = {
     entryId: number,
     payload: UpdatePayrollEntryAmountsInput,
   ) => Promise<PayrollEntry>
-  approvePayrollBatch: (accessToken: string, batchId: number) => Promise<PayrollBatch>
+  approvePayrollBatch: (accessToken: string, batchId: number, comment?: string) => Promise<PayrollBatch>
+  rejectPayrollBatch: (accessToken: string, batchId: number, comment: string) => Promise<PayrollBatch>
+  reopenPayrollBatch: (accessToken: string, batchId: number, reason: string) => Promise<PayrollBatch>
+  reversePayrollBatch: (accessToken: string, batchId: number, reason: string) => Promise<PayrollBatch>
   lockPayrollBatch: (accessToken: string, batchId: number) => Promise<PayrollBatch>
+  verifyPayrollBatchIntegrity: (accessToken: string, batchId: number) => Promise<BatchIntegrityReport>
+  importPayrollEntryAmounts: (accessToken: string, batchId: number, data: string) => Promise<EntryAmountsImportResult>
   exportPayrollBatchCSV: (accessToken: string, batchId: number) => Promise<CSVExportResult>
+  listGLAccountMappings: (accessToken: string) => Promise<GLAccountMapping[]>
+  createGLAccountMapping: (accessToken: string, payload: GLAccountMappingInput) => Promise<GLAccountMapping>
+  updateGLAccountMapping: (accessToken: string, id: number, payload: GLAccountMappingInput) => Promise<GLAccountMapping>
+  deleteGLAccountMapping: (accessToken: string, id: number) => Promise<void>
+  getPayrollJournal: (accessToken: string, batchId: number) => Promise<PayrollJournal>
+  exportPayrollJournal: (accessToken: string, batchId: number, format: PayrollJournalFormat) => Promise<CSVExportResult>
+  listProjects: (accessToken: string, activeOnly: boolean) => Promise<Project[]>
+  createProject: (accessToken: string, payload: ProjectUpsertInput) => Promise<Project>
+  updateProject: (accessToken: string, id: number, payload: ProjectUpsertInput) => Promise<Project>
+  setProjectActive: (accessToken: string, id: number, active: boolean) => Promise<Project>
+  listEmployeeProjectAllocations: (accessToken: string, employeeId: number) => Promise<EmployeeProjectAllocation[]>
+  setEmployeeProjectAllocations: (
+    accessToken: string,
+    employeeId: number,
+    allocations: ProjectAllocationInput[],
+  ) => Promise<EmployeeProjectAllocation[]>
+  getProjectChargingReport: (accessToken: string, month: string, projectId: number) => Promise<ProjectChargingReport>
+  exportProjectChargingCSV: (accessToken: string, month: string, projectId: number) => Promise<CSVExportResult>
+  getAnnualTaxSummary: (accessToken: string, year: number, startMonth: number) => Promise<AnnualTaxSummary>
+  renderTaxCertificatePDF: (accessToken: string, year: number, startMonth: number, employeeId: number) => Promise<FileExportResult>
+  renderTaxCertificatesZip: (accessToken: string, year: number, startMonth: number) => Promise<FileExportResult>
+  exportAnnualTaxReturnCSV: (accessToken: string, year: number, startMonth: number) => Promise<FileExportResult>
+  simulatePayroll: (accessToken: string, payload: PayrollSimulationInput) => Promise<PayrollSimulation>
   saveFileWithDialog: (suggestedFilename: string, dataBytes: number[], mimeType: string) => Promise<{ savedPath: string; cancelled: boolean }>
 
   listUsers: (accessToken: string, query: ListUsersQuery) => Promise<ListUsersResult>
@@ -19,6 +19,7 @@ export type AttendanceRow = {
   departmentName?: string
   attendanceId?: number
   status: AttendanceStatus
+  expectedDay: number
   isLocked: boolean
   canPostToLeave: boolean
   canEdit: boolean
@@ -2,6 +2,7 @@ export type Department = {
   id: number
   name: string
   description?: string
+  costCentre?: string
   employeeCount: number
   createdAt: string
   updatedAt: string
@@ -10,6 +11,7 @@ export type Department = {
 export type UpsertDepartmentInput = {
   name: string
   description?: string
+  costCentre?: string
 }
 
 export type ListDepartmentsQuery = {
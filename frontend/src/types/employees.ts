@@ -20,6 +20,7 @@ export type Employee = {
   position: string
   employmentStatus: string
   dateOfHire: string
+  dateOfExit?: string | null
   baseSalaryAmount: number
   createdAt: string
   updatedAt: string
@@ -41,7 +42,27 @@ export type UpsertEmployeeInput = {
   position: string
   employmentStatus: string
   dateOfHire: string
+  dateOfExit?: string
   baseSalaryAmount: number
+  salaryEffectiveFrom?: string
+  salaryChangeReason?: string
+}
+
+export type SalaryChange = {
+  id: number
+  employeeId: number
+  amount: number
+  effectiveFrom: string
+  reason: string
+  approvedBy?: number
+  approvedByName?: string
+  createdAt: string
+}
+
+export type RecordSalaryChangeInput = {
+  amount: number
+  effectiveFrom: string
+  reason: string
 }
 
 export type ListEmployeesQuery = {
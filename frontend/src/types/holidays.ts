@@ -0,0 +1,43 @@
+export type HolidayKind = 'fixed' | 'easter' | 'one_off'
+
+export type Holiday = {
+  id: number
+  name: string
+  kind: HolidayKind
+  month?: number
+  day?: number
+  easterOffset?: number
+  holidayDate?: string
+  active: boolean
+  createdAt: string
+  updatedAt: string
+}
+
+export type HolidayUpsertInput = {
+  name: string
+  kind: HolidayKind
+  month?: number
+  day?: number
+  easterOffset?: number
+  holidayDate?: string
+}
+
+export type HolidayOccurrence = {
+  date: string
+  holidayId: number
+  name: string
+  kind: HolidayKind
+}
+
+export type HolidayImportError = {
+  row: number
+  message: string
+}
+
+export type HolidayImportResult = {
+  year: number
+  rows: number
+  created: number
+  skipped: number
+  errors: HolidayImportError[]
+}
@@ -22,12 +22,24 @@ export type LeaveEntitlement = {
   id: number
   employeeId: number
   year: number
+  leaveTypeId: number
+  leaveTypeName: string
   totalDays: number
   reservedDays: number
   createdAt: string
   updatedAt: string
 }
 
+export type LeaveTypeBalance = {
+  leaveTypeId: number
+  leaveTypeName: string
+  totalDays: number
+  reservedDays: number
+  approvedDays: number
+  pendingDays: number
+  availableDays: number
+}
+
 export type LeaveBalance = {
   employeeId: number
   year: number
@@ -36,8 +48,11 @@ export type LeaveBalance = {
   approvedDays: number
   pendingDays: number
   availableDays: number
+  types: LeaveTypeBalance[]
 }
 
+export type LeaveDayPart = 'full' | 'am' | 'pm'
+
 export type LeaveRequest = {
   id: number
   employeeId: number
@@ -47,6 +62,9 @@ export type LeaveRequest = {
   leaveTypeName: string
   startDate: string
   endDate: string
+  startDayPart: LeaveDayPart
+  endDayPart: LeaveDayPart
+  hours?: number
   workingDays: number
   status: 'Pending' | 'Approved' | 'Rejected' | 'Cancelled'
   reason?: string
@@ -67,6 +85,7 @@ export type LeaveTypeUpsertInput = {
 export type UpsertEntitlementInput = {
   employeeId: number
   year: number
+  leaveTypeId: number
   totalDays: number
   reservedDays: number
 }
@@ -75,9 +94,18 @@ export type ApplyLeaveInput = {
   leaveTypeId: number
   startDate: string
   endDate: string
+  startDayPart?: LeaveDayPart
+  endDayPart?: LeaveDayPart
+  hours?: number
   reason?: string
 }
 
+export type LeaveDaysPreview = {
+  startDate: string
+  endDate: string
+  workingDays: number
+}
+
 export type ListLeaveRequestsFilter = {
   status?: string
   dateFrom?: string
@@ -1,16 +1,28 @@
+import type { PayrollTaxBand } from './settings'
+
 export type PayrollBatchStatus = 'Draft' | 'Approved' | 'Locked'
 
+export type PayrollBatchType = 'regular' | 'bonus' | 'arrears' | 'correction'
+
 export type PayrollBatch = {
   id: number
   month: string
+  batchType: PayrollBatchType
+  description: string
   status: PayrollBatchStatus
   createdBy: number
   createdAt: string
   approvedBy?: number
   approvedAt?: string
   lockedAt?: string
+  varianceReviewedBy?: number
+  varianceReviewedAt?: string
+  reversesBatchId?: number
+  integrityHash?: string
 }
 
+export type ProrationBasis = 'working_days' | 'calendar_days'
+
 export type PayrollEntry = {
   id: number
   batchId: number
@@ -22,17 +34,192 @@ export type PayrollEntry = {
   taxTotal: number
   grossPay: number
   netPay: number
+  employerContributionsTotal?: number
+  fullBaseSalary?: number
+  prorationBasis?: ProrationBasis
+  payableDays?: number
+  periodDays?: number
+  createdAt: string
+  updatedAt: string
+  lines?: PayrollEntryLine[]
+}
+
+export type PayComponentKind = 'earning' | 'deduction'
+
+export type PayComponentCalculationType = 'fixed' | 'percentage'
+
+export type PayComponent = {
+  id: number
+  code: string
+  name: string
+  kind: PayComponentKind
+  taxable: boolean
+  calculationType: PayComponentCalculationType
+  value: number
+  autoApply: boolean
+  active: boolean
   createdAt: string
   updatedAt: string
 }
 
+export type PayComponentUpsertInput = {
+  code: string
+  name: string
+  kind: PayComponentKind
+  taxable: boolean
+  calculationType: PayComponentCalculationType
+  value: number
+  autoApply: boolean
+}
+
+export type PayrollEntryLine = {
+  id: number
+  entryId: number
+  componentId?: number
+  schemeId?: number
+  code: string
+  name: string
+  kind: PayComponentKind
+  taxable: boolean
+  amount: number
+  employerAmount?: number
+  createdAt: string
+  quantity?: number
+  rate?: number
+  loanId?: number
+  sources?: PayrollEntryLineSource[]
+  arrearsMonth?: string
+}
+
+export type PayrollEntryLineSourceType = 'leave_request' | 'attendance_record'
+
+export type PayrollEntryLineSource = {
+  id: number
+  lineId: number
+  sourceType: PayrollEntryLineSourceType
+  sourceId: number
+  days: number
+}
+
+export type ContributionBase = 'gross' | 'base_salary'
+
+export type ContributionScheme = {
+  id: number
+  code: string
+  name: string
+  employeeRate: number
+  employerRate: number
+  base: ContributionBase
+  membersOnly: boolean
+  active: boolean
+  createdAt: string
+  updatedAt: string
+}
+
+export type ContributionSchemeUpsertInput = {
+  code: string
+  name: string
+  employeeRate: number
+  employerRate: number
+  base: ContributionBase
+  membersOnly: boolean
+}
+
+export type ContributionSchemeMember = {
+  schemeId: number
+  employeeId: number
+  employeeName: string
+  memberNumber: string
+}
+
+export type SetContributionSchemeMemberInput = {
+  employeeId: number
+  memberNumber: string
+}
+
+export type PaymentMethodKind = 'bank' | 'mobile_money'
+
+export type EmployeePaymentMethod = {
+  id: number
+  employeeId: number
+  method: PaymentMethodKind
+  bankName?: string
+  bankCode?: string
+  branch?: string
+  accountName?: string
+  accountNumber?: string
+  provider?: string
+  msisdn?: string
+  splitPercent: number
+  createdAt: string
+  updatedAt: string
+}
+
+export type EmployeePaymentMethodInput = {
+  method: PaymentMethodKind
+  bankName: string
+  bankCode: string
+  branch: string
+  accountName: string
+  accountNumber: string
+  provider: string
+  msisdn: string
+  splitPercent: number
+}
+
+export type PaymentFileFormatInfo = {
+  code: string
+  name: string
+  method: PaymentMethodKind
+  provider?: string
+}
+
 export type PayrollBatchDetail = {
   batch: PayrollBatch
   entries: PayrollEntry[]
+  employeeIds?: number[]
+  componentIds?: number[]
+  approval?: ApprovalProgress
+  reversedByBatchId?: number
+  taxComputed?: boolean
+}
+
+export type PayrollBatchApproval = {
+  id: number
+  batchId: number
+  stepNumber: number
+  stepName: string
+  action: 'approved' | 'rejected' | 'reopened'
+  userId?: number
+  userName?: string
+  comment: string
+  createdAt: string
+}
+
+export type ApprovalStepStatus = {
+  stepNumber: number
+  name: string
+  roles: string[]
+  approval?: PayrollBatchApproval
+}
+
+export type ApprovalProgress = {
+  steps: ApprovalStepStatus[]
+  nextStep: number
+  history: PayrollBatchApproval[]
+}
+
+export type CreatePayrollBatchInput = {
+  month: string
+  batchType?: PayrollBatchType
+  description?: string
+  employeeIds?: number[]
+  componentIds?: number[]
 }
 
 export type ListPayrollBatchesFilter = {
   month?: string
+  batchType?: PayrollBatchType | ''
   status?: PayrollBatchStatus | ''
   page?: number
   pageSize?: number
@@ -45,8 +232,346 @@ export type ListPayrollBatchesResult = {
   pageSize: number
 }
 
+export type VarianceStatus = 'joiner' | 'leaver' | 'changed' | 'unchanged'
+
+export type VarianceFigure = {
+  previous: number
+  current: number
+  change: number
+  changePercent?: number
+  flagged: boolean
+}
+
+export type VarianceTotals = {
+  baseSalary: VarianceFigure
+  allowancesTotal: VarianceFigure
+  deductionsTotal: VarianceFigure
+  taxTotal: VarianceFigure
+  netPay: VarianceFigure
+}
+
+export type VarianceRow = VarianceTotals & {
+  employeeId: number
+  employeeName: string
+  status: VarianceStatus
+  flagged: boolean
+}
+
+export type VarianceReport = {
+  batchId: number
+  month: string
+  previousBatchId?: number
+  previousMonth: string
+  thresholdPercent: number
+  joiners: number
+  leavers: number
+  changed: number
+  flagged: number
+  totals: VarianceTotals
+  rows: VarianceRow[]
+  reviewRequired: boolean
+  varianceReviewedBy?: number
+  varianceReviewedAt?: string
+}
+
+export type LoanType = 'loan' | 'advance'
+export type LoanStatus = 'active' | 'settled' | 'cancelled'
+
+export type EmployeeLoan = {
+  id: number
+  employeeId: number
+  employeeName: string
+  loanType: LoanType
+  description?: string
+  principal: number
+  interestRate: number
+  totalRepayable: number
+  installments: number
+  installmentAmount: number
+  startMonth: string
+  outstandingBalance: number
+  status: LoanStatus
+  createdBy?: number
+  createdAt: string
+  updatedAt: string
+}
+
+export type LoanCreateInput = {
+  employeeId: number
+  loanType: LoanType
+  description: string
+  principal: number
+  interestRate: number
+  installments: number
+  startMonth: string
+}
+
+export type ListLoansFilter = {
+  employeeId?: number
+  status?: LoanStatus | ''
+}
+
+export type LoanInstallment = {
+  number: number
+  month: string
+  amount: number
+  balanceAfter: number
+}
+
+export type LoanRepayment = {
+  id: number
+  loanId: number
+  batchId: number
+  entryId?: number
+  month: string
+  amount: number
+  balanceAfter: number
+  createdAt: string
+}
+
+export type LoanLedger = {
+  loan: EmployeeLoan
+  schedule: LoanInstallment[]
+  repayments: LoanRepayment[]
+}
+
+export type RetroArrears = {
+  employeeId: number
+  employeeName: string
+  month: string
+  batchId: number
+  paidBaseSalary: number
+  dueBaseSalary: number
+  arrearsPaid: number
+  arrears: number
+}
+
+export type GLAccountCategory =
+  | 'salary_expense'
+  | 'earning'
+  | 'employer_contribution_expense'
+  | 'tax_payable'
+  | 'contribution_payable'
+  | 'deduction'
+  | 'loan_receivable'
+  | 'net_pay_clearing'
+
+export type GLAccountMapping = {
+  id: number
+  category: GLAccountCategory
+  componentId?: number
+  componentCode?: string
+  schemeId?: number
+  schemeCode?: string
+  departmentId?: number
+  departmentName?: string
+  accountCode: string
+  accountName: string
+  createdAt: string
+  updatedAt: string
+}
+
+export type GLAccountMappingInput = {
+  category: GLAccountCategory
+  componentId?: number
+  schemeId?: number
+  departmentId?: number
+  accountCode: string
+  accountName: string
+}
+
+export type JournalLine = {
+  accountCode: string
+  accountName: string
+  departmentId?: number
+  departmentName: string
+  costCentre: string
+  debit: number
+  credit: number
+}
+
+export type PayrollJournal = {
+  batchId: number
+  month: string
+  batchType: string
+  reference: string
+  date: string
+  lines: JournalLine[]
+  totalDebit: number
+  totalCredit: number
+}
+
+export type PayrollJournalFormat = 'csv' | 'import'
+
+export type EntryImportRowError = {
+  row: number
+  employeeId?: number
+  message: string
+}
+
+export type EntryAmountsImportResult = {
+  batchId: number
+  rows: number
+  updated: number
+  unchanged: number
+  errors: EntryImportRowError[]
+}
+
+export type Project = {
+  id: number
+  code: string
+  name: string
+  donor: string
+  active: boolean
+  createdAt: string
+  updatedAt: string
+}
+
+export type ProjectUpsertInput = {
+  code: string
+  name: string
+  donor: string
+}
+
+export type EmployeeProjectAllocation = {
+  id: number
+  employeeId: number
+  projectId: number
+  projectCode: string
+  projectName: string
+  percent: number
+  startDate: string
+  endDate?: string
+  createdAt: string
+}
+
+export type ProjectAllocationInput = {
+  projectId: number
+  percent: number
+  startDate: string
+  endDate: string
+}
+
+export type ProjectChargingRow = {
+  projectId?: number
+  projectCode: string
+  projectName: string
+  donor: string
+  employeeId: number
+  employeeName: string
+  sharePercent: number
+  grossAmount: number
+  employerAmount: number
+  totalCost: number
+}
+
+export type ProjectChargingReport = {
+  month: string
+  rows: ProjectChargingRow[]
+  grossAmount: number
+  employerAmount: number
+  totalCost: number
+}
+
+export type AnnualSchemeColumn = {
+  schemeId: number
+  code: string
+  name: string
+}
+
+export type AnnualContribution = AnnualSchemeColumn & {
+  employeeAmount: number
+  employerAmount: number
+}
+
+export type AnnualEmployeeTax = {
+  employeeId: number
+  employeeName: string
+  nationalId?: string
+  months: number
+  grossPay: number
+  taxablePay: number
+  taxTotal: number
+  netPay: number
+  contributions: AnnualContribution[]
+}
+
+// Locked payroll of a calendar year (startMonth 1) or fiscal year, totalled per employee.
+export type AnnualTaxSummary = {
+  year: number
+  startMonth: number
+  label: string
+  fromMonth: string
+  toMonth: string
+  schemes: AnnualSchemeColumn[]
+  employees: AnnualEmployeeTax[]
+  grossPay: number
+  taxablePay: number
+  taxTotal: number
+  netPay: number
+}
+
+export type BatchIntegrityStatus = 'Verified' | 'Drift' | 'Unsealed' | 'Not Locked'
+
+export type BatchIntegrityReport = {
+  batchId: number
+  month: string
+  status: BatchIntegrityStatus
+  storedHash: string
+  computedHash: string
+  drift: string[]
+  checkedAt: string
+  headerDrift: boolean
+  driftedEntryIds: number[]
+}
+
+export type SimulationSalaryAdjustment = {
+  // Omitted for the company-wide adjustment.
+  departmentId?: number
+  percent: number
+}
+
+export type SimulationComponentOverride = {
+  componentId: number
+  value: number
+}
+
+export type SimulationSchemeOverride = {
+  schemeId: number
+  employeeRate: number
+  employerRate: number
+}
+
+export type PayrollSimulationInput = {
+  month: string
+  compareBatchId: number
+  salaryAdjustments: SimulationSalaryAdjustment[]
+  componentOverrides: SimulationComponentOverride[]
+  schemeOverrides: SimulationSchemeOverride[]
+  taxBands: PayrollTaxBand[]
+}
+
+// What-if regular payroll computed in memory; comparison figures are previous = real batch, current = simulated.
+export type PayrollSimulation = {
+  month: string
+  prorationBasis: string
+  taxTableName: string
+  adjustedEmployees: number
+  entries: PayrollEntry[]
+  grossPay: VarianceFigure
+  employerContributions: VarianceFigure
+  comparison: VarianceReport
+}
+
 export type UpdatePayrollEntryAmountsInput = {
   allowancesTotal: number
   deductionsTotal: number
-  taxTotal: number
+  taxTotal?: number
+}
+
+// Binary exports (payslip and tax certificate PDF / ZIP, tax return CSV); `data` is base64-encoded by the Wails bridge.
+export type FileExportResult = {
+  filename: string
+  data: string
+  mimeType: string
 }
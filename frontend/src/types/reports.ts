@@ -90,12 +90,15 @@ export type PayrollBatchesReportFilter = {
 
 export type PayrollBatchesReportRow = {
   month: string
+  batchType: string
   status: string
   createdAt: string
   approvedAt?: string
   lockedAt?: string
   entriesCount: number
   totalNetPay: number
+  totalEmployerContributions?: number
+  integrityStatus?: string
 }
 
 export type PayrollBatchesReportResult = {
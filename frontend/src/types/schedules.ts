@@ -0,0 +1,38 @@
+export type WorkScheduleDay = {
+  weekday: number
+  fraction: number
+}
+
+export type WorkSchedule = {
+  id: number
+  name: string
+  hoursPerDay: number
+  isDefault: boolean
+  active: boolean
+  days: WorkScheduleDay[]
+  createdAt: string
+  updatedAt: string
+}
+
+export type WorkScheduleUpsertInput = {
+  name: string
+  hoursPerDay: number
+  isDefault: boolean
+  days: WorkScheduleDay[]
+}
+
+export type EmployeeWorkSchedule = {
+  id: number
+  employeeId: number
+  scheduleId: number
+  scheduleName: string
+  effectiveFrom: string
+  createdBy?: number
+  createdAt: string
+}
+
+export type AssignWorkScheduleInput = {
+  employeeId: number
+  scheduleId: number
+  effectiveFrom: string
+}
@@ -24,6 +24,19 @@ export type PayrollDisplaySettings = {
   roundingEnabled: boolean
 }
 
+export type PayrollApprovalStep = {
+  name: string
+  roles: Array<'admin' | 'hr_officer' | 'finance_officer'>
+}
+
+export type PayrollPolicySettings = {
+  prorationBasis: 'working_days' | 'calendar_days'
+  varianceThresholdPercent?: number
+  requireVarianceReview?: boolean
+  approvalSteps?: PayrollApprovalStep[]
+  allowSelfApproval?: boolean
+}
+
 export type PhoneDefaultsSettings = {
   defaultCountryName: string
   defaultCountryISO2: string
@@ -35,6 +48,7 @@ export type AppSettings = {
   currency: CurrencySettings
   lunchDefaults: LunchDefaultsSettings
   payrollDisplay: PayrollDisplaySettings
+  payrollPolicy?: PayrollPolicySettings
   phoneDefaults: PhoneDefaultsSettings
 }
 
@@ -52,6 +66,7 @@ export type UpdateSettingsInput = {
   currency: CurrencySettings
   lunchDefaults: LunchDefaultsSettings
   payrollDisplay: PayrollDisplaySettings
+  payrollPolicy?: PayrollPolicySettings
   phoneDefaults: PhoneDefaultsSettings
 }
 
@@ -78,3 +93,18 @@ export type SaveCompanyProfileInput = {
   supportWebsite?: string
   copyrightHolder?: string
 }
+
+export type PayrollTaxBand = {
+  threshold: number
+  rate: number
+}
+
+export type PayrollTaxTable = {
+  name: string
+  effectiveFrom: string
+  bands: PayrollTaxBand[]
+}
+
+export type PayrollTaxSettings = {
+  tables: PayrollTaxTable[]
+}
@@ -8,6 +8,8 @@ export type ManagedUser = {
   createdAt: string
   updatedAt: string
   lastLoginAt?: string
+  employeeId?: number
+  employeeName?: string
 }
 
 export type ListUsersQuery = {
@@ -27,9 +29,11 @@ export type CreateUserInput = {
   username: string
   password: string
   role: UserRole
+  employeeId?: number
 }
 
 export type UpdateUserInput = {
   username: string
   role: UserRole
+  employeeId?: number
 }
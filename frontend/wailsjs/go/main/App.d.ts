@@ -1,8 +1,8 @@
 // Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
 // This file is automatically generated. DO NOT EDIT
 import {handlers} from '../models';
-import {leave} from '../models';
 import {payroll} from '../models';
+import {leave} from '../models';
 import {departments} from '../models';
 import {employees} from '../models';
 import {users} from '../models';
@@ -12,43 +12,92 @@ import {dashboard} from '../models';
 import {attendance} from '../models';
 import {main} from '../models';
 import {audit} from '../models';
+import {payslips} from '../models';
+import {holidays} from '../models';
+import {schedules} from '../models';
+
+export function AcknowledgePayrollVariance(arg1:handlers.PayrollBatchActionRequest):Promise<payroll.PayrollBatch>;
+
+export function AddPayrollEntryLine(arg1:handlers.AddPayrollEntryLineRequest):Promise<payroll.PayrollEntry>;
 
 export function ApplyLeave(arg1:handlers.ApplyLeaveRequest):Promise<leave.LeaveRequest>;
 
 export function ApproveLeave(arg1:handlers.LeaveActionRequest):Promise<leave.LeaveRequest>;
 
-export function ApprovePayrollBatch(arg1:handlers.PayrollBatchActionRequest):Promise<payroll.PayrollBatch>;
+export function ApprovePayrollBatch(arg1:handlers.PayrollApprovalRequest):Promise<payroll.PayrollBatch>;
+
+export function AssignWorkSchedule(arg1:handlers.AssignWorkScheduleRequest):Promise<schedules.EmployeeWorkSchedule>;
+
+export function CalculateRetroArrears(arg1:handlers.RetroArrearsRequest):Promise<Array<payroll.RetroArrears>>;
 
 export function CancelLeave(arg1:handlers.LeaveActionRequest):Promise<leave.LeaveRequest>;
 
+export function CancelLoan(arg1:handlers.LoanActionRequest):Promise<payroll.EmployeeLoan>;
+
+export function CreateContributionScheme(arg1:handlers.CreateContributionSchemeRequest):Promise<payroll.ContributionScheme>;
+
 export function CreateDepartment(arg1:handlers.CreateDepartmentRequest):Promise<departments.Department>;
 
 export function CreateEmployee(arg1:handlers.CreateEmployeeRequest):Promise<employees.Employee>;
 
+export function CreateGLAccountMapping(arg1:handlers.CreateGLAccountMappingRequest):Promise<payroll.GLAccountMapping>;
+
+export function CreateHoliday(arg1:handlers.CreateHolidayRequest):Promise<holidays.Holiday>;
+
 export function CreateLeaveType(arg1:handlers.CreateLeaveTypeRequest):Promise<leave.LeaveType>;
 
+export function CreateLoan(arg1:handlers.CreateLoanRequest):Promise<payroll.EmployeeLoan>;
+
+export function CreatePayComponent(arg1:handlers.CreatePayComponentRequest):Promise<payroll.PayComponent>;
+
 export function CreatePayrollBatch(arg1:handlers.CreatePayrollBatchRequest):Promise<payroll.PayrollBatch>;
 
+export function CreateProject(arg1:handlers.CreateProjectRequest):Promise<payroll.Project>;
+
 export function CreateUser(arg1:handlers.CreateUserRequest):Promise<users.User>;
 
+export function CreateWorkSchedule(arg1:handlers.CreateWorkScheduleRequest):Promise<schedules.WorkSchedule>;
+
 export function DeleteDepartment(arg1:handlers.DeleteDepartmentRequest):Promise<void>;
 
 export function DeleteEmployee(arg1:handlers.DeleteEmployeeRequest):Promise<void>;
 
+export function DeleteEmployeeWorkSchedule(arg1:handlers.DeleteEmployeeWorkScheduleRequest):Promise<void>;
+
+export function DeleteGLAccountMapping(arg1:handlers.DeleteGLAccountMappingRequest):Promise<void>;
+
+export function DeleteHoliday(arg1:handlers.DeleteHolidayRequest):Promise<void>;
+
+export function ExportAnnualTaxReturnCSV(arg1:handlers.AnnualTaxSummaryRequest):Promise<payslips.FileExport>;
+
 export function ExportAttendanceSummaryReportCSV(arg1:handlers.ExportAttendanceSummaryReportRequest):Promise<reports.CSVExport>;
 
 export function ExportAuditLogReportCSV(arg1:handlers.ExportAuditLogReportRequest):Promise<reports.CSVExport>;
 
+export function ExportContributionScheduleCSV(arg1:handlers.ExportContributionScheduleRequest):Promise<payroll.CSVExport>;
+
 export function ExportEmployeeReportCSV(arg1:handlers.ExportEmployeeReportRequest):Promise<reports.CSVExport>;
 
 export function ExportLeaveRequestsReportCSV(arg1:handlers.ExportLeaveRequestsReportRequest):Promise<reports.CSVExport>;
 
+export function ExportLoanLedgerCSV(arg1:handlers.ExportLoanLedgerRequest):Promise<payroll.CSVExport>;
+
+export function ExportPaymentFile(arg1:handlers.ExportPaymentFileRequest):Promise<payroll.CSVExport>;
+
 export function ExportPayrollBatchCSV(arg1:handlers.PayrollBatchActionRequest):Promise<payroll.CSVExport>;
 
 export function ExportPayrollBatchesReportCSV(arg1:handlers.ExportPayrollBatchesReportRequest):Promise<reports.CSVExport>;
 
+export function ExportPayrollJournal(arg1:handlers.ExportPayrollJournalRequest):Promise<payroll.CSVExport>;
+
+export function ExportPayrollVarianceCSV(arg1:handlers.PayrollVarianceRequest):Promise<payroll.CSVExport>;
+
+export function ExportProjectChargingCSV(arg1:handlers.ProjectChargingReportRequest):Promise<payroll.CSVExport>;
+
 export function GeneratePayrollEntries(arg1:handlers.PayrollBatchActionRequest):Promise<void>;
 
+export function GetAnnualTaxSummary(arg1:handlers.AnnualTaxSummaryRequest):Promise<payroll.AnnualTaxSummary>;
+
 export function GetCompanyLogo(arg1:handlers.GetCompanyLogoRequest):Promise<settings.CompanyLogo>;
 
 export function GetCompanyProfile(arg1:handlers.GetSettingsRequest):Promise<settings.CompanyProfileDTO>;
@@ -61,6 +110,8 @@ export function GetEmployee(arg1:handlers.GetEmployeeRequest):Promise<employees.
 
 export function GetLeaveBalance(arg1:handlers.LeaveBalanceRequest):Promise<leave.LeaveBalance>;
 
+export function GetLoanLedger(arg1:handlers.LoanActionRequest):Promise<payroll.LoanLedger>;
+
 export function GetLunchSummary(arg1:handlers.GetLunchSummaryRequest):Promise<attendance.LunchSummary>;
 
 export function GetMe(arg1:string):Promise<handlers.GetMeResponse>;
@@ -71,6 +122,14 @@ export function GetMyLeaveBalance(arg1:handlers.LeaveBalanceRequest):Promise<lea
 
 export function GetPayrollBatch(arg1:handlers.GetPayrollBatchRequest):Promise<payroll.PayrollBatchDetail>;
 
+export function GetPayrollJournal(arg1:handlers.PayrollJournalRequest):Promise<payroll.PayrollJournal>;
+
+export function GetPayrollTaxSettings(arg1:handlers.GetSettingsRequest):Promise<settings.PayrollTaxSettings>;
+
+export function GetPayrollVarianceReport(arg1:handlers.PayrollVarianceRequest):Promise<payroll.VarianceReport>;
+
+export function GetProjectChargingReport(arg1:handlers.ProjectChargingReportRequest):Promise<payroll.ProjectChargingReport>;
+
 export function GetSettings(arg1:handlers.GetSettingsRequest):Promise<settings.SettingsDTO>;
 
 export function GetStartupHealth():Promise<main.StartupHealthResponse>;
@@ -79,6 +138,10 @@ export function GetUser(arg1:handlers.GetUserRequest):Promise<users.User>;
 
 export function ImportCompanyLogoFromURL(arg1:handlers.ImportCompanyLogoFromURLRequest):Promise<settings.CompanyProfileDTO>;
 
+export function ImportHolidays(arg1:handlers.ImportHolidaysRequest):Promise<holidays.HolidayImportResult>;
+
+export function ImportPayrollEntryAmounts(arg1:handlers.ImportPayrollEntryAmountsRequest):Promise<payroll.EntryAmountsImportResult>;
+
 export function ListAllLeaveRequests(arg1:handlers.ListLeaveRequestsRequest):Promise<Array<leave.LeaveRequest>>;
 
 export function ListAttendanceByDate(arg1:handlers.ListAttendanceByDateRequest):Promise<Array<attendance.AttendanceRow>>;
@@ -89,26 +152,54 @@ export function ListAuditLogReport(arg1:handlers.ListAuditLogReportRequest):Prom
 
 export function ListAuditLogs(arg1:handlers.ListAuditLogsRequest):Promise<audit.ListAuditLogsResult>;
 
+export function ListContributionSchemeMembers(arg1:handlers.ListContributionSchemeMembersRequest):Promise<Array<payroll.ContributionSchemeMember>>;
+
+export function ListContributionSchemes(arg1:handlers.ListContributionSchemesRequest):Promise<Array<payroll.ContributionScheme>>;
+
 export function ListDepartments(arg1:handlers.ListDepartmentsRequest):Promise<handlers.DepartmentListResponse>;
 
+export function ListEmployeePaymentMethods(arg1:handlers.ListEmployeePaymentMethodsRequest):Promise<Array<payroll.EmployeePaymentMethod>>;
+
+export function ListEmployeeProjectAllocations(arg1:handlers.ListEmployeeProjectAllocationsRequest):Promise<Array<payroll.EmployeeProjectAllocation>>;
+
 export function ListEmployeeReport(arg1:handlers.ListEmployeeReportRequest):Promise<reports.EmployeeReportListResult>;
 
+export function ListEmployeeWorkSchedules(arg1:handlers.ListEmployeeWorkSchedulesRequest):Promise<Array<schedules.EmployeeWorkSchedule>>;
+
 export function ListEmployees(arg1:handlers.ListEmployeesRequest):Promise<handlers.EmployeeListResponse>;
 
+export function ListGLAccountMappings(arg1:handlers.ListGLAccountMappingsRequest):Promise<Array<payroll.GLAccountMapping>>;
+
+export function ListHolidayCalendar(arg1:handlers.HolidayCalendarRequest):Promise<Array<holidays.HolidayOccurrence>>;
+
+export function ListHolidays(arg1:handlers.ListHolidaysRequest):Promise<Array<holidays.Holiday>>;
+
 export function ListLeaveRequestsReport(arg1:handlers.ListLeaveRequestsReportRequest):Promise<reports.LeaveRequestsReportListResult>;
 
 export function ListLeaveTypes(arg1:handlers.ListLeaveTypesRequest):Promise<Array<leave.LeaveType>>;
 
+export function ListLoans(arg1:handlers.ListLoansRequest):Promise<Array<payroll.EmployeeLoan>>;
+
 export function ListLockedDates(arg1:handlers.ListLockedDatesRequest):Promise<Array<leave.LeaveLockedDate>>;
 
 export function ListMyLeaveRequests(arg1:handlers.ListLeaveRequestsRequest):Promise<Array<leave.LeaveRequest>>;
 
+export function ListPayComponents(arg1:handlers.ListPayComponentsRequest):Promise<Array<payroll.PayComponent>>;
+
+export function ListPaymentFileFormats(arg1:handlers.ListPaymentFileFormatsRequest):Promise<Array<payroll.PaymentFileFormatInfo>>;
+
 export function ListPayrollBatches(arg1:handlers.ListPayrollBatchesRequest):Promise<payroll.ListBatchesResult>;
 
 export function ListPayrollBatchesReport(arg1:handlers.ListPayrollBatchesReportRequest):Promise<reports.PayrollBatchesReportListResult>;
 
+export function ListProjects(arg1:handlers.ListProjectsRequest):Promise<Array<payroll.Project>>;
+
+export function ListSalaryHistory(arg1:handlers.ListSalaryHistoryRequest):Promise<Array<employees.SalaryChange>>;
+
 export function ListUsers(arg1:handlers.ListUsersRequest):Promise<users.ListUsersResult>;
 
+export function ListWorkSchedules(arg1:handlers.ListWorkSchedulesRequest):Promise<Array<schedules.WorkSchedule>>;
+
 export function LockDate(arg1:handlers.LockDateRequest):Promise<leave.LeaveLockedDate>;
 
 export function LockPayrollBatch(arg1:handlers.PayrollBatchActionRequest):Promise<payroll.PayrollBatch>;
@@ -119,44 +210,96 @@ export function Logout(arg1:handlers.LogoutRequest):Promise<void>;
 
 export function PostAbsentToLeave(arg1:handlers.PostAbsentToLeaveRequest):Promise<attendance.PostAbsentToLeaveResult>;
 
+export function PreviewLeaveDays(arg1:handlers.ApplyLeaveRequest):Promise<leave.LeaveDaysPreview>;
+
+export function RecordSalaryChange(arg1:handlers.RecordSalaryChangeRequest):Promise<employees.SalaryChange>;
+
 export function Refresh(arg1:handlers.RefreshRequest):Promise<handlers.LoginResponse>;
 
 export function RejectLeave(arg1:handlers.RejectLeaveRequest):Promise<leave.LeaveRequest>;
 
+export function RejectPayrollBatch(arg1:handlers.PayrollApprovalRequest):Promise<payroll.PayrollBatch>;
+
 export function ReloadConfigAndReconnect():Promise<main.ActionResult>;
 
 export function RemoveCompanyLogo(arg1:handlers.GetSettingsRequest):Promise<settings.CompanyProfileDTO>;
 
 export function RemoveEmployeeContract(arg1:handlers.RemoveEmployeeContractRequest):Promise<employees.Employee>;
 
+export function RemovePayrollEntryLine(arg1:handlers.RemovePayrollEntryLineRequest):Promise<payroll.PayrollEntry>;
+
+export function RenderBatchPayslipsZip(arg1:handlers.RenderBatchPayslipsRequest):Promise<payslips.FileExport>;
+
+export function RenderPayslipPDF(arg1:handlers.RenderPayslipPDFRequest):Promise<payslips.FileExport>;
+
+export function RenderTaxCertificatePDF(arg1:handlers.RenderTaxCertificateRequest):Promise<payslips.FileExport>;
+
+export function RenderTaxCertificatesZip(arg1:handlers.AnnualTaxSummaryRequest):Promise<payslips.FileExport>;
+
+export function ReopenPayrollBatch(arg1:handlers.PayrollBatchReasonRequest):Promise<payroll.PayrollBatch>;
+
 export function ResetUserPassword(arg1:handlers.ResetUserPasswordRequest):Promise<void>;
 
+export function ReversePayrollBatch(arg1:handlers.PayrollBatchReasonRequest):Promise<payroll.PayrollBatch>;
+
 export function SaveCompanyProfile(arg1:handlers.SaveCompanyProfileRequest):Promise<settings.CompanyProfileDTO>;
 
 export function SaveDatabaseConfig(arg1:main.DatabaseConfigParams):Promise<main.ActionResult>;
 
 export function SaveFileWithDialog(arg1:main.SaveFileWithDialogRequest):Promise<main.SaveFileWithDialogResult>;
 
+export function SavePayrollTaxSettings(arg1:handlers.SavePayrollTaxSettingsRequest):Promise<settings.PayrollTaxSettings>;
+
+export function SetContributionSchemeActive(arg1:handlers.SetContributionSchemeActiveRequest):Promise<payroll.ContributionScheme>;
+
+export function SetContributionSchemeMember(arg1:handlers.SetContributionSchemeMemberRequest):Promise<payroll.ContributionSchemeMember>;
+
+export function SetEmployeePaymentMethods(arg1:handlers.SetEmployeePaymentMethodsRequest):Promise<Array<payroll.EmployeePaymentMethod>>;
+
+export function SetEmployeeProjectAllocations(arg1:handlers.SetEmployeeProjectAllocationsRequest):Promise<Array<payroll.EmployeeProjectAllocation>>;
+
+export function SetHolidayActive(arg1:handlers.SetHolidayActiveRequest):Promise<holidays.Holiday>;
+
 export function SetLeaveTypeActive(arg1:handlers.SetLeaveTypeActiveRequest):Promise<leave.LeaveType>;
 
+export function SetPayComponentActive(arg1:handlers.SetPayComponentActiveRequest):Promise<payroll.PayComponent>;
+
+export function SetProjectActive(arg1:handlers.SetProjectActiveRequest):Promise<payroll.Project>;
+
 export function SetUserActive(arg1:handlers.SetUserActiveRequest):Promise<users.User>;
 
+export function SetWorkScheduleActive(arg1:handlers.SetWorkScheduleActiveRequest):Promise<schedules.WorkSchedule>;
+
+export function SimulatePayroll(arg1:handlers.SimulatePayrollRequest):Promise<payroll.PayrollSimulation>;
+
 export function TestDatabaseConnection(arg1:main.DatabaseConfigParams):Promise<main.ActionResult>;
 
 export function UnlockDate(arg1:handlers.UnlockDateRequest):Promise<void>;
 
+export function UpdateContributionScheme(arg1:handlers.UpdateContributionSchemeRequest):Promise<payroll.ContributionScheme>;
+
 export function UpdateDepartment(arg1:handlers.UpdateDepartmentRequest):Promise<departments.Department>;
 
 export function UpdateEmployee(arg1:handlers.UpdateEmployeeRequest):Promise<employees.Employee>;
 
+export function UpdateGLAccountMapping(arg1:handlers.UpdateGLAccountMappingRequest):Promise<payroll.GLAccountMapping>;
+
+export function UpdateHoliday(arg1:handlers.UpdateHolidayRequest):Promise<holidays.Holiday>;
+
 export function UpdateLeaveType(arg1:handlers.UpdateLeaveTypeRequest):Promise<leave.LeaveType>;
 
+export function UpdatePayComponent(arg1:handlers.UpdatePayComponentRequest):Promise<payroll.PayComponent>;
+
 export function UpdatePayrollEntryAmounts(arg1:handlers.UpdatePayrollEntryAmountsRequest):Promise<payroll.PayrollEntry>;
 
+export function UpdateProject(arg1:handlers.UpdateProjectRequest):Promise<payroll.Project>;
+
 export function UpdateSettings(arg1:handlers.UpdateSettingsRequest):Promise<settings.SettingsDTO>;
 
 export function UpdateUser(arg1:handlers.UpdateUserRequest):Promise<users.User>;
 
+export function UpdateWorkSchedule(arg1:handlers.UpdateWorkScheduleRequest):Promise<schedules.WorkSchedule>;
+
 export function UploadCompanyLogo(arg1:handlers.UploadCompanyLogoRequest):Promise<settings.CompanyProfileDTO>;
 
 export function UploadEmployeeContract(arg1:handlers.UploadEmployeeContractRequest):Promise<employees.Employee>;
@@ -166,3 +309,5 @@ export function UpsertAttendance(arg1:handlers.UpsertAttendanceRequest):Promise<
 export function UpsertEntitlement(arg1:handlers.UpsertEntitlementRequest):Promise<leave.LeaveEntitlement>;
 
 export function UpsertLunchVisitors(arg1:handlers.UpsertLunchVisitorsRequest):Promise<attendance.LunchSummary>;
+
+export function VerifyPayrollBatchIntegrity(arg1:handlers.PayrollBatchActionRequest):Promise<payroll.BatchIntegrityReport>;
@@ -2,6 +2,14 @@
 // Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
 // This file is automatically generated. DO NOT EDIT
 
+export function AcknowledgePayrollVariance(arg1) {
+  return window['go']['main']['App']['AcknowledgePayrollVariance'](arg1);
+}
+
+export function AddPayrollEntryLine(arg1) {
+  return window['go']['main']['App']['AddPayrollEntryLine'](arg1);
+}
+
 export function ApplyLeave(arg1) {
   return window['go']['main']['App']['ApplyLeave'](arg1);
 }
@@ -14,10 +22,26 @@ export function ApprovePayrollBatch(arg1) {
   return window['go']['main']['App']['ApprovePayrollBatch'](arg1);
 }
 
+export function AssignWorkSchedule(arg1) {
+  return window['go']['main']['App']['AssignWorkSchedule'](arg1);
+}
+
+export function CalculateRetroArrears(arg1) {
+  return window['go']['main']['App']['CalculateRetroArrears'](arg1);
+}
+
 export function CancelLeave(arg1) {
   return window['go']['main']['App']['CancelLeave'](arg1);
 }
 
+export function CancelLoan(arg1) {
+  return window['go']['main']['App']['CancelLoan'](arg1);
+}
+
+export function CreateContributionScheme(arg1) {
+  return window['go']['main']['App']['CreateContributionScheme'](arg1);
+}
+
 export function CreateDepartment(arg1) {
   return window['go']['main']['App']['CreateDepartment'](arg1);
 }
@@ -26,18 +50,42 @@ export function CreateEmployee(arg1) {
   return window['go']['main']['App']['CreateEmployee'](arg1);
 }
 
+export function CreateGLAccountMapping(arg1) {
+  return window['go']['main']['App']['CreateGLAccountMapping'](arg1);
+}
+
+export function CreateHoliday(arg1) {
+  return window['go']['main']['App']['CreateHoliday'](arg1);
+}
+
 export function CreateLeaveType(arg1) {
   return window['go']['main']['App']['CreateLeaveType'](arg1);
 }
 
+export function CreateLoan(arg1) {
+  return window['go']['main']['App']['CreateLoan'](arg1);
+}
+
+export function CreatePayComponent(arg1) {
+  return window['go']['main']['App']['CreatePayComponent'](arg1);
+}
+
 export function CreatePayrollBatch(arg1) {
   return window['go']['main']['App']['CreatePayrollBatch'](arg1);
 }
 
+export function CreateProject(arg1) {
+  return window['go']['main']['App']['CreateProject'](arg1);
+}
+
 export function CreateUser(arg1) {
   return window['go']['main']['App']['CreateUser'](arg1);
 }
 
+export function CreateWorkSchedule(arg1) {
+  return window['go']['main']['App']['CreateWorkSchedule'](arg1);
+}
+
 export function DeleteDepartment(arg1) {
   return window['go']['main']['App']['DeleteDepartment'](arg1);
 }
@@ -46,6 +94,22 @@ export function DeleteEmployee(arg1) {
   return window['go']['main']['App']['DeleteEmployee'](arg1);
 }
 
+export function DeleteEmployeeWorkSchedule(arg1) {
+  return window['go']['main']['App']['DeleteEmployeeWorkSchedule'](arg1);
+}
+
+export function DeleteGLAccountMapping(arg1) {
+  return window['go']['main']['App']['DeleteGLAccountMapping'](arg1);
+}
+
+export function DeleteHoliday(arg1) {
+  return window['go']['main']['App']['DeleteHoliday'](arg1);
+}
+
+export function ExportAnnualTaxReturnCSV(arg1) {
+  return window['go']['main']['App']['ExportAnnualTaxReturnCSV'](arg1);
+}
+
 export function ExportAttendanceSummaryReportCSV(arg1) {
   return window['go']['main']['App']['ExportAttendanceSummaryReportCSV'](arg1);
 }
@@ -54,6 +118,10 @@ export function ExportAuditLogReportCSV(arg1) {
   return window['go']['main']['App']['ExportAuditLogReportCSV'](arg1);
 }
 
+export function ExportContributionScheduleCSV(arg1) {
+  return window['go']['main']['App']['ExportContributionScheduleCSV'](arg1);
+}
+
 export function ExportEmployeeReportCSV(arg1) {
   return window['go']['main']['App']['ExportEmployeeReportCSV'](arg1);
 }
@@ -62,6 +130,14 @@ export function ExportLeaveRequestsReportCSV(arg1) {
   return window['go']['main']['App']['ExportLeaveRequestsReportCSV'](arg1);
 }
 
+export function ExportLoanLedgerCSV(arg1) {
+  return window['go']['main']['App']['ExportLoanLedgerCSV'](arg1);
+}
+
+export function ExportPaymentFile(arg1) {
+  return window['go']['main']['App']['ExportPaymentFile'](arg1);
+}
+
 export function ExportPayrollBatchCSV(arg1) {
   return window['go']['main']['App']['ExportPayrollBatchCSV'](arg1);
 }
@@ -70,10 +146,26 @@ export function ExportPayrollBatchesReportCSV(arg1) {
   return window['go']['main']['App']['ExportPayrollBatchesReportCSV'](arg1);
 }
 
+export function ExportPayrollJournal(arg1) {
+  return window['go']['main']['App']['ExportPayrollJournal'](arg1);
+}
+
+export function ExportPayrollVarianceCSV(arg1) {
+  return window['go']['main']['App']['ExportPayrollVarianceCSV'](arg1);
+}
+
+export function ExportProjectChargingCSV(arg1) {
+  return window['go']['main']['App']['ExportProjectChargingCSV'](arg1);
+}
+
 export function GeneratePayrollEntries(arg1) {
   return window['go']['main']['App']['GeneratePayrollEntries'](arg1);
 }
 
+export function GetAnnualTaxSummary(arg1) {
+  return window['go']['main']['App']['GetAnnualTaxSummary'](arg1);
+}
+
 export function GetCompanyLogo(arg1) {
   return window['go']['main']['App']['GetCompanyLogo'](arg1);
 }
@@ -98,6 +190,10 @@ export function GetLeaveBalance(arg1) {
   return window['go']['main']['App']['GetLeaveBalance'](arg1);
 }
 
+export function GetLoanLedger(arg1) {
+  return window['go']['main']['App']['GetLoanLedger'](arg1);
+}
+
 export function GetLunchSummary(arg1) {
   return window['go']['main']['App']['GetLunchSummary'](arg1);
 }
@@ -118,6 +214,22 @@ export function GetPayrollBatch(arg1) {
   return window['go']['main']['App']['GetPayrollBatch'](arg1);
 }
 
+export function GetPayrollJournal(arg1) {
+  return window['go']['main']['App']['GetPayrollJournal'](arg1);
+}
+
+export function GetPayrollTaxSettings(arg1) {
+  return window['go']['main']['App']['GetPayrollTaxSettings'](arg1);
+}
+
+export function GetPayrollVarianceReport(arg1) {
+  return window['go']['main']['App']['GetPayrollVarianceReport'](arg1);
+}
+
+export function GetProjectChargingReport(arg1) {
+  return window['go']['main']['App']['GetProjectChargingReport'](arg1);
+}
+
 export function GetSettings(arg1) {
   return window['go']['main']['App']['GetSettings'](arg1);
 }
@@ -134,6 +246,14 @@ export function ImportCompanyLogoFromURL(arg1) {
   return window['go']['main']['App']['ImportCompanyLogoFromURL'](arg1);
 }
 
+export function ImportHolidays(arg1) {
+  return window['go']['main']['App']['ImportHolidays'](arg1);
+}
+
+export function ImportPayrollEntryAmounts(arg1) {
+  return window['go']['main']['App']['ImportPayrollEntryAmounts'](arg1);
+}
+
 export function ListAllLeaveRequests(arg1) {
   return window['go']['main']['App']['ListAllLeaveRequests'](arg1);
 }
@@ -154,18 +274,50 @@ export function ListAuditLogs(arg1) {
   return window['go']['main']['App']['ListAuditLogs'](arg1);
 }
 
+export function ListContributionSchemeMembers(arg1) {
+  return window['go']['main']['App']['ListContributionSchemeMembers'](arg1);
+}
+
+export function ListContributionSchemes(arg1) {
+  return window['go']['main']['App']['ListContributionSchemes'](arg1);
+}
+
 export function ListDepartments(arg1) {
   return window['go']['main']['App']['ListDepartments'](arg1);
 }
 
+export function ListEmployeePaymentMethods(arg1) {
+  return window['go']['main']['App']['ListEmployeePaymentMethods'](arg1);
+}
+
+export function ListEmployeeProjectAllocations(arg1) {
+  return window['go']['main']['App']['ListEmployeeProjectAllocations'](arg1);
+}
+
 export function ListEmployeeReport(arg1) {
   return window['go']['main']['App']['ListEmployeeReport'](arg1);
 }
 
+export function ListEmployeeWorkSchedules(arg1) {
+  return window['go']['main']['App']['ListEmployeeWorkSchedules'](arg1);
+}
+
 export function ListEmployees(arg1) {
   return window['go']['main']['App']['ListEmployees'](arg1);
 }
 
+export function ListGLAccountMappings(arg1) {
+  return window['go']['main']['App']['ListGLAccountMappings'](arg1);
+}
+
+export function ListHolidayCalendar(arg1) {
+  return window['go']['main']['App']['ListHolidayCalendar'](arg1);
+}
+
+export function ListHolidays(arg1) {
+  return window['go']['main']['App']['ListHolidays'](arg1);
+}
+
 export function ListLeaveRequestsReport(arg1) {
   return window['go']['main']['App']['ListLeaveRequestsReport'](arg1);
 }
@@ -174,6 +326,10 @@ export function ListLeaveTypes(arg1) {
   return window['go']['main']['App']['ListLeaveTypes'](arg1);
 }
 
+export function ListLoans(arg1) {
+  return window['go']['main']['App']['ListLoans'](arg1);
+}
+
 export function ListLockedDates(arg1) {
   return window['go']['main']['App']['ListLockedDates'](arg1);
 }
@@ -182,6 +338,14 @@ export function ListMyLeaveRequests(arg1) {
   return window['go']['main']['App']['ListMyLeaveRequests'](arg1);
 }
 
+export function ListPayComponents(arg1) {
+  return window['go']['main']['App']['ListPayComponents'](arg1);
+}
+
+export function ListPaymentFileFormats(arg1) {
+  return window['go']['main']['App']['ListPaymentFileFormats'](arg1);
+}
+
 export function ListPayrollBatches(arg1) {
   return window['go']['main']['App']['ListPayrollBatches'](arg1);
 }
@@ -190,10 +354,22 @@ export function ListPayrollBatchesReport(arg1) {
   return window['go']['main']['App']['ListPayrollBatchesReport'](arg1);
 }
 
+export function ListProjects(arg1) {
+  return window['go']['main']['App']['ListProjects'](arg1);
+}
+
+export function ListSalaryHistory(arg1) {
+  return window['go']['main']['App']['ListSalaryHistory'](arg1);
+}
+
 export function ListUsers(arg1) {
   return window['go']['main']['App']['ListUsers'](arg1);
 }
 
+export function ListWorkSchedules(arg1) {
+  return window['go']['main']['App']['ListWorkSchedules'](arg1);
+}
+
 export function LockDate(arg1) {
   return window['go']['main']['App']['LockDate'](arg1);
 }
@@ -214,6 +390,14 @@ export function PostAbsentToLeave(arg1) {
   return window['go']['main']['App']['PostAbsentToLeave'](arg1);
 }
 
+export function PreviewLeaveDays(arg1) {
+  return window['go']['main']['App']['PreviewLeaveDays'](arg1);
+}
+
+export function RecordSalaryChange(arg1) {
+  return window['go']['main']['App']['RecordSalaryChange'](arg1);
+}
+
 export function Refresh(arg1) {
   return window['go']['main']['App']['Refresh'](arg1);
 }
@@ -222,6 +406,10 @@ export function RejectLeave(arg1) {
   return window['go']['main']['App']['RejectLeave'](arg1);
 }
 
+export function RejectPayrollBatch(arg1) {
+  return window['go']['main']['App']['RejectPayrollBatch'](arg1);
+}
+
 export function ReloadConfigAndReconnect() {
   return window['go']['main']['App']['ReloadConfigAndReconnect']();
 }
@@ -234,10 +422,38 @@ export function RemoveEmployeeContract(arg1) {
   return window['go']['main']['App']['RemoveEmployeeContract'](arg1);
 }
 
+export function RemovePayrollEntryLine(arg1) {
+  return window['go']['main']['App']['RemovePayrollEntryLine'](arg1);
+}
+
+export function RenderBatchPayslipsZip(arg1) {
+  return window['go']['main']['App']['RenderBatchPayslipsZip'](arg1);
+}
+
+export function RenderPayslipPDF(arg1) {
+  return window['go']['main']['App']['RenderPayslipPDF'](arg1);
+}
+
+export function RenderTaxCertificatePDF(arg1) {
+  return window['go']['main']['App']['RenderTaxCertificatePDF'](arg1);
+}
+
+export function RenderTaxCertificatesZip(arg1) {
+  return window['go']['main']['App']['RenderTaxCertificatesZip'](arg1);
+}
+
+export function ReopenPayrollBatch(arg1) {
+  return window['go']['main']['App']['ReopenPayrollBatch'](arg1);
+}
+
 export function ResetUserPassword(arg1) {
   return window['go']['main']['App']['ResetUserPassword'](arg1);
 }
 
+export function ReversePayrollBatch(arg1) {
+  return window['go']['main']['App']['ReversePayrollBatch'](arg1);
+}
+
 export function SaveCompanyProfile(arg1) {
   return window['go']['main']['App']['SaveCompanyProfile'](arg1);
 }
@@ -250,14 +466,54 @@ export function SaveFileWithDialog(arg1) {
   return window['go']['main']['App']['SaveFileWithDialog'](arg1);
 }
 
+export function SavePayrollTaxSettings(arg1) {
+  return window['go']['main']['App']['SavePayrollTaxSettings'](arg1);
+}
+
+export function SetContributionSchemeActive(arg1) {
+  return window['go']['main']['App']['SetContributionSchemeActive'](arg1);
+}
+
+export function SetContributionSchemeMember(arg1) {
+  return window['go']['main']['App']['SetContributionSchemeMember'](arg1);
+}
+
+export function SetEmployeePaymentMethods(arg1) {
+  return window['go']['main']['App']['SetEmployeePaymentMethods'](arg1);
+}
+
+export function SetEmployeeProjectAllocations(arg1) {
+  return window['go']['main']['App']['SetEmployeeProjectAllocations'](arg1);
+}
+
+export function SetHolidayActive(arg1) {
+  return window['go']['main']['App']['SetHolidayActive'](arg1);
+}
+
 export function SetLeaveTypeActive(arg1) {
   return window['go']['main']['App']['SetLeaveTypeActive'](arg1);
 }
 
+export function SetPayComponentActive(arg1) {
+  return window['go']['main']['App']['SetPayComponentActive'](arg1);
+}
+
+export function SetProjectActive(arg1) {
+  return window['go']['main']['App']['SetProjectActive'](arg1);
+}
+
 export function SetUserActive(arg1) {
   return window['go']['main']['App']['SetUserActive'](arg1);
 }
 
+export function SetWorkScheduleActive(arg1) {
+  return window['go']['main']['App']['SetWorkScheduleActive'](arg1);
+}
+
+export function SimulatePayroll(arg1) {
+  return window['go']['main']['App']['SimulatePayroll'](arg1);
+}
+
 export function TestDatabaseConnection(arg1) {
   return window['go']['main']['App']['TestDatabaseConnection'](arg1);
 }
@@ -266,6 +522,10 @@ export function UnlockDate(arg1) {
   return window['go']['main']['App']['UnlockDate'](arg1);
 }
 
+export function UpdateContributionScheme(arg1) {
+  return window['go']['main']['App']['UpdateContributionScheme'](arg1);
+}
+
 export function UpdateDepartment(arg1) {
   return window['go']['main']['App']['UpdateDepartment'](arg1);
 }
@@ -274,14 +534,30 @@ export function UpdateEmployee(arg1) {
   return window['go']['main']['App']['UpdateEmployee'](arg1);
 }
 
+export function UpdateGLAccountMapping(arg1) {
+  return window['go']['main']['App']['UpdateGLAccountMapping'](arg1);
+}
+
+export function UpdateHoliday(arg1) {
+  return window['go']['main']['App']['UpdateHoliday'](arg1);
+}
+
 export function UpdateLeaveType(arg1) {
   return window['go']['main']['App']['UpdateLeaveType'](arg1);
 }
 
+export function UpdatePayComponent(arg1) {
+  return window['go']['main']['App']['UpdatePayComponent'](arg1);
+}
+
 export function UpdatePayrollEntryAmounts(arg1) {
   return window['go']['main']['App']['UpdatePayrollEntryAmounts'](arg1);
 }
 
+export function UpdateProject(arg1) {
+  return window['go']['main']['App']['UpdateProject'](arg1);
+}
+
 export function UpdateSettings(arg1) {
   return window['go']['main']['App']['UpdateSettings'](arg1);
 }
@@ -290,6 +566,10 @@ export function UpdateUser(arg1) {
   return window['go']['main']['App']['UpdateUser'](arg1);
 }
 
+export function UpdateWorkSchedule(arg1) {
+  return window['go']['main']['App']['UpdateWorkSchedule'](arg1);
+}
+
 export function UploadCompanyLogo(arg1) {
   return window['go']['main']['App']['UploadCompanyLogo'](arg1);
 }
@@ -309,3 +589,7 @@ export function UpsertEntitlement(arg1) {
 export function UpsertLunchVisitors(arg1) {
   return window['go']['main']['App']['UpsertLunchVisitors'](arg1);
 }
+
+export function VerifyPayrollBatchIntegrity(arg1) {
+  return window['go']['main']['App']['VerifyPayrollBatchIntegrity'](arg1);
+}
@@ -58,6 +58,7 @@ export namespace attendance {
 	    departmentName?: string;
 	    attendanceId?: number;
 	    status: string;
+	    expectedDay: number;
 	    isLocked: boolean;
 	    canPostToLeave: boolean;
 	    canEdit: boolean;
@@ -76,6 +77,7 @@ export namespace attendance {
 	        this.departmentName = source["departmentName"];
 	        this.attendanceId = source["attendanceId"];
 	        this.status = source["status"];
+	        this.expectedDay = source["expectedDay"];
 	        this.isLocked = source["isLocked"];
 	        this.canPostToLeave = source["canPostToLeave"];
 	        this.canEdit = source["canEdit"];
@@ -356,6 +358,7 @@ export namespace departments {
 	    id: number;
 	    name: string;
 	    description?: string;
+	    costCentre?: string;
 	    employeeCount: number;
 	    // Go type: time
 	    createdAt: any;
@@ -371,6 +374,7 @@ export namespace departments {
 	        this.id = source["id"];
 	        this.name = source["name"];
 	        this.description = source["description"];
+	        this.costCentre = source["costCentre"];
 	        this.employeeCount = source["employeeCount"];
 	        this.createdAt = this.convertValues(source["createdAt"], null);
 	        this.updatedAt = this.convertValues(source["updatedAt"], null);
@@ -397,6 +401,7 @@ export namespace departments {
 	export class UpsertDepartmentInput {
 	    name: string;
 	    description?: string;
+	    costCentre?: string;
 	
 	    static createFrom(source: any = {}) {
 	        return new UpsertDepartmentInput(source);
@@ -406,6 +411,7 @@ export namespace departments {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.name = source["name"];
 	        this.description = source["description"];
+	        this.costCentre = source["costCentre"];
 	    }
 	}
 
@@ -435,6 +441,8 @@ export namespace employees {
 	    employmentStatus: string;
 	    // Go type: time
 	    dateOfHire: any;
+	    // Go type: time
+	    dateOfExit?: any;
 	    baseSalaryAmount: number;
 	    // Go type: time
 	    createdAt: any;
@@ -466,6 +474,7 @@ export namespace employees {
 	        this.position = source["position"];
 	        this.employmentStatus = source["employmentStatus"];
 	        this.dateOfHire = this.convertValues(source["dateOfHire"], null);
+	        this.dateOfExit = this.convertValues(source["dateOfExit"], null);
 	        this.baseSalaryAmount = source["baseSalaryAmount"];
 	        this.createdAt = this.convertValues(source["createdAt"], null);
 	        this.updatedAt = this.convertValues(source["updatedAt"], null);
@@ -489,6 +498,68 @@ export namespace employees {
 		    return a;
 		}
 	}
+	export class RecordSalaryChangeInput {
+	    amount: number;
+	    effectiveFrom: string;
+	    reason: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new RecordSalaryChangeInput(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.amount = source["amount"];
+	        this.effectiveFrom = source["effectiveFrom"];
+	        this.reason = source["reason"];
+	    }
+	}
+	export class SalaryChange {
+	    id: number;
+	    employeeId: number;
+	    amount: number;
+	    // Go type: time
+	    effectiveFrom: any;
+	    reason: string;
+	    approvedBy?: number;
+	    approvedByName?: string;
+	    // Go type: time
+	    createdAt: any;
+	
+	    static createFrom(source: any = {}) {
+	        return new SalaryChange(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.employeeId = source["employeeId"];
+	        this.amount = source["amount"];
+	        this.effectiveFrom = this.convertValues(source["effectiveFrom"], null);
+	        this.reason = source["reason"];
+	        this.approvedBy = source["approvedBy"];
+	        this.approvedByName = source["approvedByName"];
+	        this.createdAt = this.convertValues(source["createdAt"], null);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
 	export class UpsertEmployeeInput {
 	    firstName: string;
 	    lastName: string;
@@ -505,7 +576,10 @@ export namespace employees {
 	    position: string;
 	    employmentStatus: string;
 	    dateOfHire: string;
+	    dateOfExit?: string;
 	    baseSalaryAmount: number;
+	    salaryEffectiveFrom?: string;
+	    salaryChangeReason?: string;
 	
 	    static createFrom(source: any = {}) {
 	        return new UpsertEmployeeInput(source);
@@ -528,7 +602,10 @@ export namespace employees {
 	        this.position = source["position"];
 	        this.employmentStatus = source["employmentStatus"];
 	        this.dateOfHire = source["dateOfHire"];
+	        this.dateOfExit = source["dateOfExit"];
 	        this.baseSalaryAmount = source["baseSalaryAmount"];
+	        this.salaryEffectiveFrom = source["salaryEffectiveFrom"];
+	        this.salaryChangeReason = source["salaryChangeReason"];
 	    }
 	}
 
@@ -536,18 +613,20 @@ export namespace employees {
 
 export namespace handlers {
 	
-	export class ApplyLeaveRequest {
+	export class AddPayrollEntryLineRequest {
 	    accessToken: string;
-	    payload: leave.ApplyLeaveInput;
+	    entryId: number;
+	    payload: payroll.AddEntryLineInput;
 	
 	    static createFrom(source: any = {}) {
-	        return new ApplyLeaveRequest(source);
+	        return new AddPayrollEntryLineRequest(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.accessToken = source["accessToken"];
-	        this.payload = this.convertValues(source["payload"], leave.ApplyLeaveInput);
+	        this.entryId = source["entryId"];
+	        this.payload = this.convertValues(source["payload"], payroll.AddEntryLineInput);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -568,18 +647,34 @@ export namespace handlers {
 		    return a;
 		}
 	}
-	export class CreateDepartmentRequest {
+	export class AnnualTaxSummaryRequest {
 	    accessToken: string;
-	    payload: departments.UpsertDepartmentInput;
+	    year: number;
+	    startMonth: number;
 	
 	    static createFrom(source: any = {}) {
-	        return new CreateDepartmentRequest(source);
+	        return new AnnualTaxSummaryRequest(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.accessToken = source["accessToken"];
-	        this.payload = this.convertValues(source["payload"], departments.UpsertDepartmentInput);
+	        this.year = source["year"];
+	        this.startMonth = source["startMonth"];
+	    }
+	}
+	export class ApplyLeaveRequest {
+	    accessToken: string;
+	    payload: leave.ApplyLeaveInput;
+	
+	    static createFrom(source: any = {}) {
+	        return new ApplyLeaveRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.accessToken = source["accessToken"];
+	        this.payload = this.convertValues(source["payload"], leave.ApplyLeaveInput);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -600,18 +695,18 @@ export namespace handlers {
 		    return a;
 		}
 	}
-	export class CreateEmployeeRequest {
+	export class AssignWorkScheduleRequest {
 	    accessToken: string;
-	    payload: employees.UpsertEmployeeInput;
+	    payload: schedules.AssignWorkScheduleInput;
 	
 	    static createFrom(source: any = {}) {
-	        return new CreateEmployeeRequest(source);
+	        return new AssignWorkScheduleRequest(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.accessToken = source["accessToken"];
-	        this.payload = this.convertValues(source["payload"], employees.UpsertEmployeeInput);
+	        this.payload = this.convertValues(source["payload"], schedules.AssignWorkScheduleInput);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -632,18 +727,18 @@ export namespace handlers {
 		    return a;
 		}
 	}
-	export class CreateLeaveTypeRequest {
+	export class CreateContributionSchemeRequest {
 	    accessToken: string;
-	    payload: leave.LeaveTypeUpsertInput;
+	    payload: payroll.ContributionSchemeUpsertInput;
 	
 	    static createFrom(source: any = {}) {
-	        return new CreateLeaveTypeRequest(source);
+	        return new CreateContributionSchemeRequest(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.accessToken = source["accessToken"];
-	        this.payload = this.convertValues(source["payload"], leave.LeaveTypeUpsertInput);
+	        this.payload = this.convertValues(source["payload"], payroll.ContributionSchemeUpsertInput);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -664,18 +759,18 @@ export namespace handlers {
 		    return a;
 		}
 	}
-	export class CreatePayrollBatchRequest {
+	export class CreateDepartmentRequest {
 	    accessToken: string;
-	    payload: payroll.CreateBatchInput;
+	    payload: departments.UpsertDepartmentInput;
 	
 	    static createFrom(source: any = {}) {
-	        return new CreatePayrollBatchRequest(source);
+	        return new CreateDepartmentRequest(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.accessToken = source["accessToken"];
-	        this.payload = this.convertValues(source["payload"], payroll.CreateBatchInput);
+	        this.payload = this.convertValues(source["payload"], departments.UpsertDepartmentInput);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -696,18 +791,18 @@ export namespace handlers {
 		    return a;
 		}
 	}
-	export class CreateUserRequest {
+	export class CreateEmployeeRequest {
 	    accessToken: string;
-	    payload: users.CreateUserInput;
+	    payload: employees.UpsertEmployeeInput;
 	
 	    static createFrom(source: any = {}) {
-	        return new CreateUserRequest(source);
+	        return new CreateEmployeeRequest(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.accessToken = source["accessToken"];
-	        this.payload = this.convertValues(source["payload"], users.CreateUserInput);
+	        this.payload = this.convertValues(source["payload"], employees.UpsertEmployeeInput);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -728,50 +823,50 @@ export namespace handlers {
 		    return a;
 		}
 	}
-	export class DeleteDepartmentRequest {
+	export class CreateGLAccountMappingRequest {
 	    accessToken: string;
-	    id: number;
+	    payload: payroll.GLAccountMappingInput;
 	
 	    static createFrom(source: any = {}) {
-	        return new DeleteDepartmentRequest(source);
+	        return new CreateGLAccountMappingRequest(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.accessToken = source["accessToken"];
-	        this.id = source["id"];
+	        this.payload = this.convertValues(source["payload"], payroll.GLAccountMappingInput);
 	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
 	}
-	export class DeleteEmployeeRequest {
+	export class CreateHolidayRequest {
 	    accessToken: string;
-	    id: number;
+	    payload: holidays.HolidayUpsertInput;
 	
 	    static createFrom(source: any = {}) {
-	        return new DeleteEmployeeRequest(source);
+	        return new CreateHolidayRequest(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.accessToken = source["accessToken"];
-	        this.id = source["id"];
-	    }
-	}
-	export class DepartmentListResponse {
-	    items: departments.Department[];
-	    totalCount: number;
-	    page: number;
-	    pageSize: number;
-	
-	    static createFrom(source: any = {}) {
-	        return new DepartmentListResponse(source);
-	    }
-	
-	    constructor(source: any = {}) {
-	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.items = this.convertValues(source["items"], departments.Department);
-	        this.totalCount = source["totalCount"];
-	        this.page = source["page"];
-	        this.pageSize = source["pageSize"];
+	        this.payload = this.convertValues(source["payload"], holidays.HolidayUpsertInput);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -792,22 +887,18 @@ export namespace handlers {
 		    return a;
 		}
 	}
-	export class EmployeeListResponse {
-	    items: employees.Employee[];
-	    totalCount: number;
-	    page: number;
-	    pageSize: number;
+	export class CreateLeaveTypeRequest {
+	    accessToken: string;
+	    payload: leave.LeaveTypeUpsertInput;
 	
 	    static createFrom(source: any = {}) {
-	        return new EmployeeListResponse(source);
+	        return new CreateLeaveTypeRequest(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.items = this.convertValues(source["items"], employees.Employee);
-	        this.totalCount = source["totalCount"];
-	        this.page = source["page"];
-	        this.pageSize = source["pageSize"];
+	        this.accessToken = source["accessToken"];
+	        this.payload = this.convertValues(source["payload"], leave.LeaveTypeUpsertInput);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -828,18 +919,18 @@ export namespace handlers {
 		    return a;
 		}
 	}
-	export class ExportAttendanceSummaryReportRequest {
+	export class CreateLoanRequest {
 	    accessToken: string;
-	    filters: reports.AttendanceSummaryFilter;
+	    payload: payroll.LoanCreateInput;
 	
 	    static createFrom(source: any = {}) {
-	        return new ExportAttendanceSummaryReportRequest(source);
+	        return new CreateLoanRequest(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.accessToken = source["accessToken"];
-	        this.filters = this.convertValues(source["filters"], reports.AttendanceSummaryFilter);
+	        this.payload = this.convertValues(source["payload"], payroll.LoanCreateInput);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -860,18 +951,18 @@ export namespace handlers {
 		    return a;
 		}
 	}
-	export class ExportAuditLogReportRequest {
+	export class CreatePayComponentRequest {
 	    accessToken: string;
-	    filters: reports.AuditLogFilter;
+	    payload: payroll.PayComponentUpsertInput;
 	
 	    static createFrom(source: any = {}) {
-	        return new ExportAuditLogReportRequest(source);
+	        return new CreatePayComponentRequest(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.accessToken = source["accessToken"];
-	        this.filters = this.convertValues(source["filters"], reports.AuditLogFilter);
+	        this.payload = this.convertValues(source["payload"], payroll.PayComponentUpsertInput);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -892,18 +983,18 @@ export namespace handlers {
 		    return a;
 		}
 	}
-	export class ExportEmployeeReportRequest {
+	export class CreatePayrollBatchRequest {
 	    accessToken: string;
-	    filters: reports.EmployeeListFilter;
+	    payload: payroll.CreateBatchInput;
 	
 	    static createFrom(source: any = {}) {
-	        return new ExportEmployeeReportRequest(source);
+	        return new CreatePayrollBatchRequest(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.accessToken = source["accessToken"];
-	        this.filters = this.convertValues(source["filters"], reports.EmployeeListFilter);
+	        this.payload = this.convertValues(source["payload"], payroll.CreateBatchInput);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -924,18 +1015,18 @@ export namespace handlers {
 		    return a;
 		}
 	}
-	export class ExportLeaveRequestsReportRequest {
+	export class CreateProjectRequest {
 	    accessToken: string;
-	    filters: reports.LeaveRequestsFilter;
+	    payload: payroll.ProjectUpsertInput;
 	
 	    static createFrom(source: any = {}) {
-	        return new ExportLeaveRequestsReportRequest(source);
+	        return new CreateProjectRequest(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.accessToken = source["accessToken"];
-	        this.filters = this.convertValues(source["filters"], reports.LeaveRequestsFilter);
+	        this.payload = this.convertValues(source["payload"], payroll.ProjectUpsertInput);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -956,18 +1047,18 @@ export namespace handlers {
 		    return a;
 		}
 	}
-	export class ExportPayrollBatchesReportRequest {
+	export class CreateUserRequest {
 	    accessToken: string;
-	    filters: reports.PayrollBatchesFilter;
+	    payload: users.CreateUserInput;
 	
 	    static createFrom(source: any = {}) {
-	        return new ExportPayrollBatchesReportRequest(source);
+	        return new CreateUserRequest(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.accessToken = source["accessToken"];
-	        this.filters = this.convertValues(source["filters"], reports.PayrollBatchesFilter);
+	        this.payload = this.convertValues(source["payload"], users.CreateUserInput);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -988,36 +1079,58 @@ export namespace handlers {
 		    return a;
 		}
 	}
-	export class GetCompanyLogoRequest {
+	export class CreateWorkScheduleRequest {
 	    accessToken: string;
+	    payload: schedules.WorkScheduleUpsertInput;
 	
 	    static createFrom(source: any = {}) {
-	        return new GetCompanyLogoRequest(source);
+	        return new CreateWorkScheduleRequest(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.accessToken = source["accessToken"];
+	        this.payload = this.convertValues(source["payload"], schedules.WorkScheduleUpsertInput);
 	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
 	}
-	export class GetDashboardSummaryRequest {
+	export class DeleteDepartmentRequest {
 	    accessToken: string;
+	    id: number;
 	
 	    static createFrom(source: any = {}) {
-	        return new GetDashboardSummaryRequest(source);
+	        return new DeleteDepartmentRequest(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.accessToken = source["accessToken"];
+	        this.id = source["id"];
 	    }
 	}
-	export class GetDepartmentRequest {
+	export class DeleteEmployeeRequest {
 	    accessToken: string;
 	    id: number;
 	
 	    static createFrom(source: any = {}) {
-	        return new GetDepartmentRequest(source);
+	        return new DeleteEmployeeRequest(source);
 	    }
 	
 	    constructor(source: any = {}) {
@@ -1026,12 +1139,12 @@ export namespace handlers {
 	        this.id = source["id"];
 	    }
 	}
-	export class GetEmployeeRequest {
+	export class DeleteEmployeeWorkScheduleRequest {
 	    accessToken: string;
 	    id: number;
 	
 	    static createFrom(source: any = {}) {
-	        return new GetEmployeeRequest(source);
+	        return new DeleteEmployeeWorkScheduleRequest(source);
 	    }
 	
 	    constructor(source: any = {}) {
@@ -1040,46 +1153,50 @@ export namespace handlers {
 	        this.id = source["id"];
 	    }
 	}
-	export class GetLunchSummaryRequest {
+	export class DeleteGLAccountMappingRequest {
 	    accessToken: string;
-	    date: string;
+	    id: number;
 	
 	    static createFrom(source: any = {}) {
-	        return new GetLunchSummaryRequest(source);
+	        return new DeleteGLAccountMappingRequest(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.accessToken = source["accessToken"];
-	        this.date = source["date"];
+	        this.id = source["id"];
 	    }
 	}
-	export class UserDTO {
+	export class DeleteHolidayRequest {
+	    accessToken: string;
 	    id: number;
-	    username: string;
-	    role: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new UserDTO(source);
+	        return new DeleteHolidayRequest(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.accessToken = source["accessToken"];
 	        this.id = source["id"];
-	        this.username = source["username"];
-	        this.role = source["role"];
 	    }
 	}
-	export class GetMeResponse {
-	    user: UserDTO;
+	export class DepartmentListResponse {
+	    items: departments.Department[];
+	    totalCount: number;
+	    page: number;
+	    pageSize: number;
 	
 	    static createFrom(source: any = {}) {
-	        return new GetMeResponse(source);
+	        return new DepartmentListResponse(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.user = this.convertValues(source["user"], UserDTO);
+	        this.items = this.convertValues(source["items"], departments.Department);
+	        this.totalCount = source["totalCount"];
+	        this.page = source["page"];
+	        this.pageSize = source["pageSize"];
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -1100,134 +1217,22 @@ export namespace handlers {
 		    return a;
 		}
 	}
-	export class GetMyAttendanceRangeRequest {
-	    accessToken: string;
-	    startDate: string;
-	    endDate: string;
+	export class EmployeeListResponse {
+	    items: employees.Employee[];
+	    totalCount: number;
+	    page: number;
+	    pageSize: number;
 	
 	    static createFrom(source: any = {}) {
-	        return new GetMyAttendanceRangeRequest(source);
-	    }
-	
-	    constructor(source: any = {}) {
-	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.accessToken = source["accessToken"];
-	        this.startDate = source["startDate"];
-	        this.endDate = source["endDate"];
-	    }
-	}
-	export class GetPayrollBatchRequest {
-	    accessToken: string;
-	    batchId: number;
-	
-	    static createFrom(source: any = {}) {
-	        return new GetPayrollBatchRequest(source);
-	    }
-	
-	    constructor(source: any = {}) {
-	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.accessToken = source["accessToken"];
-	        this.batchId = source["batchId"];
-	    }
-	}
-	export class GetSettingsRequest {
-	    accessToken: string;
-	
-	    static createFrom(source: any = {}) {
-	        return new GetSettingsRequest(source);
-	    }
-	
-	    constructor(source: any = {}) {
-	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.accessToken = source["accessToken"];
-	    }
-	}
-	export class GetUserRequest {
-	    accessToken: string;
-	    id: number;
-	
-	    static createFrom(source: any = {}) {
-	        return new GetUserRequest(source);
-	    }
-	
-	    constructor(source: any = {}) {
-	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.accessToken = source["accessToken"];
-	        this.id = source["id"];
-	    }
-	}
-	export class ImportCompanyLogoFromURLRequest {
-	    accessToken: string;
-	    url: string;
-	
-	    static createFrom(source: any = {}) {
-	        return new ImportCompanyLogoFromURLRequest(source);
-	    }
-	
-	    constructor(source: any = {}) {
-	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.accessToken = source["accessToken"];
-	        this.url = source["url"];
-	    }
-	}
-	export class LeaveActionRequest {
-	    accessToken: string;
-	    id: number;
-	
-	    static createFrom(source: any = {}) {
-	        return new LeaveActionRequest(source);
-	    }
-	
-	    constructor(source: any = {}) {
-	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.accessToken = source["accessToken"];
-	        this.id = source["id"];
-	    }
-	}
-	export class LeaveBalanceRequest {
-	    accessToken: string;
-	    employeeId: number;
-	    year: number;
-	
-	    static createFrom(source: any = {}) {
-	        return new LeaveBalanceRequest(source);
-	    }
-	
-	    constructor(source: any = {}) {
-	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.accessToken = source["accessToken"];
-	        this.employeeId = source["employeeId"];
-	        this.year = source["year"];
-	    }
-	}
-	export class ListAttendanceByDateRequest {
-	    accessToken: string;
-	    date: string;
-	
-	    static createFrom(source: any = {}) {
-	        return new ListAttendanceByDateRequest(source);
-	    }
-	
-	    constructor(source: any = {}) {
-	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.accessToken = source["accessToken"];
-	        this.date = source["date"];
-	    }
-	}
-	export class ListAttendanceSummaryReportRequest {
-	    accessToken: string;
-	    filters: reports.AttendanceSummaryFilter;
-	    pager: reports.PagerInput;
-	
-	    static createFrom(source: any = {}) {
-	        return new ListAttendanceSummaryReportRequest(source);
+	        return new EmployeeListResponse(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.accessToken = source["accessToken"];
-	        this.filters = this.convertValues(source["filters"], reports.AttendanceSummaryFilter);
-	        this.pager = this.convertValues(source["pager"], reports.PagerInput);
+	        this.items = this.convertValues(source["items"], employees.Employee);
+	        this.totalCount = source["totalCount"];
+	        this.page = source["page"];
+	        this.pageSize = source["pageSize"];
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -1248,20 +1253,18 @@ export namespace handlers {
 		    return a;
 		}
 	}
-	export class ListAuditLogReportRequest {
+	export class ExportAttendanceSummaryReportRequest {
 	    accessToken: string;
-	    filters: reports.AuditLogFilter;
-	    pager: reports.PagerInput;
+	    filters: reports.AttendanceSummaryFilter;
 	
 	    static createFrom(source: any = {}) {
-	        return new ListAuditLogReportRequest(source);
+	        return new ExportAttendanceSummaryReportRequest(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.accessToken = source["accessToken"];
-	        this.filters = this.convertValues(source["filters"], reports.AuditLogFilter);
-	        this.pager = this.convertValues(source["pager"], reports.PagerInput);
+	        this.filters = this.convertValues(source["filters"], reports.AttendanceSummaryFilter);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -1282,56 +1285,18 @@ export namespace handlers {
 		    return a;
 		}
 	}
-	export class ListAuditLogsRequest {
-	    accessToken: string;
-	    page: number;
-	    pageSize: number;
-	    q: string;
-	
-	    static createFrom(source: any = {}) {
-	        return new ListAuditLogsRequest(source);
-	    }
-	
-	    constructor(source: any = {}) {
-	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.accessToken = source["accessToken"];
-	        this.page = source["page"];
-	        this.pageSize = source["pageSize"];
-	        this.q = source["q"];
-	    }
-	}
-	export class ListDepartmentsRequest {
-	    accessToken: string;
-	    page: number;
-	    pageSize: number;
-	    q: string;
-	
-	    static createFrom(source: any = {}) {
-	        return new ListDepartmentsRequest(source);
-	    }
-	
-	    constructor(source: any = {}) {
-	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.accessToken = source["accessToken"];
-	        this.page = source["page"];
-	        this.pageSize = source["pageSize"];
-	        this.q = source["q"];
-	    }
-	}
-	export class ListEmployeeReportRequest {
+	export class ExportAuditLogReportRequest {
 	    accessToken: string;
-	    filters: reports.EmployeeListFilter;
-	    pager: reports.PagerInput;
+	    filters: reports.AuditLogFilter;
 	
 	    static createFrom(source: any = {}) {
-	        return new ListEmployeeReportRequest(source);
+	        return new ExportAuditLogReportRequest(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.accessToken = source["accessToken"];
-	        this.filters = this.convertValues(source["filters"], reports.EmployeeListFilter);
-	        this.pager = this.convertValues(source["pager"], reports.PagerInput);
+	        this.filters = this.convertValues(source["filters"], reports.AuditLogFilter);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -1352,42 +1317,34 @@ export namespace handlers {
 		    return a;
 		}
 	}
-	export class ListEmployeesRequest {
+	export class ExportContributionScheduleRequest {
 	    accessToken: string;
-	    page: number;
-	    pageSize: number;
-	    q: string;
-	    status: string;
-	    departmentId?: number;
+	    batchId: number;
+	    schemeId: number;
 	
 	    static createFrom(source: any = {}) {
-	        return new ListEmployeesRequest(source);
+	        return new ExportContributionScheduleRequest(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.accessToken = source["accessToken"];
-	        this.page = source["page"];
-	        this.pageSize = source["pageSize"];
-	        this.q = source["q"];
-	        this.status = source["status"];
-	        this.departmentId = source["departmentId"];
+	        this.batchId = source["batchId"];
+	        this.schemeId = source["schemeId"];
 	    }
 	}
-	export class ListLeaveRequestsReportRequest {
+	export class ExportEmployeeReportRequest {
 	    accessToken: string;
-	    filters: reports.LeaveRequestsFilter;
-	    pager: reports.PagerInput;
+	    filters: reports.EmployeeListFilter;
 	
 	    static createFrom(source: any = {}) {
-	        return new ListLeaveRequestsReportRequest(source);
+	        return new ExportEmployeeReportRequest(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.accessToken = source["accessToken"];
-	        this.filters = this.convertValues(source["filters"], reports.LeaveRequestsFilter);
-	        this.pager = this.convertValues(source["pager"], reports.PagerInput);
+	        this.filters = this.convertValues(source["filters"], reports.EmployeeListFilter);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -1408,18 +1365,18 @@ export namespace handlers {
 		    return a;
 		}
 	}
-	export class ListLeaveRequestsRequest {
+	export class ExportLeaveRequestsReportRequest {
 	    accessToken: string;
-	    filter: leave.ListLeaveRequestsFilter;
+	    filters: reports.LeaveRequestsFilter;
 	
 	    static createFrom(source: any = {}) {
-	        return new ListLeaveRequestsRequest(source);
+	        return new ExportLeaveRequestsReportRequest(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.accessToken = source["accessToken"];
-	        this.filter = this.convertValues(source["filter"], leave.ListLeaveRequestsFilter);
+	        this.filters = this.convertValues(source["filters"], reports.LeaveRequestsFilter);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -1440,48 +1397,48 @@ export namespace handlers {
 		    return a;
 		}
 	}
-	export class ListLeaveTypesRequest {
+	export class ExportLoanLedgerRequest {
 	    accessToken: string;
-	    activeOnly: boolean;
+	    employeeId: number;
 	
 	    static createFrom(source: any = {}) {
-	        return new ListLeaveTypesRequest(source);
+	        return new ExportLoanLedgerRequest(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.accessToken = source["accessToken"];
-	        this.activeOnly = source["activeOnly"];
+	        this.employeeId = source["employeeId"];
 	    }
 	}
-	export class ListLockedDatesRequest {
+	export class ExportPaymentFileRequest {
 	    accessToken: string;
-	    year: number;
+	    batchId: number;
+	    formatCode: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new ListLockedDatesRequest(source);
+	        return new ExportPaymentFileRequest(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.accessToken = source["accessToken"];
-	        this.year = source["year"];
+	        this.batchId = source["batchId"];
+	        this.formatCode = source["formatCode"];
 	    }
 	}
-	export class ListPayrollBatchesReportRequest {
+	export class ExportPayrollBatchesReportRequest {
 	    accessToken: string;
 	    filters: reports.PayrollBatchesFilter;
-	    pager: reports.PagerInput;
 	
 	    static createFrom(source: any = {}) {
-	        return new ListPayrollBatchesReportRequest(source);
+	        return new ExportPayrollBatchesReportRequest(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.accessToken = source["accessToken"];
 	        this.filters = this.convertValues(source["filters"], reports.PayrollBatchesFilter);
-	        this.pager = this.convertValues(source["pager"], reports.PagerInput);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -1502,99 +1459,113 @@ export namespace handlers {
 		    return a;
 		}
 	}
-	export class ListPayrollBatchesRequest {
+	export class ExportPayrollJournalRequest {
 	    accessToken: string;
-	    filter: payroll.ListBatchesFilter;
+	    batchId: number;
+	    format: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new ListPayrollBatchesRequest(source);
+	        return new ExportPayrollJournalRequest(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.accessToken = source["accessToken"];
-	        this.filter = this.convertValues(source["filter"], payroll.ListBatchesFilter);
+	        this.batchId = source["batchId"];
+	        this.format = source["format"];
 	    }
-	
-		convertValues(a: any, classs: any, asMap: boolean = false): any {
-		    if (!a) {
-		        return a;
-		    }
-		    if (a.slice && a.map) {
-		        return (a as any[]).map(elem => this.convertValues(elem, classs));
-		    } else if ("object" === typeof a) {
-		        if (asMap) {
-		            for (const key of Object.keys(a)) {
-		                a[key] = new classs(a[key]);
-		            }
-		            return a;
-		        }
-		        return new classs(a);
-		    }
-		    return a;
-		}
 	}
-	export class ListUsersRequest {
+	export class GetCompanyLogoRequest {
 	    accessToken: string;
-	    page: number;
-	    pageSize: number;
-	    q: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new ListUsersRequest(source);
+	        return new GetCompanyLogoRequest(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.accessToken = source["accessToken"];
-	        this.page = source["page"];
-	        this.pageSize = source["pageSize"];
-	        this.q = source["q"];
 	    }
 	}
-	export class LockDateRequest {
+	export class GetDashboardSummaryRequest {
+	    accessToken: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new GetDashboardSummaryRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.accessToken = source["accessToken"];
+	    }
+	}
+	export class GetDepartmentRequest {
+	    accessToken: string;
+	    id: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new GetDepartmentRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.accessToken = source["accessToken"];
+	        this.id = source["id"];
+	    }
+	}
+	export class GetEmployeeRequest {
+	    accessToken: string;
+	    id: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new GetEmployeeRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.accessToken = source["accessToken"];
+	        this.id = source["id"];
+	    }
+	}
+	export class GetLunchSummaryRequest {
 	    accessToken: string;
 	    date: string;
-	    reason: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new LockDateRequest(source);
+	        return new GetLunchSummaryRequest(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.accessToken = source["accessToken"];
 	        this.date = source["date"];
-	        this.reason = source["reason"];
 	    }
 	}
-	export class LoginRequest {
+	export class UserDTO {
+	    id: number;
 	    username: string;
-	    password: string;
+	    role: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new LoginRequest(source);
+	        return new UserDTO(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
 	        this.username = source["username"];
-	        this.password = source["password"];
+	        this.role = source["role"];
 	    }
 	}
-	export class LoginResponse {
-	    accessToken: string;
-	    refreshToken: string;
+	export class GetMeResponse {
 	    user: UserDTO;
 	
 	    static createFrom(source: any = {}) {
-	        return new LoginResponse(source);
+	        return new GetMeResponse(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.accessToken = source["accessToken"];
-	        this.refreshToken = source["refreshToken"];
 	        this.user = this.convertValues(source["user"], UserDTO);
 	    }
 	
@@ -1616,24 +1587,28 @@ export namespace handlers {
 		    return a;
 		}
 	}
-	export class LogoutRequest {
-	    refreshToken: string;
+	export class GetMyAttendanceRangeRequest {
+	    accessToken: string;
+	    startDate: string;
+	    endDate: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new LogoutRequest(source);
+	        return new GetMyAttendanceRangeRequest(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.refreshToken = source["refreshToken"];
+	        this.accessToken = source["accessToken"];
+	        this.startDate = source["startDate"];
+	        this.endDate = source["endDate"];
 	    }
 	}
-	export class PayrollBatchActionRequest {
+	export class GetPayrollBatchRequest {
 	    accessToken: string;
 	    batchId: number;
 	
 	    static createFrom(source: any = {}) {
-	        return new PayrollBatchActionRequest(source);
+	        return new GetPayrollBatchRequest(source);
 	    }
 	
 	    constructor(source: any = {}) {
@@ -1642,168 +1617,128 @@ export namespace handlers {
 	        this.batchId = source["batchId"];
 	    }
 	}
-	export class PostAbsentToLeaveRequest {
+	export class GetSettingsRequest {
 	    accessToken: string;
-	    date: string;
-	    employeeId: number;
 	
 	    static createFrom(source: any = {}) {
-	        return new PostAbsentToLeaveRequest(source);
+	        return new GetSettingsRequest(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.accessToken = source["accessToken"];
-	        this.date = source["date"];
-	        this.employeeId = source["employeeId"];
 	    }
 	}
-	export class RefreshRequest {
-	    refreshToken: string;
+	export class GetUserRequest {
+	    accessToken: string;
+	    id: number;
 	
 	    static createFrom(source: any = {}) {
-	        return new RefreshRequest(source);
+	        return new GetUserRequest(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.refreshToken = source["refreshToken"];
+	        this.accessToken = source["accessToken"];
+	        this.id = source["id"];
 	    }
 	}
-	export class RejectLeaveRequest {
+	export class HolidayCalendarRequest {
 	    accessToken: string;
-	    id: number;
-	    reason?: string;
+	    year: number;
 	
 	    static createFrom(source: any = {}) {
-	        return new RejectLeaveRequest(source);
+	        return new HolidayCalendarRequest(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.accessToken = source["accessToken"];
-	        this.id = source["id"];
-	        this.reason = source["reason"];
+	        this.year = source["year"];
 	    }
 	}
-	export class RemoveEmployeeContractRequest {
+	export class ImportCompanyLogoFromURLRequest {
 	    accessToken: string;
-	    employeeId: number;
+	    url: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new RemoveEmployeeContractRequest(source);
+	        return new ImportCompanyLogoFromURLRequest(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.accessToken = source["accessToken"];
-	        this.employeeId = source["employeeId"];
+	        this.url = source["url"];
 	    }
 	}
-	export class ResetUserPasswordRequest {
+	export class ImportHolidaysRequest {
 	    accessToken: string;
-	    id: number;
-	    payload: users.ResetUserPasswordInput;
+	    year: number;
+	    data: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new ResetUserPasswordRequest(source);
+	        return new ImportHolidaysRequest(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.accessToken = source["accessToken"];
-	        this.id = source["id"];
-	        this.payload = this.convertValues(source["payload"], users.ResetUserPasswordInput);
+	        this.year = source["year"];
+	        this.data = source["data"];
 	    }
-	
-		convertValues(a: any, classs: any, asMap: boolean = false): any {
-		    if (!a) {
-		        return a;
-		    }
-		    if (a.slice && a.map) {
-		        return (a as any[]).map(elem => this.convertValues(elem, classs));
-		    } else if ("object" === typeof a) {
-		        if (asMap) {
-		            for (const key of Object.keys(a)) {
-		                a[key] = new classs(a[key]);
-		            }
-		            return a;
-		        }
-		        return new classs(a);
-		    }
-		    return a;
-		}
 	}
-	export class SaveCompanyProfileRequest {
+	export class ImportPayrollEntryAmountsRequest {
 	    accessToken: string;
-	    payload: settings.SaveCompanyProfileInput;
+	    batchId: number;
+	    data: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new SaveCompanyProfileRequest(source);
+	        return new ImportPayrollEntryAmountsRequest(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.accessToken = source["accessToken"];
-	        this.payload = this.convertValues(source["payload"], settings.SaveCompanyProfileInput);
+	        this.batchId = source["batchId"];
+	        this.data = source["data"];
 	    }
-	
-		convertValues(a: any, classs: any, asMap: boolean = false): any {
-		    if (!a) {
-		        return a;
-		    }
-		    if (a.slice && a.map) {
-		        return (a as any[]).map(elem => this.convertValues(elem, classs));
-		    } else if ("object" === typeof a) {
-		        if (asMap) {
-		            for (const key of Object.keys(a)) {
-		                a[key] = new classs(a[key]);
-		            }
-		            return a;
-		        }
-		        return new classs(a);
-		    }
-		    return a;
-		}
 	}
-	export class SetLeaveTypeActiveRequest {
+	export class LeaveActionRequest {
 	    accessToken: string;
 	    id: number;
-	    active: boolean;
 	
 	    static createFrom(source: any = {}) {
-	        return new SetLeaveTypeActiveRequest(source);
+	        return new LeaveActionRequest(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.accessToken = source["accessToken"];
 	        this.id = source["id"];
-	        this.active = source["active"];
 	    }
 	}
-	export class SetUserActiveRequest {
+	export class LeaveBalanceRequest {
 	    accessToken: string;
-	    id: number;
-	    active: boolean;
+	    employeeId: number;
+	    year: number;
 	
 	    static createFrom(source: any = {}) {
-	        return new SetUserActiveRequest(source);
+	        return new LeaveBalanceRequest(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.accessToken = source["accessToken"];
-	        this.id = source["id"];
-	        this.active = source["active"];
+	        this.employeeId = source["employeeId"];
+	        this.year = source["year"];
 	    }
 	}
-	export class UnlockDateRequest {
+	export class ListAttendanceByDateRequest {
 	    accessToken: string;
 	    date: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new UnlockDateRequest(source);
+	        return new ListAttendanceByDateRequest(source);
 	    }
 	
 	    constructor(source: any = {}) {
@@ -1812,20 +1747,20 @@ export namespace handlers {
 	        this.date = source["date"];
 	    }
 	}
-	export class UpdateDepartmentRequest {
+	export class ListAttendanceSummaryReportRequest {
 	    accessToken: string;
-	    id: number;
-	    payload: departments.UpsertDepartmentInput;
+	    filters: reports.AttendanceSummaryFilter;
+	    pager: reports.PagerInput;
 	
 	    static createFrom(source: any = {}) {
-	        return new UpdateDepartmentRequest(source);
+	        return new ListAttendanceSummaryReportRequest(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.accessToken = source["accessToken"];
-	        this.id = source["id"];
-	        this.payload = this.convertValues(source["payload"], departments.UpsertDepartmentInput);
+	        this.filters = this.convertValues(source["filters"], reports.AttendanceSummaryFilter);
+	        this.pager = this.convertValues(source["pager"], reports.PagerInput);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -1846,20 +1781,20 @@ export namespace handlers {
 		    return a;
 		}
 	}
-	export class UpdateEmployeeRequest {
+	export class ListAuditLogReportRequest {
 	    accessToken: string;
-	    id: number;
-	    payload: employees.UpsertEmployeeInput;
+	    filters: reports.AuditLogFilter;
+	    pager: reports.PagerInput;
 	
 	    static createFrom(source: any = {}) {
-	        return new UpdateEmployeeRequest(source);
+	        return new ListAuditLogReportRequest(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.accessToken = source["accessToken"];
-	        this.id = source["id"];
-	        this.payload = this.convertValues(source["payload"], employees.UpsertEmployeeInput);
+	        this.filters = this.convertValues(source["filters"], reports.AuditLogFilter);
+	        this.pager = this.convertValues(source["pager"], reports.PagerInput);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -1880,210 +1815,112 @@ export namespace handlers {
 		    return a;
 		}
 	}
-	export class UpdateLeaveTypeRequest {
+	export class ListAuditLogsRequest {
 	    accessToken: string;
-	    id: number;
-	    payload: leave.LeaveTypeUpsertInput;
+	    page: number;
+	    pageSize: number;
+	    q: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new UpdateLeaveTypeRequest(source);
+	        return new ListAuditLogsRequest(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.accessToken = source["accessToken"];
-	        this.id = source["id"];
-	        this.payload = this.convertValues(source["payload"], leave.LeaveTypeUpsertInput);
+	        this.page = source["page"];
+	        this.pageSize = source["pageSize"];
+	        this.q = source["q"];
 	    }
-	
-		convertValues(a: any, classs: any, asMap: boolean = false): any {
-		    if (!a) {
-		        return a;
-		    }
-		    if (a.slice && a.map) {
-		        return (a as any[]).map(elem => this.convertValues(elem, classs));
-		    } else if ("object" === typeof a) {
-		        if (asMap) {
-		            for (const key of Object.keys(a)) {
-		                a[key] = new classs(a[key]);
-		            }
-		            return a;
-		        }
-		        return new classs(a);
-		    }
-		    return a;
-		}
 	}
-	export class UpdatePayrollEntryAmountsRequest {
+	export class ListContributionSchemeMembersRequest {
 	    accessToken: string;
-	    entryId: number;
-	    payload: payroll.UpdateEntryAmountsInput;
+	    schemeId: number;
 	
 	    static createFrom(source: any = {}) {
-	        return new UpdatePayrollEntryAmountsRequest(source);
-	    }
-	
-	    constructor(source: any = {}) {
-	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.accessToken = source["accessToken"];
-	        this.entryId = source["entryId"];
-	        this.payload = this.convertValues(source["payload"], payroll.UpdateEntryAmountsInput);
-	    }
-	
-		convertValues(a: any, classs: any, asMap: boolean = false): any {
-		    if (!a) {
-		        return a;
-		    }
-		    if (a.slice && a.map) {
-		        return (a as any[]).map(elem => this.convertValues(elem, classs));
-		    } else if ("object" === typeof a) {
-		        if (asMap) {
-		            for (const key of Object.keys(a)) {
-		                a[key] = new classs(a[key]);
-		            }
-		            return a;
-		        }
-		        return new classs(a);
-		    }
-		    return a;
-		}
-	}
-	export class UpdateSettingsRequest {
-	    accessToken: string;
-	    payload: settings.UpdateSettingsInput;
-	
-	    static createFrom(source: any = {}) {
-	        return new UpdateSettingsRequest(source);
+	        return new ListContributionSchemeMembersRequest(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.accessToken = source["accessToken"];
-	        this.payload = this.convertValues(source["payload"], settings.UpdateSettingsInput);
+	        this.schemeId = source["schemeId"];
 	    }
-	
-		convertValues(a: any, classs: any, asMap: boolean = false): any {
-		    if (!a) {
-		        return a;
-		    }
-		    if (a.slice && a.map) {
-		        return (a as any[]).map(elem => this.convertValues(elem, classs));
-		    } else if ("object" === typeof a) {
-		        if (asMap) {
-		            for (const key of Object.keys(a)) {
-		                a[key] = new classs(a[key]);
-		            }
-		            return a;
-		        }
-		        return new classs(a);
-		    }
-		    return a;
-		}
 	}
-	export class UpdateUserRequest {
+	export class ListContributionSchemesRequest {
 	    accessToken: string;
-	    id: number;
-	    payload: users.UpdateUserInput;
+	    activeOnly: boolean;
 	
 	    static createFrom(source: any = {}) {
-	        return new UpdateUserRequest(source);
+	        return new ListContributionSchemesRequest(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.accessToken = source["accessToken"];
-	        this.id = source["id"];
-	        this.payload = this.convertValues(source["payload"], users.UpdateUserInput);
+	        this.activeOnly = source["activeOnly"];
 	    }
-	
-		convertValues(a: any, classs: any, asMap: boolean = false): any {
-		    if (!a) {
-		        return a;
-		    }
-		    if (a.slice && a.map) {
-		        return (a as any[]).map(elem => this.convertValues(elem, classs));
-		    } else if ("object" === typeof a) {
-		        if (asMap) {
-		            for (const key of Object.keys(a)) {
-		                a[key] = new classs(a[key]);
-		            }
-		            return a;
-		        }
-		        return new classs(a);
-		    }
-		    return a;
-		}
 	}
-	export class UploadCompanyLogoRequest {
+	export class ListDepartmentsRequest {
 	    accessToken: string;
-	    filename: string;
-	    mimeType: string;
-	    data: number[];
+	    page: number;
+	    pageSize: number;
+	    q: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new UploadCompanyLogoRequest(source);
+	        return new ListDepartmentsRequest(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.accessToken = source["accessToken"];
-	        this.filename = source["filename"];
-	        this.mimeType = source["mimeType"];
-	        this.data = source["data"];
+	        this.page = source["page"];
+	        this.pageSize = source["pageSize"];
+	        this.q = source["q"];
 	    }
 	}
-	export class UploadEmployeeContractRequest {
+	export class ListEmployeePaymentMethodsRequest {
 	    accessToken: string;
 	    employeeId: number;
-	    filename: string;
-	    mimeType: string;
-	    data: number[];
 	
 	    static createFrom(source: any = {}) {
-	        return new UploadEmployeeContractRequest(source);
+	        return new ListEmployeePaymentMethodsRequest(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.accessToken = source["accessToken"];
 	        this.employeeId = source["employeeId"];
-	        this.filename = source["filename"];
-	        this.mimeType = source["mimeType"];
-	        this.data = source["data"];
 	    }
 	}
-	export class UpsertAttendanceRequest {
+	export class ListEmployeeProjectAllocationsRequest {
 	    accessToken: string;
-	    date: string;
 	    employeeId: number;
-	    status: string;
-	    reason?: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new UpsertAttendanceRequest(source);
+	        return new ListEmployeeProjectAllocationsRequest(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.accessToken = source["accessToken"];
-	        this.date = source["date"];
 	        this.employeeId = source["employeeId"];
-	        this.status = source["status"];
-	        this.reason = source["reason"];
 	    }
 	}
-	export class UpsertEntitlementRequest {
+	export class ListEmployeeReportRequest {
 	    accessToken: string;
-	    payload: leave.UpsertEntitlementInput;
+	    filters: reports.EmployeeListFilter;
+	    pager: reports.PagerInput;
 	
 	    static createFrom(source: any = {}) {
-	        return new UpsertEntitlementRequest(source);
+	        return new ListEmployeeReportRequest(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.accessToken = source["accessToken"];
-	        this.payload = this.convertValues(source["payload"], leave.UpsertEntitlementInput);
+	        this.filters = this.convertValues(source["filters"], reports.EmployeeListFilter);
+	        this.pager = this.convertValues(source["pager"], reports.PagerInput);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -2104,93 +1941,82 @@ export namespace handlers {
 		    return a;
 		}
 	}
-	export class UpsertLunchVisitorsRequest {
+	export class ListEmployeeWorkSchedulesRequest {
 	    accessToken: string;
-	    date: string;
-	    visitorsCount: number;
+	    employeeId: number;
 	
 	    static createFrom(source: any = {}) {
-	        return new UpsertLunchVisitorsRequest(source);
+	        return new ListEmployeeWorkSchedulesRequest(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.accessToken = source["accessToken"];
-	        this.date = source["date"];
-	        this.visitorsCount = source["visitorsCount"];
+	        this.employeeId = source["employeeId"];
 	    }
 	}
-
-}
-
-export namespace leave {
+	export class ListEmployeesRequest {
+	    accessToken: string;
+	    page: number;
+	    pageSize: number;
+	    q: string;
+	    status: string;
+	    departmentId?: number;
 	
-	export class ApplyLeaveInput {
-	    leaveTypeId: number;
-	    startDate: string;
-	    endDate: string;
-	    reason?: string;
+	    static createFrom(source: any = {}) {
+	        return new ListEmployeesRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.accessToken = source["accessToken"];
+	        this.page = source["page"];
+	        this.pageSize = source["pageSize"];
+	        this.q = source["q"];
+	        this.status = source["status"];
+	        this.departmentId = source["departmentId"];
+	    }
+	}
+	export class ListGLAccountMappingsRequest {
+	    accessToken: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new ApplyLeaveInput(source);
+	        return new ListGLAccountMappingsRequest(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.leaveTypeId = source["leaveTypeId"];
-	        this.startDate = source["startDate"];
-	        this.endDate = source["endDate"];
-	        this.reason = source["reason"];
+	        this.accessToken = source["accessToken"];
 	    }
 	}
-	export class LeaveBalance {
-	    employeeId: number;
-	    year: number;
-	    totalDays: number;
-	    reservedDays: number;
-	    approvedDays: number;
-	    pendingDays: number;
-	    availableDays: number;
+	export class ListHolidaysRequest {
+	    accessToken: string;
+	    activeOnly: boolean;
 	
 	    static createFrom(source: any = {}) {
-	        return new LeaveBalance(source);
+	        return new ListHolidaysRequest(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.employeeId = source["employeeId"];
-	        this.year = source["year"];
-	        this.totalDays = source["totalDays"];
-	        this.reservedDays = source["reservedDays"];
-	        this.approvedDays = source["approvedDays"];
-	        this.pendingDays = source["pendingDays"];
-	        this.availableDays = source["availableDays"];
+	        this.accessToken = source["accessToken"];
+	        this.activeOnly = source["activeOnly"];
 	    }
 	}
-	export class LeaveEntitlement {
-	    id: number;
-	    employeeId: number;
-	    year: number;
-	    totalDays: number;
-	    reservedDays: number;
-	    // Go type: time
-	    createdAt: any;
-	    // Go type: time
-	    updatedAt: any;
+	export class ListLeaveRequestsReportRequest {
+	    accessToken: string;
+	    filters: reports.LeaveRequestsFilter;
+	    pager: reports.PagerInput;
 	
 	    static createFrom(source: any = {}) {
-	        return new LeaveEntitlement(source);
+	        return new ListLeaveRequestsReportRequest(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.id = source["id"];
-	        this.employeeId = source["employeeId"];
-	        this.year = source["year"];
-	        this.totalDays = source["totalDays"];
-	        this.reservedDays = source["reservedDays"];
-	        this.createdAt = this.convertValues(source["createdAt"], null);
-	        this.updatedAt = this.convertValues(source["updatedAt"], null);
+	        this.accessToken = source["accessToken"];
+	        this.filters = this.convertValues(source["filters"], reports.LeaveRequestsFilter);
+	        this.pager = this.convertValues(source["pager"], reports.PagerInput);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -2211,26 +2037,18 @@ export namespace leave {
 		    return a;
 		}
 	}
-	export class LeaveLockedDate {
-	    id: number;
-	    // Go type: time
-	    date: any;
-	    reason?: string;
-	    createdBy?: number;
-	    // Go type: time
-	    createdAt: any;
+	export class ListLeaveRequestsRequest {
+	    accessToken: string;
+	    filter: leave.ListLeaveRequestsFilter;
 	
 	    static createFrom(source: any = {}) {
-	        return new LeaveLockedDate(source);
+	        return new ListLeaveRequestsRequest(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.id = source["id"];
-	        this.date = this.convertValues(source["date"], null);
-	        this.reason = source["reason"];
-	        this.createdBy = source["createdBy"];
-	        this.createdAt = this.convertValues(source["createdAt"], null);
+	        this.accessToken = source["accessToken"];
+	        this.filter = this.convertValues(source["filter"], leave.ListLeaveRequestsFilter);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -2251,49 +2069,3501 @@ export namespace leave {
 		    return a;
 		}
 	}
-	export class LeaveRequest {
-	    id: number;
-	    employeeId: number;
-	    employeeName: string;
-	    departmentName?: string;
-	    leaveTypeId: number;
-	    leaveTypeName: string;
-	    // Go type: time
-	    startDate: any;
-	    // Go type: time
-	    endDate: any;
-	    workingDays: number;
-	    status: string;
-	    reason?: string;
-	    approvedBy?: number;
-	    // Go type: time
-	    approvedAt?: any;
-	    // Go type: time
-	    createdAt: any;
-	    // Go type: time
-	    updatedAt: any;
+	export class ListLeaveTypesRequest {
+	    accessToken: string;
+	    activeOnly: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new ListLeaveTypesRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.accessToken = source["accessToken"];
+	        this.activeOnly = source["activeOnly"];
+	    }
+	}
+	export class ListLoansRequest {
+	    accessToken: string;
+	    filter: payroll.ListLoansFilter;
+	
+	    static createFrom(source: any = {}) {
+	        return new ListLoansRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.accessToken = source["accessToken"];
+	        this.filter = this.convertValues(source["filter"], payroll.ListLoansFilter);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ListLockedDatesRequest {
+	    accessToken: string;
+	    year: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new ListLockedDatesRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.accessToken = source["accessToken"];
+	        this.year = source["year"];
+	    }
+	}
+	export class ListPayComponentsRequest {
+	    accessToken: string;
+	    activeOnly: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new ListPayComponentsRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.accessToken = source["accessToken"];
+	        this.activeOnly = source["activeOnly"];
+	    }
+	}
+	export class ListPaymentFileFormatsRequest {
+	    accessToken: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ListPaymentFileFormatsRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.accessToken = source["accessToken"];
+	    }
+	}
+	export class ListPayrollBatchesReportRequest {
+	    accessToken: string;
+	    filters: reports.PayrollBatchesFilter;
+	    pager: reports.PagerInput;
+	
+	    static createFrom(source: any = {}) {
+	        return new ListPayrollBatchesReportRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.accessToken = source["accessToken"];
+	        this.filters = this.convertValues(source["filters"], reports.PayrollBatchesFilter);
+	        this.pager = this.convertValues(source["pager"], reports.PagerInput);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ListPayrollBatchesRequest {
+	    accessToken: string;
+	    filter: payroll.ListBatchesFilter;
+	
+	    static createFrom(source: any = {}) {
+	        return new ListPayrollBatchesRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.accessToken = source["accessToken"];
+	        this.filter = this.convertValues(source["filter"], payroll.ListBatchesFilter);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ListProjectsRequest {
+	    accessToken: string;
+	    activeOnly: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new ListProjectsRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.accessToken = source["accessToken"];
+	        this.activeOnly = source["activeOnly"];
+	    }
+	}
+	export class ListSalaryHistoryRequest {
+	    accessToken: string;
+	    employeeId: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new ListSalaryHistoryRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.accessToken = source["accessToken"];
+	        this.employeeId = source["employeeId"];
+	    }
+	}
+	export class ListUsersRequest {
+	    accessToken: string;
+	    page: number;
+	    pageSize: number;
+	    q: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ListUsersRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.accessToken = source["accessToken"];
+	        this.page = source["page"];
+	        this.pageSize = source["pageSize"];
+	        this.q = source["q"];
+	    }
+	}
+	export class ListWorkSchedulesRequest {
+	    accessToken: string;
+	    activeOnly: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new ListWorkSchedulesRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.accessToken = source["accessToken"];
+	        this.activeOnly = source["activeOnly"];
+	    }
+	}
+	export class LoanActionRequest {
+	    accessToken: string;
+	    loanId: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new LoanActionRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.accessToken = source["accessToken"];
+	        this.loanId = source["loanId"];
+	    }
+	}
+	export class LockDateRequest {
+	    accessToken: string;
+	    date: string;
+	    reason: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new LockDateRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.accessToken = source["accessToken"];
+	        this.date = source["date"];
+	        this.reason = source["reason"];
+	    }
+	}
+	export class LoginRequest {
+	    username: string;
+	    password: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new LoginRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.username = source["username"];
+	        this.password = source["password"];
+	    }
+	}
+	export class LoginResponse {
+	    accessToken: string;
+	    refreshToken: string;
+	    user: UserDTO;
+	
+	    static createFrom(source: any = {}) {
+	        return new LoginResponse(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.accessToken = source["accessToken"];
+	        this.refreshToken = source["refreshToken"];
+	        this.user = this.convertValues(source["user"], UserDTO);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class LogoutRequest {
+	    refreshToken: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new LogoutRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.refreshToken = source["refreshToken"];
+	    }
+	}
+	export class PayrollApprovalRequest {
+	    accessToken: string;
+	    batchId: number;
+	    comment: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new PayrollApprovalRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.accessToken = source["accessToken"];
+	        this.batchId = source["batchId"];
+	        this.comment = source["comment"];
+	    }
+	}
+	export class PayrollBatchActionRequest {
+	    accessToken: string;
+	    batchId: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new PayrollBatchActionRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.accessToken = source["accessToken"];
+	        this.batchId = source["batchId"];
+	    }
+	}
+	export class PayrollBatchReasonRequest {
+	    accessToken: string;
+	    batchId: number;
+	    reason: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new PayrollBatchReasonRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.accessToken = source["accessToken"];
+	        this.batchId = source["batchId"];
+	        this.reason = source["reason"];
+	    }
+	}
+	export class PayrollJournalRequest {
+	    accessToken: string;
+	    batchId: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new PayrollJournalRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.accessToken = source["accessToken"];
+	        this.batchId = source["batchId"];
+	    }
+	}
+	export class PayrollVarianceRequest {
+	    accessToken: string;
+	    batchId: number;
+	    previousBatchId: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new PayrollVarianceRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.accessToken = source["accessToken"];
+	        this.batchId = source["batchId"];
+	        this.previousBatchId = source["previousBatchId"];
+	    }
+	}
+	export class PostAbsentToLeaveRequest {
+	    accessToken: string;
+	    date: string;
+	    employeeId: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new PostAbsentToLeaveRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.accessToken = source["accessToken"];
+	        this.date = source["date"];
+	        this.employeeId = source["employeeId"];
+	    }
+	}
+	export class ProjectChargingReportRequest {
+	    accessToken: string;
+	    month: string;
+	    projectId: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new ProjectChargingReportRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.accessToken = source["accessToken"];
+	        this.month = source["month"];
+	        this.projectId = source["projectId"];
+	    }
+	}
+	export class RecordSalaryChangeRequest {
+	    accessToken: string;
+	    employeeId: number;
+	    payload: employees.RecordSalaryChangeInput;
+	
+	    static createFrom(source: any = {}) {
+	        return new RecordSalaryChangeRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.accessToken = source["accessToken"];
+	        this.employeeId = source["employeeId"];
+	        this.payload = this.convertValues(source["payload"], employees.RecordSalaryChangeInput);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class RefreshRequest {
+	    refreshToken: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new RefreshRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.refreshToken = source["refreshToken"];
+	    }
+	}
+	export class RejectLeaveRequest {
+	    accessToken: string;
+	    id: number;
+	    reason?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new RejectLeaveRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.accessToken = source["accessToken"];
+	        this.id = source["id"];
+	        this.reason = source["reason"];
+	    }
+	}
+	export class RemoveEmployeeContractRequest {
+	    accessToken: string;
+	    employeeId: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new RemoveEmployeeContractRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.accessToken = source["accessToken"];
+	        this.employeeId = source["employeeId"];
+	    }
+	}
+	export class RemovePayrollEntryLineRequest {
+	    accessToken: string;
+	    lineId: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new RemovePayrollEntryLineRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.accessToken = source["accessToken"];
+	        this.lineId = source["lineId"];
+	    }
+	}
+	export class RenderBatchPayslipsRequest {
+	    accessToken: string;
+	    batchId: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new RenderBatchPayslipsRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.accessToken = source["accessToken"];
+	        this.batchId = source["batchId"];
+	    }
+	}
+	export class RenderPayslipPDFRequest {
+	    accessToken: string;
+	    entryId: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new RenderPayslipPDFRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.accessToken = source["accessToken"];
+	        this.entryId = source["entryId"];
+	    }
+	}
+	export class RenderTaxCertificateRequest {
+	    accessToken: string;
+	    year: number;
+	    startMonth: number;
+	    employeeId: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new RenderTaxCertificateRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.accessToken = source["accessToken"];
+	        this.year = source["year"];
+	        this.startMonth = source["startMonth"];
+	        this.employeeId = source["employeeId"];
+	    }
+	}
+	export class ResetUserPasswordRequest {
+	    accessToken: string;
+	    id: number;
+	    payload: users.ResetUserPasswordInput;
+	
+	    static createFrom(source: any = {}) {
+	        return new ResetUserPasswordRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.accessToken = source["accessToken"];
+	        this.id = source["id"];
+	        this.payload = this.convertValues(source["payload"], users.ResetUserPasswordInput);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class RetroArrearsRequest {
+	    accessToken: string;
+	    month: string;
+	    employeeId: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new RetroArrearsRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.accessToken = source["accessToken"];
+	        this.month = source["month"];
+	        this.employeeId = source["employeeId"];
+	    }
+	}
+	export class SaveCompanyProfileRequest {
+	    accessToken: string;
+	    payload: settings.SaveCompanyProfileInput;
+	
+	    static createFrom(source: any = {}) {
+	        return new SaveCompanyProfileRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.accessToken = source["accessToken"];
+	        this.payload = this.convertValues(source["payload"], settings.SaveCompanyProfileInput);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class SavePayrollTaxSettingsRequest {
+	    accessToken: string;
+	    payload: settings.PayrollTaxSettings;
+	
+	    static createFrom(source: any = {}) {
+	        return new SavePayrollTaxSettingsRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.accessToken = source["accessToken"];
+	        this.payload = this.convertValues(source["payload"], settings.PayrollTaxSettings);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class SetContributionSchemeActiveRequest {
+	    accessToken: string;
+	    id: number;
+	    active: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new SetContributionSchemeActiveRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.accessToken = source["accessToken"];
+	        this.id = source["id"];
+	        this.active = source["active"];
+	    }
+	}
+	export class SetContributionSchemeMemberRequest {
+	    accessToken: string;
+	    schemeId: number;
+	    payload: payroll.SetContributionSchemeMemberInput;
+	
+	    static createFrom(source: any = {}) {
+	        return new SetContributionSchemeMemberRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.accessToken = source["accessToken"];
+	        this.schemeId = source["schemeId"];
+	        this.payload = this.convertValues(source["payload"], payroll.SetContributionSchemeMemberInput);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class SetEmployeePaymentMethodsRequest {
+	    accessToken: string;
+	    employeeId: number;
+	    methods: payroll.EmployeePaymentMethodInput[];
+	
+	    static createFrom(source: any = {}) {
+	        return new SetEmployeePaymentMethodsRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.accessToken = source["accessToken"];
+	        this.employeeId = source["employeeId"];
+	        this.methods = this.convertValues(source["methods"], payroll.EmployeePaymentMethodInput);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class SetEmployeeProjectAllocationsRequest {
+	    accessToken: string;
+	    employeeId: number;
+	    allocations: payroll.ProjectAllocationInput[];
+	
+	    static createFrom(source: any = {}) {
+	        return new SetEmployeeProjectAllocationsRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.accessToken = source["accessToken"];
+	        this.employeeId = source["employeeId"];
+	        this.allocations = this.convertValues(source["allocations"], payroll.ProjectAllocationInput);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class SetHolidayActiveRequest {
+	    accessToken: string;
+	    id: number;
+	    active: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new SetHolidayActiveRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.accessToken = source["accessToken"];
+	        this.id = source["id"];
+	        this.active = source["active"];
+	    }
+	}
+	export class SetLeaveTypeActiveRequest {
+	    accessToken: string;
+	    id: number;
+	    active: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new SetLeaveTypeActiveRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.accessToken = source["accessToken"];
+	        this.id = source["id"];
+	        this.active = source["active"];
+	    }
+	}
+	export class SetPayComponentActiveRequest {
+	    accessToken: string;
+	    id: number;
+	    active: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new SetPayComponentActiveRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.accessToken = source["accessToken"];
+	        this.id = source["id"];
+	        this.active = source["active"];
+	    }
+	}
+	export class SetProjectActiveRequest {
+	    accessToken: string;
+	    id: number;
+	    active: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new SetProjectActiveRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.accessToken = source["accessToken"];
+	        this.id = source["id"];
+	        this.active = source["active"];
+	    }
+	}
+	export class SetUserActiveRequest {
+	    accessToken: string;
+	    id: number;
+	    active: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new SetUserActiveRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.accessToken = source["accessToken"];
+	        this.id = source["id"];
+	        this.active = source["active"];
+	    }
+	}
+	export class SetWorkScheduleActiveRequest {
+	    accessToken: string;
+	    id: number;
+	    active: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new SetWorkScheduleActiveRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.accessToken = source["accessToken"];
+	        this.id = source["id"];
+	        this.active = source["active"];
+	    }
+	}
+	export class SimulatePayrollRequest {
+	    accessToken: string;
+	    payload: payroll.PayrollSimulationInput;
+	
+	    static createFrom(source: any = {}) {
+	        return new SimulatePayrollRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.accessToken = source["accessToken"];
+	        this.payload = this.convertValues(source["payload"], payroll.PayrollSimulationInput);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class UnlockDateRequest {
+	    accessToken: string;
+	    date: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new UnlockDateRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.accessToken = source["accessToken"];
+	        this.date = source["date"];
+	    }
+	}
+	export class UpdateContributionSchemeRequest {
+	    accessToken: string;
+	    id: number;
+	    payload: payroll.ContributionSchemeUpsertInput;
+	
+	    static createFrom(source: any = {}) {
+	        return new UpdateContributionSchemeRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.accessToken = source["accessToken"];
+	        this.id = source["id"];
+	        this.payload = this.convertValues(source["payload"], payroll.ContributionSchemeUpsertInput);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class UpdateDepartmentRequest {
+	    accessToken: string;
+	    id: number;
+	    payload: departments.UpsertDepartmentInput;
+	
+	    static createFrom(source: any = {}) {
+	        return new UpdateDepartmentRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.accessToken = source["accessToken"];
+	        this.id = source["id"];
+	        this.payload = this.convertValues(source["payload"], departments.UpsertDepartmentInput);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class UpdateEmployeeRequest {
+	    accessToken: string;
+	    id: number;
+	    payload: employees.UpsertEmployeeInput;
+	
+	    static createFrom(source: any = {}) {
+	        return new UpdateEmployeeRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.accessToken = source["accessToken"];
+	        this.id = source["id"];
+	        this.payload = this.convertValues(source["payload"], employees.UpsertEmployeeInput);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class UpdateGLAccountMappingRequest {
+	    accessToken: string;
+	    id: number;
+	    payload: payroll.GLAccountMappingInput;
+	
+	    static createFrom(source: any = {}) {
+	        return new UpdateGLAccountMappingRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.accessToken = source["accessToken"];
+	        this.id = source["id"];
+	        this.payload = this.convertValues(source["payload"], payroll.GLAccountMappingInput);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class UpdateHolidayRequest {
+	    accessToken: string;
+	    id: number;
+	    payload: holidays.HolidayUpsertInput;
+	
+	    static createFrom(source: any = {}) {
+	        return new UpdateHolidayRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.accessToken = source["accessToken"];
+	        this.id = source["id"];
+	        this.payload = this.convertValues(source["payload"], holidays.HolidayUpsertInput);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class UpdateLeaveTypeRequest {
+	    accessToken: string;
+	    id: number;
+	    payload: leave.LeaveTypeUpsertInput;
+	
+	    static createFrom(source: any = {}) {
+	        return new UpdateLeaveTypeRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.accessToken = source["accessToken"];
+	        this.id = source["id"];
+	        this.payload = this.convertValues(source["payload"], leave.LeaveTypeUpsertInput);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class UpdatePayComponentRequest {
+	    accessToken: string;
+	    id: number;
+	    payload: payroll.PayComponentUpsertInput;
+	
+	    static createFrom(source: any = {}) {
+	        return new UpdatePayComponentRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.accessToken = source["accessToken"];
+	        this.id = source["id"];
+	        this.payload = this.convertValues(source["payload"], payroll.PayComponentUpsertInput);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class UpdatePayrollEntryAmountsRequest {
+	    accessToken: string;
+	    entryId: number;
+	    payload: payroll.UpdateEntryAmountsInput;
+	
+	    static createFrom(source: any = {}) {
+	        return new UpdatePayrollEntryAmountsRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.accessToken = source["accessToken"];
+	        this.entryId = source["entryId"];
+	        this.payload = this.convertValues(source["payload"], payroll.UpdateEntryAmountsInput);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class UpdateProjectRequest {
+	    accessToken: string;
+	    id: number;
+	    payload: payroll.ProjectUpsertInput;
+	
+	    static createFrom(source: any = {}) {
+	        return new UpdateProjectRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.accessToken = source["accessToken"];
+	        this.id = source["id"];
+	        this.payload = this.convertValues(source["payload"], payroll.ProjectUpsertInput);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class UpdateSettingsRequest {
+	    accessToken: string;
+	    payload: settings.UpdateSettingsInput;
+	
+	    static createFrom(source: any = {}) {
+	        return new UpdateSettingsRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.accessToken = source["accessToken"];
+	        this.payload = this.convertValues(source["payload"], settings.UpdateSettingsInput);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class UpdateUserRequest {
+	    accessToken: string;
+	    id: number;
+	    payload: users.UpdateUserInput;
+	
+	    static createFrom(source: any = {}) {
+	        return new UpdateUserRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.accessToken = source["accessToken"];
+	        this.id = source["id"];
+	        this.payload = this.convertValues(source["payload"], users.UpdateUserInput);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class UpdateWorkScheduleRequest {
+	    accessToken: string;
+	    id: number;
+	    payload: schedules.WorkScheduleUpsertInput;
+	
+	    static createFrom(source: any = {}) {
+	        return new UpdateWorkScheduleRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.accessToken = source["accessToken"];
+	        this.id = source["id"];
+	        this.payload = this.convertValues(source["payload"], schedules.WorkScheduleUpsertInput);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class UploadCompanyLogoRequest {
+	    accessToken: string;
+	    filename: string;
+	    mimeType: string;
+	    data: number[];
+	
+	    static createFrom(source: any = {}) {
+	        return new UploadCompanyLogoRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.accessToken = source["accessToken"];
+	        this.filename = source["filename"];
+	        this.mimeType = source["mimeType"];
+	        this.data = source["data"];
+	    }
+	}
+	export class UploadEmployeeContractRequest {
+	    accessToken: string;
+	    employeeId: number;
+	    filename: string;
+	    mimeType: string;
+	    data: number[];
+	
+	    static createFrom(source: any = {}) {
+	        return new UploadEmployeeContractRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.accessToken = source["accessToken"];
+	        this.employeeId = source["employeeId"];
+	        this.filename = source["filename"];
+	        this.mimeType = source["mimeType"];
+	        this.data = source["data"];
+	    }
+	}
+	export class UpsertAttendanceRequest {
+	    accessToken: string;
+	    date: string;
+	    employeeId: number;
+	    status: string;
+	    reason?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new UpsertAttendanceRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.accessToken = source["accessToken"];
+	        this.date = source["date"];
+	        this.employeeId = source["employeeId"];
+	        this.status = source["status"];
+	        this.reason = source["reason"];
+	    }
+	}
+	export class UpsertEntitlementRequest {
+	    accessToken: string;
+	    payload: leave.UpsertEntitlementInput;
+	
+	    static createFrom(source: any = {}) {
+	        return new UpsertEntitlementRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.accessToken = source["accessToken"];
+	        this.payload = this.convertValues(source["payload"], leave.UpsertEntitlementInput);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class UpsertLunchVisitorsRequest {
+	    accessToken: string;
+	    date: string;
+	    visitorsCount: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new UpsertLunchVisitorsRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.accessToken = source["accessToken"];
+	        this.date = source["date"];
+	        this.visitorsCount = source["visitorsCount"];
+	    }
+	}
+
+}
+
+export namespace holidays {
+	
+	export class HolidayImportError {
+	    row: number;
+	    message: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new HolidayImportError(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.row = source["row"];
+	        this.message = source["message"];
+	    }
+	}
+	export class HolidayImportResult {
+	    year: number;
+	    rows: number;
+	    created: number;
+	    skipped: number;
+	    errors: HolidayImportError[];
+	
+	    static createFrom(source: any = {}) {
+	        return new HolidayImportResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.year = source["year"];
+	        this.rows = source["rows"];
+	        this.created = source["created"];
+	        this.skipped = source["skipped"];
+	        this.errors = this.convertValues(source["errors"], HolidayImportError);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class HolidayOccurrence {
+	    date: string;
+	    holidayId: number;
+	    name: string;
+	    kind: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new HolidayOccurrence(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.date = source["date"];
+	        this.holidayId = source["holidayId"];
+	        this.name = source["name"];
+	        this.kind = source["kind"];
+	    }
+	}
+	export class HolidayUpsertInput {
+	    name: string;
+	    kind: string;
+	    month?: number;
+	    day?: number;
+	    easterOffset?: number;
+	    holidayDate?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new HolidayUpsertInput(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.kind = source["kind"];
+	        this.month = source["month"];
+	        this.day = source["day"];
+	        this.easterOffset = source["easterOffset"];
+	        this.holidayDate = source["holidayDate"];
+	    }
+	}
+	export class Holiday {
+	    id: number;
+	    name: string;
+	    kind: string;
+	    month?: number;
+	    day?: number;
+	    easterOffset?: number;
+	    // Go type: time
+	    holidayDate?: any;
+	    active: boolean;
+	    // Go type: time
+	    createdAt: any;
+	    // Go type: time
+	    updatedAt: any;
+	
+	    static createFrom(source: any = {}) {
+	        return new Holiday(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.name = source["name"];
+	        this.kind = source["kind"];
+	        this.month = source["month"];
+	        this.day = source["day"];
+	        this.easterOffset = source["easterOffset"];
+	        this.holidayDate = this.convertValues(source["holidayDate"], null);
+	        this.active = source["active"];
+	        this.createdAt = this.convertValues(source["createdAt"], null);
+	        this.updatedAt = this.convertValues(source["updatedAt"], null);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+}
+
+export namespace leave {
+	
+	export class ApplyLeaveInput {
+	    leaveTypeId: number;
+	    startDate: string;
+	    endDate: string;
+	    startDayPart: string;
+	    endDayPart: string;
+	    hours?: number;
+	    reason?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ApplyLeaveInput(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.leaveTypeId = source["leaveTypeId"];
+	        this.startDate = source["startDate"];
+	        this.endDate = source["endDate"];
+	        this.startDayPart = source["startDayPart"];
+	        this.endDayPart = source["endDayPart"];
+	        this.hours = source["hours"];
+	        this.reason = source["reason"];
+	    }
+	}
+	export class LeaveBalance {
+	    employeeId: number;
+	    year: number;
+	    totalDays: number;
+	    reservedDays: number;
+	    approvedDays: number;
+	    pendingDays: number;
+	    availableDays: number;
+	    types: LeaveTypeBalance[];
+	
+	    static createFrom(source: any = {}) {
+	        return new LeaveBalance(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.employeeId = source["employeeId"];
+	        this.year = source["year"];
+	        this.totalDays = source["totalDays"];
+	        this.reservedDays = source["reservedDays"];
+	        this.approvedDays = source["approvedDays"];
+	        this.pendingDays = source["pendingDays"];
+	        this.availableDays = source["availableDays"];
+	        this.types = this.convertValues(source["types"], LeaveTypeBalance);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class LeaveDaysPreview {
+	    startDate: string;
+	    endDate: string;
+	    workingDays: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new LeaveDaysPreview(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.startDate = source["startDate"];
+	        this.endDate = source["endDate"];
+	        this.workingDays = source["workingDays"];
+	    }
+	}
+	export class LeaveEntitlement {
+	    id: number;
+	    employeeId: number;
+	    year: number;
+	    leaveTypeId: number;
+	    leaveTypeName: string;
+	    totalDays: number;
+	    reservedDays: number;
+	    // Go type: time
+	    createdAt: any;
+	    // Go type: time
+	    updatedAt: any;
+	
+	    static createFrom(source: any = {}) {
+	        return new LeaveEntitlement(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.employeeId = source["employeeId"];
+	        this.year = source["year"];
+	        this.leaveTypeId = source["leaveTypeId"];
+	        this.leaveTypeName = source["leaveTypeName"];
+	        this.totalDays = source["totalDays"];
+	        this.reservedDays = source["reservedDays"];
+	        this.createdAt = this.convertValues(source["createdAt"], null);
+	        this.updatedAt = this.convertValues(source["updatedAt"], null);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class LeaveLockedDate {
+	    id: number;
+	    // Go type: time
+	    date: any;
+	    reason?: string;
+	    createdBy?: number;
+	    // Go type: time
+	    createdAt: any;
+	
+	    static createFrom(source: any = {}) {
+	        return new LeaveLockedDate(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.date = this.convertValues(source["date"], null);
+	        this.reason = source["reason"];
+	        this.createdBy = source["createdBy"];
+	        this.createdAt = this.convertValues(source["createdAt"], null);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class LeaveRequest {
+	    id: number;
+	    employeeId: number;
+	    employeeName: string;
+	    departmentName?: string;
+	    leaveTypeId: number;
+	    leaveTypeName: string;
+	    // Go type: time
+	    startDate: any;
+	    // Go type: time
+	    endDate: any;
+	    startDayPart: string;
+	    endDayPart: string;
+	    hours?: number;
+	    workingDays: number;
+	    status: string;
+	    reason?: string;
+	    approvedBy?: number;
+	    // Go type: time
+	    approvedAt?: any;
+	    // Go type: time
+	    createdAt: any;
+	    // Go type: time
+	    updatedAt: any;
+	
+	    static createFrom(source: any = {}) {
+	        return new LeaveRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.employeeId = source["employeeId"];
+	        this.employeeName = source["employeeName"];
+	        this.departmentName = source["departmentName"];
+	        this.leaveTypeId = source["leaveTypeId"];
+	        this.leaveTypeName = source["leaveTypeName"];
+	        this.startDate = this.convertValues(source["startDate"], null);
+	        this.endDate = this.convertValues(source["endDate"], null);
+	        this.startDayPart = source["startDayPart"];
+	        this.endDayPart = source["endDayPart"];
+	        this.hours = source["hours"];
+	        this.workingDays = source["workingDays"];
+	        this.status = source["status"];
+	        this.reason = source["reason"];
+	        this.approvedBy = source["approvedBy"];
+	        this.approvedAt = this.convertValues(source["approvedAt"], null);
+	        this.createdAt = this.convertValues(source["createdAt"], null);
+	        this.updatedAt = this.convertValues(source["updatedAt"], null);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class LeaveType {
+	    id: number;
+	    name: string;
+	    paid: boolean;
+	    countsTowardEntitlement: boolean;
+	    requiresAttachment: boolean;
+	    requiresApproval: boolean;
+	    active: boolean;
+	    // Go type: time
+	    createdAt: any;
+	    // Go type: time
+	    updatedAt: any;
+	
+	    static createFrom(source: any = {}) {
+	        return new LeaveType(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.name = source["name"];
+	        this.paid = source["paid"];
+	        this.countsTowardEntitlement = source["countsTowardEntitlement"];
+	        this.requiresAttachment = source["requiresAttachment"];
+	        this.requiresApproval = source["requiresApproval"];
+	        this.active = source["active"];
+	        this.createdAt = this.convertValues(source["createdAt"], null);
+	        this.updatedAt = this.convertValues(source["updatedAt"], null);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class LeaveTypeBalance {
+	    leaveTypeId: number;
+	    leaveTypeName: string;
+	    totalDays: number;
+	    reservedDays: number;
+	    approvedDays: number;
+	    pendingDays: number;
+	    availableDays: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new LeaveTypeBalance(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.leaveTypeId = source["leaveTypeId"];
+	        this.leaveTypeName = source["leaveTypeName"];
+	        this.totalDays = source["totalDays"];
+	        this.reservedDays = source["reservedDays"];
+	        this.approvedDays = source["approvedDays"];
+	        this.pendingDays = source["pendingDays"];
+	        this.availableDays = source["availableDays"];
+	    }
+	}
+	export class LeaveTypeUpsertInput {
+	    name: string;
+	    paid: boolean;
+	    countsTowardEntitlement: boolean;
+	    requiresAttachment: boolean;
+	    requiresApproval: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new LeaveTypeUpsertInput(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.paid = source["paid"];
+	        this.countsTowardEntitlement = source["countsTowardEntitlement"];
+	        this.requiresAttachment = source["requiresAttachment"];
+	        this.requiresApproval = source["requiresApproval"];
+	    }
+	}
+	export class ListLeaveRequestsFilter {
+	    status: string;
+	    dateFrom: string;
+	    dateTo: string;
+	    employee: string;
+	    leaveType: string;
+	    dept: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ListLeaveRequestsFilter(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.status = source["status"];
+	        this.dateFrom = source["dateFrom"];
+	        this.dateTo = source["dateTo"];
+	        this.employee = source["employee"];
+	        this.leaveType = source["leaveType"];
+	        this.dept = source["dept"];
+	    }
+	}
+	export class UpsertEntitlementInput {
+	    employeeId: number;
+	    year: number;
+	    leaveTypeId: number;
+	    totalDays: number;
+	    reservedDays: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new UpsertEntitlementInput(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.employeeId = source["employeeId"];
+	        this.year = source["year"];
+	        this.leaveTypeId = source["leaveTypeId"];
+	        this.totalDays = source["totalDays"];
+	        this.reservedDays = source["reservedDays"];
+	    }
+	}
+
+}
+
+export namespace main {
+	
+	export class ActionResult {
+	    ok: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new ActionResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.ok = source["ok"];
+	    }
+	}
+	export class DatabaseConfigParams {
+	    host: string;
+	    port: number;
+	    database: string;
+	    user: string;
+	    password: string;
+	    sslmode: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new DatabaseConfigParams(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.host = source["host"];
+	        this.port = source["port"];
+	        this.database = source["database"];
+	        this.user = source["user"];
+	        this.password = source["password"];
+	        this.sslmode = source["sslmode"];
+	    }
+	}
+	export class SaveFileWithDialogRequest {
+	    suggestedFilename: string;
+	    dataBytes: number[];
+	    mimeType: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new SaveFileWithDialogRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.suggestedFilename = source["suggestedFilename"];
+	        this.dataBytes = source["dataBytes"];
+	        this.mimeType = source["mimeType"];
+	    }
+	}
+	export class SaveFileWithDialogResult {
+	    savedPath: string;
+	    cancelled: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new SaveFileWithDialogResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.savedPath = source["savedPath"];
+	        this.cancelled = source["cancelled"];
+	    }
+	}
+	export class StartupHealthResponse {
+	    dbOk: boolean;
+	    runtimeOk: boolean;
+	    dbError?: string;
+	    runtimeError?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new StartupHealthResponse(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.dbOk = source["dbOk"];
+	        this.runtimeOk = source["runtimeOk"];
+	        this.dbError = source["dbError"];
+	        this.runtimeError = source["runtimeError"];
+	    }
+	}
+
+}
+
+export namespace payroll {
+	
+	export class AddEntryLineInput {
+	    componentId: number;
+	    amount?: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new AddEntryLineInput(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.componentId = source["componentId"];
+	        this.amount = source["amount"];
+	    }
+	}
+	export class AnnualContribution {
+	    schemeId: number;
+	    code: string;
+	    name: string;
+	    employeeAmount: number;
+	    employerAmount: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new AnnualContribution(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.schemeId = source["schemeId"];
+	        this.code = source["code"];
+	        this.name = source["name"];
+	        this.employeeAmount = source["employeeAmount"];
+	        this.employerAmount = source["employerAmount"];
+	    }
+	}
+	export class AnnualEmployeeTax {
+	    employeeId: number;
+	    employeeName: string;
+	    nationalId?: string;
+	    months: number;
+	    grossPay: number;
+	    taxablePay: number;
+	    taxTotal: number;
+	    netPay: number;
+	    contributions: AnnualContribution[];
+	
+	    static createFrom(source: any = {}) {
+	        return new AnnualEmployeeTax(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.employeeId = source["employeeId"];
+	        this.employeeName = source["employeeName"];
+	        this.nationalId = source["nationalId"];
+	        this.months = source["months"];
+	        this.grossPay = source["grossPay"];
+	        this.taxablePay = source["taxablePay"];
+	        this.taxTotal = source["taxTotal"];
+	        this.netPay = source["netPay"];
+	        this.contributions = this.convertValues(source["contributions"], AnnualContribution);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class AnnualSchemeColumn {
+	    schemeId: number;
+	    code: string;
+	    name: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new AnnualSchemeColumn(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.schemeId = source["schemeId"];
+	        this.code = source["code"];
+	        this.name = source["name"];
+	    }
+	}
+	export class AnnualTaxSummary {
+	    year: number;
+	    startMonth: number;
+	    label: string;
+	    fromMonth: string;
+	    toMonth: string;
+	    schemes: AnnualSchemeColumn[];
+	    employees: AnnualEmployeeTax[];
+	    grossPay: number;
+	    taxablePay: number;
+	    taxTotal: number;
+	    netPay: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new AnnualTaxSummary(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.year = source["year"];
+	        this.startMonth = source["startMonth"];
+	        this.label = source["label"];
+	        this.fromMonth = source["fromMonth"];
+	        this.toMonth = source["toMonth"];
+	        this.schemes = this.convertValues(source["schemes"], AnnualSchemeColumn);
+	        this.employees = this.convertValues(source["employees"], AnnualEmployeeTax);
+	        this.grossPay = source["grossPay"];
+	        this.taxablePay = source["taxablePay"];
+	        this.taxTotal = source["taxTotal"];
+	        this.netPay = source["netPay"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ApprovalProgress {
+	    steps: ApprovalStepStatus[];
+	    nextStep: number;
+	    history: PayrollBatchApproval[];
+	
+	    static createFrom(source: any = {}) {
+	        return new ApprovalProgress(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.steps = this.convertValues(source["steps"], ApprovalStepStatus);
+	        this.nextStep = source["nextStep"];
+	        this.history = this.convertValues(source["history"], PayrollBatchApproval);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ApprovalStep {
+	    name: string;
+	    roles: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new ApprovalStep(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.roles = source["roles"];
+	    }
+	}
+	export class ApprovalStepStatus {
+	    stepNumber: number;
+	    name: string;
+	    roles: string[];
+	    approval?: PayrollBatchApproval;
+	
+	    static createFrom(source: any = {}) {
+	        return new ApprovalStepStatus(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.stepNumber = source["stepNumber"];
+	        this.name = source["name"];
+	        this.roles = source["roles"];
+	        this.approval = this.convertValues(source["approval"], PayrollBatchApproval);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class BatchIntegrityReport {
+	    batchId: number;
+	    month: string;
+	    status: string;
+	    storedHash: string;
+	    computedHash: string;
+	    drift: string[];
+	    // Go type: time
+	    checkedAt: any;
+	    headerDrift: boolean;
+	    driftedEntryIds: number[];
+	
+	    static createFrom(source: any = {}) {
+	        return new BatchIntegrityReport(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.batchId = source["batchId"];
+	        this.month = source["month"];
+	        this.status = source["status"];
+	        this.storedHash = source["storedHash"];
+	        this.computedHash = source["computedHash"];
+	        this.drift = source["drift"];
+	        this.checkedAt = this.convertValues(source["checkedAt"], null);
+	        this.headerDrift = source["headerDrift"];
+	        this.driftedEntryIds = source["driftedEntryIds"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class CSVExport {
+	    filename: string;
+	    data: string;
+	    mimeType: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new CSVExport(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.filename = source["filename"];
+	        this.data = source["data"];
+	        this.mimeType = source["mimeType"];
+	    }
+	}
+	export class ContributionScheme {
+	    id: number;
+	    code: string;
+	    name: string;
+	    employeeRate: number;
+	    employerRate: number;
+	    base: string;
+	    membersOnly: boolean;
+	    active: boolean;
+	    // Go type: time
+	    createdAt: any;
+	    // Go type: time
+	    updatedAt: any;
+	
+	    static createFrom(source: any = {}) {
+	        return new ContributionScheme(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.code = source["code"];
+	        this.name = source["name"];
+	        this.employeeRate = source["employeeRate"];
+	        this.employerRate = source["employerRate"];
+	        this.base = source["base"];
+	        this.membersOnly = source["membersOnly"];
+	        this.active = source["active"];
+	        this.createdAt = this.convertValues(source["createdAt"], null);
+	        this.updatedAt = this.convertValues(source["updatedAt"], null);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ContributionSchemeMember {
+	    schemeId: number;
+	    employeeId: number;
+	    employeeName: string;
+	    memberNumber: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ContributionSchemeMember(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.schemeId = source["schemeId"];
+	        this.employeeId = source["employeeId"];
+	        this.employeeName = source["employeeName"];
+	        this.memberNumber = source["memberNumber"];
+	    }
+	}
+	export class ContributionSchemeUpsertInput {
+	    code: string;
+	    name: string;
+	    employeeRate: number;
+	    employerRate: number;
+	    base: string;
+	    membersOnly: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new ContributionSchemeUpsertInput(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.code = source["code"];
+	        this.name = source["name"];
+	        this.employeeRate = source["employeeRate"];
+	        this.employerRate = source["employerRate"];
+	        this.base = source["base"];
+	        this.membersOnly = source["membersOnly"];
+	    }
+	}
+	export class CreateBatchInput {
+	    month: string;
+	    batchType: string;
+	    description: string;
+	    employeeIds: number[];
+	    componentIds: number[];
+	
+	    static createFrom(source: any = {}) {
+	        return new CreateBatchInput(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.month = source["month"];
+	        this.batchType = source["batchType"];
+	        this.description = source["description"];
+	        this.employeeIds = source["employeeIds"];
+	        this.componentIds = source["componentIds"];
+	    }
+	}
+	export class EmployeeLoan {
+	    id: number;
+	    employeeId: number;
+	    employeeName: string;
+	    loanType: string;
+	    description?: string;
+	    principal: number;
+	    interestRate: number;
+	    totalRepayable: number;
+	    installments: number;
+	    installmentAmount: number;
+	    startMonth: string;
+	    outstandingBalance: number;
+	    status: string;
+	    createdBy?: number;
+	    // Go type: time
+	    createdAt: any;
+	    // Go type: time
+	    updatedAt: any;
+	
+	    static createFrom(source: any = {}) {
+	        return new EmployeeLoan(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.employeeId = source["employeeId"];
+	        this.employeeName = source["employeeName"];
+	        this.loanType = source["loanType"];
+	        this.description = source["description"];
+	        this.principal = source["principal"];
+	        this.interestRate = source["interestRate"];
+	        this.totalRepayable = source["totalRepayable"];
+	        this.installments = source["installments"];
+	        this.installmentAmount = source["installmentAmount"];
+	        this.startMonth = source["startMonth"];
+	        this.outstandingBalance = source["outstandingBalance"];
+	        this.status = source["status"];
+	        this.createdBy = source["createdBy"];
+	        this.createdAt = this.convertValues(source["createdAt"], null);
+	        this.updatedAt = this.convertValues(source["updatedAt"], null);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class EmployeePaymentMethod {
+	    id: number;
+	    employeeId: number;
+	    method: string;
+	    bankName?: string;
+	    bankCode?: string;
+	    branch?: string;
+	    accountName?: string;
+	    accountNumber?: string;
+	    provider?: string;
+	    msisdn?: string;
+	    splitPercent: number;
+	    // Go type: time
+	    createdAt: any;
+	    // Go type: time
+	    updatedAt: any;
+	
+	    static createFrom(source: any = {}) {
+	        return new EmployeePaymentMethod(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.employeeId = source["employeeId"];
+	        this.method = source["method"];
+	        this.bankName = source["bankName"];
+	        this.bankCode = source["bankCode"];
+	        this.branch = source["branch"];
+	        this.accountName = source["accountName"];
+	        this.accountNumber = source["accountNumber"];
+	        this.provider = source["provider"];
+	        this.msisdn = source["msisdn"];
+	        this.splitPercent = source["splitPercent"];
+	        this.createdAt = this.convertValues(source["createdAt"], null);
+	        this.updatedAt = this.convertValues(source["updatedAt"], null);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class EmployeePaymentMethodInput {
+	    method: string;
+	    bankName: string;
+	    bankCode: string;
+	    branch: string;
+	    accountName: string;
+	    accountNumber: string;
+	    provider: string;
+	    msisdn: string;
+	    splitPercent: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new EmployeePaymentMethodInput(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.method = source["method"];
+	        this.bankName = source["bankName"];
+	        this.bankCode = source["bankCode"];
+	        this.branch = source["branch"];
+	        this.accountName = source["accountName"];
+	        this.accountNumber = source["accountNumber"];
+	        this.provider = source["provider"];
+	        this.msisdn = source["msisdn"];
+	        this.splitPercent = source["splitPercent"];
+	    }
+	}
+	export class EmployeeProjectAllocation {
+	    id: number;
+	    employeeId: number;
+	    projectId: number;
+	    projectCode: string;
+	    projectName: string;
+	    percent: number;
+	    // Go type: time
+	    startDate: any;
+	    // Go type: time
+	    endDate?: any;
+	    // Go type: time
+	    createdAt: any;
+	
+	    static createFrom(source: any = {}) {
+	        return new EmployeeProjectAllocation(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.employeeId = source["employeeId"];
+	        this.projectId = source["projectId"];
+	        this.projectCode = source["projectCode"];
+	        this.projectName = source["projectName"];
+	        this.percent = source["percent"];
+	        this.startDate = this.convertValues(source["startDate"], null);
+	        this.endDate = this.convertValues(source["endDate"], null);
+	        this.createdAt = this.convertValues(source["createdAt"], null);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class EntryAmountsImportResult {
+	    batchId: number;
+	    rows: number;
+	    updated: number;
+	    unchanged: number;
+	    errors: EntryImportRowError[];
+	
+	    static createFrom(source: any = {}) {
+	        return new EntryAmountsImportResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.batchId = source["batchId"];
+	        this.rows = source["rows"];
+	        this.updated = source["updated"];
+	        this.unchanged = source["unchanged"];
+	        this.errors = this.convertValues(source["errors"], EntryImportRowError);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class EntryImportRowError {
+	    row: number;
+	    employeeId?: number;
+	    message: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new EntryImportRowError(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.row = source["row"];
+	        this.employeeId = source["employeeId"];
+	        this.message = source["message"];
+	    }
+	}
+	export class GLAccountMapping {
+	    id: number;
+	    category: string;
+	    componentId?: number;
+	    componentCode?: string;
+	    schemeId?: number;
+	    schemeCode?: string;
+	    departmentId?: number;
+	    departmentName?: string;
+	    accountCode: string;
+	    accountName: string;
+	    // Go type: time
+	    createdAt: any;
+	    // Go type: time
+	    updatedAt: any;
+	
+	    static createFrom(source: any = {}) {
+	        return new GLAccountMapping(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.category = source["category"];
+	        this.componentId = source["componentId"];
+	        this.componentCode = source["componentCode"];
+	        this.schemeId = source["schemeId"];
+	        this.schemeCode = source["schemeCode"];
+	        this.departmentId = source["departmentId"];
+	        this.departmentName = source["departmentName"];
+	        this.accountCode = source["accountCode"];
+	        this.accountName = source["accountName"];
+	        this.createdAt = this.convertValues(source["createdAt"], null);
+	        this.updatedAt = this.convertValues(source["updatedAt"], null);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class GLAccountMappingInput {
+	    category: string;
+	    componentId?: number;
+	    schemeId?: number;
+	    departmentId?: number;
+	    accountCode: string;
+	    accountName: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new GLAccountMappingInput(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.category = source["category"];
+	        this.componentId = source["componentId"];
+	        this.schemeId = source["schemeId"];
+	        this.departmentId = source["departmentId"];
+	        this.accountCode = source["accountCode"];
+	        this.accountName = source["accountName"];
+	    }
+	}
+	export class JournalLine {
+	    accountCode: string;
+	    accountName: string;
+	    departmentId?: number;
+	    departmentName: string;
+	    costCentre: string;
+	    debit: number;
+	    credit: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new JournalLine(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.accountCode = source["accountCode"];
+	        this.accountName = source["accountName"];
+	        this.departmentId = source["departmentId"];
+	        this.departmentName = source["departmentName"];
+	        this.costCentre = source["costCentre"];
+	        this.debit = source["debit"];
+	        this.credit = source["credit"];
+	    }
+	}
+	export class ListBatchesFilter {
+	    month: string;
+	    batchType: string;
+	    status: string;
+	    page: number;
+	    pageSize: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new ListBatchesFilter(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.month = source["month"];
+	        this.batchType = source["batchType"];
+	        this.status = source["status"];
+	        this.page = source["page"];
+	        this.pageSize = source["pageSize"];
+	    }
+	}
+	export class ListLoansFilter {
+	    employeeId: number;
+	    status: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ListLoansFilter(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.employeeId = source["employeeId"];
+	        this.status = source["status"];
+	    }
+	}
+	export class LoanCreateInput {
+	    employeeId: number;
+	    loanType: string;
+	    description: string;
+	    principal: number;
+	    interestRate: number;
+	    installments: number;
+	    startMonth: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new LoanCreateInput(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.employeeId = source["employeeId"];
+	        this.loanType = source["loanType"];
+	        this.description = source["description"];
+	        this.principal = source["principal"];
+	        this.interestRate = source["interestRate"];
+	        this.installments = source["installments"];
+	        this.startMonth = source["startMonth"];
+	    }
+	}
+	export class LoanInstallment {
+	    number: number;
+	    month: string;
+	    amount: number;
+	    balanceAfter: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new LoanInstallment(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.number = source["number"];
+	        this.month = source["month"];
+	        this.amount = source["amount"];
+	        this.balanceAfter = source["balanceAfter"];
+	    }
+	}
+	export class LoanRepayment {
+	    id: number;
+	    loanId: number;
+	    batchId: number;
+	    entryId?: number;
+	    month: string;
+	    amount: number;
+	    balanceAfter: number;
+	    // Go type: time
+	    createdAt: any;
+	
+	    static createFrom(source: any = {}) {
+	        return new LoanRepayment(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.loanId = source["loanId"];
+	        this.batchId = source["batchId"];
+	        this.entryId = source["entryId"];
+	        this.month = source["month"];
+	        this.amount = source["amount"];
+	        this.balanceAfter = source["balanceAfter"];
+	        this.createdAt = this.convertValues(source["createdAt"], null);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class LoanLedger {
+	    loan: EmployeeLoan;
+	    schedule: LoanInstallment[];
+	    repayments: LoanRepayment[];
+	
+	    static createFrom(source: any = {}) {
+	        return new LoanLedger(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.loan = this.convertValues(source["loan"], EmployeeLoan);
+	        this.schedule = this.convertValues(source["schedule"], LoanInstallment);
+	        this.repayments = this.convertValues(source["repayments"], LoanRepayment);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class PayrollBatch {
+	    id: number;
+	    month: string;
+	    batchType: string;
+	    description: string;
+	    status: string;
+	    createdBy: number;
+	    // Go type: time
+	    createdAt: any;
+	    approvedBy?: number;
+	    // Go type: time
+	    approvedAt?: any;
+	    // Go type: time
+	    lockedAt?: any;
+	    varianceReviewedBy?: number;
+	    // Go type: time
+	    varianceReviewedAt?: any;
+	    reversesBatchId?: number;
+	    integrityHash?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new PayrollBatch(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.month = source["month"];
+	        this.batchType = source["batchType"];
+	        this.description = source["description"];
+	        this.status = source["status"];
+	        this.createdBy = source["createdBy"];
+	        this.createdAt = this.convertValues(source["createdAt"], null);
+	        this.approvedBy = source["approvedBy"];
+	        this.approvedAt = this.convertValues(source["approvedAt"], null);
+	        this.lockedAt = this.convertValues(source["lockedAt"], null);
+	        this.varianceReviewedBy = source["varianceReviewedBy"];
+	        this.varianceReviewedAt = this.convertValues(source["varianceReviewedAt"], null);
+	        this.reversesBatchId = source["reversesBatchId"];
+	        this.integrityHash = source["integrityHash"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ListBatchesResult {
+	    items: PayrollBatch[];
+	    totalCount: number;
+	    page: number;
+	    pageSize: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new ListBatchesResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.items = this.convertValues(source["items"], PayrollBatch);
+	        this.totalCount = source["totalCount"];
+	        this.page = source["page"];
+	        this.pageSize = source["pageSize"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class PayComponent {
+	    id: number;
+	    code: string;
+	    name: string;
+	    kind: string;
+	    taxable: boolean;
+	    calculationType: string;
+	    value: number;
+	    autoApply: boolean;
+	    active: boolean;
+	    // Go type: time
+	    createdAt: any;
+	    // Go type: time
+	    updatedAt: any;
+	
+	    static createFrom(source: any = {}) {
+	        return new PayComponent(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.code = source["code"];
+	        this.name = source["name"];
+	        this.kind = source["kind"];
+	        this.taxable = source["taxable"];
+	        this.calculationType = source["calculationType"];
+	        this.value = source["value"];
+	        this.autoApply = source["autoApply"];
+	        this.active = source["active"];
+	        this.createdAt = this.convertValues(source["createdAt"], null);
+	        this.updatedAt = this.convertValues(source["updatedAt"], null);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class PayComponentUpsertInput {
+	    code: string;
+	    name: string;
+	    kind: string;
+	    taxable: boolean;
+	    calculationType: string;
+	    value: number;
+	    autoApply: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new PayComponentUpsertInput(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.code = source["code"];
+	        this.name = source["name"];
+	        this.kind = source["kind"];
+	        this.taxable = source["taxable"];
+	        this.calculationType = source["calculationType"];
+	        this.value = source["value"];
+	        this.autoApply = source["autoApply"];
+	    }
+	}
+	
+	export class PaymentFileFormatInfo {
+	    code: string;
+	    name: string;
+	    method: string;
+	    provider?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new PaymentFileFormatInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.code = source["code"];
+	        this.name = source["name"];
+	        this.method = source["method"];
+	        this.provider = source["provider"];
+	    }
+	}
+	export class PayrollBatchApproval {
+	    id: number;
+	    batchId: number;
+	    stepNumber: number;
+	    stepName: string;
+	    action: string;
+	    userId?: number;
+	    userName?: string;
+	    comment: string;
+	    // Go type: time
+	    createdAt: any;
+	
+	    static createFrom(source: any = {}) {
+	        return new PayrollBatchApproval(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.batchId = source["batchId"];
+	        this.stepNumber = source["stepNumber"];
+	        this.stepName = source["stepName"];
+	        this.action = source["action"];
+	        this.userId = source["userId"];
+	        this.userName = source["userName"];
+	        this.comment = source["comment"];
+	        this.createdAt = this.convertValues(source["createdAt"], null);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class PayrollEntryLineSource {
+	    id: number;
+	    lineId: number;
+	    sourceType: string;
+	    sourceId: number;
+	    days: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new PayrollEntryLineSource(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.lineId = source["lineId"];
+	        this.sourceType = source["sourceType"];
+	        this.sourceId = source["sourceId"];
+	        this.days = source["days"];
+	    }
+	}
+	export class PayrollEntryLine {
+	    id: number;
+	    entryId: number;
+	    componentId?: number;
+	    schemeId?: number;
+	    code: string;
+	    name: string;
+	    kind: string;
+	    taxable: boolean;
+	    amount: number;
+	    employerAmount: number;
+	    // Go type: time
+	    createdAt: any;
+	    quantity?: number;
+	    rate?: number;
+	    loanId?: number;
+	    sources?: PayrollEntryLineSource[];
+	    arrearsMonth?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new PayrollEntryLine(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.entryId = source["entryId"];
+	        this.componentId = source["componentId"];
+	        this.schemeId = source["schemeId"];
+	        this.code = source["code"];
+	        this.name = source["name"];
+	        this.kind = source["kind"];
+	        this.taxable = source["taxable"];
+	        this.amount = source["amount"];
+	        this.employerAmount = source["employerAmount"];
+	        this.createdAt = this.convertValues(source["createdAt"], null);
+	        this.quantity = source["quantity"];
+	        this.rate = source["rate"];
+	        this.loanId = source["loanId"];
+	        this.sources = this.convertValues(source["sources"], PayrollEntryLineSource);
+	        this.arrearsMonth = source["arrearsMonth"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class PayrollEntry {
+	    id: number;
+	    batchId: number;
+	    employeeId: number;
+	    employeeName: string;
+	    baseSalary: number;
+	    allowancesTotal: number;
+	    deductionsTotal: number;
+	    taxTotal: number;
+	    grossPay: number;
+	    netPay: number;
+	    // Go type: time
+	    createdAt: any;
+	    // Go type: time
+	    updatedAt: any;
+	    employerContributionsTotal: number;
+	    fullBaseSalary?: number;
+	    prorationBasis?: string;
+	    payableDays?: number;
+	    periodDays?: number;
+	    lines: PayrollEntryLine[];
+	
+	    static createFrom(source: any = {}) {
+	        return new PayrollEntry(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.batchId = source["batchId"];
+	        this.employeeId = source["employeeId"];
+	        this.employeeName = source["employeeName"];
+	        this.baseSalary = source["baseSalary"];
+	        this.allowancesTotal = source["allowancesTotal"];
+	        this.deductionsTotal = source["deductionsTotal"];
+	        this.taxTotal = source["taxTotal"];
+	        this.grossPay = source["grossPay"];
+	        this.netPay = source["netPay"];
+	        this.createdAt = this.convertValues(source["createdAt"], null);
+	        this.updatedAt = this.convertValues(source["updatedAt"], null);
+	        this.employerContributionsTotal = source["employerContributionsTotal"];
+	        this.fullBaseSalary = source["fullBaseSalary"];
+	        this.prorationBasis = source["prorationBasis"];
+	        this.payableDays = source["payableDays"];
+	        this.periodDays = source["periodDays"];
+	        this.lines = this.convertValues(source["lines"], PayrollEntryLine);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class PayrollBatchDetail {
+	    batch: PayrollBatch;
+	    entries: PayrollEntry[];
+	    employeeIds: number[];
+	    componentIds: number[];
+	    approval: ApprovalProgress;
+	    reversedByBatchId?: number;
+	    taxComputed: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new PayrollBatchDetail(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.batch = this.convertValues(source["batch"], PayrollBatch);
+	        this.entries = this.convertValues(source["entries"], PayrollEntry);
+	        this.employeeIds = source["employeeIds"];
+	        this.componentIds = source["componentIds"];
+	        this.approval = this.convertValues(source["approval"], ApprovalProgress);
+	        this.reversedByBatchId = source["reversedByBatchId"];
+	        this.taxComputed = source["taxComputed"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+	
+	export class PayrollJournal {
+	    batchId: number;
+	    month: string;
+	    batchType: string;
+	    reference: string;
+	    date: string;
+	    lines: JournalLine[];
+	    totalDebit: number;
+	    totalCredit: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new PayrollJournal(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.batchId = source["batchId"];
+	        this.month = source["month"];
+	        this.batchType = source["batchType"];
+	        this.reference = source["reference"];
+	        this.date = source["date"];
+	        this.lines = this.convertValues(source["lines"], JournalLine);
+	        this.totalDebit = source["totalDebit"];
+	        this.totalCredit = source["totalCredit"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class PayrollSimulation {
+	    month: string;
+	    prorationBasis: string;
+	    taxTableName: string;
+	    adjustedEmployees: number;
+	    entries: PayrollEntry[];
+	    grossPay: VarianceFigure;
+	    employerContributions: VarianceFigure;
+	    comparison: VarianceReport;
+	
+	    static createFrom(source: any = {}) {
+	        return new PayrollSimulation(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.month = source["month"];
+	        this.prorationBasis = source["prorationBasis"];
+	        this.taxTableName = source["taxTableName"];
+	        this.adjustedEmployees = source["adjustedEmployees"];
+	        this.entries = this.convertValues(source["entries"], PayrollEntry);
+	        this.grossPay = this.convertValues(source["grossPay"], VarianceFigure);
+	        this.employerContributions = this.convertValues(source["employerContributions"], VarianceFigure);
+	        this.comparison = this.convertValues(source["comparison"], VarianceReport);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class PayrollSimulationInput {
+	    month: string;
+	    compareBatchId: number;
+	    salaryAdjustments: SimulationSalaryAdjustment[];
+	    componentOverrides: SimulationComponentOverride[];
+	    schemeOverrides: SimulationSchemeOverride[];
+	    taxBands: TaxBand[];
 	
 	    static createFrom(source: any = {}) {
-	        return new LeaveRequest(source);
+	        return new PayrollSimulationInput(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.id = source["id"];
-	        this.employeeId = source["employeeId"];
-	        this.employeeName = source["employeeName"];
-	        this.departmentName = source["departmentName"];
-	        this.leaveTypeId = source["leaveTypeId"];
-	        this.leaveTypeName = source["leaveTypeName"];
-	        this.startDate = this.convertValues(source["startDate"], null);
-	        this.endDate = this.convertValues(source["endDate"], null);
-	        this.workingDays = source["workingDays"];
-	        this.status = source["status"];
-	        this.reason = source["reason"];
-	        this.approvedBy = source["approvedBy"];
-	        this.approvedAt = this.convertValues(source["approvedAt"], null);
-	        this.createdAt = this.convertValues(source["createdAt"], null);
-	        this.updatedAt = this.convertValues(source["updatedAt"], null);
+	        this.month = source["month"];
+	        this.compareBatchId = source["compareBatchId"];
+	        this.salaryAdjustments = this.convertValues(source["salaryAdjustments"], SimulationSalaryAdjustment);
+	        this.componentOverrides = this.convertValues(source["componentOverrides"], SimulationComponentOverride);
+	        this.schemeOverrides = this.convertValues(source["schemeOverrides"], SimulationSchemeOverride);
+	        this.taxBands = this.convertValues(source["taxBands"], TaxBand);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -2314,13 +5584,11 @@ export namespace leave {
 		    return a;
 		}
 	}
-	export class LeaveType {
+	export class Project {
 	    id: number;
+	    code: string;
 	    name: string;
-	    paid: boolean;
-	    countsTowardEntitlement: boolean;
-	    requiresAttachment: boolean;
-	    requiresApproval: boolean;
+	    donor: string;
 	    active: boolean;
 	    // Go type: time
 	    createdAt: any;
@@ -2328,17 +5596,15 @@ export namespace leave {
 	    updatedAt: any;
 	
 	    static createFrom(source: any = {}) {
-	        return new LeaveType(source);
+	        return new Project(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.id = source["id"];
+	        this.code = source["code"];
 	        this.name = source["name"];
-	        this.paid = source["paid"];
-	        this.countsTowardEntitlement = source["countsTowardEntitlement"];
-	        this.requiresAttachment = source["requiresAttachment"];
-	        this.requiresApproval = source["requiresApproval"];
+	        this.donor = source["donor"];
 	        this.active = source["active"];
 	        this.createdAt = this.convertValues(source["createdAt"], null);
 	        this.updatedAt = this.convertValues(source["updatedAt"], null);
@@ -2362,231 +5628,220 @@ export namespace leave {
 		    return a;
 		}
 	}
-	export class LeaveTypeUpsertInput {
-	    name: string;
-	    paid: boolean;
-	    countsTowardEntitlement: boolean;
-	    requiresAttachment: boolean;
-	    requiresApproval: boolean;
+	export class ProjectAllocationInput {
+	    projectId: number;
+	    percent: number;
+	    startDate: string;
+	    endDate: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new LeaveTypeUpsertInput(source);
+	        return new ProjectAllocationInput(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.name = source["name"];
-	        this.paid = source["paid"];
-	        this.countsTowardEntitlement = source["countsTowardEntitlement"];
-	        this.requiresAttachment = source["requiresAttachment"];
-	        this.requiresApproval = source["requiresApproval"];
+	        this.projectId = source["projectId"];
+	        this.percent = source["percent"];
+	        this.startDate = source["startDate"];
+	        this.endDate = source["endDate"];
 	    }
 	}
-	export class ListLeaveRequestsFilter {
-	    status: string;
-	    dateFrom: string;
-	    dateTo: string;
-	    employee: string;
-	    leaveType: string;
-	    dept: string;
+	export class ProjectChargingReport {
+	    month: string;
+	    rows: ProjectChargingRow[];
+	    grossAmount: number;
+	    employerAmount: number;
+	    totalCost: number;
 	
 	    static createFrom(source: any = {}) {
-	        return new ListLeaveRequestsFilter(source);
+	        return new ProjectChargingReport(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.status = source["status"];
-	        this.dateFrom = source["dateFrom"];
-	        this.dateTo = source["dateTo"];
-	        this.employee = source["employee"];
-	        this.leaveType = source["leaveType"];
-	        this.dept = source["dept"];
+	        this.month = source["month"];
+	        this.rows = this.convertValues(source["rows"], ProjectChargingRow);
+	        this.grossAmount = source["grossAmount"];
+	        this.employerAmount = source["employerAmount"];
+	        this.totalCost = source["totalCost"];
 	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
 	}
-	export class UpsertEntitlementInput {
+	export class ProjectChargingRow {
+	    projectId?: number;
+	    projectCode: string;
+	    projectName: string;
+	    donor: string;
 	    employeeId: number;
-	    year: number;
-	    totalDays: number;
-	    reservedDays: number;
+	    employeeName: string;
+	    sharePercent: number;
+	    grossAmount: number;
+	    employerAmount: number;
+	    totalCost: number;
 	
 	    static createFrom(source: any = {}) {
-	        return new UpsertEntitlementInput(source);
+	        return new ProjectChargingRow(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.projectId = source["projectId"];
+	        this.projectCode = source["projectCode"];
+	        this.projectName = source["projectName"];
+	        this.donor = source["donor"];
 	        this.employeeId = source["employeeId"];
-	        this.year = source["year"];
-	        this.totalDays = source["totalDays"];
-	        this.reservedDays = source["reservedDays"];
-	    }
-	}
-
-}
-
-export namespace main {
-	
-	export class ActionResult {
-	    ok: boolean;
-	
-	    static createFrom(source: any = {}) {
-	        return new ActionResult(source);
-	    }
-	
-	    constructor(source: any = {}) {
-	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.ok = source["ok"];
+	        this.employeeName = source["employeeName"];
+	        this.sharePercent = source["sharePercent"];
+	        this.grossAmount = source["grossAmount"];
+	        this.employerAmount = source["employerAmount"];
+	        this.totalCost = source["totalCost"];
 	    }
 	}
-	export class DatabaseConfigParams {
-	    host: string;
-	    port: number;
-	    database: string;
-	    user: string;
-	    password: string;
-	    sslmode: string;
+	export class ProjectUpsertInput {
+	    code: string;
+	    name: string;
+	    donor: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new DatabaseConfigParams(source);
+	        return new ProjectUpsertInput(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.host = source["host"];
-	        this.port = source["port"];
-	        this.database = source["database"];
-	        this.user = source["user"];
-	        this.password = source["password"];
-	        this.sslmode = source["sslmode"];
+	        this.code = source["code"];
+	        this.name = source["name"];
+	        this.donor = source["donor"];
 	    }
 	}
-	export class SaveFileWithDialogRequest {
-	    suggestedFilename: string;
-	    dataBytes: number[];
-	    mimeType: string;
+	export class RetroArrears {
+	    employeeId: number;
+	    employeeName: string;
+	    month: string;
+	    batchId: number;
+	    paidBaseSalary: number;
+	    dueBaseSalary: number;
+	    arrearsPaid: number;
+	    arrears: number;
 	
 	    static createFrom(source: any = {}) {
-	        return new SaveFileWithDialogRequest(source);
+	        return new RetroArrears(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.suggestedFilename = source["suggestedFilename"];
-	        this.dataBytes = source["dataBytes"];
-	        this.mimeType = source["mimeType"];
+	        this.employeeId = source["employeeId"];
+	        this.employeeName = source["employeeName"];
+	        this.month = source["month"];
+	        this.batchId = source["batchId"];
+	        this.paidBaseSalary = source["paidBaseSalary"];
+	        this.dueBaseSalary = source["dueBaseSalary"];
+	        this.arrearsPaid = source["arrearsPaid"];
+	        this.arrears = source["arrears"];
 	    }
 	}
-	export class SaveFileWithDialogResult {
-	    savedPath: string;
-	    cancelled: boolean;
+	export class SetContributionSchemeMemberInput {
+	    employeeId: number;
+	    memberNumber: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new SaveFileWithDialogResult(source);
+	        return new SetContributionSchemeMemberInput(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.savedPath = source["savedPath"];
-	        this.cancelled = source["cancelled"];
+	        this.employeeId = source["employeeId"];
+	        this.memberNumber = source["memberNumber"];
 	    }
 	}
-	export class StartupHealthResponse {
-	    dbOk: boolean;
-	    runtimeOk: boolean;
-	    dbError?: string;
-	    runtimeError?: string;
+	export class SimulationComponentOverride {
+	    componentId: number;
+	    value: number;
 	
 	    static createFrom(source: any = {}) {
-	        return new StartupHealthResponse(source);
+	        return new SimulationComponentOverride(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.dbOk = source["dbOk"];
-	        this.runtimeOk = source["runtimeOk"];
-	        this.dbError = source["dbError"];
-	        this.runtimeError = source["runtimeError"];
+	        this.componentId = source["componentId"];
+	        this.value = source["value"];
 	    }
 	}
-
-}
-
-export namespace payroll {
-	
-	export class CSVExport {
-	    filename: string;
-	    data: string;
-	    mimeType: string;
+	export class SimulationSalaryAdjustment {
+	    departmentId?: number;
+	    percent: number;
 	
 	    static createFrom(source: any = {}) {
-	        return new CSVExport(source);
+	        return new SimulationSalaryAdjustment(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.filename = source["filename"];
-	        this.data = source["data"];
-	        this.mimeType = source["mimeType"];
+	        this.departmentId = source["departmentId"];
+	        this.percent = source["percent"];
 	    }
 	}
-	export class CreateBatchInput {
-	    month: string;
+	export class SimulationSchemeOverride {
+	    schemeId: number;
+	    employeeRate: number;
+	    employerRate: number;
 	
 	    static createFrom(source: any = {}) {
-	        return new CreateBatchInput(source);
+	        return new SimulationSchemeOverride(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.month = source["month"];
+	        this.schemeId = source["schemeId"];
+	        this.employeeRate = source["employeeRate"];
+	        this.employerRate = source["employerRate"];
 	    }
 	}
-	export class ListBatchesFilter {
-	    month: string;
-	    status: string;
-	    page: number;
-	    pageSize: number;
+	export class TaxBand {
+	    threshold: number;
+	    rate: number;
 	
 	    static createFrom(source: any = {}) {
-	        return new ListBatchesFilter(source);
+	        return new TaxBand(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.month = source["month"];
-	        this.status = source["status"];
-	        this.page = source["page"];
-	        this.pageSize = source["pageSize"];
+	        this.threshold = source["threshold"];
+	        this.rate = source["rate"];
 	    }
 	}
-	export class PayrollBatch {
-	    id: number;
-	    month: string;
-	    status: string;
-	    createdBy: number;
-	    // Go type: time
-	    createdAt: any;
-	    approvedBy?: number;
-	    // Go type: time
-	    approvedAt?: any;
-	    // Go type: time
-	    lockedAt?: any;
+	export class TaxTable {
+	    name: string;
+	    effectiveFrom: string;
+	    bands: TaxBand[];
 	
 	    static createFrom(source: any = {}) {
-	        return new PayrollBatch(source);
+	        return new TaxTable(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.id = source["id"];
-	        this.month = source["month"];
-	        this.status = source["status"];
-	        this.createdBy = source["createdBy"];
-	        this.createdAt = this.convertValues(source["createdAt"], null);
-	        this.approvedBy = source["approvedBy"];
-	        this.approvedAt = this.convertValues(source["approvedAt"], null);
-	        this.lockedAt = this.convertValues(source["lockedAt"], null);
+	        this.name = source["name"];
+	        this.effectiveFrom = source["effectiveFrom"];
+	        this.bands = this.convertValues(source["bands"], TaxBand);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -2607,22 +5862,68 @@ export namespace payroll {
 		    return a;
 		}
 	}
-	export class ListBatchesResult {
-	    items: PayrollBatch[];
-	    totalCount: number;
-	    page: number;
-	    pageSize: number;
+	export class UpdateEntryAmountsInput {
+	    allowancesTotal: number;
+	    deductionsTotal: number;
+	    taxTotal?: number;
 	
 	    static createFrom(source: any = {}) {
-	        return new ListBatchesResult(source);
+	        return new UpdateEntryAmountsInput(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.items = this.convertValues(source["items"], PayrollBatch);
-	        this.totalCount = source["totalCount"];
-	        this.page = source["page"];
-	        this.pageSize = source["pageSize"];
+	        this.allowancesTotal = source["allowancesTotal"];
+	        this.deductionsTotal = source["deductionsTotal"];
+	        this.taxTotal = source["taxTotal"];
+	    }
+	}
+	export class VarianceFigure {
+	    previous: number;
+	    current: number;
+	    change: number;
+	    changePercent?: number;
+	    flagged: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new VarianceFigure(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.previous = source["previous"];
+	        this.current = source["current"];
+	        this.change = source["change"];
+	        this.changePercent = source["changePercent"];
+	        this.flagged = source["flagged"];
+	    }
+	}
+	export class VarianceRow {
+	    employeeId: number;
+	    employeeName: string;
+	    status: string;
+	    baseSalary: VarianceFigure;
+	    allowancesTotal: VarianceFigure;
+	    deductionsTotal: VarianceFigure;
+	    taxTotal: VarianceFigure;
+	    netPay: VarianceFigure;
+	    flagged: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new VarianceRow(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.employeeId = source["employeeId"];
+	        this.employeeName = source["employeeName"];
+	        this.status = source["status"];
+	        this.baseSalary = this.convertValues(source["baseSalary"], VarianceFigure);
+	        this.allowancesTotal = this.convertValues(source["allowancesTotal"], VarianceFigure);
+	        this.deductionsTotal = this.convertValues(source["deductionsTotal"], VarianceFigure);
+	        this.taxTotal = this.convertValues(source["taxTotal"], VarianceFigure);
+	        this.netPay = this.convertValues(source["netPay"], VarianceFigure);
+	        this.flagged = source["flagged"];
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -2643,41 +5944,24 @@ export namespace payroll {
 		    return a;
 		}
 	}
-	
-	export class PayrollEntry {
-	    id: number;
-	    batchId: number;
-	    employeeId: number;
-	    employeeName: string;
-	    baseSalary: number;
-	    allowancesTotal: number;
-	    deductionsTotal: number;
-	    taxTotal: number;
-	    grossPay: number;
-	    netPay: number;
-	    // Go type: time
-	    createdAt: any;
-	    // Go type: time
-	    updatedAt: any;
+	export class VarianceTotals {
+	    baseSalary: VarianceFigure;
+	    allowancesTotal: VarianceFigure;
+	    deductionsTotal: VarianceFigure;
+	    taxTotal: VarianceFigure;
+	    netPay: VarianceFigure;
 	
 	    static createFrom(source: any = {}) {
-	        return new PayrollEntry(source);
+	        return new VarianceTotals(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.id = source["id"];
-	        this.batchId = source["batchId"];
-	        this.employeeId = source["employeeId"];
-	        this.employeeName = source["employeeName"];
-	        this.baseSalary = source["baseSalary"];
-	        this.allowancesTotal = source["allowancesTotal"];
-	        this.deductionsTotal = source["deductionsTotal"];
-	        this.taxTotal = source["taxTotal"];
-	        this.grossPay = source["grossPay"];
-	        this.netPay = source["netPay"];
-	        this.createdAt = this.convertValues(source["createdAt"], null);
-	        this.updatedAt = this.convertValues(source["updatedAt"], null);
+	        this.baseSalary = this.convertValues(source["baseSalary"], VarianceFigure);
+	        this.allowancesTotal = this.convertValues(source["allowancesTotal"], VarianceFigure);
+	        this.deductionsTotal = this.convertValues(source["deductionsTotal"], VarianceFigure);
+	        this.taxTotal = this.convertValues(source["taxTotal"], VarianceFigure);
+	        this.netPay = this.convertValues(source["netPay"], VarianceFigure);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -2698,18 +5982,43 @@ export namespace payroll {
 		    return a;
 		}
 	}
-	export class PayrollBatchDetail {
-	    batch: PayrollBatch;
-	    entries: PayrollEntry[];
+	export class VarianceReport {
+	    batchId: number;
+	    month: string;
+	    previousBatchId?: number;
+	    previousMonth: string;
+	    thresholdPercent: number;
+	    joiners: number;
+	    leavers: number;
+	    changed: number;
+	    flagged: number;
+	    totals: VarianceTotals;
+	    rows: VarianceRow[];
+	    reviewRequired: boolean;
+	    varianceReviewedBy?: number;
+	    // Go type: time
+	    varianceReviewedAt?: any;
 	
 	    static createFrom(source: any = {}) {
-	        return new PayrollBatchDetail(source);
+	        return new VarianceReport(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.batch = this.convertValues(source["batch"], PayrollBatch);
-	        this.entries = this.convertValues(source["entries"], PayrollEntry);
+	        this.batchId = source["batchId"];
+	        this.month = source["month"];
+	        this.previousBatchId = source["previousBatchId"];
+	        this.previousMonth = source["previousMonth"];
+	        this.thresholdPercent = source["thresholdPercent"];
+	        this.joiners = source["joiners"];
+	        this.leavers = source["leavers"];
+	        this.changed = source["changed"];
+	        this.flagged = source["flagged"];
+	        this.totals = this.convertValues(source["totals"], VarianceTotals);
+	        this.rows = this.convertValues(source["rows"], VarianceRow);
+	        this.reviewRequired = source["reviewRequired"];
+	        this.varianceReviewedBy = source["varianceReviewedBy"];
+	        this.varianceReviewedAt = this.convertValues(source["varianceReviewedAt"], null);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -2730,21 +6039,25 @@ export namespace payroll {
 		    return a;
 		}
 	}
+
+}
+
+export namespace payslips {
 	
-	export class UpdateEntryAmountsInput {
-	    allowancesTotal: number;
-	    deductionsTotal: number;
-	    taxTotal: number;
+	export class FileExport {
+	    filename: string;
+	    data: number[];
+	    mimeType: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new UpdateEntryAmountsInput(source);
+	        return new FileExport(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.allowancesTotal = source["allowancesTotal"];
-	        this.deductionsTotal = source["deductionsTotal"];
-	        this.taxTotal = source["taxTotal"];
+	        this.filename = source["filename"];
+	        this.data = source["data"];
+	        this.mimeType = source["mimeType"];
 	    }
 	}
 
@@ -3188,8 +6501,28 @@ export namespace reports {
 	        this.status = source["status"];
 	    }
 	}
+	export class PayrollComponentTotal {
+	    code: string;
+	    name: string;
+	    kind: string;
+	    total: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new PayrollComponentTotal(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.code = source["code"];
+	        this.name = source["name"];
+	        this.kind = source["kind"];
+	        this.total = source["total"];
+	    }
+	}
 	export class PayrollBatchesReportRow {
+	    batchId: number;
 	    month: string;
+	    batchType: string;
 	    status: string;
 	    // Go type: time
 	    createdAt: any;
@@ -3199,6 +6532,9 @@ export namespace reports {
 	    lockedAt?: any;
 	    entriesCount: number;
 	    totalNetPay: number;
+	    totalEmployerContributions: number;
+	    components: PayrollComponentTotal[];
+	    integrityStatus: string;
 	
 	    static createFrom(source: any = {}) {
 	        return new PayrollBatchesReportRow(source);
@@ -3206,13 +6542,18 @@ export namespace reports {
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.batchId = source["batchId"];
 	        this.month = source["month"];
+	        this.batchType = source["batchType"];
 	        this.status = source["status"];
 	        this.createdAt = this.convertValues(source["createdAt"], null);
 	        this.approvedAt = this.convertValues(source["approvedAt"], null);
 	        this.lockedAt = this.convertValues(source["lockedAt"], null);
 	        this.entriesCount = source["entriesCount"];
 	        this.totalNetPay = source["totalNetPay"];
+	        this.totalEmployerContributions = source["totalEmployerContributions"];
+	        this.components = this.convertValues(source["components"], PayrollComponentTotal);
+	        this.integrityStatus = source["integrityStatus"];
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -3265,7 +6606,168 @@ export namespace reports {
 		    return a;
 		}
 	}
+	
+
+}
 
+export namespace schedules {
+	
+	export class AssignWorkScheduleInput {
+	    employeeId: number;
+	    scheduleId: number;
+	    effectiveFrom: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new AssignWorkScheduleInput(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.employeeId = source["employeeId"];
+	        this.scheduleId = source["scheduleId"];
+	        this.effectiveFrom = source["effectiveFrom"];
+	    }
+	}
+	export class EmployeeWorkSchedule {
+	    id: number;
+	    employeeId: number;
+	    scheduleId: number;
+	    scheduleName: string;
+	    // Go type: time
+	    effectiveFrom: any;
+	    createdBy?: number;
+	    // Go type: time
+	    createdAt: any;
+	
+	    static createFrom(source: any = {}) {
+	        return new EmployeeWorkSchedule(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.employeeId = source["employeeId"];
+	        this.scheduleId = source["scheduleId"];
+	        this.scheduleName = source["scheduleName"];
+	        this.effectiveFrom = this.convertValues(source["effectiveFrom"], null);
+	        this.createdBy = source["createdBy"];
+	        this.createdAt = this.convertValues(source["createdAt"], null);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class WorkSchedule {
+	    id: number;
+	    name: string;
+	    hoursPerDay: number;
+	    isDefault: boolean;
+	    active: boolean;
+	    days: WorkScheduleDay[];
+	    // Go type: time
+	    createdAt: any;
+	    // Go type: time
+	    updatedAt: any;
+	
+	    static createFrom(source: any = {}) {
+	        return new WorkSchedule(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.name = source["name"];
+	        this.hoursPerDay = source["hoursPerDay"];
+	        this.isDefault = source["isDefault"];
+	        this.active = source["active"];
+	        this.days = this.convertValues(source["days"], WorkScheduleDay);
+	        this.createdAt = this.convertValues(source["createdAt"], null);
+	        this.updatedAt = this.convertValues(source["updatedAt"], null);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class WorkScheduleUpsertInput {
+	    name: string;
+	    hoursPerDay: number;
+	    isDefault: boolean;
+	    days: WorkScheduleDay[];
+	
+	    static createFrom(source: any = {}) {
+	        return new WorkScheduleUpsertInput(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.hoursPerDay = source["hoursPerDay"];
+	        this.isDefault = source["isDefault"];
+	        this.days = this.convertValues(source["days"], WorkScheduleDay);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class WorkScheduleDay {
+	    weekday: number;
+	    fraction: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new WorkScheduleDay(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.weekday = source["weekday"];
+	        this.fraction = source["fraction"];
+	    }
+	}
 }
 
 export namespace settings {
@@ -3419,6 +6921,74 @@ export namespace settings {
 	        this.roundingEnabled = source["roundingEnabled"];
 	    }
 	}
+	export class PayrollPolicySettings {
+	    prorationBasis: string;
+	    varianceThresholdPercent: number;
+	    requireVarianceReview: boolean;
+	    approvalSteps: payroll.ApprovalStep[];
+	    allowSelfApproval: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new PayrollPolicySettings(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.prorationBasis = source["prorationBasis"];
+	        this.varianceThresholdPercent = source["varianceThresholdPercent"];
+	        this.requireVarianceReview = source["requireVarianceReview"];
+	        this.approvalSteps = this.convertValues(source["approvalSteps"], payroll.ApprovalStep);
+	        this.allowSelfApproval = source["allowSelfApproval"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class PayrollTaxSettings {
+	    tables: payroll.TaxTable[];
+	
+	    static createFrom(source: any = {}) {
+	        return new PayrollTaxSettings(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.tables = this.convertValues(source["tables"], payroll.TaxTable);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
 	export class PhoneDefaultsSettings {
 	    defaultCountryName: string;
 	    defaultCountryISO2: string;
@@ -3460,6 +7030,7 @@ export namespace settings {
 	    currency: CurrencySettings;
 	    lunchDefaults: LunchDefaultsSettings;
 	    payrollDisplay: PayrollDisplaySettings;
+	    payrollPolicy: PayrollPolicySettings;
 	    phoneDefaults: PhoneDefaultsSettings;
 	
 	    static createFrom(source: any = {}) {
@@ -3472,6 +7043,7 @@ export namespace settings {
 	        this.currency = this.convertValues(source["currency"], CurrencySettings);
 	        this.lunchDefaults = this.convertValues(source["lunchDefaults"], LunchDefaultsSettings);
 	        this.payrollDisplay = this.convertValues(source["payrollDisplay"], PayrollDisplaySettings);
+	        this.payrollPolicy = this.convertValues(source["payrollPolicy"], PayrollPolicySettings);
 	        this.phoneDefaults = this.convertValues(source["phoneDefaults"], PhoneDefaultsSettings);
 	    }
 	
@@ -3498,6 +7070,7 @@ export namespace settings {
 	    currency: CurrencySettings;
 	    lunchDefaults: LunchDefaultsSettings;
 	    payrollDisplay: PayrollDisplaySettings;
+	    payrollPolicy: PayrollPolicySettings;
 	    phoneDefaults: PhoneDefaultsSettings;
 	
 	    static createFrom(source: any = {}) {
@@ -3510,6 +7083,7 @@ export namespace settings {
 	        this.currency = this.convertValues(source["currency"], CurrencySettings);
 	        this.lunchDefaults = this.convertValues(source["lunchDefaults"], LunchDefaultsSettings);
 	        this.payrollDisplay = this.convertValues(source["payrollDisplay"], PayrollDisplaySettings);
+	        this.payrollPolicy = this.convertValues(source["payrollPolicy"], PayrollPolicySettings);
 	        this.phoneDefaults = this.convertValues(source["phoneDefaults"], PhoneDefaultsSettings);
 	    }
 	
@@ -3540,6 +7114,7 @@ export namespace users {
 	    username: string;
 	    password: string;
 	    role: string;
+	    employeeId?: number;
 	
 	    static createFrom(source: any = {}) {
 	        return new CreateUserInput(source);
@@ -3550,6 +7125,7 @@ export namespace users {
 	        this.username = source["username"];
 	        this.password = source["password"];
 	        this.role = source["role"];
+	        this.employeeId = source["employeeId"];
 	    }
 	}
 	export class User {
@@ -3563,6 +7139,8 @@ export namespace users {
 	    updatedAt: any;
 	    // Go type: time
 	    lastLoginAt?: any;
+	    employeeId?: number;
+	    employeeName?: string;
 	
 	    static createFrom(source: any = {}) {
 	        return new User(source);
@@ -3577,6 +7155,8 @@ export namespace users {
 	        this.createdAt = this.convertValues(source["createdAt"], null);
 	        this.updatedAt = this.convertValues(source["updatedAt"], null);
 	        this.lastLoginAt = this.convertValues(source["lastLoginAt"], null);
+	        this.employeeId = source["employeeId"];
+	        this.employeeName = source["employeeName"];
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -3648,6 +7228,7 @@ export namespace users {
 	export class UpdateUserInput {
 	    username: string;
 	    role: string;
+	    employeeId?: number;
 	
 	    static createFrom(source: any = {}) {
 	        return new UpdateUserInput(source);
@@ -3657,6 +7238,7 @@ export namespace users {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.username = source["username"];
 	        this.role = source["role"];
+	        this.employeeId = source["employeeId"];
 	    }
 	}
 
@@ -11,6 +11,7 @@ import (
 
 type Repository interface {
 	EmployeeExists(ctx context.Context, employeeID int64) (bool, error)
+	GetLinkedEmployeeID(ctx context.Context, userID int64) (*int64, error)
 	ListAttendanceRowsByDate(ctx context.Context, attendanceDate time.Time) ([]AttendanceRow, error)
 	GetAttendanceRowByDateAndEmployee(ctx context.Context, attendanceDate time.Time, employeeID int64) (*AttendanceRow, error)
 	GetAttendanceRecordByDateAndEmployee(ctx context.Context, attendanceDate time.Time, employeeID int64) (*AttendanceRecord, error)
@@ -39,6 +40,19 @@ func (r *SQLXRepository) EmployeeExists(ctx context.Context, employeeID int64) (
 	return exists, nil
 }
 
+// GetLinkedEmployeeID returns the employee linked to a user account, or nil when the account has no link.
+func (r *SQLXRepository) GetLinkedEmployeeID(ctx context.Context, userID int64) (*int64, error) {
+	var employeeID *int64
+	query := `SELECT employee_id FROM users WHERE id = $1`
+	if err := r.db.GetContext(ctx, &employeeID, query, userID); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get linked employee: %w", err)
+	}
+	return employeeID, nil
+}
+
 func (r *SQLXRepository) ListAttendanceRowsByDate(ctx context.Context, attendanceDate time.Time) ([]AttendanceRow, error) {
 	query := `
 		SELECT
@@ -4,10 +4,12 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"hrpro/internal/audit"
 	"hrpro/internal/middleware"
 	"hrpro/internal/models"
+	"hrpro/internal/schedules"
 )
 
 type LeaveIntegration interface {
@@ -18,10 +20,22 @@ type LunchDefaultsProvider interface {
 	GetLunchDefaults(ctx context.Context) (plateCostAmount int, staffContributionAmount int, err error)
 }
 
+// WorkScheduleProvider supplies the days employees are scheduled to work, which the register expects marked.
+type WorkScheduleProvider interface {
+	EmployeesWorkDays(ctx context.Context, employeeIDs []int64, from, to time.Time) (map[int64][]schedules.WorkDay, error)
+}
+
+// HolidayProvider supplies the public holidays, on which no attendance is expected.
+type HolidayProvider interface {
+	HolidayDates(ctx context.Context, from, to time.Time) ([]time.Time, error)
+}
+
 type Service struct {
 	repository            Repository
 	leave                 LeaveIntegration
 	lunchDefaultsProvider LunchDefaultsProvider
+	schedules             WorkScheduleProvider
+	holidays              HolidayProvider
 	audit                 audit.Recorder
 }
 
@@ -41,6 +55,14 @@ func (s *Service) SetLunchDefaultsProvider(provider LunchDefaultsProvider) {
 	s.lunchDefaultsProvider = provider
 }
 
+func (s *Service) SetWorkScheduleProvider(provider WorkScheduleProvider) {
+	s.schedules = provider
+}
+
+func (s *Service) SetHolidayProvider(provider HolidayProvider) {
+	s.holidays = provider
+}
+
 func (s *Service) ListAttendanceByDate(ctx context.Context, claims *models.Claims, date string) ([]AttendanceRow, error) {
 	if claims == nil {
 		return nil, ErrForbidden
@@ -56,6 +78,9 @@ func (s *Service) ListAttendanceByDate(ctx context.Context, claims *models.Claim
 		if err != nil {
 			return nil, err
 		}
+		if err := s.setExpectedDays(ctx, attendanceDate, rows); err != nil {
+			return nil, err
+		}
 		for i := range rows {
 			rows[i].CanPostToLeave = CanPostAbsentToLeave(role) && rows[i].Status == StatusAbsent
 			rows[i].CanEdit = CanMarkAttendance(role) && (!rows[i].IsLocked || CanOverrideLocked(role))
@@ -66,16 +91,64 @@ func (s *Service) ListAttendanceByDate(ctx context.Context, claims *models.Claim
 		return nil, ErrForbidden
 	}
 
-	row, err := s.repository.GetAttendanceRowByDateAndEmployee(ctx, attendanceDate, claims.UserID)
+	employeeID, err := s.resolveEmployeeID(ctx, claims)
+	if err != nil {
+		return nil, err
+	}
+	row, err := s.repository.GetAttendanceRowByDateAndEmployee(ctx, attendanceDate, employeeID)
 	if err != nil {
 		return nil, err
 	}
 	if row == nil {
 		return []AttendanceRow{}, nil
 	}
-	row.CanPostToLeave = false
-	row.CanEdit = false
-	return []AttendanceRow{*row}, nil
+	rows := []AttendanceRow{*row}
+	if err := s.setExpectedDays(ctx, attendanceDate, rows); err != nil {
+		return nil, err
+	}
+	rows[0].CanPostToLeave = false
+	rows[0].CanEdit = false
+	return rows, nil
+}
+
+// setExpectedDays sets the part of a day each employee is scheduled to work on date: 1, 0.5 for a half day, or 0
+// on a day off or public holiday. Without a schedule provider employees work Monday to Friday.
+func (s *Service) setExpectedDays(ctx context.Context, date time.Time, rows []AttendanceRow) error {
+	if s.holidays != nil {
+		holidays, err := s.holidays.HolidayDates(ctx, date, date)
+		if err != nil {
+			return err
+		}
+		if len(holidays) > 0 {
+			for i := range rows {
+				rows[i].ExpectedDay = 0
+			}
+			return nil
+		}
+	}
+
+	if s.schedules == nil {
+		standard := schedules.StandardWorkDays(date, date)
+		for i := range rows {
+			rows[i].ExpectedDay = standard[0].Fraction
+		}
+		return nil
+	}
+	employeeIDs := make([]int64, 0, len(rows))
+	for _, row := range rows {
+		employeeIDs = append(employeeIDs, row.EmployeeID)
+	}
+	workDays, err := s.schedules.EmployeesWorkDays(ctx, employeeIDs, date, date)
+	if err != nil {
+		return err
+	}
+	for i := range rows {
+		rows[i].ExpectedDay = 0
+		if days := workDays[rows[i].EmployeeID]; len(days) > 0 {
+			rows[i].ExpectedDay = days[0].Fraction
+		}
+	}
+	return nil
 }
 
 func (s *Service) UpsertAttendance(ctx context.Context, claims *models.Claims, date string, employeeID int64, status string, reason *string) (*AttendanceRecord, error) {
@@ -170,7 +243,11 @@ func (s *Service) GetMyAttendanceRange(ctx context.Context, claims *models.Claim
 	if end.Before(start) {
 		return nil, fmt.Errorf("%w: end date must be on or after start date", ErrValidation)
 	}
-	return s.repository.ListAttendanceRangeForEmployee(ctx, claims.UserID, start, end)
+	employeeID, err := s.resolveEmployeeID(ctx, claims)
+	if err != nil {
+		return nil, err
+	}
+	return s.repository.ListAttendanceRangeForEmployee(ctx, employeeID, start, end)
 }
 
 func (s *Service) GetLunchSummary(ctx context.Context, claims *models.Claims, date string) (*LunchSummary, error) {
@@ -326,6 +403,18 @@ func (s *Service) PostAbsentToLeave(ctx context.Context, claims *models.Claims,
 	return &PostAbsentToLeaveResult{Success: true, Message: "Absent posted to leave", LeaveID: &leaveID, Status: StatusLeave}, nil
 }
 
+// resolveEmployeeID returns the employee linked to the signed-in user account for self-service operations.
+func (s *Service) resolveEmployeeID(ctx context.Context, claims *models.Claims) (int64, error) {
+	employeeID, err := s.repository.GetLinkedEmployeeID(ctx, claims.UserID)
+	if err != nil {
+		return 0, err
+	}
+	if employeeID == nil {
+		return 0, ErrEmployeeNotLinked
+	}
+	return *employeeID, nil
+}
+
 func claimsUserID(claims *models.Claims) *int64 {
 	if claims == nil || claims.UserID <= 0 {
 		return nil
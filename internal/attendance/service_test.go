@@ -7,10 +7,13 @@ import (
 	"time"
 
 	"hrpro/internal/models"
+	"hrpro/internal/schedules"
 )
 
 type fakeRepository struct {
 	employeeExists      bool
+	linkedEmployees     map[int64]int64
+	rangeEmployeeID     int64
 	record              *AttendanceRecord
 	updatedRecord       *AttendanceRecord
 	rows                []AttendanceRow
@@ -24,6 +27,14 @@ func (f *fakeRepository) EmployeeExists(_ context.Context, _ int64) (bool, error
 	return f.employeeExists, nil
 }
 
+func (f *fakeRepository) GetLinkedEmployeeID(_ context.Context, userID int64) (*int64, error) {
+	employeeID, ok := f.linkedEmployees[userID]
+	if !ok {
+		return nil, nil
+	}
+	return &employeeID, nil
+}
+
 func (f *fakeRepository) ListAttendanceRowsByDate(_ context.Context, _ time.Time) ([]AttendanceRow, error) {
 	return f.rows, nil
 }
@@ -59,7 +70,8 @@ func (f *fakeRepository) UpdateAttendanceRecordStatus(_ context.Context, attenda
 	return f.record, nil
 }
 
-func (f *fakeRepository) ListAttendanceRangeForEmployee(_ context.Context, _ int64, _, _ time.Time) ([]AttendanceRecord, error) {
+func (f *fakeRepository) ListAttendanceRangeForEmployee(_ context.Context, employeeID int64, _, _ time.Time) ([]AttendanceRecord, error) {
+	f.rangeEmployeeID = employeeID
 	return []AttendanceRecord{}, nil
 }
 
@@ -92,6 +104,36 @@ func (f *fakeLeaveIntegration) CreateSingleDayLeaveFromAttendance(_ context.Cont
 	return f.resultID, f.resultErr
 }
 
+type fakeWorkScheduleProvider struct {
+	schedules map[int64]schedules.WorkSchedule
+}
+
+func (f fakeWorkScheduleProvider) EmployeesWorkDays(_ context.Context, employeeIDs []int64, from, to time.Time) (map[int64][]schedules.WorkDay, error) {
+	result := make(map[int64][]schedules.WorkDay, len(employeeIDs))
+	for _, employeeID := range employeeIDs {
+		schedule, ok := f.schedules[employeeID]
+		if !ok {
+			schedule = schedules.StandardSchedule()
+		}
+		result[employeeID] = schedules.WorkDays(from, to, nil, nil, schedule)
+	}
+	return result, nil
+}
+
+type fakeHolidayProvider struct {
+	dates []time.Time
+}
+
+func (f fakeHolidayProvider) HolidayDates(_ context.Context, from, to time.Time) ([]time.Time, error) {
+	result := make([]time.Time, 0)
+	for _, date := range f.dates {
+		if !date.Before(from) && !date.After(to) {
+			result = append(result, date)
+		}
+	}
+	return result, nil
+}
+
 func TestRBACNonAdminCannotMarkOthers(t *testing.T) {
 	repo := &fakeRepository{employeeExists: true}
 	service := NewService(repo, &fakeLeaveIntegration{})
@@ -162,3 +204,49 @@ func TestLunchCalculations(t *testing.T) {
 		t.Fatalf("expected organization balance 88000, got %d", summary.OrganizationBalance)
 	}
 }
+
+func TestGetMyAttendanceRangeUsesLinkedEmployee(t *testing.T) {
+	repo := &fakeRepository{linkedEmployees: map[int64]int64{4: 21}}
+	service := NewService(repo, &fakeLeaveIntegration{})
+
+	if _, err := service.GetMyAttendanceRange(context.Background(), &models.Claims{UserID: 4, Role: "Viewer"}, "2026-02-01", "2026-02-28"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if repo.rangeEmployeeID != 21 {
+		t.Fatalf("expected attendance of linked employee 21, got %d", repo.rangeEmployeeID)
+	}
+
+	_, err := service.GetMyAttendanceRange(context.Background(), &models.Claims{UserID: 21, Role: "Viewer"}, "2026-02-01", "2026-02-28")
+	if !errors.Is(err, ErrEmployeeNotLinked) {
+		t.Fatalf("expected not linked error, got %v", err)
+	}
+}
+
+func TestListAttendanceByDateSetsExpectedDayFromSchedules(t *testing.T) {
+	repo := &fakeRepository{rows: []AttendanceRow{
+		{EmployeeID: 1, Status: "unmarked"},
+		{EmployeeID: 2, Status: "unmarked"},
+	}}
+	service := NewService(repo, &fakeLeaveIntegration{})
+	service.SetWorkScheduleProvider(fakeWorkScheduleProvider{schedules: map[int64]schedules.WorkSchedule{
+		2: {HoursPerDay: 8, Days: []schedules.WorkScheduleDay{{Weekday: int(time.Saturday), Fraction: 0.5}}},
+	}})
+	claims := &models.Claims{UserID: 1, Role: "Admin"}
+
+	rows, err := service.ListAttendanceByDate(context.Background(), claims, "2026-02-21")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if rows[0].ExpectedDay != 0 || rows[1].ExpectedDay != 0.5 {
+		t.Fatalf("expected Saturday off and a half day, got %.1f and %.1f", rows[0].ExpectedDay, rows[1].ExpectedDay)
+	}
+
+	service.SetHolidayProvider(fakeHolidayProvider{dates: []time.Time{time.Date(2026, 2, 21, 0, 0, 0, 0, time.UTC)}})
+	rows, err = service.ListAttendanceByDate(context.Background(), claims, "2026-02-21")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if rows[1].ExpectedDay != 0 {
+		t.Fatalf("expected no attendance on a public holiday, got %.1f", rows[1].ExpectedDay)
+	}
+}
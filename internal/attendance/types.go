@@ -30,6 +30,7 @@ type AttendanceRow struct {
 	DepartmentName *string    `db:"department_name" json:"departmentName,omitempty"`
 	AttendanceID   *int64     `db:"attendance_id" json:"attendanceId,omitempty"`
 	Status         string     `db:"status" json:"status"`
+	ExpectedDay    float64    `json:"expectedDay"`
 	IsLocked       bool       `db:"is_locked" json:"isLocked"`
 	CanPostToLeave bool       `json:"canPostToLeave"`
 	CanEdit        bool       `json:"canEdit"`
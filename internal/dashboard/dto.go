@@ -1,6 +1,10 @@
 package dashboard
 
-import "time"
+import (
+	"time"
+
+	"hrpro/internal/money"
+)
 
 type DepartmentHeadcount struct {
 	DepartmentName string `db:"department_name" json:"departmentName"`
@@ -25,7 +29,7 @@ type SummaryDTO struct {
 	ApprovedLeaveThisMonth int64                 `json:"approvedLeaveThisMonth"`
 	EmployeesOnLeaveToday  int64                 `json:"employeesOnLeaveToday"`
 	CurrentPayrollStatus   *string               `json:"currentPayrollStatus,omitempty"`
-	CurrentPayrollTotal    *float64              `json:"currentPayrollTotal,omitempty"`
+	CurrentPayrollTotal    *money.Amount         `json:"currentPayrollTotal,omitempty"`
 	ActiveUsers            *int64                `json:"activeUsers,omitempty"`
 	EmployeesPerDepartment []DepartmentHeadcount `json:"employeesPerDepartment"`
 	RecentAuditEvents      []AuditEvent          `json:"recentAuditEvents"`
@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"time"
 
+	"hrpro/internal/money"
+
 	"github.com/jmoiron/sqlx"
 )
 
@@ -21,8 +23,8 @@ type Repository interface {
 }
 
 type PayrollSnapshot struct {
-	Status string  `db:"status"`
-	Total  float64 `db:"total"`
+	Status string       `db:"status"`
+	Total  money.Amount `db:"total"`
 }
 
 type SQLXRepository struct {
@@ -98,9 +100,10 @@ func (r *SQLXRepository) CountEmployeesOnLeaveDate(ctx context.Context, day time
 
 func (r *SQLXRepository) GetCurrentPayrollSnapshot(ctx context.Context) (*PayrollSnapshot, error) {
 	query := `
-		SELECT pb.status, COALESCE(SUM(CAST(pe.net_pay AS DOUBLE PRECISION)), 0) AS total
+		SELECT pb.status, COALESCE(SUM(pe.net_pay), 0) AS total
 		FROM payroll_batches pb
 		LEFT JOIN payroll_entries pe ON pe.batch_id = pb.id
+		WHERE pb.batch_type = 'regular'
 		GROUP BY pb.id, pb.status, pb.month
 		ORDER BY pb.month DESC
 		LIMIT 1
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS payroll_entry_lines;
+DROP TABLE IF EXISTS pay_components;
@@ -0,0 +1,33 @@
+CREATE TABLE IF NOT EXISTS pay_components (
+    id BIGSERIAL PRIMARY KEY,
+    code VARCHAR(40) NOT NULL UNIQUE,
+    name VARCHAR(120) NOT NULL,
+    kind VARCHAR(20) NOT NULL,
+    taxable BOOLEAN NOT NULL DEFAULT TRUE,
+    calculation_type VARCHAR(20) NOT NULL,
+    value NUMERIC(14,4) NOT NULL DEFAULT 0,
+    auto_apply BOOLEAN NOT NULL DEFAULT FALSE,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_pay_components_kind CHECK (kind IN ('earning', 'deduction')),
+    CONSTRAINT chk_pay_components_calculation_type CHECK (calculation_type IN ('fixed', 'percentage')),
+    CONSTRAINT chk_pay_components_value_non_negative CHECK (value >= 0)
+);
+
+CREATE TABLE IF NOT EXISTS payroll_entry_lines (
+    id BIGSERIAL PRIMARY KEY,
+    entry_id BIGINT NOT NULL REFERENCES payroll_entries(id) ON DELETE CASCADE,
+    component_id BIGINT REFERENCES pay_components(id) ON DELETE SET NULL,
+    code VARCHAR(40) NOT NULL,
+    name VARCHAR(120) NOT NULL,
+    kind VARCHAR(20) NOT NULL,
+    taxable BOOLEAN NOT NULL DEFAULT TRUE,
+    amount NUMERIC(14,2) NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_payroll_entry_lines_kind CHECK (kind IN ('earning', 'deduction')),
+    CONSTRAINT chk_payroll_entry_lines_amount_non_negative CHECK (amount >= 0)
+);
+
+CREATE INDEX IF NOT EXISTS idx_payroll_entry_lines_entry_id ON payroll_entry_lines(entry_id);
+CREATE INDEX IF NOT EXISTS idx_payroll_entry_lines_component_id ON payroll_entry_lines(component_id);
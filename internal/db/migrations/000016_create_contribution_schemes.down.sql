@@ -0,0 +1,10 @@
+ALTER TABLE payroll_entries DROP COLUMN IF EXISTS employer_contributions_total;
+
+DROP INDEX IF EXISTS idx_payroll_entry_lines_scheme_id;
+ALTER TABLE payroll_entry_lines DROP CONSTRAINT IF EXISTS chk_payroll_entry_lines_employer_amount_non_negative;
+ALTER TABLE payroll_entry_lines
+    DROP COLUMN IF EXISTS employer_amount,
+    DROP COLUMN IF EXISTS scheme_id;
+
+DROP TABLE IF EXISTS contribution_scheme_members;
+DROP TABLE IF EXISTS contribution_schemes;
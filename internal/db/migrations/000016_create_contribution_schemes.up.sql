@@ -0,0 +1,42 @@
+CREATE TABLE IF NOT EXISTS contribution_schemes (
+    id BIGSERIAL PRIMARY KEY,
+    code VARCHAR(40) NOT NULL UNIQUE,
+    name VARCHAR(120) NOT NULL,
+    employee_rate NUMERIC(7,4) NOT NULL DEFAULT 0,
+    employer_rate NUMERIC(7,4) NOT NULL DEFAULT 0,
+    base VARCHAR(20) NOT NULL DEFAULT 'gross',
+    members_only BOOLEAN NOT NULL DEFAULT FALSE,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_contribution_schemes_base CHECK (base IN ('gross', 'base_salary')),
+    CONSTRAINT chk_contribution_schemes_employee_rate CHECK (employee_rate >= 0 AND employee_rate <= 100),
+    CONSTRAINT chk_contribution_schemes_employer_rate CHECK (employer_rate >= 0 AND employer_rate <= 100)
+);
+
+CREATE TABLE IF NOT EXISTS contribution_scheme_members (
+    scheme_id BIGINT NOT NULL REFERENCES contribution_schemes(id) ON DELETE CASCADE,
+    employee_id BIGINT NOT NULL REFERENCES employees(id) ON DELETE CASCADE,
+    member_number VARCHAR(60) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (scheme_id, employee_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_contribution_scheme_members_employee_id ON contribution_scheme_members(employee_id);
+
+ALTER TABLE payroll_entry_lines
+    ADD COLUMN IF NOT EXISTS scheme_id BIGINT REFERENCES contribution_schemes(id) ON DELETE SET NULL,
+    ADD COLUMN IF NOT EXISTS employer_amount NUMERIC(14,2) NOT NULL DEFAULT 0;
+
+ALTER TABLE payroll_entry_lines
+    ADD CONSTRAINT chk_payroll_entry_lines_employer_amount_non_negative CHECK (employer_amount >= 0);
+
+CREATE INDEX IF NOT EXISTS idx_payroll_entry_lines_scheme_id ON payroll_entry_lines(scheme_id);
+
+ALTER TABLE payroll_entries
+    ADD COLUMN IF NOT EXISTS employer_contributions_total NUMERIC(14,2) NOT NULL DEFAULT 0;
+
+INSERT INTO contribution_schemes (code, name, employee_rate, employer_rate, base)
+VALUES ('NSSF', 'National Social Security Fund', 5, 10, 'gross')
+ON CONFLICT (code) DO NOTHING;
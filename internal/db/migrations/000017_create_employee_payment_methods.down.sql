@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_employee_payment_methods_employee_id;
+DROP TABLE IF EXISTS employee_payment_methods;
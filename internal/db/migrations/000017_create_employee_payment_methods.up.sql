@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS employee_payment_methods (
+    id BIGSERIAL PRIMARY KEY,
+    employee_id BIGINT NOT NULL REFERENCES employees(id) ON DELETE CASCADE,
+    method VARCHAR(20) NOT NULL,
+    bank_name VARCHAR(120),
+    bank_code VARCHAR(20),
+    branch VARCHAR(120),
+    account_name VARCHAR(160),
+    account_number VARCHAR(40),
+    provider VARCHAR(20),
+    msisdn VARCHAR(20),
+    split_percent NUMERIC(5,2) NOT NULL DEFAULT 100,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_employee_payment_methods_method CHECK (method IN ('bank', 'mobile_money')),
+    CONSTRAINT chk_employee_payment_methods_split CHECK (split_percent > 0 AND split_percent <= 100),
+    CONSTRAINT chk_employee_payment_methods_bank CHECK (method <> 'bank' OR (bank_name IS NOT NULL AND account_number IS NOT NULL)),
+    CONSTRAINT chk_employee_payment_methods_mobile CHECK (method <> 'mobile_money' OR (provider IS NOT NULL AND msisdn IS NOT NULL))
+);
+
+CREATE INDEX IF NOT EXISTS idx_employee_payment_methods_employee_id ON employee_payment_methods(employee_id);
@@ -0,0 +1,10 @@
+ALTER TABLE payroll_entries DROP CONSTRAINT IF EXISTS chk_payroll_entries_payable_days;
+ALTER TABLE payroll_entries DROP CONSTRAINT IF EXISTS chk_payroll_entries_proration_basis;
+ALTER TABLE payroll_entries
+    DROP COLUMN IF EXISTS period_days,
+    DROP COLUMN IF EXISTS payable_days,
+    DROP COLUMN IF EXISTS proration_basis,
+    DROP COLUMN IF EXISTS full_base_salary;
+
+ALTER TABLE employees DROP CONSTRAINT IF EXISTS chk_employees_exit_after_hire;
+ALTER TABLE employees DROP COLUMN IF EXISTS date_of_exit;
@@ -0,0 +1,15 @@
+ALTER TABLE employees
+    ADD COLUMN IF NOT EXISTS date_of_exit DATE;
+
+ALTER TABLE employees
+    ADD CONSTRAINT chk_employees_exit_after_hire CHECK (date_of_exit IS NULL OR date_of_exit >= date_of_hire);
+
+ALTER TABLE payroll_entries
+    ADD COLUMN IF NOT EXISTS full_base_salary NUMERIC(14,2),
+    ADD COLUMN IF NOT EXISTS proration_basis VARCHAR(20),
+    ADD COLUMN IF NOT EXISTS payable_days INT,
+    ADD COLUMN IF NOT EXISTS period_days INT;
+
+ALTER TABLE payroll_entries
+    ADD CONSTRAINT chk_payroll_entries_proration_basis CHECK (proration_basis IS NULL OR proration_basis IN ('calendar_days', 'working_days')),
+    ADD CONSTRAINT chk_payroll_entries_payable_days CHECK (payable_days IS NULL OR (payable_days >= 0 AND payable_days <= period_days));
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS payroll_entry_line_sources;
+
+ALTER TABLE payroll_entry_lines
+    DROP COLUMN IF EXISTS rate,
+    DROP COLUMN IF EXISTS quantity;
@@ -0,0 +1,17 @@
+ALTER TABLE payroll_entry_lines
+    ADD COLUMN IF NOT EXISTS quantity NUMERIC(8,2),
+    ADD COLUMN IF NOT EXISTS rate NUMERIC(14,2);
+
+CREATE TABLE IF NOT EXISTS payroll_entry_line_sources (
+    id BIGSERIAL PRIMARY KEY,
+    line_id BIGINT NOT NULL REFERENCES payroll_entry_lines(id) ON DELETE CASCADE,
+    source_type VARCHAR(30) NOT NULL,
+    source_id BIGINT NOT NULL,
+    days NUMERIC(8,2) NOT NULL,
+    CONSTRAINT uq_payroll_entry_line_sources UNIQUE (line_id, source_type, source_id),
+    CONSTRAINT chk_payroll_entry_line_sources_type CHECK (source_type IN ('leave_request', 'attendance_record')),
+    CONSTRAINT chk_payroll_entry_line_sources_days_positive CHECK (days > 0)
+);
+
+CREATE INDEX IF NOT EXISTS idx_payroll_entry_line_sources_line_id ON payroll_entry_line_sources(line_id);
+CREATE INDEX IF NOT EXISTS idx_payroll_entry_line_sources_source ON payroll_entry_line_sources(source_type, source_id);
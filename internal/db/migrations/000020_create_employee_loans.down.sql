@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_payroll_entry_lines_loan_id;
+ALTER TABLE payroll_entry_lines DROP COLUMN IF EXISTS loan_id;
+
+DROP TABLE IF EXISTS employee_loan_repayments;
+DROP TABLE IF EXISTS employee_loans;
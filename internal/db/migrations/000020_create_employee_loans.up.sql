@@ -0,0 +1,47 @@
+CREATE TABLE IF NOT EXISTS employee_loans (
+    id BIGSERIAL PRIMARY KEY,
+    employee_id BIGINT NOT NULL REFERENCES employees(id) ON DELETE RESTRICT,
+    loan_type VARCHAR(20) NOT NULL,
+    description TEXT,
+    principal NUMERIC(14,2) NOT NULL,
+    interest_rate NUMERIC(7,4) NOT NULL DEFAULT 0,
+    total_repayable NUMERIC(14,2) NOT NULL,
+    installments INT NOT NULL,
+    installment_amount NUMERIC(14,2) NOT NULL,
+    start_month VARCHAR(7) NOT NULL,
+    outstanding_balance NUMERIC(14,2) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'active',
+    created_by BIGINT REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_employee_loans_type CHECK (loan_type IN ('loan', 'advance')),
+    CONSTRAINT chk_employee_loans_status CHECK (status IN ('active', 'settled', 'cancelled')),
+    CONSTRAINT chk_employee_loans_principal_positive CHECK (principal > 0),
+    CONSTRAINT chk_employee_loans_interest_non_negative CHECK (interest_rate >= 0),
+    CONSTRAINT chk_employee_loans_installments_positive CHECK (installments > 0),
+    CONSTRAINT chk_employee_loans_start_month_format CHECK (start_month ~ '^[0-9]{4}-[0-9]{2}$'),
+    CONSTRAINT chk_employee_loans_outstanding_range CHECK (outstanding_balance >= 0 AND outstanding_balance <= total_repayable)
+);
+
+CREATE INDEX IF NOT EXISTS idx_employee_loans_employee_id ON employee_loans(employee_id);
+CREATE INDEX IF NOT EXISTS idx_employee_loans_status ON employee_loans(status);
+
+CREATE TABLE IF NOT EXISTS employee_loan_repayments (
+    id BIGSERIAL PRIMARY KEY,
+    loan_id BIGINT NOT NULL REFERENCES employee_loans(id) ON DELETE CASCADE,
+    batch_id BIGINT NOT NULL REFERENCES payroll_batches(id) ON DELETE RESTRICT,
+    entry_id BIGINT REFERENCES payroll_entries(id) ON DELETE SET NULL,
+    month VARCHAR(7) NOT NULL,
+    amount NUMERIC(14,2) NOT NULL,
+    balance_after NUMERIC(14,2) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT uq_employee_loan_repayments_loan_batch UNIQUE (loan_id, batch_id),
+    CONSTRAINT chk_employee_loan_repayments_amount_positive CHECK (amount > 0)
+);
+
+CREATE INDEX IF NOT EXISTS idx_employee_loan_repayments_loan_id ON employee_loan_repayments(loan_id);
+
+ALTER TABLE payroll_entry_lines
+    ADD COLUMN IF NOT EXISTS loan_id BIGINT REFERENCES employee_loans(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_payroll_entry_lines_loan_id ON payroll_entry_lines(loan_id);
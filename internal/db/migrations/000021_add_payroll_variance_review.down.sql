@@ -0,0 +1,3 @@
+ALTER TABLE payroll_batches
+    DROP COLUMN IF EXISTS variance_reviewed_at,
+    DROP COLUMN IF EXISTS variance_reviewed_by;
@@ -0,0 +1,3 @@
+ALTER TABLE payroll_batches
+    ADD COLUMN IF NOT EXISTS variance_reviewed_by BIGINT REFERENCES users(id) ON DELETE SET NULL,
+    ADD COLUMN IF NOT EXISTS variance_reviewed_at TIMESTAMPTZ;
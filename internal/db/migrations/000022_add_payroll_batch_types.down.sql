@@ -0,0 +1,15 @@
+DROP TABLE IF EXISTS payroll_batch_components;
+DROP TABLE IF EXISTS payroll_batch_employees;
+
+DROP INDEX IF EXISTS idx_payroll_batches_month_type;
+DROP INDEX IF EXISTS uq_payroll_batches_regular_month;
+
+DELETE FROM payroll_batches WHERE batch_type <> 'regular';
+
+ALTER TABLE payroll_batches
+    DROP CONSTRAINT IF EXISTS chk_payroll_batches_batch_type,
+    DROP COLUMN IF EXISTS description,
+    DROP COLUMN IF EXISTS batch_type;
+
+ALTER TABLE payroll_batches
+    ADD CONSTRAINT payroll_batches_month_key UNIQUE (month);
@@ -0,0 +1,28 @@
+ALTER TABLE payroll_batches
+    DROP CONSTRAINT IF EXISTS payroll_batches_month_key;
+
+ALTER TABLE payroll_batches
+    ADD COLUMN IF NOT EXISTS batch_type VARCHAR(20) NOT NULL DEFAULT 'regular',
+    ADD COLUMN IF NOT EXISTS description VARCHAR(200) NOT NULL DEFAULT '';
+
+ALTER TABLE payroll_batches
+    ADD CONSTRAINT chk_payroll_batches_batch_type CHECK (batch_type IN ('regular', 'bonus', 'arrears', 'correction'));
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_payroll_batches_regular_month
+    ON payroll_batches (month)
+    WHERE batch_type = 'regular';
+
+CREATE INDEX IF NOT EXISTS idx_payroll_batches_month_type
+    ON payroll_batches (month, batch_type);
+
+CREATE TABLE IF NOT EXISTS payroll_batch_employees (
+    batch_id BIGINT NOT NULL REFERENCES payroll_batches(id) ON DELETE CASCADE,
+    employee_id BIGINT NOT NULL REFERENCES employees(id) ON DELETE RESTRICT,
+    PRIMARY KEY (batch_id, employee_id)
+);
+
+CREATE TABLE IF NOT EXISTS payroll_batch_components (
+    batch_id BIGINT NOT NULL REFERENCES payroll_batches(id) ON DELETE CASCADE,
+    component_id BIGINT NOT NULL REFERENCES pay_components(id) ON DELETE RESTRICT,
+    PRIMARY KEY (batch_id, component_id)
+);
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_payroll_entry_lines_arrears_month;
+
+ALTER TABLE payroll_entry_lines
+    DROP COLUMN IF EXISTS arrears_month;
+
+DROP TABLE IF EXISTS employee_salary_history;
@@ -0,0 +1,23 @@
+CREATE TABLE IF NOT EXISTS employee_salary_history (
+    id BIGSERIAL PRIMARY KEY,
+    employee_id BIGINT NOT NULL REFERENCES employees(id) ON DELETE CASCADE,
+    amount NUMERIC(14,2) NOT NULL,
+    effective_from DATE NOT NULL,
+    reason VARCHAR(200) NOT NULL DEFAULT '',
+    approved_by BIGINT REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT uq_employee_salary_history_effective UNIQUE (employee_id, effective_from),
+    CONSTRAINT chk_employee_salary_history_amount_non_negative CHECK (amount >= 0)
+);
+
+CREATE INDEX IF NOT EXISTS idx_employee_salary_history_employee_id ON employee_salary_history(employee_id, effective_from);
+
+INSERT INTO employee_salary_history (employee_id, amount, effective_from, reason, created_at)
+SELECT e.id, e.base_salary_amount, e.date_of_hire, 'Opening salary', e.created_at
+FROM employees e
+ON CONFLICT (employee_id, effective_from) DO NOTHING;
+
+ALTER TABLE payroll_entry_lines
+    ADD COLUMN IF NOT EXISTS arrears_month VARCHAR(7);
+
+CREATE INDEX IF NOT EXISTS idx_payroll_entry_lines_arrears_month ON payroll_entry_lines(arrears_month);
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS payroll_batch_approvals (
+    id BIGSERIAL PRIMARY KEY,
+    batch_id BIGINT NOT NULL REFERENCES payroll_batches(id) ON DELETE CASCADE,
+    step_number INT NOT NULL,
+    step_name VARCHAR(60) NOT NULL,
+    action VARCHAR(20) NOT NULL,
+    user_id BIGINT REFERENCES users(id) ON DELETE SET NULL,
+    comment VARCHAR(500) NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_payroll_batch_approvals_action CHECK (action IN ('approved', 'rejected')),
+    CONSTRAINT chk_payroll_batch_approvals_step_positive CHECK (step_number > 0)
+);
+
+CREATE INDEX IF NOT EXISTS idx_payroll_batch_approvals_batch_id ON payroll_batch_approvals(batch_id, created_at);
@@ -0,0 +1,40 @@
+DELETE FROM payroll_batch_approvals WHERE action = 'reopened';
+
+ALTER TABLE payroll_batch_approvals
+    DROP CONSTRAINT IF EXISTS chk_payroll_batch_approvals_action;
+
+ALTER TABLE payroll_batch_approvals
+    ADD CONSTRAINT chk_payroll_batch_approvals_action CHECK (action IN ('approved', 'rejected'));
+
+UPDATE employee_loans l
+SET outstanding_balance = LEAST(GREATEST(l.outstanding_balance + undone.amount, 0), l.total_repayable),
+    status = CASE
+        WHEN l.status = 'cancelled' THEN l.status
+        WHEN l.outstanding_balance + undone.amount <= 0 THEN 'settled'
+        ELSE 'active'
+    END,
+    updated_at = NOW()
+FROM (
+    SELECT r.loan_id, SUM(r.amount) AS amount
+    FROM employee_loan_repayments r
+    INNER JOIN payroll_batches b ON b.id = r.batch_id
+    WHERE b.reverses_batch_id IS NOT NULL
+    GROUP BY r.loan_id
+) undone
+WHERE l.id = undone.loan_id;
+
+DELETE FROM employee_loan_repayments r
+USING payroll_batches b
+WHERE b.id = r.batch_id
+    AND b.reverses_batch_id IS NOT NULL;
+
+DELETE FROM payroll_batches WHERE reverses_batch_id IS NOT NULL;
+
+ALTER TABLE payroll_entry_lines
+    ADD CONSTRAINT chk_payroll_entry_lines_amount_non_negative CHECK (amount >= 0),
+    ADD CONSTRAINT chk_payroll_entry_lines_employer_amount_non_negative CHECK (employer_amount >= 0);
+
+DROP INDEX IF EXISTS uq_payroll_batches_reverses_batch_id;
+
+ALTER TABLE payroll_batches
+    DROP COLUMN IF EXISTS reverses_batch_id;
@@ -0,0 +1,16 @@
+ALTER TABLE payroll_batches
+    ADD COLUMN IF NOT EXISTS reverses_batch_id BIGINT REFERENCES payroll_batches(id) ON DELETE RESTRICT;
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_payroll_batches_reverses_batch_id
+    ON payroll_batches (reverses_batch_id)
+    WHERE reverses_batch_id IS NOT NULL;
+
+ALTER TABLE payroll_entry_lines
+    DROP CONSTRAINT IF EXISTS chk_payroll_entry_lines_amount_non_negative,
+    DROP CONSTRAINT IF EXISTS chk_payroll_entry_lines_employer_amount_non_negative;
+
+ALTER TABLE payroll_batch_approvals
+    DROP CONSTRAINT IF EXISTS chk_payroll_batch_approvals_action;
+
+ALTER TABLE payroll_batch_approvals
+    ADD CONSTRAINT chk_payroll_batch_approvals_action CHECK (action IN ('approved', 'rejected', 'reopened'));
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS gl_account_mappings;
+
+ALTER TABLE departments
+    DROP COLUMN IF EXISTS cost_centre;
@@ -0,0 +1,28 @@
+ALTER TABLE departments
+    ADD COLUMN IF NOT EXISTS cost_centre VARCHAR(40);
+
+CREATE TABLE IF NOT EXISTS gl_account_mappings (
+    id BIGSERIAL PRIMARY KEY,
+    category VARCHAR(40) NOT NULL,
+    component_id BIGINT REFERENCES pay_components(id) ON DELETE CASCADE,
+    scheme_id BIGINT REFERENCES contribution_schemes(id) ON DELETE CASCADE,
+    department_id BIGINT REFERENCES departments(id) ON DELETE CASCADE,
+    account_code VARCHAR(40) NOT NULL,
+    account_name VARCHAR(120) NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_gl_account_mappings_category CHECK (category IN (
+        'salary_expense',
+        'earning',
+        'employer_contribution_expense',
+        'tax_payable',
+        'contribution_payable',
+        'deduction',
+        'loan_receivable',
+        'net_pay_clearing'
+    )),
+    CONSTRAINT chk_gl_account_mappings_single_target CHECK (component_id IS NULL OR scheme_id IS NULL)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_gl_account_mappings_target
+    ON gl_account_mappings (category, COALESCE(component_id, 0), COALESCE(scheme_id, 0), COALESCE(department_id, 0));
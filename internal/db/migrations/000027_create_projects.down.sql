@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS payroll_project_charges;
+DROP TABLE IF EXISTS employee_project_allocations;
+DROP TABLE IF EXISTS projects;
@@ -0,0 +1,36 @@
+CREATE TABLE IF NOT EXISTS projects (
+    id BIGSERIAL PRIMARY KEY,
+    code VARCHAR(40) NOT NULL UNIQUE,
+    name VARCHAR(150) NOT NULL,
+    donor VARCHAR(150) NOT NULL DEFAULT '',
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS employee_project_allocations (
+    id BIGSERIAL PRIMARY KEY,
+    employee_id BIGINT NOT NULL REFERENCES employees(id) ON DELETE CASCADE,
+    project_id BIGINT NOT NULL REFERENCES projects(id) ON DELETE RESTRICT,
+    percent NUMERIC(5,2) NOT NULL,
+    start_date DATE NOT NULL,
+    end_date DATE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_employee_project_allocations_percent CHECK (percent > 0 AND percent <= 100),
+    CONSTRAINT chk_employee_project_allocations_dates CHECK (end_date IS NULL OR end_date >= start_date)
+);
+
+CREATE INDEX IF NOT EXISTS idx_employee_project_allocations_employee_id ON employee_project_allocations(employee_id, start_date);
+
+CREATE TABLE IF NOT EXISTS payroll_project_charges (
+    id BIGSERIAL PRIMARY KEY,
+    batch_id BIGINT NOT NULL REFERENCES payroll_batches(id) ON DELETE CASCADE,
+    entry_id BIGINT NOT NULL REFERENCES payroll_entries(id) ON DELETE CASCADE,
+    project_id BIGINT REFERENCES projects(id) ON DELETE RESTRICT,
+    gross_amount NUMERIC(14,2) NOT NULL,
+    employer_amount NUMERIC(14,2) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_payroll_project_charges_batch_id ON payroll_project_charges(batch_id);
+CREATE INDEX IF NOT EXISTS idx_payroll_project_charges_project_id ON payroll_project_charges(project_id);
@@ -0,0 +1,3 @@
+ALTER TABLE payroll_batches
+    DROP COLUMN IF EXISTS integrity_manifest,
+    DROP COLUMN IF EXISTS integrity_hash;
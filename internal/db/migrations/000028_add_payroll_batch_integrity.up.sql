@@ -0,0 +1,3 @@
+ALTER TABLE payroll_batches
+    ADD COLUMN IF NOT EXISTS integrity_hash VARCHAR(64),
+    ADD COLUMN IF NOT EXISTS integrity_manifest JSONB;
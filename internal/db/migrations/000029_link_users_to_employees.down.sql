@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS uq_users_employee_id;
+
+ALTER TABLE users
+    DROP COLUMN IF EXISTS employee_id;
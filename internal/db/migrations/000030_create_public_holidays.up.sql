@@ -0,0 +1,37 @@
+CREATE TABLE IF NOT EXISTS public_holidays (
+    id BIGSERIAL PRIMARY KEY,
+    name VARCHAR(150) NOT NULL,
+    kind VARCHAR(20) NOT NULL,
+    month SMALLINT,
+    day SMALLINT,
+    easter_offset SMALLINT,
+    holiday_date DATE,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_public_holidays_kind CHECK (kind IN ('fixed', 'easter', 'one_off')),
+    CONSTRAINT chk_public_holidays_fixed CHECK (kind <> 'fixed' OR (month BETWEEN 1 AND 12 AND day BETWEEN 1 AND 31)),
+    CONSTRAINT chk_public_holidays_easter CHECK (kind <> 'easter' OR easter_offset BETWEEN -60 AND 60),
+    CONSTRAINT chk_public_holidays_one_off CHECK (kind <> 'one_off' OR holiday_date IS NOT NULL)
+);
+
+CREATE INDEX IF NOT EXISTS idx_public_holidays_holiday_date ON public_holidays(holiday_date);
+
+INSERT INTO public_holidays (name, kind, month, day, easter_offset)
+SELECT seed.name, seed.kind, seed.month, seed.day, seed.easter_offset
+FROM (
+    VALUES
+        ('New Year''s Day', 'fixed', 1, 1, NULL),
+        ('NRM Liberation Day', 'fixed', 1, 26, NULL),
+        ('Archbishop Janani Luwum Day', 'fixed', 2, 16, NULL),
+        ('International Women''s Day', 'fixed', 3, 8, NULL),
+        ('Good Friday', 'easter', NULL, NULL, -2),
+        ('Easter Monday', 'easter', NULL, NULL, 1),
+        ('Labour Day', 'fixed', 5, 1, NULL),
+        ('Martyrs'' Day', 'fixed', 6, 3, NULL),
+        ('National Heroes'' Day', 'fixed', 6, 9, NULL),
+        ('Independence Day', 'fixed', 10, 9, NULL),
+        ('Christmas Day', 'fixed', 12, 25, NULL),
+        ('Boxing Day', 'fixed', 12, 26, NULL)
+) AS seed (name, kind, month, day, easter_offset)
+WHERE NOT EXISTS (SELECT 1 FROM public_holidays);
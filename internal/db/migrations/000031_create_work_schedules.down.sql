@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS employee_work_schedules;
+DROP TABLE IF EXISTS work_schedule_days;
+DROP TABLE IF EXISTS work_schedules;
@@ -0,0 +1,46 @@
+CREATE TABLE IF NOT EXISTS work_schedules (
+    id BIGSERIAL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    hours_per_day NUMERIC(4,2) NOT NULL DEFAULT 8,
+    is_default BOOLEAN NOT NULL DEFAULT FALSE,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_work_schedules_hours_per_day CHECK (hours_per_day > 0 AND hours_per_day <= 24),
+    CONSTRAINT chk_work_schedules_default_active CHECK (NOT is_default OR active)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_work_schedules_name ON work_schedules(LOWER(name));
+CREATE UNIQUE INDEX IF NOT EXISTS uq_work_schedules_default ON work_schedules(is_default) WHERE is_default;
+
+CREATE TABLE IF NOT EXISTS work_schedule_days (
+    schedule_id BIGINT NOT NULL REFERENCES work_schedules(id) ON DELETE CASCADE,
+    weekday SMALLINT NOT NULL,
+    day_fraction NUMERIC(3,2) NOT NULL DEFAULT 1,
+    PRIMARY KEY (schedule_id, weekday),
+    CONSTRAINT chk_work_schedule_days_weekday CHECK (weekday BETWEEN 0 AND 6),
+    CONSTRAINT chk_work_schedule_days_fraction CHECK (day_fraction IN (0.5, 1))
+);
+
+CREATE TABLE IF NOT EXISTS employee_work_schedules (
+    id BIGSERIAL PRIMARY KEY,
+    employee_id BIGINT NOT NULL REFERENCES employees(id) ON DELETE CASCADE,
+    schedule_id BIGINT NOT NULL REFERENCES work_schedules(id) ON DELETE RESTRICT,
+    effective_from DATE NOT NULL,
+    created_by BIGINT REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT uq_employee_work_schedules_effective UNIQUE (employee_id, effective_from)
+);
+
+CREATE INDEX IF NOT EXISTS idx_employee_work_schedules_employee_id ON employee_work_schedules(employee_id, effective_from);
+
+INSERT INTO work_schedules (name, hours_per_day, is_default)
+SELECT 'Standard (Mon-Fri)', 8, TRUE
+WHERE NOT EXISTS (SELECT 1 FROM work_schedules);
+
+INSERT INTO work_schedule_days (schedule_id, weekday, day_fraction)
+SELECT ws.id, days.weekday, 1
+FROM work_schedules ws
+CROSS JOIN (VALUES (1), (2), (3), (4), (5)) AS days(weekday)
+WHERE ws.is_default
+ON CONFLICT (schedule_id, weekday) DO NOTHING;
@@ -0,0 +1,7 @@
+ALTER TABLE leave_requests DROP CONSTRAINT IF EXISTS chk_leave_requests_hours;
+ALTER TABLE leave_requests DROP CONSTRAINT IF EXISTS chk_leave_requests_end_day_part;
+ALTER TABLE leave_requests DROP CONSTRAINT IF EXISTS chk_leave_requests_start_day_part;
+ALTER TABLE leave_requests
+    DROP COLUMN IF EXISTS hours,
+    DROP COLUMN IF EXISTS end_day_part,
+    DROP COLUMN IF EXISTS start_day_part;
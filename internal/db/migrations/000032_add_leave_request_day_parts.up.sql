@@ -0,0 +1,9 @@
+ALTER TABLE leave_requests
+    ADD COLUMN IF NOT EXISTS start_day_part VARCHAR(10) NOT NULL DEFAULT 'full',
+    ADD COLUMN IF NOT EXISTS end_day_part VARCHAR(10) NOT NULL DEFAULT 'full',
+    ADD COLUMN IF NOT EXISTS hours NUMERIC(5,2);
+
+ALTER TABLE leave_requests
+    ADD CONSTRAINT chk_leave_requests_start_day_part CHECK (start_day_part IN ('full', 'am', 'pm')),
+    ADD CONSTRAINT chk_leave_requests_end_day_part CHECK (end_day_part IN ('full', 'am', 'pm')),
+    ADD CONSTRAINT chk_leave_requests_hours CHECK (hours IS NULL OR (hours > 0 AND hours <= 24 AND start_date = end_date));
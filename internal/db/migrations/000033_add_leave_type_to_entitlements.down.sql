@@ -0,0 +1,23 @@
+DROP INDEX IF EXISTS idx_leave_requests_employee_type;
+
+UPDATE leave_entitlements e
+SET total_days = totals.total_days,
+    reserved_days = totals.reserved_days
+FROM (
+    SELECT MIN(id) AS id, SUM(total_days) AS total_days, SUM(reserved_days) AS reserved_days
+    FROM leave_entitlements
+    GROUP BY employee_id, year
+) totals
+WHERE e.id = totals.id;
+
+DELETE FROM leave_entitlements e
+USING leave_entitlements kept
+WHERE kept.employee_id = e.employee_id
+    AND kept.year = e.year
+    AND kept.id < e.id;
+
+ALTER TABLE leave_entitlements
+    DROP CONSTRAINT IF EXISTS uq_leave_entitlements_employee_year_type,
+    ADD CONSTRAINT uq_leave_entitlements_employee_year UNIQUE (employee_id, year);
+
+ALTER TABLE leave_entitlements DROP COLUMN IF EXISTS leave_type_id;
@@ -0,0 +1,30 @@
+ALTER TABLE leave_entitlements
+    ADD COLUMN IF NOT EXISTS leave_type_id BIGINT REFERENCES leave_types(id) ON DELETE RESTRICT;
+
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM leave_entitlements WHERE leave_type_id IS NULL)
+        AND NOT EXISTS (SELECT 1 FROM leave_types WHERE counts_toward_entitlement = TRUE) THEN
+        RAISE EXCEPTION 'leave entitlements exist but no leave type counts toward entitlement; mark one (such as Annual Leave) as counting toward entitlement and rerun the migration';
+    END IF;
+END
+$$;
+
+UPDATE leave_entitlements
+SET leave_type_id = (
+    SELECT id
+    FROM leave_types
+    WHERE counts_toward_entitlement = TRUE
+    ORDER BY LOWER(name) = 'annual leave' DESC, id ASC
+    LIMIT 1
+)
+WHERE leave_type_id IS NULL;
+
+ALTER TABLE leave_entitlements
+    ALTER COLUMN leave_type_id SET NOT NULL;
+
+ALTER TABLE leave_entitlements
+    DROP CONSTRAINT IF EXISTS uq_leave_entitlements_employee_year,
+    ADD CONSTRAINT uq_leave_entitlements_employee_year_type UNIQUE (employee_id, year, leave_type_id);
+
+CREATE INDEX IF NOT EXISTS idx_leave_requests_employee_type ON leave_requests(employee_id, leave_type_id);
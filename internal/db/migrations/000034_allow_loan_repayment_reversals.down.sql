@@ -0,0 +1,21 @@
+UPDATE employee_loans l
+SET outstanding_balance = LEAST(GREATEST(l.outstanding_balance + undone.amount, 0), l.total_repayable),
+    status = CASE
+        WHEN l.status = 'cancelled' THEN l.status
+        WHEN l.outstanding_balance + undone.amount <= 0 THEN 'settled'
+        ELSE 'active'
+    END,
+    updated_at = NOW()
+FROM (
+    SELECT r.loan_id, SUM(r.amount) AS amount
+    FROM employee_loan_repayments r
+    WHERE r.amount < 0
+    GROUP BY r.loan_id
+) undone
+WHERE l.id = undone.loan_id;
+
+DELETE FROM employee_loan_repayments WHERE amount < 0;
+
+ALTER TABLE employee_loan_repayments
+    DROP CONSTRAINT IF EXISTS chk_employee_loan_repayments_amount_non_zero,
+    ADD CONSTRAINT chk_employee_loan_repayments_amount_positive CHECK (amount > 0);
@@ -0,0 +1,3 @@
+ALTER TABLE employee_loan_repayments
+    DROP CONSTRAINT IF EXISTS chk_employee_loan_repayments_amount_positive,
+    ADD CONSTRAINT chk_employee_loan_repayments_amount_non_zero CHECK (amount <> 0);
@@ -40,3 +40,387 @@ func TestEmployeeGenderConstraintMigrationExists(t *testing.T) {
 		}
 	}
 }
+
+func TestPayComponentsMigrationExists(t *testing.T) {
+	content, err := migrationsFS.ReadFile("migrations/000015_create_pay_components.up.sql")
+	if err != nil {
+		t.Fatalf("expected migration file, got %v", err)
+	}
+	sql := string(content)
+	required := []string{
+		"CREATE TABLE IF NOT EXISTS pay_components",
+		"CREATE TABLE IF NOT EXISTS payroll_entry_lines",
+		"REFERENCES payroll_entries(id) ON DELETE CASCADE",
+	}
+	for _, token := range required {
+		if !strings.Contains(sql, token) {
+			t.Fatalf("expected migration to contain %q", token)
+		}
+	}
+}
+
+func TestContributionSchemesMigrationExists(t *testing.T) {
+	content, err := migrationsFS.ReadFile("migrations/000016_create_contribution_schemes.up.sql")
+	if err != nil {
+		t.Fatalf("expected migration file, got %v", err)
+	}
+	sql := string(content)
+	required := []string{
+		"CREATE TABLE IF NOT EXISTS contribution_schemes",
+		"CREATE TABLE IF NOT EXISTS contribution_scheme_members",
+		"ADD COLUMN IF NOT EXISTS employer_contributions_total",
+		"VALUES ('NSSF', 'National Social Security Fund', 5, 10, 'gross')",
+	}
+	for _, token := range required {
+		if !strings.Contains(sql, token) {
+			t.Fatalf("expected migration to contain %q", token)
+		}
+	}
+}
+
+func TestEmployeePaymentMethodsMigrationExists(t *testing.T) {
+	content, err := migrationsFS.ReadFile("migrations/000017_create_employee_payment_methods.up.sql")
+	if err != nil {
+		t.Fatalf("expected migration file, got %v", err)
+	}
+	sql := string(content)
+	required := []string{
+		"CREATE TABLE IF NOT EXISTS employee_payment_methods",
+		"CHECK (method IN ('bank', 'mobile_money'))",
+		"split_percent NUMERIC(5,2)",
+	}
+	for _, token := range required {
+		if !strings.Contains(sql, token) {
+			t.Fatalf("expected migration to contain %q", token)
+		}
+	}
+}
+
+func TestEmployeeExitDateAndProrationMigrationExists(t *testing.T) {
+	content, err := migrationsFS.ReadFile("migrations/000018_add_employee_exit_date_and_entry_proration.up.sql")
+	if err != nil {
+		t.Fatalf("expected migration file, got %v", err)
+	}
+	sql := string(content)
+	required := []string{
+		"ADD COLUMN IF NOT EXISTS date_of_exit DATE",
+		"ADD COLUMN IF NOT EXISTS payable_days INT",
+		"proration_basis IN ('calendar_days', 'working_days')",
+	}
+	for _, token := range required {
+		if !strings.Contains(sql, token) {
+			t.Fatalf("expected migration to contain %q", token)
+		}
+	}
+}
+
+func TestPayrollEntryLineSourcesMigrationExists(t *testing.T) {
+	content, err := migrationsFS.ReadFile("migrations/000019_create_payroll_entry_line_sources.up.sql")
+	if err != nil {
+		t.Fatalf("expected migration file, got %v", err)
+	}
+	sql := string(content)
+	required := []string{
+		"CREATE TABLE IF NOT EXISTS payroll_entry_line_sources",
+		"CHECK (source_type IN ('leave_request', 'attendance_record'))",
+		"ADD COLUMN IF NOT EXISTS quantity NUMERIC(8,2)",
+	}
+	for _, token := range required {
+		if !strings.Contains(sql, token) {
+			t.Fatalf("expected migration to contain %q", token)
+		}
+	}
+}
+
+func TestEmployeeLoansMigrationExists(t *testing.T) {
+	content, err := migrationsFS.ReadFile("migrations/000020_create_employee_loans.up.sql")
+	if err != nil {
+		t.Fatalf("expected migration file, got %v", err)
+	}
+	sql := string(content)
+	required := []string{
+		"CREATE TABLE IF NOT EXISTS employee_loans",
+		"CREATE TABLE IF NOT EXISTS employee_loan_repayments",
+		"CONSTRAINT uq_employee_loan_repayments_loan_batch UNIQUE (loan_id, batch_id)",
+		"ADD COLUMN IF NOT EXISTS loan_id BIGINT REFERENCES employee_loans(id)",
+	}
+	for _, token := range required {
+		if !strings.Contains(sql, token) {
+			t.Fatalf("expected migration to contain %q", token)
+		}
+	}
+}
+
+func TestPayrollVarianceReviewMigrationExists(t *testing.T) {
+	content, err := migrationsFS.ReadFile("migrations/000021_add_payroll_variance_review.up.sql")
+	if err != nil {
+		t.Fatalf("expected migration file, got %v", err)
+	}
+	sql := string(content)
+	required := []string{
+		"ADD COLUMN IF NOT EXISTS variance_reviewed_by BIGINT REFERENCES users(id)",
+		"ADD COLUMN IF NOT EXISTS variance_reviewed_at TIMESTAMPTZ",
+	}
+	for _, token := range required {
+		if !strings.Contains(sql, token) {
+			t.Fatalf("expected migration to contain %q", token)
+		}
+	}
+}
+
+func TestPayrollBatchTypesMigrationExists(t *testing.T) {
+	content, err := migrationsFS.ReadFile("migrations/000022_add_payroll_batch_types.up.sql")
+	if err != nil {
+		t.Fatalf("expected migration file, got %v", err)
+	}
+	sql := string(content)
+	required := []string{
+		"DROP CONSTRAINT IF EXISTS payroll_batches_month_key",
+		"ADD COLUMN IF NOT EXISTS batch_type VARCHAR(20) NOT NULL DEFAULT 'regular'",
+		"CREATE UNIQUE INDEX IF NOT EXISTS uq_payroll_batches_regular_month",
+		"CREATE TABLE IF NOT EXISTS payroll_batch_employees",
+		"CREATE TABLE IF NOT EXISTS payroll_batch_components",
+	}
+	for _, token := range required {
+		if !strings.Contains(sql, token) {
+			t.Fatalf("expected migration to contain %q", token)
+		}
+	}
+}
+
+func TestEmployeeSalaryHistoryMigrationExists(t *testing.T) {
+	content, err := migrationsFS.ReadFile("migrations/000023_create_employee_salary_history.up.sql")
+	if err != nil {
+		t.Fatalf("expected migration file, got %v", err)
+	}
+	sql := string(content)
+	required := []string{
+		"CREATE TABLE IF NOT EXISTS employee_salary_history",
+		"CONSTRAINT uq_employee_salary_history_effective UNIQUE (employee_id, effective_from)",
+		"INSERT INTO employee_salary_history (employee_id, amount, effective_from, reason, created_at)",
+		"ADD COLUMN IF NOT EXISTS arrears_month VARCHAR(7)",
+	}
+	for _, token := range required {
+		if !strings.Contains(sql, token) {
+			t.Fatalf("expected migration to contain %q", token)
+		}
+	}
+}
+
+func TestPayrollBatchApprovalsMigrationExists(t *testing.T) {
+	content, err := migrationsFS.ReadFile("migrations/000024_create_payroll_batch_approvals.up.sql")
+	if err != nil {
+		t.Fatalf("expected migration file, got %v", err)
+	}
+	sql := string(content)
+	required := []string{
+		"CREATE TABLE IF NOT EXISTS payroll_batch_approvals",
+		"batch_id BIGINT NOT NULL REFERENCES payroll_batches(id) ON DELETE CASCADE",
+		"CONSTRAINT chk_payroll_batch_approvals_action CHECK (action IN ('approved', 'rejected'))",
+	}
+	for _, token := range required {
+		if !strings.Contains(sql, token) {
+			t.Fatalf("expected migration to contain %q", token)
+		}
+	}
+}
+
+func TestPayrollBatchReversalsMigrationExists(t *testing.T) {
+	content, err := migrationsFS.ReadFile("migrations/000025_add_payroll_batch_reversals.up.sql")
+	if err != nil {
+		t.Fatalf("expected migration file, got %v", err)
+	}
+	sql := string(content)
+	required := []string{
+		"ADD COLUMN IF NOT EXISTS reverses_batch_id BIGINT REFERENCES payroll_batches(id)",
+		"CREATE UNIQUE INDEX IF NOT EXISTS uq_payroll_batches_reverses_batch_id",
+		"DROP CONSTRAINT IF EXISTS chk_payroll_entry_lines_amount_non_negative",
+		"CHECK (action IN ('approved', 'rejected', 'reopened'))",
+	}
+	for _, token := range required {
+		if !strings.Contains(sql, token) {
+			t.Fatalf("expected migration to contain %q", token)
+		}
+	}
+}
+
+func TestGLAccountMappingsMigrationExists(t *testing.T) {
+	content, err := migrationsFS.ReadFile("migrations/000026_create_gl_account_mappings.up.sql")
+	if err != nil {
+		t.Fatalf("expected migration file, got %v", err)
+	}
+	sql := string(content)
+	required := []string{
+		"ADD COLUMN IF NOT EXISTS cost_centre VARCHAR(40)",
+		"CREATE TABLE IF NOT EXISTS gl_account_mappings",
+		"CONSTRAINT chk_gl_account_mappings_category CHECK (category IN (",
+		"CREATE UNIQUE INDEX IF NOT EXISTS uq_gl_account_mappings_target",
+	}
+	for _, token := range required {
+		if !strings.Contains(sql, token) {
+			t.Fatalf("expected migration to contain %q", token)
+		}
+	}
+}
+
+func TestProjectsMigrationExists(t *testing.T) {
+	content, err := migrationsFS.ReadFile("migrations/000027_create_projects.up.sql")
+	if err != nil {
+		t.Fatalf("expected migration file, got %v", err)
+	}
+	sql := string(content)
+	required := []string{
+		"CREATE TABLE IF NOT EXISTS projects",
+		"CREATE TABLE IF NOT EXISTS employee_project_allocations",
+		"CONSTRAINT chk_employee_project_allocations_percent CHECK (percent > 0 AND percent <= 100)",
+		"CREATE TABLE IF NOT EXISTS payroll_project_charges",
+	}
+	for _, token := range required {
+		if !strings.Contains(sql, token) {
+			t.Fatalf("expected migration to contain %q", token)
+		}
+	}
+}
+
+func TestPayrollBatchIntegrityMigrationExists(t *testing.T) {
+	content, err := migrationsFS.ReadFile("migrations/000028_add_payroll_batch_integrity.up.sql")
+	if err != nil {
+		t.Fatalf("expected migration file, got %v", err)
+	}
+	sql := string(content)
+	required := []string{
+		"ADD COLUMN IF NOT EXISTS integrity_hash VARCHAR(64)",
+		"ADD COLUMN IF NOT EXISTS integrity_manifest JSONB",
+	}
+	for _, token := range required {
+		if !strings.Contains(sql, token) {
+			t.Fatalf("expected migration to contain %q", token)
+		}
+	}
+}
+
+func TestUserEmployeeLinkMigrationExists(t *testing.T) {
+	content, err := migrationsFS.ReadFile("migrations/000029_link_users_to_employees.up.sql")
+	if err != nil {
+		t.Fatalf("expected migration file, got %v", err)
+	}
+	sql := string(content)
+	required := []string{
+		"ADD COLUMN IF NOT EXISTS employee_id BIGINT REFERENCES employees(id) ON DELETE SET NULL",
+		"CREATE UNIQUE INDEX IF NOT EXISTS uq_users_employee_id",
+	}
+	for _, token := range required {
+		if !strings.Contains(sql, token) {
+			t.Fatalf("expected migration to contain %q", token)
+		}
+	}
+}
+
+func TestPublicHolidaysMigrationExists(t *testing.T) {
+	content, err := migrationsFS.ReadFile("migrations/000030_create_public_holidays.up.sql")
+	if err != nil {
+		t.Fatalf("expected migration file, got %v", err)
+	}
+	sql := string(content)
+	required := []string{
+		"CREATE TABLE IF NOT EXISTS public_holidays",
+		"CONSTRAINT chk_public_holidays_kind CHECK (kind IN ('fixed', 'easter', 'one_off'))",
+		"('Good Friday', 'easter', NULL, NULL, -2)",
+		"WHERE NOT EXISTS (SELECT 1 FROM public_holidays)",
+	}
+	for _, token := range required {
+		if !strings.Contains(sql, token) {
+			t.Fatalf("expected migration to contain %q", token)
+		}
+	}
+}
+
+func TestWorkSchedulesMigrationExists(t *testing.T) {
+	content, err := migrationsFS.ReadFile("migrations/000031_create_work_schedules.up.sql")
+	if err != nil {
+		t.Fatalf("expected migration file, got %v", err)
+	}
+	sql := string(content)
+	required := []string{
+		"CREATE TABLE IF NOT EXISTS work_schedules",
+		"CREATE UNIQUE INDEX IF NOT EXISTS uq_work_schedules_default ON work_schedules(is_default) WHERE is_default",
+		"CONSTRAINT chk_work_schedule_days_fraction CHECK (day_fraction IN (0.5, 1))",
+		"CONSTRAINT uq_employee_work_schedules_effective UNIQUE (employee_id, effective_from)",
+		"SELECT 'Standard (Mon-Fri)', 8, TRUE",
+	}
+	for _, token := range required {
+		if !strings.Contains(sql, token) {
+			t.Fatalf("expected migration to contain %q", token)
+		}
+	}
+}
+
+func TestLeaveRequestDayPartsMigrationExists(t *testing.T) {
+	content, err := migrationsFS.ReadFile("migrations/000032_add_leave_request_day_parts.up.sql")
+	if err != nil {
+		t.Fatalf("expected migration file, got %v", err)
+	}
+	sql := string(content)
+	required := []string{
+		"ADD COLUMN IF NOT EXISTS start_day_part VARCHAR(10) NOT NULL DEFAULT 'full'",
+		"ADD COLUMN IF NOT EXISTS hours NUMERIC(5,2)",
+		"CHECK (end_day_part IN ('full', 'am', 'pm'))",
+		"CHECK (hours IS NULL OR (hours > 0 AND hours <= 24 AND start_date = end_date))",
+	}
+	for _, token := range required {
+		if !strings.Contains(sql, token) {
+			t.Fatalf("expected migration to contain %q", token)
+		}
+	}
+}
+
+func TestLeaveEntitlementTypesMigrationExists(t *testing.T) {
+	content, err := migrationsFS.ReadFile("migrations/000033_add_leave_type_to_entitlements.up.sql")
+	if err != nil {
+		t.Fatalf("expected migration file, got %v", err)
+	}
+	sql := string(content)
+	required := []string{
+		"ADD COLUMN IF NOT EXISTS leave_type_id BIGINT REFERENCES leave_types(id) ON DELETE RESTRICT",
+		"ORDER BY LOWER(name) = 'annual leave' DESC, id ASC",
+		"RAISE EXCEPTION 'leave entitlements exist but no leave type counts toward entitlement",
+		"DROP CONSTRAINT IF EXISTS uq_leave_entitlements_employee_year",
+		"ADD CONSTRAINT uq_leave_entitlements_employee_year_type UNIQUE (employee_id, year, leave_type_id)",
+	}
+	for _, token := range required {
+		if !strings.Contains(sql, token) {
+			t.Fatalf("expected migration to contain %q", token)
+		}
+	}
+}
+
+func TestLoanRepaymentReversalsMigrationExists(t *testing.T) {
+	content, err := migrationsFS.ReadFile("migrations/000034_allow_loan_repayment_reversals.up.sql")
+	if err != nil {
+		t.Fatalf("expected migration file, got %v", err)
+	}
+	sql := string(content)
+	required := []string{
+		"DROP CONSTRAINT IF EXISTS chk_employee_loan_repayments_amount_positive",
+		"ADD CONSTRAINT chk_employee_loan_repayments_amount_non_zero CHECK (amount <> 0)",
+	}
+	for _, token := range required {
+		if !strings.Contains(sql, token) {
+			t.Fatalf("expected migration to contain %q", token)
+		}
+	}
+}
+
+func TestPayrollBatchReversalsDownMigrationUndoesLoanRepayments(t *testing.T) {
+	content, err := migrationsFS.ReadFile("migrations/000025_add_payroll_batch_reversals.down.sql")
+	if err != nil {
+		t.Fatalf("expected migration file, got %v", err)
+	}
+	sql := string(content)
+	undo := strings.Index(sql, "UPDATE employee_loans l")
+	deleteRepayments := strings.Index(sql, "DELETE FROM employee_loan_repayments r")
+	deleteBatches := strings.Index(sql, "DELETE FROM payroll_batches WHERE reverses_batch_id IS NOT NULL")
+	if undo < 0 || deleteRepayments < undo || deleteBatches < deleteRepayments {
+		t.Fatalf("expected loan balances to be restored and repayments deleted before reversal batches")
+	}
+}
@@ -10,8 +10,8 @@ import (
 )
 
 type Repository interface {
-	Create(ctx context.Context, name string, description *string) (*Department, error)
-	Update(ctx context.Context, id int64, name string, description *string) (*Department, error)
+	Create(ctx context.Context, name string, description, costCentre *string) (*Department, error)
+	Update(ctx context.Context, id int64, name string, description, costCentre *string) (*Department, error)
 	Delete(ctx context.Context, id int64) (bool, error)
 	GetByID(ctx context.Context, id int64) (*Department, error)
 	List(ctx context.Context, query ListDepartmentsQuery) ([]Department, int64, error)
@@ -27,15 +27,15 @@ func NewRepository(db *sqlx.DB) *SQLXRepository {
 	return &SQLXRepository{db: db}
 }
 
-func (r *SQLXRepository) Create(ctx context.Context, name string, description *string) (*Department, error) {
+func (r *SQLXRepository) Create(ctx context.Context, name string, description, costCentre *string) (*Department, error) {
 	query := `
-        INSERT INTO departments (name, description)
-        VALUES ($1, $2)
-        RETURNING id, name, description, created_at, updated_at
+        INSERT INTO departments (name, description, cost_centre)
+        VALUES ($1, $2, $3)
+        RETURNING id, name, description, cost_centre, created_at, updated_at
     `
 
 	var department Department
-	if err := r.db.GetContext(ctx, &department, query, name, description); err != nil {
+	if err := r.db.GetContext(ctx, &department, query, name, description, costCentre); err != nil {
 		return nil, fmt.Errorf("create department: %w", err)
 	}
 
@@ -43,16 +43,16 @@ func (r *SQLXRepository) Create(ctx context.Context, name string, description *s
 	return &department, nil
 }
 
-func (r *SQLXRepository) Update(ctx context.Context, id int64, name string, description *string) (*Department, error) {
+func (r *SQLXRepository) Update(ctx context.Context, id int64, name string, description, costCentre *string) (*Department, error) {
 	query := `
         UPDATE departments
-        SET name = $2, description = $3, updated_at = NOW()
+        SET name = $2, description = $3, cost_centre = $4, updated_at = NOW()
         WHERE id = $1
-        RETURNING id, name, description, created_at, updated_at
+        RETURNING id, name, description, cost_centre, created_at, updated_at
     `
 
 	var department Department
-	if err := r.db.GetContext(ctx, &department, query, id, name, description); err != nil {
+	if err := r.db.GetContext(ctx, &department, query, id, name, description, costCentre); err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
 		}
@@ -85,7 +85,7 @@ func (r *SQLXRepository) Delete(ctx context.Context, id int64) (bool, error) {
 
 func (r *SQLXRepository) GetByID(ctx context.Context, id int64) (*Department, error) {
 	query := `
-        SELECT d.id, d.name, d.description,
+        SELECT d.id, d.name, d.description, d.cost_centre,
                COUNT(e.id)::BIGINT AS employee_count,
                d.created_at, d.updated_at
         FROM departments d
@@ -141,7 +141,7 @@ func (r *SQLXRepository) List(ctx context.Context, query ListDepartmentsQuery) (
 	offsetPlaceholder := fmt.Sprintf("$%d", len(args))
 
 	listQuery := `
-        SELECT d.id, d.name, d.description,
+        SELECT d.id, d.name, d.description, d.cost_centre,
                COUNT(e.id)::BIGINT AS employee_count,
                d.created_at, d.updated_at
         FROM departments d
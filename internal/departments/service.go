@@ -31,6 +31,10 @@ func (s *Service) CreateDepartment(ctx context.Context, _ *models.Claims, input
 	}
 
 	description := normalizeOptional(input.Description)
+	costCentre := normalizeOptional(input.CostCentre)
+	if costCentre != nil && len(*costCentre) > 40 {
+		return nil, fmt.Errorf("%w: cost centre is too long", ErrValidation)
+	}
 
 	exists, err := s.repository.ExistsByNameCaseInsensitive(ctx, name, nil)
 	if err != nil {
@@ -41,7 +45,7 @@ func (s *Service) CreateDepartment(ctx context.Context, _ *models.Claims, input
 		return nil, ErrDuplicateName
 	}
 
-	return s.repository.Create(ctx, name, description)
+	return s.repository.Create(ctx, name, description, costCentre)
 }
 
 func (s *Service) UpdateDepartment(ctx context.Context, _ *models.Claims, id int64, input UpsertDepartmentInput) (*Department, error) {
@@ -55,6 +59,10 @@ func (s *Service) UpdateDepartment(ctx context.Context, _ *models.Claims, id int
 	}
 
 	description := normalizeOptional(input.Description)
+	costCentre := normalizeOptional(input.CostCentre)
+	if costCentre != nil && len(*costCentre) > 40 {
+		return nil, fmt.Errorf("%w: cost centre is too long", ErrValidation)
+	}
 
 	exists, err := s.repository.ExistsByNameCaseInsensitive(ctx, name, &id)
 	if err != nil {
@@ -65,7 +73,7 @@ func (s *Service) UpdateDepartment(ctx context.Context, _ *models.Claims, id int
 		return nil, ErrDuplicateName
 	}
 
-	department, err := s.repository.Update(ctx, id, name, description)
+	department, err := s.repository.Update(ctx, id, name, description, costCentre)
 	if err != nil {
 		return nil, err
 	}
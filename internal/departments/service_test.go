@@ -3,6 +3,7 @@ package departments
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
 
 	"hrpro/internal/models"
@@ -13,12 +14,12 @@ type fakeRepository struct {
 	employeesByID map[int64]int64
 }
 
-func (f *fakeRepository) Create(_ context.Context, name string, description *string) (*Department, error) {
-	return &Department{ID: 1, Name: name, Description: description}, nil
+func (f *fakeRepository) Create(_ context.Context, name string, description, costCentre *string) (*Department, error) {
+	return &Department{ID: 1, Name: name, Description: description, CostCentre: costCentre}, nil
 }
 
-func (f *fakeRepository) Update(_ context.Context, id int64, name string, description *string) (*Department, error) {
-	return &Department{ID: id, Name: name, Description: description}, nil
+func (f *fakeRepository) Update(_ context.Context, id int64, name string, description, costCentre *string) (*Department, error) {
+	return &Department{ID: id, Name: name, Description: description, CostCentre: costCentre}, nil
 }
 
 func (f *fakeRepository) Delete(_ context.Context, _ int64) (bool, error) {
@@ -68,3 +69,30 @@ func TestDeleteDepartmentFailsWhenEmployeesExist(t *testing.T) {
 		t.Fatalf("expected department has employees error, got %v", err)
 	}
 }
+
+func TestCreateDepartmentTrimsCostCentre(t *testing.T) {
+	service := NewService(&fakeRepository{})
+	costCentre := "  CC-100 "
+
+	department, err := service.CreateDepartment(
+		context.Background(),
+		&models.Claims{Role: "Admin"},
+		UpsertDepartmentInput{Name: "Finance", CostCentre: &costCentre},
+	)
+	if err != nil {
+		t.Fatalf("expected department, got %v", err)
+	}
+	if department.CostCentre == nil || *department.CostCentre != "CC-100" {
+		t.Fatalf("expected trimmed cost centre, got %v", department.CostCentre)
+	}
+
+	tooLong := strings.Repeat("C", 41)
+	_, err = service.CreateDepartment(
+		context.Background(),
+		&models.Claims{Role: "Admin"},
+		UpsertDepartmentInput{Name: "Finance", CostCentre: &tooLong},
+	)
+	if !errors.Is(err, ErrValidation) {
+		t.Fatalf("expected validation error, got %v", err)
+	}
+}
@@ -6,6 +6,7 @@ type Department struct {
 	ID            int64     `db:"id" json:"id"`
 	Name          string    `db:"name" json:"name"`
 	Description   *string   `db:"description" json:"description,omitempty"`
+	CostCentre    *string   `db:"cost_centre" json:"costCentre,omitempty"`
 	EmployeeCount int64     `db:"employee_count" json:"employeeCount"`
 	CreatedAt     time.Time `db:"created_at" json:"createdAt"`
 	UpdatedAt     time.Time `db:"updated_at" json:"updatedAt"`
@@ -14,6 +15,7 @@ type Department struct {
 type UpsertDepartmentInput struct {
 	Name        string  `json:"name"`
 	Description *string `json:"description"`
+	CostCentre  *string `json:"costCentre"`
 }
 
 type ListDepartmentsQuery struct {
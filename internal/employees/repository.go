@@ -8,6 +8,8 @@ import (
 	"time"
 
 	"github.com/jmoiron/sqlx"
+
+	"hrpro/internal/money"
 )
 
 type Repository interface {
@@ -17,6 +19,8 @@ type Repository interface {
 	Delete(ctx context.Context, id int64) (bool, error)
 	GetByID(ctx context.Context, id int64) (*Employee, error)
 	List(ctx context.Context, query ListEmployeesQuery) ([]Employee, int64, error)
+	RecordSalaryChange(ctx context.Context, employeeID int64, input SalaryChangeInsert) (*SalaryChange, error)
+	ListSalaryHistory(ctx context.Context, employeeID int64) ([]SalaryChange, error)
 }
 
 type RepositoryUpsertInput struct {
@@ -36,7 +40,18 @@ type RepositoryUpsertInput struct {
 	Position         string
 	EmploymentStatus string
 	DateOfHire       time.Time
-	BaseSalaryAmount float64
+	DateOfExit       *time.Time
+	BaseSalaryAmount money.Amount
+
+	// SalaryChange, when set, is written to the salary history in the same transaction.
+	SalaryChange *SalaryChangeInsert
+}
+
+type SalaryChangeInsert struct {
+	Amount        money.Amount
+	EffectiveFrom time.Time
+	Reason        string
+	ApprovedBy    *int64
 }
 
 type SQLXRepository struct {
@@ -51,19 +66,25 @@ func (r *SQLXRepository) Create(ctx context.Context, input RepositoryUpsertInput
 	query := `
         INSERT INTO employees (
             first_name, last_name, other_name, gender, dob, phone, email, national_id,
-            address, job_description, contract_url, contract_file_path, department_id, position, employment_status, date_of_hire, base_salary_amount, phone_e164
+            address, job_description, contract_url, contract_file_path, department_id, position, employment_status, date_of_hire, date_of_exit, base_salary_amount, phone_e164
         )
         VALUES (
             $1, $2, $3, $4, $5, $6, $7, $8,
-            $9, $10, $11, $12, $13, $14, $15, $16, $17
+            $9, $10, $11, $12, $13, $14, $15, $16, $17, $18
         )
         RETURNING id, first_name, last_name, other_name, gender, dob, phone, phone_e164, email, national_id,
-            address, job_description, contract_url, contract_file_path, department_id, position, employment_status, date_of_hire,
+            address, job_description, contract_url, contract_file_path, department_id, position, employment_status, date_of_hire, date_of_exit,
             base_salary_amount, created_at, updated_at
     `
 
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin create employee: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
 	var employee Employee
-	if err := r.db.GetContext(
+	if err := tx.GetContext(
 		ctx,
 		&employee,
 		query,
@@ -83,12 +104,25 @@ func (r *SQLXRepository) Create(ctx context.Context, input RepositoryUpsertInput
 		input.Position,
 		input.EmploymentStatus,
 		input.DateOfHire,
+		input.DateOfExit,
 		input.BaseSalaryAmount,
 		input.PhoneE164,
 	); err != nil {
 		return nil, fmt.Errorf("create employee: %w", err)
 	}
 
+	if input.SalaryChange != nil {
+		if err := recordSalaryChange(ctx, tx, employee.ID, *input.SalaryChange); err != nil {
+			return nil, err
+		}
+		if employee.BaseSalaryAmount, err = syncCurrentSalary(ctx, tx, employee.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit create employee: %w", err)
+	}
 	return &employee, nil
 }
 
@@ -112,16 +146,23 @@ func (r *SQLXRepository) Update(ctx context.Context, id int64, input RepositoryU
             position = $15,
             employment_status = $16,
             date_of_hire = $17,
-            base_salary_amount = $18,
+            date_of_exit = $18,
+            base_salary_amount = $19,
             updated_at = NOW()
         WHERE id = $1
         RETURNING id, first_name, last_name, other_name, gender, dob, phone, phone_e164, email, national_id,
-            address, job_description, contract_url, contract_file_path, department_id, position, employment_status, date_of_hire,
+            address, job_description, contract_url, contract_file_path, department_id, position, employment_status, date_of_hire, date_of_exit,
             base_salary_amount, created_at, updated_at
     `
 
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin update employee: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
 	var employee Employee
-	err := r.db.GetContext(
+	err = tx.GetContext(
 		ctx,
 		&employee,
 		query,
@@ -142,6 +183,7 @@ func (r *SQLXRepository) Update(ctx context.Context, id int64, input RepositoryU
 		input.Position,
 		input.EmploymentStatus,
 		input.DateOfHire,
+		input.DateOfExit,
 		input.BaseSalaryAmount,
 	)
 	if err != nil {
@@ -152,6 +194,18 @@ func (r *SQLXRepository) Update(ctx context.Context, id int64, input RepositoryU
 		return nil, fmt.Errorf("update employee: %w", err)
 	}
 
+	if input.SalaryChange != nil {
+		if err := recordSalaryChange(ctx, tx, employee.ID, *input.SalaryChange); err != nil {
+			return nil, err
+		}
+		if employee.BaseSalaryAmount, err = syncCurrentSalary(ctx, tx, employee.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit update employee: %w", err)
+	}
 	return &employee, nil
 }
 
@@ -163,7 +217,7 @@ func (r *SQLXRepository) UpdateContractFilePath(ctx context.Context, id int64, c
             updated_at = NOW()
         WHERE id = $1
         RETURNING id, first_name, last_name, other_name, gender, dob, phone, phone_e164, email, national_id,
-            address, job_description, contract_url, contract_file_path, department_id, position, employment_status, date_of_hire,
+            address, job_description, contract_url, contract_file_path, department_id, position, employment_status, date_of_hire, date_of_exit,
             base_salary_amount, created_at, updated_at
     `
 
@@ -198,7 +252,7 @@ func (r *SQLXRepository) Delete(ctx context.Context, id int64) (bool, error) {
 func (r *SQLXRepository) GetByID(ctx context.Context, id int64) (*Employee, error) {
 	query := `
         SELECT e.id, e.first_name, e.last_name, e.other_name, e.gender, e.dob, e.phone, e.phone_e164, e.email, e.national_id,
-            e.address, e.job_description, e.contract_url, e.contract_file_path, e.department_id, d.name AS department_name, e.position, e.employment_status, e.date_of_hire,
+            e.address, e.job_description, e.contract_url, e.contract_file_path, e.department_id, d.name AS department_name, e.position, e.employment_status, e.date_of_hire, e.date_of_exit,
             e.base_salary_amount, e.created_at, e.updated_at
         FROM employees e
         LEFT JOIN departments d ON d.id = e.department_id
@@ -274,7 +328,7 @@ func (r *SQLXRepository) List(ctx context.Context, query ListEmployeesQuery) ([]
 
 	listQuery := `
         SELECT e.id, e.first_name, e.last_name, e.other_name, e.gender, e.dob, e.phone, e.phone_e164, e.email, e.national_id,
-            e.address, e.job_description, e.contract_url, e.contract_file_path, e.department_id, d.name AS department_name, e.position, e.employment_status, e.date_of_hire,
+            e.address, e.job_description, e.contract_url, e.contract_file_path, e.department_id, d.name AS department_name, e.position, e.employment_status, e.date_of_hire, e.date_of_exit,
             e.base_salary_amount, e.created_at, e.updated_at
         FROM employees e
         LEFT JOIN departments d ON d.id = e.department_id
@@ -289,3 +343,94 @@ func (r *SQLXRepository) List(ctx context.Context, query ListEmployeesQuery) ([]
 
 	return items, total, nil
 }
+
+// RecordSalaryChange adds a rate to the salary history, replacing any rate with the same effective date, and
+// keeps employees.base_salary_amount at the rate in effect today.
+func (r *SQLXRepository) RecordSalaryChange(ctx context.Context, employeeID int64, input SalaryChangeInsert) (*SalaryChange, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin record salary change: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	var exists bool
+	if err := tx.GetContext(ctx, &exists, `SELECT EXISTS (SELECT 1 FROM employees WHERE id = $1)`, employeeID); err != nil {
+		return nil, fmt.Errorf("check employee exists: %w", err)
+	}
+	if !exists {
+		return nil, nil
+	}
+	if err := recordSalaryChange(ctx, tx, employeeID, input); err != nil {
+		return nil, err
+	}
+	if _, err := syncCurrentSalary(ctx, tx, employeeID); err != nil {
+		return nil, err
+	}
+
+	var change SalaryChange
+	query := salaryHistorySelect + ` WHERE esh.employee_id = $1 AND esh.effective_from = $2`
+	if err := tx.GetContext(ctx, &change, query, employeeID, input.EffectiveFrom); err != nil {
+		return nil, fmt.Errorf("get salary change: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit record salary change: %w", err)
+	}
+	return &change, nil
+}
+
+func (r *SQLXRepository) ListSalaryHistory(ctx context.Context, employeeID int64) ([]SalaryChange, error) {
+	query := salaryHistorySelect + ` WHERE esh.employee_id = $1 ORDER BY esh.effective_from DESC`
+
+	items := make([]SalaryChange, 0)
+	if err := r.db.SelectContext(ctx, &items, query, employeeID); err != nil {
+		return nil, fmt.Errorf("list salary history: %w", err)
+	}
+	return items, nil
+}
+
+const salaryHistorySelect = `
+        SELECT esh.id, esh.employee_id, esh.amount, esh.effective_from, esh.reason,
+            esh.approved_by, u.username AS approved_by_name, esh.created_at
+        FROM employee_salary_history esh
+        LEFT JOIN users u ON u.id = esh.approved_by
+`
+
+func recordSalaryChange(ctx context.Context, tx *sqlx.Tx, employeeID int64, input SalaryChangeInsert) error {
+	query := `
+        INSERT INTO employee_salary_history (employee_id, amount, effective_from, reason, approved_by)
+        VALUES ($1, $2, $3, $4, $5)
+        ON CONFLICT (employee_id, effective_from) DO UPDATE
+        SET amount = EXCLUDED.amount,
+            reason = EXCLUDED.reason,
+            approved_by = EXCLUDED.approved_by,
+            created_at = NOW()
+    `
+	if _, err := tx.ExecContext(ctx, query, employeeID, input.Amount, input.EffectiveFrom, input.Reason, input.ApprovedBy); err != nil {
+		return fmt.Errorf("record salary change: %w", err)
+	}
+	return nil
+}
+
+// syncCurrentSalary sets base_salary_amount to the rate in effect today, so a future-dated change does not
+// take effect early.
+func syncCurrentSalary(ctx context.Context, tx *sqlx.Tx, employeeID int64) (money.Amount, error) {
+	query := `
+        UPDATE employees
+        SET base_salary_amount = COALESCE((
+                SELECT esh.amount
+                FROM employee_salary_history esh
+                WHERE esh.employee_id = employees.id AND esh.effective_from <= CURRENT_DATE
+                ORDER BY esh.effective_from DESC
+                LIMIT 1
+            ), base_salary_amount),
+            updated_at = NOW()
+        WHERE id = $1
+        RETURNING base_salary_amount
+    `
+	var amount money.Amount
+	if err := tx.GetContext(ctx, &amount, query, employeeID); err != nil {
+		return 0, fmt.Errorf("sync employee base salary: %w", err)
+	}
+	return amount, nil
+}
@@ -9,13 +9,16 @@ import (
 	"strings"
 	"time"
 
+	"hrpro/internal/audit"
 	"hrpro/internal/middleware"
 	"hrpro/internal/models"
+	"hrpro/internal/money"
 	"hrpro/internal/phone"
 )
 
 const (
-	maxContractSizeBytes = 10 * 1024 * 1024
+	maxContractSizeBytes        = 10 * 1024 * 1024
+	maxSalaryChangeReasonLength = 200
 )
 
 var (
@@ -31,10 +34,19 @@ type Service struct {
 	repository            Repository
 	contractStore         ContractStore
 	phoneDefaultsProvider PhoneDefaultsProvider
+	audit                 audit.Recorder
 }
 
 func NewService(repository Repository) *Service {
-	return &Service{repository: repository}
+	return &Service{repository: repository, audit: audit.NewNoopRecorder()}
+}
+
+func (s *Service) SetAuditRecorder(recorder audit.Recorder) {
+	if recorder == nil {
+		s.audit = audit.NewNoopRecorder()
+		return
+	}
+	s.audit = recorder
 }
 
 func (s *Service) SetContractStore(store ContractStore) {
@@ -45,11 +57,17 @@ func (s *Service) SetPhoneDefaultsProvider(provider PhoneDefaultsProvider) {
 	s.phoneDefaultsProvider = provider
 }
 
-func (s *Service) CreateEmployee(ctx context.Context, _ *models.Claims, input UpsertEmployeeInput) (*Employee, error) {
+func (s *Service) CreateEmployee(ctx context.Context, claims *models.Claims, input UpsertEmployeeInput) (*Employee, error) {
 	normalized, err := s.normalizeAndValidate(ctx, input)
 	if err != nil {
 		return nil, err
 	}
+	normalized.SalaryChange = &SalaryChangeInsert{
+		Amount:        normalized.BaseSalaryAmount,
+		EffectiveFrom: normalized.DateOfHire,
+		Reason:        "Starting salary",
+		ApprovedBy:    claimsUserID(claims),
+	}
 
 	employee, err := s.repository.Create(ctx, normalized)
 	if err != nil {
@@ -59,7 +77,7 @@ func (s *Service) CreateEmployee(ctx context.Context, _ *models.Claims, input Up
 	return employee, nil
 }
 
-func (s *Service) UpdateEmployee(ctx context.Context, _ *models.Claims, id int64, input UpsertEmployeeInput) (*Employee, error) {
+func (s *Service) UpdateEmployee(ctx context.Context, claims *models.Claims, id int64, input UpsertEmployeeInput) (*Employee, error) {
 	if id <= 0 {
 		return nil, newFieldValidationError("id", "must be positive")
 	}
@@ -69,6 +87,18 @@ func (s *Service) UpdateEmployee(ctx context.Context, _ *models.Claims, id int64
 		return nil, err
 	}
 
+	current, err := s.repository.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if current == nil {
+		return nil, ErrNotFound
+	}
+	normalized.SalaryChange, err = salaryChangeFromUpdate(*current, normalized, input, claimsUserID(claims), today())
+	if err != nil {
+		return nil, err
+	}
+
 	employee, err := s.repository.Update(ctx, id, normalized)
 	if err != nil {
 		return nil, err
@@ -78,9 +108,119 @@ func (s *Service) UpdateEmployee(ctx context.Context, _ *models.Claims, id int64
 		return nil, ErrNotFound
 	}
 
+	if normalized.SalaryChange != nil {
+		s.recordSalaryChangeAudit(ctx, claims, id, current.BaseSalaryAmount, *normalized.SalaryChange)
+	}
+
 	return employee, nil
 }
 
+// RecordSalaryChange adds a dated rate to an employee's salary history. A back-dated change is picked up as
+// arrears by payroll for months that were already locked.
+func (s *Service) RecordSalaryChange(ctx context.Context, claims *models.Claims, employeeID int64, input RecordSalaryChangeInput) (*SalaryChange, error) {
+	if employeeID <= 0 {
+		return nil, newFieldValidationError("employeeId", "must be positive")
+	}
+	if input.Amount < 0 {
+		return nil, newFieldValidationError("amount", "must be >= 0")
+	}
+	effectiveFrom, err := time.Parse("2006-01-02", strings.TrimSpace(input.EffectiveFrom))
+	if err != nil {
+		return nil, newFieldValidationError("effectiveFrom", "must use YYYY-MM-DD")
+	}
+	reason := strings.TrimSpace(input.Reason)
+	if reason == "" {
+		return nil, newFieldValidationError("reason", "is required")
+	}
+	if len(reason) > maxSalaryChangeReasonLength {
+		return nil, newFieldValidationError("reason", fmt.Sprintf("must be at most %d characters", maxSalaryChangeReasonLength))
+	}
+
+	employee, err := s.repository.GetByID(ctx, employeeID)
+	if err != nil {
+		return nil, err
+	}
+	if employee == nil {
+		return nil, ErrNotFound
+	}
+	if effectiveFrom.Before(employee.DateOfHire) {
+		return nil, newFieldValidationError("effectiveFrom", "must be on/after dateOfHire")
+	}
+
+	insert := SalaryChangeInsert{
+		Amount:        input.Amount,
+		EffectiveFrom: effectiveFrom,
+		Reason:        reason,
+		ApprovedBy:    claimsUserID(claims),
+	}
+	change, err := s.repository.RecordSalaryChange(ctx, employeeID, insert)
+	if err != nil {
+		return nil, err
+	}
+	if change == nil {
+		return nil, ErrNotFound
+	}
+	s.recordSalaryChangeAudit(ctx, claims, employeeID, employee.BaseSalaryAmount, insert)
+	return change, nil
+}
+
+func (s *Service) ListSalaryHistory(ctx context.Context, _ *models.Claims, employeeID int64) ([]SalaryChange, error) {
+	if employeeID <= 0 {
+		return nil, newFieldValidationError("employeeId", "must be positive")
+	}
+	employee, err := s.repository.GetByID(ctx, employeeID)
+	if err != nil {
+		return nil, err
+	}
+	if employee == nil {
+		return nil, ErrNotFound
+	}
+	return s.repository.ListSalaryHistory(ctx, employeeID)
+}
+
+func (s *Service) recordSalaryChangeAudit(ctx context.Context, claims *models.Claims, employeeID int64, previous money.Amount, change SalaryChangeInsert) {
+	s.audit.RecordAuditEvent(ctx, claimsUserID(claims), "employee.salary.change", stringPtr("employee"), &employeeID, map[string]any{
+		"previous_amount": previous,
+		"amount":          change.Amount,
+		"effective_from":  change.EffectiveFrom.Format("2006-01-02"),
+		"reason":          change.Reason,
+	})
+}
+
+// salaryChangeFromUpdate turns a changed base salary on the employee form into a salary history entry,
+// effective today unless the form gives another date.
+func salaryChangeFromUpdate(current Employee, normalized RepositoryUpsertInput, input UpsertEmployeeInput, approvedBy *int64, today time.Time) (*SalaryChangeInsert, error) {
+	effectiveFrom, err := parseOptionalDate(input.SalaryEffectiveFrom)
+	if err != nil {
+		return nil, newFieldValidationError("salaryEffectiveFrom", "must use YYYY-MM-DD")
+	}
+	amount := normalized.BaseSalaryAmount
+	if effectiveFrom == nil && amount == current.BaseSalaryAmount {
+		return nil, nil
+	}
+	if effectiveFrom == nil {
+		effectiveFrom = &today
+	}
+	if effectiveFrom.Before(normalized.DateOfHire) {
+		return nil, newFieldValidationError("salaryEffectiveFrom", "must be on/after dateOfHire")
+	}
+
+	reason := "Salary updated"
+	if value := normalizeOptional(input.SalaryChangeReason); value != nil {
+		reason = *value
+	}
+	if len(reason) > maxSalaryChangeReasonLength {
+		return nil, newFieldValidationError("salaryChangeReason", fmt.Sprintf("must be at most %d characters", maxSalaryChangeReasonLength))
+	}
+
+	return &SalaryChangeInsert{
+		Amount:        amount,
+		EffectiveFrom: *effectiveFrom,
+		Reason:        reason,
+		ApprovedBy:    approvedBy,
+	}, nil
+}
+
 func (s *Service) UploadEmployeeContract(
 	ctx context.Context,
 	claims *models.Claims,
@@ -285,6 +425,13 @@ func (s *Service) normalizeAndValidate(ctx context.Context, input UpsertEmployee
 		return RepositoryUpsertInput{}, newFieldValidationError("dateOfBirth", "must use YYYY-MM-DD")
 	}
 
+	if normalized.DateOfExit, err = parseOptionalDate(input.DateOfExit); err != nil {
+		return RepositoryUpsertInput{}, newFieldValidationError("dateOfExit", "must use YYYY-MM-DD")
+	}
+	if normalized.DateOfExit != nil && normalized.DateOfExit.Before(hireDate) {
+		return RepositoryUpsertInput{}, newFieldValidationError("dateOfExit", "must be on/after dateOfHire")
+	}
+
 	if normalized.Gender, err = normalizeGender(input.Gender); err != nil {
 		return RepositoryUpsertInput{}, err
 	}
@@ -319,6 +466,23 @@ func (s *Service) normalizeAndValidate(ctx context.Context, input UpsertEmployee
 	return normalized, nil
 }
 
+func claimsUserID(claims *models.Claims) *int64 {
+	if claims == nil || claims.UserID <= 0 {
+		return nil
+	}
+	actor := claims.UserID
+	return &actor
+}
+
+func stringPtr(value string) *string {
+	return &value
+}
+
+func today() time.Time {
+	now := time.Now()
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+}
+
 func normalizeOptional(value *string) *string {
 	if value == nil {
 		return nil
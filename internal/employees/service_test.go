@@ -7,13 +7,16 @@ import (
 	"time"
 
 	"hrpro/internal/models"
+	"hrpro/internal/money"
 )
 
 type fakeRepository struct {
 	createInput    RepositoryUpsertInput
+	updateInput    RepositoryUpsertInput
 	employee       *Employee
 	updatedPath    *string
 	updatePathCall int
+	salaryChanges  []SalaryChangeInsert
 }
 
 func (f *fakeRepository) Create(_ context.Context, input RepositoryUpsertInput) (*Employee, error) {
@@ -21,7 +24,8 @@ func (f *fakeRepository) Create(_ context.Context, input RepositoryUpsertInput)
 	return &Employee{ID: 1, FirstName: input.FirstName, LastName: input.LastName, Phone: input.Phone, PhoneE164: input.PhoneE164}, nil
 }
 
-func (f *fakeRepository) Update(_ context.Context, id int64, _ RepositoryUpsertInput) (*Employee, error) {
+func (f *fakeRepository) Update(_ context.Context, id int64, input RepositoryUpsertInput) (*Employee, error) {
+	f.updateInput = input
 	return &Employee{ID: id}, nil
 }
 
@@ -46,6 +50,8 @@ func (f *fakeRepository) GetByID(_ context.Context, id int64) (*Employee, error)
 	return &Employee{
 		ID:               id,
 		ContractFilePath: f.employee.ContractFilePath,
+		DateOfHire:       f.employee.DateOfHire,
+		BaseSalaryAmount: f.employee.BaseSalaryAmount,
 	}, nil
 }
 
@@ -53,6 +59,15 @@ func (f *fakeRepository) List(_ context.Context, _ ListEmployeesQuery) ([]Employ
 	return []Employee{}, 0, nil
 }
 
+func (f *fakeRepository) RecordSalaryChange(_ context.Context, employeeID int64, input SalaryChangeInsert) (*SalaryChange, error) {
+	f.salaryChanges = append(f.salaryChanges, input)
+	return &SalaryChange{ID: int64(len(f.salaryChanges)), EmployeeID: employeeID, Amount: input.Amount, EffectiveFrom: input.EffectiveFrom, Reason: input.Reason, ApprovedBy: input.ApprovedBy}, nil
+}
+
+func (f *fakeRepository) ListSalaryHistory(_ context.Context, _ int64) ([]SalaryChange, error) {
+	return []SalaryChange{}, nil
+}
+
 type fakePhoneDefaultsProvider struct {
 	iso2      string
 	calling   string
@@ -108,6 +123,19 @@ func TestCreateEmployeeValidation(t *testing.T) {
 			},
 			wantErr: ErrValidation,
 		},
+		{
+			name: "exit before hire",
+			input: UpsertEmployeeInput{
+				FirstName:        "Jane",
+				LastName:         "Doe",
+				Gender:           stringPtr("Female"),
+				Position:         "Engineer",
+				EmploymentStatus: "Exited",
+				DateOfHire:       "2026-02-21",
+				DateOfExit:       stringPtr("2026-02-20"),
+			},
+			wantErr: ErrValidation,
+		},
 		{
 			name: "invalid email",
 			input: UpsertEmployeeInput{
@@ -143,7 +171,7 @@ func TestCreateEmployeeValidation(t *testing.T) {
 				Position:         "Engineer",
 				EmploymentStatus: "Active",
 				DateOfHire:       "2026-02-21",
-				BaseSalaryAmount: -1,
+				BaseSalaryAmount: money.FromUnits(-1),
 			},
 			wantErr: ErrValidation,
 		},
@@ -156,7 +184,7 @@ func TestCreateEmployeeValidation(t *testing.T) {
 				Position:         "Engineer",
 				EmploymentStatus: "Active",
 				DateOfHire:       "2026-02-21",
-				BaseSalaryAmount: 1000,
+				BaseSalaryAmount: money.FromUnits(1000),
 				Phone:            stringPtr("+256701234567"),
 				Email:            stringPtr("jane@example.com"),
 			},
@@ -396,6 +424,80 @@ func TestUploadRemoveEmployeeContract(t *testing.T) {
 	}
 }
 
-func stringPtr(value string) *string {
-	return &value
+func TestUpdateEmployeeRecordsChangedSalaryInHistory(t *testing.T) {
+	repo := &fakeRepository{employee: &Employee{
+		DateOfHire:       time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC),
+		BaseSalaryAmount: money.FromUnits(1000000),
+	}}
+	service := NewService(repo)
+	claims := &models.Claims{UserID: 7, Role: "Admin"}
+	input := UpsertEmployeeInput{
+		FirstName:        "Jane",
+		LastName:         "Doe",
+		Gender:           stringPtr("Female"),
+		Position:         "Engineer",
+		EmploymentStatus: "Active",
+		DateOfHire:       "2025-01-06",
+		BaseSalaryAmount: money.FromUnits(1000000),
+	}
+
+	if _, err := service.UpdateEmployee(context.Background(), claims, 1, input); err != nil {
+		t.Fatalf("expected update to succeed, got %v", err)
+	}
+	if repo.updateInput.SalaryChange != nil {
+		t.Fatalf("expected no salary change for an unchanged salary, got %+v", repo.updateInput.SalaryChange)
+	}
+
+	input.BaseSalaryAmount = money.FromUnits(1200000)
+	input.SalaryEffectiveFrom = stringPtr("2026-03-01")
+	input.SalaryChangeReason = stringPtr("Annual increment")
+	if _, err := service.UpdateEmployee(context.Background(), claims, 1, input); err != nil {
+		t.Fatalf("expected update to succeed, got %v", err)
+	}
+	change := repo.updateInput.SalaryChange
+	if change == nil {
+		t.Fatalf("expected salary change to be recorded")
+	}
+	if change.Amount.String() != "1200000.00" || change.EffectiveFrom.Format("2006-01-02") != "2026-03-01" || change.Reason != "Annual increment" {
+		t.Fatalf("unexpected salary change %+v", change)
+	}
+	if change.ApprovedBy == nil || *change.ApprovedBy != 7 {
+		t.Fatalf("expected approver 7, got %v", change.ApprovedBy)
+	}
+
+	input.SalaryEffectiveFrom = stringPtr("2024-12-31")
+	if _, err := service.UpdateEmployee(context.Background(), claims, 1, input); !errors.Is(err, ErrValidation) {
+		t.Fatalf("expected validation error for a change before hire, got %v", err)
+	}
+}
+
+func TestRecordSalaryChangeValidation(t *testing.T) {
+	repo := &fakeRepository{employee: &Employee{DateOfHire: time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)}}
+	service := NewService(repo)
+	claims := &models.Claims{UserID: 7, Role: "HR Officer"}
+
+	tests := []struct {
+		name  string
+		input RecordSalaryChangeInput
+	}{
+		{name: "negative amount", input: RecordSalaryChangeInput{Amount: -1, EffectiveFrom: "2026-01-01", Reason: "Increment"}},
+		{name: "bad date", input: RecordSalaryChangeInput{Amount: 100, EffectiveFrom: "01/01/2026", Reason: "Increment"}},
+		{name: "missing reason", input: RecordSalaryChangeInput{Amount: 100, EffectiveFrom: "2026-01-01", Reason: "  "}},
+		{name: "before hire", input: RecordSalaryChangeInput{Amount: 100, EffectiveFrom: "2025-01-05", Reason: "Increment"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := service.RecordSalaryChange(context.Background(), claims, 1, tc.input); !errors.Is(err, ErrValidation) {
+				t.Fatalf("expected validation error, got %v", err)
+			}
+		})
+	}
+
+	change, err := service.RecordSalaryChange(context.Background(), claims, 1, RecordSalaryChangeInput{Amount: 150000000, EffectiveFrom: "2026-01-01", Reason: " Back-dated increment "})
+	if err != nil {
+		t.Fatalf("expected salary change to be recorded, got %v", err)
+	}
+	if change.Reason != "Back-dated increment" || len(repo.salaryChanges) != 1 {
+		t.Fatalf("unexpected salary change %+v (%d recorded)", change, len(repo.salaryChanges))
+	}
 }
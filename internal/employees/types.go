@@ -1,49 +1,58 @@
 package employees
 
-import "time"
+import (
+	"time"
+
+	"hrpro/internal/money"
+)
 
 type Employee struct {
-	ID               int64      `db:"id" json:"id"`
-	FirstName        string     `db:"first_name" json:"firstName"`
-	LastName         string     `db:"last_name" json:"lastName"`
-	OtherName        *string    `db:"other_name" json:"otherName,omitempty"`
-	Gender           *string    `db:"gender" json:"gender,omitempty"`
-	DateOfBirth      *time.Time `db:"dob" json:"dateOfBirth,omitempty"`
-	Phone            *string    `db:"phone" json:"phone,omitempty"`
-	PhoneE164        *string    `db:"phone_e164" json:"phoneE164,omitempty"`
-	Email            *string    `db:"email" json:"email,omitempty"`
-	NationalID       *string    `db:"national_id" json:"nationalId,omitempty"`
-	Address          *string    `db:"address" json:"address,omitempty"`
-	JobDescription   *string    `db:"job_description" json:"jobDescription,omitempty"`
-	ContractURL      *string    `db:"contract_url" json:"contractUrl,omitempty"`
-	ContractFilePath *string    `db:"contract_file_path" json:"contractFilePath,omitempty"`
-	DepartmentID     *int64     `db:"department_id" json:"departmentId,omitempty"`
-	DepartmentName   *string    `db:"department_name" json:"departmentName,omitempty"`
-	Position         string     `db:"position" json:"position"`
-	EmploymentStatus string     `db:"employment_status" json:"employmentStatus"`
-	DateOfHire       time.Time  `db:"date_of_hire" json:"dateOfHire"`
-	BaseSalaryAmount float64    `db:"base_salary_amount" json:"baseSalaryAmount"`
-	CreatedAt        time.Time  `db:"created_at" json:"createdAt"`
-	UpdatedAt        time.Time  `db:"updated_at" json:"updatedAt"`
+	ID               int64        `db:"id" json:"id"`
+	FirstName        string       `db:"first_name" json:"firstName"`
+	LastName         string       `db:"last_name" json:"lastName"`
+	OtherName        *string      `db:"other_name" json:"otherName,omitempty"`
+	Gender           *string      `db:"gender" json:"gender,omitempty"`
+	DateOfBirth      *time.Time   `db:"dob" json:"dateOfBirth,omitempty"`
+	Phone            *string      `db:"phone" json:"phone,omitempty"`
+	PhoneE164        *string      `db:"phone_e164" json:"phoneE164,omitempty"`
+	Email            *string      `db:"email" json:"email,omitempty"`
+	NationalID       *string      `db:"national_id" json:"nationalId,omitempty"`
+	Address          *string      `db:"address" json:"address,omitempty"`
+	JobDescription   *string      `db:"job_description" json:"jobDescription,omitempty"`
+	ContractURL      *string      `db:"contract_url" json:"contractUrl,omitempty"`
+	ContractFilePath *string      `db:"contract_file_path" json:"contractFilePath,omitempty"`
+	DepartmentID     *int64       `db:"department_id" json:"departmentId,omitempty"`
+	DepartmentName   *string      `db:"department_name" json:"departmentName,omitempty"`
+	Position         string       `db:"position" json:"position"`
+	EmploymentStatus string       `db:"employment_status" json:"employmentStatus"`
+	DateOfHire       time.Time    `db:"date_of_hire" json:"dateOfHire"`
+	DateOfExit       *time.Time   `db:"date_of_exit" json:"dateOfExit,omitempty"`
+	BaseSalaryAmount money.Amount `db:"base_salary_amount" json:"baseSalaryAmount"`
+	CreatedAt        time.Time    `db:"created_at" json:"createdAt"`
+	UpdatedAt        time.Time    `db:"updated_at" json:"updatedAt"`
 }
 
 type UpsertEmployeeInput struct {
-	FirstName        string  `json:"firstName"`
-	LastName         string  `json:"lastName"`
-	OtherName        *string `json:"otherName"`
-	Gender           *string `json:"gender"`
-	DateOfBirth      *string `json:"dateOfBirth"`
-	Phone            *string `json:"phone"`
-	Email            *string `json:"email"`
-	NationalID       *string `json:"nationalId"`
-	Address          *string `json:"address"`
-	JobDescription   *string `json:"jobDescription"`
-	ContractURL      *string `json:"contractUrl"`
-	DepartmentID     *int64  `json:"departmentId"`
-	Position         string  `json:"position"`
-	EmploymentStatus string  `json:"employmentStatus"`
-	DateOfHire       string  `json:"dateOfHire"`
-	BaseSalaryAmount float64 `json:"baseSalaryAmount"`
+	FirstName        string       `json:"firstName"`
+	LastName         string       `json:"lastName"`
+	OtherName        *string      `json:"otherName"`
+	Gender           *string      `json:"gender"`
+	DateOfBirth      *string      `json:"dateOfBirth"`
+	Phone            *string      `json:"phone"`
+	Email            *string      `json:"email"`
+	NationalID       *string      `json:"nationalId"`
+	Address          *string      `json:"address"`
+	JobDescription   *string      `json:"jobDescription"`
+	ContractURL      *string      `json:"contractUrl"`
+	DepartmentID     *int64       `json:"departmentId"`
+	Position         string       `json:"position"`
+	EmploymentStatus string       `json:"employmentStatus"`
+	DateOfHire       string       `json:"dateOfHire"`
+	DateOfExit       *string      `json:"dateOfExit"`
+	BaseSalaryAmount money.Amount `json:"baseSalaryAmount"`
+
+	SalaryEffectiveFrom *string `json:"salaryEffectiveFrom"`
+	SalaryChangeReason  *string `json:"salaryChangeReason"`
 }
 
 type ListEmployeesQuery struct {
@@ -60,3 +69,21 @@ type ListEmployeesResult struct {
 	Page       int        `json:"page"`
 	PageSize   int        `json:"pageSize"`
 }
+
+// SalaryChange is one rate in an employee's salary history, in effect from EffectiveFrom until the next one.
+type SalaryChange struct {
+	ID             int64        `db:"id" json:"id"`
+	EmployeeID     int64        `db:"employee_id" json:"employeeId"`
+	Amount         money.Amount `db:"amount" json:"amount"`
+	EffectiveFrom  time.Time    `db:"effective_from" json:"effectiveFrom"`
+	Reason         string       `db:"reason" json:"reason"`
+	ApprovedBy     *int64       `db:"approved_by" json:"approvedBy,omitempty"`
+	ApprovedByName *string      `db:"approved_by_name" json:"approvedByName,omitempty"`
+	CreatedAt      time.Time    `db:"created_at" json:"createdAt"`
+}
+
+type RecordSalaryChangeInput struct {
+	Amount        money.Amount `json:"amount"`
+	EffectiveFrom string       `json:"effectiveFrom"`
+	Reason        string       `json:"reason"`
+}
@@ -154,6 +154,8 @@ func mapAttendanceError(err error) error {
 		return fmt.Errorf("validation error: %w", err)
 	case errors.Is(err, attendance.ErrNotFound):
 		return fmt.Errorf("not found: %w", err)
+	case errors.Is(err, attendance.ErrEmployeeNotLinked):
+		return fmt.Errorf("no employee record: %w", err)
 	case errors.Is(err, attendance.ErrLocked):
 		return fmt.Errorf("record locked: %w", err)
 	case errors.Is(err, attendance.ErrNotAbsent):
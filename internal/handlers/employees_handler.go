@@ -69,6 +69,17 @@ type RemoveEmployeeContractRequest struct {
 	EmployeeID  int64  `json:"employeeId"`
 }
 
+type RecordSalaryChangeRequest struct {
+	AccessToken string                            `json:"accessToken"`
+	EmployeeID  int64                             `json:"employeeId"`
+	Payload     employees.RecordSalaryChangeInput `json:"payload"`
+}
+
+type ListSalaryHistoryRequest struct {
+	AccessToken string `json:"accessToken"`
+	EmployeeID  int64  `json:"employeeId"`
+}
+
 func NewEmployeesHandler(authService EmployeesAuthService, service *employees.Service) *EmployeesHandler {
 	return &EmployeesHandler{authService: authService, service: service}
 }
@@ -199,6 +210,40 @@ func (h *EmployeesHandler) RemoveEmployeeContract(ctx context.Context, request R
 	return employee, nil
 }
 
+func (h *EmployeesHandler) RecordSalaryChange(ctx context.Context, request RecordSalaryChangeRequest) (*employees.SalaryChange, error) {
+	claims, err := h.validateClaims(request.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := middleware.RequireRoles(claims, "Admin", "HR Officer"); err != nil {
+		return nil, err
+	}
+
+	change, err := h.service.RecordSalaryChange(ctx, claims, request.EmployeeID, request.Payload)
+	if err != nil {
+		return nil, mapEmployeeError(err)
+	}
+	return change, nil
+}
+
+func (h *EmployeesHandler) ListSalaryHistory(ctx context.Context, request ListSalaryHistoryRequest) ([]employees.SalaryChange, error) {
+	claims, err := h.validateClaims(request.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := middleware.RequireRoles(claims, "Admin", "HR Officer", "Finance Officer"); err != nil {
+		return nil, err
+	}
+
+	items, err := h.service.ListSalaryHistory(ctx, claims, request.EmployeeID)
+	if err != nil {
+		return nil, mapEmployeeError(err)
+	}
+	return items, nil
+}
+
 func (h *EmployeesHandler) validateClaims(accessToken string) (*models.Claims, error) {
 	return validateAuthClaims(h.authService, accessToken)
 }
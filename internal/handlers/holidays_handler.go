@@ -0,0 +1,188 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"hrpro/internal/audit"
+	"hrpro/internal/holidays"
+	"hrpro/internal/middleware"
+	"hrpro/internal/models"
+)
+
+type HolidaysAuthService interface {
+	ValidateAccessToken(accessToken string) (*models.Claims, error)
+}
+
+type HolidaysHandler struct {
+	authService HolidaysAuthService
+	service     *holidays.Service
+}
+
+type ListHolidaysRequest struct {
+	AccessToken string `json:"accessToken"`
+	ActiveOnly  bool   `json:"activeOnly"`
+}
+
+type HolidayCalendarRequest struct {
+	AccessToken string `json:"accessToken"`
+	Year        int    `json:"year"`
+}
+
+type CreateHolidayRequest struct {
+	AccessToken string                      `json:"accessToken"`
+	Payload     holidays.HolidayUpsertInput `json:"payload"`
+}
+
+type UpdateHolidayRequest struct {
+	AccessToken string                      `json:"accessToken"`
+	ID          int64                       `json:"id"`
+	Payload     holidays.HolidayUpsertInput `json:"payload"`
+}
+
+type SetHolidayActiveRequest struct {
+	AccessToken string `json:"accessToken"`
+	ID          int64  `json:"id"`
+	Active      bool   `json:"active"`
+}
+
+type DeleteHolidayRequest struct {
+	AccessToken string `json:"accessToken"`
+	ID          int64  `json:"id"`
+}
+
+type ImportHolidaysRequest struct {
+	AccessToken string `json:"accessToken"`
+	Year        int    `json:"year"`
+	Data        string `json:"data"`
+}
+
+func NewHolidaysHandler(authService HolidaysAuthService, service *holidays.Service) *HolidaysHandler {
+	return &HolidaysHandler{authService: authService, service: service}
+}
+
+func (h *HolidaysHandler) ListHolidays(ctx context.Context, request ListHolidaysRequest) ([]holidays.Holiday, error) {
+	if _, err := h.validateClaims(request.AccessToken); err != nil {
+		return nil, err
+	}
+
+	items, err := h.service.ListHolidays(ctx, request.ActiveOnly)
+	if err != nil {
+		return nil, mapHolidayError(err)
+	}
+	return items, nil
+}
+
+func (h *HolidaysHandler) ListHolidayCalendar(ctx context.Context, request HolidayCalendarRequest) ([]holidays.HolidayOccurrence, error) {
+	if _, err := h.validateClaims(request.AccessToken); err != nil {
+		return nil, err
+	}
+
+	items, err := h.service.ListHolidayCalendar(ctx, request.Year)
+	if err != nil {
+		return nil, mapHolidayError(err)
+	}
+	return items, nil
+}
+
+func (h *HolidaysHandler) CreateHoliday(ctx context.Context, request CreateHolidayRequest) (*holidays.Holiday, error) {
+	claims, err := h.validateClaims(request.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := middleware.RequireRoles(claims, "Admin", "HR Officer"); err != nil {
+		return nil, err
+	}
+	ctx = audit.WithActorUserID(ctx, claims.UserID)
+
+	item, err := h.service.CreateHoliday(ctx, claims, request.Payload)
+	if err != nil {
+		return nil, mapHolidayError(err)
+	}
+	return item, nil
+}
+
+func (h *HolidaysHandler) UpdateHoliday(ctx context.Context, request UpdateHolidayRequest) (*holidays.Holiday, error) {
+	claims, err := h.validateClaims(request.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := middleware.RequireRoles(claims, "Admin", "HR Officer"); err != nil {
+		return nil, err
+	}
+	ctx = audit.WithActorUserID(ctx, claims.UserID)
+
+	item, err := h.service.UpdateHoliday(ctx, claims, request.ID, request.Payload)
+	if err != nil {
+		return nil, mapHolidayError(err)
+	}
+	return item, nil
+}
+
+func (h *HolidaysHandler) SetHolidayActive(ctx context.Context, request SetHolidayActiveRequest) (*holidays.Holiday, error) {
+	claims, err := h.validateClaims(request.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := middleware.RequireRoles(claims, "Admin", "HR Officer"); err != nil {
+		return nil, err
+	}
+	ctx = audit.WithActorUserID(ctx, claims.UserID)
+
+	item, err := h.service.SetHolidayActive(ctx, claims, request.ID, request.Active)
+	if err != nil {
+		return nil, mapHolidayError(err)
+	}
+	return item, nil
+}
+
+func (h *HolidaysHandler) DeleteHoliday(ctx context.Context, request DeleteHolidayRequest) error {
+	claims, err := h.validateClaims(request.AccessToken)
+	if err != nil {
+		return err
+	}
+	if err := middleware.RequireRoles(claims, "Admin", "HR Officer"); err != nil {
+		return err
+	}
+	ctx = audit.WithActorUserID(ctx, claims.UserID)
+
+	if err := h.service.DeleteHoliday(ctx, claims, request.ID); err != nil {
+		return mapHolidayError(err)
+	}
+	return nil
+}
+
+func (h *HolidaysHandler) ImportHolidays(ctx context.Context, request ImportHolidaysRequest) (*holidays.HolidayImportResult, error) {
+	claims, err := h.validateClaims(request.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := middleware.RequireRoles(claims, "Admin", "HR Officer"); err != nil {
+		return nil, err
+	}
+	ctx = audit.WithActorUserID(ctx, claims.UserID)
+
+	result, err := h.service.ImportHolidays(ctx, claims, request.Year, request.Data)
+	if err != nil {
+		return nil, mapHolidayError(err)
+	}
+	return result, nil
+}
+
+func (h *HolidaysHandler) validateClaims(accessToken string) (*models.Claims, error) {
+	return validateAuthClaims(h.authService, accessToken)
+}
+
+func mapHolidayError(err error) error {
+	switch {
+	case errors.Is(err, holidays.ErrValidation):
+		return fmt.Errorf("validation error: %w", err)
+	case errors.Is(err, holidays.ErrNotFound):
+		return fmt.Errorf("not found: %w", err)
+	case errors.Is(err, holidays.ErrDuplicateHoliday):
+		return fmt.Errorf("duplicate holiday: %w", err)
+	default:
+		return err
+	}
+}
@@ -246,6 +246,19 @@ func (h *LeaveHandler) UpsertEntitlement(ctx context.Context, request UpsertEnti
 	return item, nil
 }
 
+func (h *LeaveHandler) PreviewLeaveDays(ctx context.Context, request ApplyLeaveRequest) (*leave.LeaveDaysPreview, error) {
+	claims, err := h.validateClaims(request.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := h.service.PreviewLeaveDays(ctx, claims, request.Payload)
+	if err != nil {
+		return nil, mapLeaveError(err)
+	}
+	return item, nil
+}
+
 func (h *LeaveHandler) ApplyLeave(ctx context.Context, request ApplyLeaveRequest) (*leave.LeaveRequest, error) {
 	claims, err := h.validateClaims(request.AccessToken)
 	if err != nil {
@@ -347,6 +360,8 @@ func mapLeaveError(err error) error {
 		return fmt.Errorf("validation error: %w", err)
 	case errors.Is(err, leave.ErrNotFound):
 		return fmt.Errorf("not found: %w", err)
+	case errors.Is(err, leave.ErrEmployeeNotLinked):
+		return fmt.Errorf("no employee record: %w", err)
 	case errors.Is(err, leave.ErrLockedDateConflict):
 		return fmt.Errorf("locked date conflict: %w", err)
 	case errors.Is(err, leave.ErrOverlapApproved):
@@ -40,12 +40,204 @@ type PayrollBatchActionRequest struct {
 	BatchID     int64  `json:"batchId"`
 }
 
+type PayrollApprovalRequest struct {
+	AccessToken string `json:"accessToken"`
+	BatchID     int64  `json:"batchId"`
+	Comment     string `json:"comment"`
+}
+
+type PayrollBatchReasonRequest struct {
+	AccessToken string `json:"accessToken"`
+	BatchID     int64  `json:"batchId"`
+	Reason      string `json:"reason"`
+}
+
 type UpdatePayrollEntryAmountsRequest struct {
 	AccessToken string                          `json:"accessToken"`
 	EntryID     int64                           `json:"entryId"`
 	Payload     payroll.UpdateEntryAmountsInput `json:"payload"`
 }
 
+type ImportPayrollEntryAmountsRequest struct {
+	AccessToken string `json:"accessToken"`
+	BatchID     int64  `json:"batchId"`
+	Data        string `json:"data"`
+}
+
+type ListPayComponentsRequest struct {
+	AccessToken string `json:"accessToken"`
+	ActiveOnly  bool   `json:"activeOnly"`
+}
+
+type CreatePayComponentRequest struct {
+	AccessToken string                          `json:"accessToken"`
+	Payload     payroll.PayComponentUpsertInput `json:"payload"`
+}
+
+type UpdatePayComponentRequest struct {
+	AccessToken string                          `json:"accessToken"`
+	ID          int64                           `json:"id"`
+	Payload     payroll.PayComponentUpsertInput `json:"payload"`
+}
+
+type SetPayComponentActiveRequest struct {
+	AccessToken string `json:"accessToken"`
+	ID          int64  `json:"id"`
+	Active      bool   `json:"active"`
+}
+
+type AddPayrollEntryLineRequest struct {
+	AccessToken string                    `json:"accessToken"`
+	EntryID     int64                     `json:"entryId"`
+	Payload     payroll.AddEntryLineInput `json:"payload"`
+}
+
+type RemovePayrollEntryLineRequest struct {
+	AccessToken string `json:"accessToken"`
+	LineID      int64  `json:"lineId"`
+}
+
+type ListContributionSchemesRequest struct {
+	AccessToken string `json:"accessToken"`
+	ActiveOnly  bool   `json:"activeOnly"`
+}
+
+type CreateContributionSchemeRequest struct {
+	AccessToken string                                `json:"accessToken"`
+	Payload     payroll.ContributionSchemeUpsertInput `json:"payload"`
+}
+
+type UpdateContributionSchemeRequest struct {
+	AccessToken string                                `json:"accessToken"`
+	ID          int64                                 `json:"id"`
+	Payload     payroll.ContributionSchemeUpsertInput `json:"payload"`
+}
+
+type SetContributionSchemeActiveRequest struct {
+	AccessToken string `json:"accessToken"`
+	ID          int64  `json:"id"`
+	Active      bool   `json:"active"`
+}
+
+type ListContributionSchemeMembersRequest struct {
+	AccessToken string `json:"accessToken"`
+	SchemeID    int64  `json:"schemeId"`
+}
+
+type SetContributionSchemeMemberRequest struct {
+	AccessToken string                                   `json:"accessToken"`
+	SchemeID    int64                                    `json:"schemeId"`
+	Payload     payroll.SetContributionSchemeMemberInput `json:"payload"`
+}
+
+type ExportContributionScheduleRequest struct {
+	AccessToken string `json:"accessToken"`
+	BatchID     int64  `json:"batchId"`
+	SchemeID    int64  `json:"schemeId"`
+}
+
+type ListEmployeePaymentMethodsRequest struct {
+	AccessToken string `json:"accessToken"`
+	EmployeeID  int64  `json:"employeeId"`
+}
+
+type SetEmployeePaymentMethodsRequest struct {
+	AccessToken string                               `json:"accessToken"`
+	EmployeeID  int64                                `json:"employeeId"`
+	Methods     []payroll.EmployeePaymentMethodInput `json:"methods"`
+}
+
+type ListPaymentFileFormatsRequest struct {
+	AccessToken string `json:"accessToken"`
+}
+
+type ExportPaymentFileRequest struct {
+	AccessToken string `json:"accessToken"`
+	BatchID     int64  `json:"batchId"`
+	FormatCode  string `json:"formatCode"`
+}
+
+type ListGLAccountMappingsRequest struct {
+	AccessToken string `json:"accessToken"`
+}
+
+type CreateGLAccountMappingRequest struct {
+	AccessToken string                        `json:"accessToken"`
+	Payload     payroll.GLAccountMappingInput `json:"payload"`
+}
+
+type UpdateGLAccountMappingRequest struct {
+	AccessToken string                        `json:"accessToken"`
+	ID          int64                         `json:"id"`
+	Payload     payroll.GLAccountMappingInput `json:"payload"`
+}
+
+type DeleteGLAccountMappingRequest struct {
+	AccessToken string `json:"accessToken"`
+	ID          int64  `json:"id"`
+}
+
+type PayrollJournalRequest struct {
+	AccessToken string `json:"accessToken"`
+	BatchID     int64  `json:"batchId"`
+}
+
+type ExportPayrollJournalRequest struct {
+	AccessToken string `json:"accessToken"`
+	BatchID     int64  `json:"batchId"`
+	Format      string `json:"format"`
+}
+
+type ListProjectsRequest struct {
+	AccessToken string `json:"accessToken"`
+	ActiveOnly  bool   `json:"activeOnly"`
+}
+
+type CreateProjectRequest struct {
+	AccessToken string                     `json:"accessToken"`
+	Payload     payroll.ProjectUpsertInput `json:"payload"`
+}
+
+type UpdateProjectRequest struct {
+	AccessToken string                     `json:"accessToken"`
+	ID          int64                      `json:"id"`
+	Payload     payroll.ProjectUpsertInput `json:"payload"`
+}
+
+type SetProjectActiveRequest struct {
+	AccessToken string `json:"accessToken"`
+	ID          int64  `json:"id"`
+	Active      bool   `json:"active"`
+}
+
+type ListEmployeeProjectAllocationsRequest struct {
+	AccessToken string `json:"accessToken"`
+	EmployeeID  int64  `json:"employeeId"`
+}
+
+type SetEmployeeProjectAllocationsRequest struct {
+	AccessToken string                           `json:"accessToken"`
+	EmployeeID  int64                            `json:"employeeId"`
+	Allocations []payroll.ProjectAllocationInput `json:"allocations"`
+}
+
+type ProjectChargingReportRequest struct {
+	AccessToken string `json:"accessToken"`
+	Month       string `json:"month"`
+	ProjectID   int64  `json:"projectId"`
+}
+
+type AnnualTaxSummaryRequest struct {
+	AccessToken string `json:"accessToken"`
+	Year        int    `json:"year"`
+	StartMonth  int    `json:"startMonth"`
+}
+
+type SimulatePayrollRequest struct {
+	AccessToken string                         `json:"accessToken"`
+	Payload     payroll.PayrollSimulationInput `json:"payload"`
+}
+
 func NewPayrollHandler(authService PayrollAuthService, service *payroll.Service) *PayrollHandler {
 	return &PayrollHandler{authService: authService, service: service}
 }
@@ -55,7 +247,7 @@ func (h *PayrollHandler) ListPayrollBatches(ctx context.Context, request ListPay
 	if err != nil {
 		return nil, err
 	}
-	if err := middleware.RequireRoles(claims, "Admin", "Finance Officer"); err != nil {
+	if err := middleware.RequireRoles(claims, "Admin", "HR Officer", "Finance Officer"); err != nil {
 		return nil, err
 	}
 	ctx = audit.WithActorUserID(ctx, claims.UserID)
@@ -89,7 +281,7 @@ func (h *PayrollHandler) GetPayrollBatch(ctx context.Context, request GetPayroll
 	if err != nil {
 		return nil, err
 	}
-	if err := middleware.RequireRoles(claims, "Admin", "Finance Officer"); err != nil {
+	if err := middleware.RequireRoles(claims, "Admin", "HR Officer", "Finance Officer"); err != nil {
 		return nil, err
 	}
 	ctx = audit.WithActorUserID(ctx, claims.UserID)
@@ -134,7 +326,7 @@ func (h *PayrollHandler) UpdatePayrollEntryAmounts(ctx context.Context, request
 	return entry, nil
 }
 
-func (h *PayrollHandler) ApprovePayrollBatch(ctx context.Context, request PayrollBatchActionRequest) (*payroll.PayrollBatch, error) {
+func (h *PayrollHandler) ImportPayrollEntryAmounts(ctx context.Context, request ImportPayrollEntryAmountsRequest) (*payroll.EntryAmountsImportResult, error) {
 	claims, err := h.validateClaims(request.AccessToken)
 	if err != nil {
 		return nil, err
@@ -144,7 +336,77 @@ func (h *PayrollHandler) ApprovePayrollBatch(ctx context.Context, request Payrol
 	}
 	ctx = audit.WithActorUserID(ctx, claims.UserID)
 
-	batch, err := h.service.ApprovePayrollBatch(ctx, claims, request.BatchID)
+	result, err := h.service.ImportPayrollEntryAmounts(ctx, request.BatchID, request.Data)
+	if err != nil {
+		return nil, mapPayrollError(err)
+	}
+	return result, nil
+}
+
+// ApprovePayrollBatch is open to every role that can appear in the approval chain; the service checks the
+// role of the step being approved.
+func (h *PayrollHandler) ApprovePayrollBatch(ctx context.Context, request PayrollApprovalRequest) (*payroll.PayrollBatch, error) {
+	claims, err := h.validateClaims(request.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := middleware.RequireRoles(claims, "Admin", "HR Officer", "Finance Officer"); err != nil {
+		return nil, err
+	}
+	ctx = audit.WithActorUserID(ctx, claims.UserID)
+
+	batch, err := h.service.ApprovePayrollBatch(ctx, claims, request.BatchID, request.Comment)
+	if err != nil {
+		return nil, mapPayrollError(err)
+	}
+	return batch, nil
+}
+
+func (h *PayrollHandler) RejectPayrollBatch(ctx context.Context, request PayrollApprovalRequest) (*payroll.PayrollBatch, error) {
+	claims, err := h.validateClaims(request.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := middleware.RequireRoles(claims, "Admin", "HR Officer", "Finance Officer"); err != nil {
+		return nil, err
+	}
+	ctx = audit.WithActorUserID(ctx, claims.UserID)
+
+	batch, err := h.service.RejectPayrollBatch(ctx, claims, request.BatchID, request.Comment)
+	if err != nil {
+		return nil, mapPayrollError(err)
+	}
+	return batch, nil
+}
+
+func (h *PayrollHandler) ReopenPayrollBatch(ctx context.Context, request PayrollBatchReasonRequest) (*payroll.PayrollBatch, error) {
+	claims, err := h.validateClaims(request.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := middleware.RequireRoles(claims, "Admin"); err != nil {
+		return nil, err
+	}
+	ctx = audit.WithActorUserID(ctx, claims.UserID)
+
+	batch, err := h.service.ReopenPayrollBatch(ctx, claims, request.BatchID, request.Reason)
+	if err != nil {
+		return nil, mapPayrollError(err)
+	}
+	return batch, nil
+}
+
+func (h *PayrollHandler) ReversePayrollBatch(ctx context.Context, request PayrollBatchReasonRequest) (*payroll.PayrollBatch, error) {
+	claims, err := h.validateClaims(request.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := middleware.RequireRoles(claims, "Admin"); err != nil {
+		return nil, err
+	}
+	ctx = audit.WithActorUserID(ctx, claims.UserID)
+
+	batch, err := h.service.ReversePayrollBatch(ctx, claims, request.BatchID, request.Reason)
 	if err != nil {
 		return nil, mapPayrollError(err)
 	}
@@ -168,6 +430,23 @@ func (h *PayrollHandler) LockPayrollBatch(ctx context.Context, request PayrollBa
 	return batch, nil
 }
 
+func (h *PayrollHandler) VerifyPayrollBatchIntegrity(ctx context.Context, request PayrollBatchActionRequest) (*payroll.BatchIntegrityReport, error) {
+	claims, err := h.validateClaims(request.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := middleware.RequireRoles(claims, "Admin", "Finance Officer"); err != nil {
+		return nil, err
+	}
+	ctx = audit.WithActorUserID(ctx, claims.UserID)
+
+	report, err := h.service.VerifyPayrollBatchIntegrity(ctx, request.BatchID)
+	if err != nil {
+		return nil, mapPayrollError(err)
+	}
+	return report, nil
+}
+
 func (h *PayrollHandler) ExportPayrollBatchCSV(ctx context.Context, request PayrollBatchActionRequest) (*payroll.CSVExport, error) {
 	claims, err := h.validateClaims(request.AccessToken)
 	if err != nil {
@@ -185,27 +464,779 @@ func (h *PayrollHandler) ExportPayrollBatchCSV(ctx context.Context, request Payr
 	return csvText, nil
 }
 
-func (h *PayrollHandler) validateClaims(accessToken string) (*models.Claims, error) {
-	return validateAuthClaims(h.authService, accessToken)
+func (h *PayrollHandler) ListPayComponents(ctx context.Context, request ListPayComponentsRequest) ([]payroll.PayComponent, error) {
+	claims, err := h.validateClaims(request.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := middleware.RequireRoles(claims, "Admin", "Finance Officer"); err != nil {
+		return nil, err
+	}
+
+	items, err := h.service.ListPayComponents(ctx, request.ActiveOnly)
+	if err != nil {
+		return nil, mapPayrollError(err)
+	}
+	return items, nil
 }
 
-func mapPayrollError(err error) error {
-	switch {
-	case errors.Is(err, payroll.ErrValidation):
-		return fmt.Errorf("validation error: %w", err)
-	case errors.Is(err, payroll.ErrDuplicateMonth):
-		return fmt.Errorf("duplicate month: %w", err)
-	case errors.Is(err, payroll.ErrNotFound):
-		return fmt.Errorf("not found: %w", err)
-	case errors.Is(err, payroll.ErrInvalidTransition):
-		return fmt.Errorf("invalid status transition: %w", err)
-	case errors.Is(err, payroll.ErrImmutableBatch):
-		return fmt.Errorf("batch is immutable: %w", err)
-	case errors.Is(err, payroll.ErrExportNotAllowed):
-		return fmt.Errorf("export not allowed: %w", err)
-	case errors.Is(err, payroll.ErrForbidden), errors.Is(err, middleware.ErrForbidden):
-		return middleware.ErrForbidden
-	default:
-		return err
+func (h *PayrollHandler) CreatePayComponent(ctx context.Context, request CreatePayComponentRequest) (*payroll.PayComponent, error) {
+	claims, err := h.validateClaims(request.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := middleware.RequireRoles(claims, "Admin", "Finance Officer"); err != nil {
+		return nil, err
+	}
+	ctx = audit.WithActorUserID(ctx, claims.UserID)
+
+	item, err := h.service.CreatePayComponent(ctx, request.Payload)
+	if err != nil {
+		return nil, mapPayrollError(err)
+	}
+	return item, nil
+}
+
+func (h *PayrollHandler) UpdatePayComponent(ctx context.Context, request UpdatePayComponentRequest) (*payroll.PayComponent, error) {
+	claims, err := h.validateClaims(request.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := middleware.RequireRoles(claims, "Admin", "Finance Officer"); err != nil {
+		return nil, err
+	}
+	ctx = audit.WithActorUserID(ctx, claims.UserID)
+
+	item, err := h.service.UpdatePayComponent(ctx, request.ID, request.Payload)
+	if err != nil {
+		return nil, mapPayrollError(err)
+	}
+	return item, nil
+}
+
+func (h *PayrollHandler) SetPayComponentActive(ctx context.Context, request SetPayComponentActiveRequest) (*payroll.PayComponent, error) {
+	claims, err := h.validateClaims(request.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := middleware.RequireRoles(claims, "Admin", "Finance Officer"); err != nil {
+		return nil, err
+	}
+	ctx = audit.WithActorUserID(ctx, claims.UserID)
+
+	item, err := h.service.SetPayComponentActive(ctx, request.ID, request.Active)
+	if err != nil {
+		return nil, mapPayrollError(err)
+	}
+	return item, nil
+}
+
+func (h *PayrollHandler) AddPayrollEntryLine(ctx context.Context, request AddPayrollEntryLineRequest) (*payroll.PayrollEntry, error) {
+	claims, err := h.validateClaims(request.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := middleware.RequireRoles(claims, "Admin", "Finance Officer"); err != nil {
+		return nil, err
+	}
+	ctx = audit.WithActorUserID(ctx, claims.UserID)
+
+	entry, err := h.service.AddPayrollEntryLine(ctx, request.EntryID, request.Payload)
+	if err != nil {
+		return nil, mapPayrollError(err)
+	}
+	return entry, nil
+}
+
+func (h *PayrollHandler) RemovePayrollEntryLine(ctx context.Context, request RemovePayrollEntryLineRequest) (*payroll.PayrollEntry, error) {
+	claims, err := h.validateClaims(request.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := middleware.RequireRoles(claims, "Admin", "Finance Officer"); err != nil {
+		return nil, err
+	}
+	ctx = audit.WithActorUserID(ctx, claims.UserID)
+
+	entry, err := h.service.RemovePayrollEntryLine(ctx, request.LineID)
+	if err != nil {
+		return nil, mapPayrollError(err)
+	}
+	return entry, nil
+}
+
+func (h *PayrollHandler) ListContributionSchemes(ctx context.Context, request ListContributionSchemesRequest) ([]payroll.ContributionScheme, error) {
+	claims, err := h.validateClaims(request.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := middleware.RequireRoles(claims, "Admin", "Finance Officer"); err != nil {
+		return nil, err
+	}
+	ctx = audit.WithActorUserID(ctx, claims.UserID)
+
+	items, err := h.service.ListContributionSchemes(ctx, request.ActiveOnly)
+	if err != nil {
+		return nil, mapPayrollError(err)
+	}
+	return items, nil
+}
+
+func (h *PayrollHandler) CreateContributionScheme(ctx context.Context, request CreateContributionSchemeRequest) (*payroll.ContributionScheme, error) {
+	claims, err := h.validateClaims(request.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := middleware.RequireRoles(claims, "Admin", "Finance Officer"); err != nil {
+		return nil, err
+	}
+	ctx = audit.WithActorUserID(ctx, claims.UserID)
+
+	item, err := h.service.CreateContributionScheme(ctx, request.Payload)
+	if err != nil {
+		return nil, mapPayrollError(err)
+	}
+	return item, nil
+}
+
+func (h *PayrollHandler) UpdateContributionScheme(ctx context.Context, request UpdateContributionSchemeRequest) (*payroll.ContributionScheme, error) {
+	claims, err := h.validateClaims(request.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := middleware.RequireRoles(claims, "Admin", "Finance Officer"); err != nil {
+		return nil, err
+	}
+	ctx = audit.WithActorUserID(ctx, claims.UserID)
+
+	item, err := h.service.UpdateContributionScheme(ctx, request.ID, request.Payload)
+	if err != nil {
+		return nil, mapPayrollError(err)
+	}
+	return item, nil
+}
+
+func (h *PayrollHandler) SetContributionSchemeActive(ctx context.Context, request SetContributionSchemeActiveRequest) (*payroll.ContributionScheme, error) {
+	claims, err := h.validateClaims(request.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := middleware.RequireRoles(claims, "Admin", "Finance Officer"); err != nil {
+		return nil, err
+	}
+	ctx = audit.WithActorUserID(ctx, claims.UserID)
+
+	item, err := h.service.SetContributionSchemeActive(ctx, request.ID, request.Active)
+	if err != nil {
+		return nil, mapPayrollError(err)
+	}
+	return item, nil
+}
+
+func (h *PayrollHandler) ListContributionSchemeMembers(ctx context.Context, request ListContributionSchemeMembersRequest) ([]payroll.ContributionSchemeMember, error) {
+	claims, err := h.validateClaims(request.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := middleware.RequireRoles(claims, "Admin", "Finance Officer"); err != nil {
+		return nil, err
+	}
+	ctx = audit.WithActorUserID(ctx, claims.UserID)
+
+	items, err := h.service.ListContributionSchemeMembers(ctx, request.SchemeID)
+	if err != nil {
+		return nil, mapPayrollError(err)
+	}
+	return items, nil
+}
+
+func (h *PayrollHandler) SetContributionSchemeMember(ctx context.Context, request SetContributionSchemeMemberRequest) (*payroll.ContributionSchemeMember, error) {
+	claims, err := h.validateClaims(request.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := middleware.RequireRoles(claims, "Admin", "Finance Officer"); err != nil {
+		return nil, err
+	}
+	ctx = audit.WithActorUserID(ctx, claims.UserID)
+
+	item, err := h.service.SetContributionSchemeMember(ctx, request.SchemeID, request.Payload)
+	if err != nil {
+		return nil, mapPayrollError(err)
+	}
+	return item, nil
+}
+
+func (h *PayrollHandler) ExportContributionScheduleCSV(ctx context.Context, request ExportContributionScheduleRequest) (*payroll.CSVExport, error) {
+	claims, err := h.validateClaims(request.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := middleware.RequireRoles(claims, "Admin", "Finance Officer"); err != nil {
+		return nil, err
+	}
+	ctx = audit.WithActorUserID(ctx, claims.UserID)
+
+	item, err := h.service.ExportContributionScheduleCSV(ctx, request.BatchID, request.SchemeID)
+	if err != nil {
+		return nil, mapPayrollError(err)
+	}
+	return item, nil
+}
+
+type PayrollVarianceRequest struct {
+	AccessToken     string `json:"accessToken"`
+	BatchID         int64  `json:"batchId"`
+	PreviousBatchID int64  `json:"previousBatchId"`
+}
+
+type RetroArrearsRequest struct {
+	AccessToken string `json:"accessToken"`
+	Month       string `json:"month"`
+	EmployeeID  int64  `json:"employeeId"`
+}
+
+type ListLoansRequest struct {
+	AccessToken string                  `json:"accessToken"`
+	Filter      payroll.ListLoansFilter `json:"filter"`
+}
+
+type CreateLoanRequest struct {
+	AccessToken string                  `json:"accessToken"`
+	Payload     payroll.LoanCreateInput `json:"payload"`
+}
+
+type LoanActionRequest struct {
+	AccessToken string `json:"accessToken"`
+	LoanID      int64  `json:"loanId"`
+}
+
+type ExportLoanLedgerRequest struct {
+	AccessToken string `json:"accessToken"`
+	EmployeeID  int64  `json:"employeeId"`
+}
+
+func (h *PayrollHandler) ListEmployeePaymentMethods(ctx context.Context, request ListEmployeePaymentMethodsRequest) ([]payroll.EmployeePaymentMethod, error) {
+	claims, err := h.validateClaims(request.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := middleware.RequireRoles(claims, "Admin", "Finance Officer"); err != nil {
+		return nil, err
+	}
+	ctx = audit.WithActorUserID(ctx, claims.UserID)
+
+	items, err := h.service.ListEmployeePaymentMethods(ctx, request.EmployeeID)
+	if err != nil {
+		return nil, mapPayrollError(err)
+	}
+	return items, nil
+}
+
+func (h *PayrollHandler) SetEmployeePaymentMethods(ctx context.Context, request SetEmployeePaymentMethodsRequest) ([]payroll.EmployeePaymentMethod, error) {
+	claims, err := h.validateClaims(request.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := middleware.RequireRoles(claims, "Admin", "Finance Officer"); err != nil {
+		return nil, err
+	}
+	ctx = audit.WithActorUserID(ctx, claims.UserID)
+
+	items, err := h.service.SetEmployeePaymentMethods(ctx, request.EmployeeID, request.Methods)
+	if err != nil {
+		return nil, mapPayrollError(err)
+	}
+	return items, nil
+}
+
+func (h *PayrollHandler) ListPaymentFileFormats(_ context.Context, request ListPaymentFileFormatsRequest) ([]payroll.PaymentFileFormatInfo, error) {
+	claims, err := h.validateClaims(request.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := middleware.RequireRoles(claims, "Admin", "Finance Officer"); err != nil {
+		return nil, err
+	}
+	return h.service.ListPaymentFileFormats(), nil
+}
+
+func (h *PayrollHandler) ExportPaymentFile(ctx context.Context, request ExportPaymentFileRequest) (*payroll.CSVExport, error) {
+	claims, err := h.validateClaims(request.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := middleware.RequireRoles(claims, "Admin", "Finance Officer"); err != nil {
+		return nil, err
+	}
+	ctx = audit.WithActorUserID(ctx, claims.UserID)
+
+	item, err := h.service.ExportPaymentFile(ctx, request.BatchID, request.FormatCode)
+	if err != nil {
+		return nil, mapPayrollError(err)
+	}
+	return item, nil
+}
+
+func (h *PayrollHandler) ListGLAccountMappings(ctx context.Context, request ListGLAccountMappingsRequest) ([]payroll.GLAccountMapping, error) {
+	claims, err := h.validateClaims(request.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := middleware.RequireRoles(claims, "Admin", "Finance Officer"); err != nil {
+		return nil, err
+	}
+	ctx = audit.WithActorUserID(ctx, claims.UserID)
+
+	items, err := h.service.ListGLAccountMappings(ctx)
+	if err != nil {
+		return nil, mapPayrollError(err)
+	}
+	return items, nil
+}
+
+func (h *PayrollHandler) CreateGLAccountMapping(ctx context.Context, request CreateGLAccountMappingRequest) (*payroll.GLAccountMapping, error) {
+	claims, err := h.validateClaims(request.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := middleware.RequireRoles(claims, "Admin", "Finance Officer"); err != nil {
+		return nil, err
+	}
+	ctx = audit.WithActorUserID(ctx, claims.UserID)
+
+	item, err := h.service.CreateGLAccountMapping(ctx, request.Payload)
+	if err != nil {
+		return nil, mapPayrollError(err)
+	}
+	return item, nil
+}
+
+func (h *PayrollHandler) UpdateGLAccountMapping(ctx context.Context, request UpdateGLAccountMappingRequest) (*payroll.GLAccountMapping, error) {
+	claims, err := h.validateClaims(request.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := middleware.RequireRoles(claims, "Admin", "Finance Officer"); err != nil {
+		return nil, err
+	}
+	ctx = audit.WithActorUserID(ctx, claims.UserID)
+
+	item, err := h.service.UpdateGLAccountMapping(ctx, request.ID, request.Payload)
+	if err != nil {
+		return nil, mapPayrollError(err)
+	}
+	return item, nil
+}
+
+func (h *PayrollHandler) DeleteGLAccountMapping(ctx context.Context, request DeleteGLAccountMappingRequest) error {
+	claims, err := h.validateClaims(request.AccessToken)
+	if err != nil {
+		return err
+	}
+	if err := middleware.RequireRoles(claims, "Admin", "Finance Officer"); err != nil {
+		return err
+	}
+	ctx = audit.WithActorUserID(ctx, claims.UserID)
+
+	if err := h.service.DeleteGLAccountMapping(ctx, request.ID); err != nil {
+		return mapPayrollError(err)
+	}
+	return nil
+}
+
+func (h *PayrollHandler) GetPayrollJournal(ctx context.Context, request PayrollJournalRequest) (*payroll.PayrollJournal, error) {
+	claims, err := h.validateClaims(request.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := middleware.RequireRoles(claims, "Admin", "Finance Officer"); err != nil {
+		return nil, err
+	}
+	ctx = audit.WithActorUserID(ctx, claims.UserID)
+
+	journal, err := h.service.GetPayrollJournal(ctx, request.BatchID)
+	if err != nil {
+		return nil, mapPayrollError(err)
+	}
+	return journal, nil
+}
+
+func (h *PayrollHandler) ExportPayrollJournal(ctx context.Context, request ExportPayrollJournalRequest) (*payroll.CSVExport, error) {
+	claims, err := h.validateClaims(request.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := middleware.RequireRoles(claims, "Admin", "Finance Officer"); err != nil {
+		return nil, err
+	}
+	ctx = audit.WithActorUserID(ctx, claims.UserID)
+
+	item, err := h.service.ExportPayrollJournal(ctx, request.BatchID, request.Format)
+	if err != nil {
+		return nil, mapPayrollError(err)
+	}
+	return item, nil
+}
+
+func (h *PayrollHandler) ListProjects(ctx context.Context, request ListProjectsRequest) ([]payroll.Project, error) {
+	claims, err := h.validateClaims(request.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := middleware.RequireRoles(claims, "Admin", "Finance Officer"); err != nil {
+		return nil, err
+	}
+	ctx = audit.WithActorUserID(ctx, claims.UserID)
+
+	items, err := h.service.ListProjects(ctx, request.ActiveOnly)
+	if err != nil {
+		return nil, mapPayrollError(err)
+	}
+	return items, nil
+}
+
+func (h *PayrollHandler) CreateProject(ctx context.Context, request CreateProjectRequest) (*payroll.Project, error) {
+	claims, err := h.validateClaims(request.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := middleware.RequireRoles(claims, "Admin", "Finance Officer"); err != nil {
+		return nil, err
+	}
+	ctx = audit.WithActorUserID(ctx, claims.UserID)
+
+	item, err := h.service.CreateProject(ctx, request.Payload)
+	if err != nil {
+		return nil, mapPayrollError(err)
+	}
+	return item, nil
+}
+
+func (h *PayrollHandler) UpdateProject(ctx context.Context, request UpdateProjectRequest) (*payroll.Project, error) {
+	claims, err := h.validateClaims(request.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := middleware.RequireRoles(claims, "Admin", "Finance Officer"); err != nil {
+		return nil, err
+	}
+	ctx = audit.WithActorUserID(ctx, claims.UserID)
+
+	item, err := h.service.UpdateProject(ctx, request.ID, request.Payload)
+	if err != nil {
+		return nil, mapPayrollError(err)
+	}
+	return item, nil
+}
+
+func (h *PayrollHandler) SetProjectActive(ctx context.Context, request SetProjectActiveRequest) (*payroll.Project, error) {
+	claims, err := h.validateClaims(request.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := middleware.RequireRoles(claims, "Admin", "Finance Officer"); err != nil {
+		return nil, err
+	}
+	ctx = audit.WithActorUserID(ctx, claims.UserID)
+
+	item, err := h.service.SetProjectActive(ctx, request.ID, request.Active)
+	if err != nil {
+		return nil, mapPayrollError(err)
+	}
+	return item, nil
+}
+
+func (h *PayrollHandler) ListEmployeeProjectAllocations(ctx context.Context, request ListEmployeeProjectAllocationsRequest) ([]payroll.EmployeeProjectAllocation, error) {
+	claims, err := h.validateClaims(request.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := middleware.RequireRoles(claims, "Admin", "Finance Officer"); err != nil {
+		return nil, err
+	}
+	ctx = audit.WithActorUserID(ctx, claims.UserID)
+
+	items, err := h.service.ListEmployeeProjectAllocations(ctx, request.EmployeeID)
+	if err != nil {
+		return nil, mapPayrollError(err)
+	}
+	return items, nil
+}
+
+func (h *PayrollHandler) SetEmployeeProjectAllocations(ctx context.Context, request SetEmployeeProjectAllocationsRequest) ([]payroll.EmployeeProjectAllocation, error) {
+	claims, err := h.validateClaims(request.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := middleware.RequireRoles(claims, "Admin", "Finance Officer"); err != nil {
+		return nil, err
+	}
+	ctx = audit.WithActorUserID(ctx, claims.UserID)
+
+	items, err := h.service.SetEmployeeProjectAllocations(ctx, request.EmployeeID, request.Allocations)
+	if err != nil {
+		return nil, mapPayrollError(err)
+	}
+	return items, nil
+}
+
+func (h *PayrollHandler) GetProjectChargingReport(ctx context.Context, request ProjectChargingReportRequest) (*payroll.ProjectChargingReport, error) {
+	claims, err := h.validateClaims(request.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := middleware.RequireRoles(claims, "Admin", "Finance Officer"); err != nil {
+		return nil, err
+	}
+	ctx = audit.WithActorUserID(ctx, claims.UserID)
+
+	report, err := h.service.GetProjectChargingReport(ctx, request.Month, request.ProjectID)
+	if err != nil {
+		return nil, mapPayrollError(err)
+	}
+	return report, nil
+}
+
+func (h *PayrollHandler) ExportProjectChargingCSV(ctx context.Context, request ProjectChargingReportRequest) (*payroll.CSVExport, error) {
+	claims, err := h.validateClaims(request.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := middleware.RequireRoles(claims, "Admin", "Finance Officer"); err != nil {
+		return nil, err
+	}
+	ctx = audit.WithActorUserID(ctx, claims.UserID)
+
+	item, err := h.service.ExportProjectChargingCSV(ctx, request.Month, request.ProjectID)
+	if err != nil {
+		return nil, mapPayrollError(err)
+	}
+	return item, nil
+}
+
+func (h *PayrollHandler) GetPayrollVarianceReport(ctx context.Context, request PayrollVarianceRequest) (*payroll.VarianceReport, error) {
+	claims, err := h.validateClaims(request.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := middleware.RequireRoles(claims, "Admin", "Finance Officer"); err != nil {
+		return nil, err
+	}
+
+	report, err := h.service.GetPayrollVarianceReport(ctx, request.BatchID, request.PreviousBatchID)
+	if err != nil {
+		return nil, mapPayrollError(err)
+	}
+	return report, nil
+}
+
+func (h *PayrollHandler) AcknowledgePayrollVariance(ctx context.Context, request PayrollBatchActionRequest) (*payroll.PayrollBatch, error) {
+	claims, err := h.validateClaims(request.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := middleware.RequireRoles(claims, "Admin", "Finance Officer"); err != nil {
+		return nil, err
+	}
+	ctx = audit.WithActorUserID(ctx, claims.UserID)
+
+	batch, err := h.service.AcknowledgePayrollVariance(ctx, claims, request.BatchID)
+	if err != nil {
+		return nil, mapPayrollError(err)
+	}
+	return batch, nil
+}
+
+func (h *PayrollHandler) ExportPayrollVarianceCSV(ctx context.Context, request PayrollVarianceRequest) (*payroll.CSVExport, error) {
+	claims, err := h.validateClaims(request.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := middleware.RequireRoles(claims, "Admin", "Finance Officer"); err != nil {
+		return nil, err
+	}
+
+	item, err := h.service.ExportPayrollVarianceCSV(ctx, request.BatchID, request.PreviousBatchID)
+	if err != nil {
+		return nil, mapPayrollError(err)
+	}
+	return item, nil
+}
+
+func (h *PayrollHandler) CalculateRetroArrears(ctx context.Context, request RetroArrearsRequest) ([]payroll.RetroArrears, error) {
+	claims, err := h.validateClaims(request.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := middleware.RequireRoles(claims, "Admin", "Finance Officer"); err != nil {
+		return nil, err
+	}
+
+	items, err := h.service.CalculateRetroArrears(ctx, request.Month, request.EmployeeID)
+	if err != nil {
+		return nil, mapPayrollError(err)
+	}
+	return items, nil
+}
+
+func (h *PayrollHandler) ListLoans(ctx context.Context, request ListLoansRequest) ([]payroll.EmployeeLoan, error) {
+	claims, err := h.validateClaims(request.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := middleware.RequireRoles(claims, "Admin", "Finance Officer"); err != nil {
+		return nil, err
+	}
+
+	items, err := h.service.ListLoans(ctx, request.Filter)
+	if err != nil {
+		return nil, mapPayrollError(err)
+	}
+	return items, nil
+}
+
+func (h *PayrollHandler) CreateLoan(ctx context.Context, request CreateLoanRequest) (*payroll.EmployeeLoan, error) {
+	claims, err := h.validateClaims(request.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := middleware.RequireRoles(claims, "Admin", "Finance Officer"); err != nil {
+		return nil, err
+	}
+	ctx = audit.WithActorUserID(ctx, claims.UserID)
+
+	item, err := h.service.CreateLoan(ctx, claims, request.Payload)
+	if err != nil {
+		return nil, mapPayrollError(err)
+	}
+	return item, nil
+}
+
+func (h *PayrollHandler) CancelLoan(ctx context.Context, request LoanActionRequest) (*payroll.EmployeeLoan, error) {
+	claims, err := h.validateClaims(request.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := middleware.RequireRoles(claims, "Admin", "Finance Officer"); err != nil {
+		return nil, err
+	}
+	ctx = audit.WithActorUserID(ctx, claims.UserID)
+
+	item, err := h.service.CancelLoan(ctx, request.LoanID)
+	if err != nil {
+		return nil, mapPayrollError(err)
+	}
+	return item, nil
+}
+
+func (h *PayrollHandler) GetLoanLedger(ctx context.Context, request LoanActionRequest) (*payroll.LoanLedger, error) {
+	claims, err := h.validateClaims(request.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := middleware.RequireRoles(claims, "Admin", "Finance Officer"); err != nil {
+		return nil, err
+	}
+
+	item, err := h.service.GetLoanLedger(ctx, request.LoanID)
+	if err != nil {
+		return nil, mapPayrollError(err)
+	}
+	return item, nil
+}
+
+func (h *PayrollHandler) ExportLoanLedgerCSV(ctx context.Context, request ExportLoanLedgerRequest) (*payroll.CSVExport, error) {
+	claims, err := h.validateClaims(request.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := middleware.RequireRoles(claims, "Admin", "Finance Officer"); err != nil {
+		return nil, err
+	}
+
+	item, err := h.service.ExportLoanLedgerCSV(ctx, request.EmployeeID)
+	if err != nil {
+		return nil, mapPayrollError(err)
+	}
+	return item, nil
+}
+
+func (h *PayrollHandler) validateClaims(accessToken string) (*models.Claims, error) {
+	return validateAuthClaims(h.authService, accessToken)
+}
+
+func mapPayrollError(err error) error {
+	switch {
+	case errors.Is(err, payroll.ErrValidation):
+		return fmt.Errorf("validation error: %w", err)
+	case errors.Is(err, payroll.ErrDuplicateMonth):
+		return fmt.Errorf("duplicate month: %w", err)
+	case errors.Is(err, payroll.ErrNotFound):
+		return fmt.Errorf("not found: %w", err)
+	case errors.Is(err, payroll.ErrInvalidTransition):
+		return fmt.Errorf("invalid status transition: %w", err)
+	case errors.Is(err, payroll.ErrImmutableBatch):
+		return fmt.Errorf("batch is immutable: %w", err)
+	case errors.Is(err, payroll.ErrExportNotAllowed):
+		return fmt.Errorf("export not allowed: %w", err)
+	case errors.Is(err, payroll.ErrDuplicateComponent):
+		return fmt.Errorf("duplicate component: %w", err)
+	case errors.Is(err, payroll.ErrDuplicateScheme):
+		return fmt.Errorf("duplicate scheme: %w", err)
+	case errors.Is(err, payroll.ErrPaymentNotAllowed):
+		return fmt.Errorf("payment files not allowed: %w", err)
+	case errors.Is(err, payroll.ErrControlTotal):
+		return fmt.Errorf("control total mismatch: %w", err)
+	case errors.Is(err, payroll.ErrVarianceNotReviewed):
+		return fmt.Errorf("variance review required: %w", err)
+	case errors.Is(err, payroll.ErrApprovalStepRole):
+		return fmt.Errorf("approval step not allowed: %w", err)
+	case errors.Is(err, payroll.ErrSelfApproval):
+		return fmt.Errorf("segregation of duties: %w", err)
+	case errors.Is(err, payroll.ErrJournalNotAllowed):
+		return fmt.Errorf("journal not allowed: %w", err)
+	case errors.Is(err, payroll.ErrDuplicateGLMapping):
+		return fmt.Errorf("duplicate GL mapping: %w", err)
+	case errors.Is(err, payroll.ErrDuplicateProject):
+		return fmt.Errorf("duplicate project: %w", err)
+	case errors.Is(err, payroll.ErrForbidden), errors.Is(err, middleware.ErrForbidden):
+		return middleware.ErrForbidden
+	default:
+		return err
+	}
+}
+
+func (h *PayrollHandler) GetAnnualTaxSummary(ctx context.Context, request AnnualTaxSummaryRequest) (*payroll.AnnualTaxSummary, error) {
+	claims, err := h.validateClaims(request.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := middleware.RequireRoles(claims, "Admin", "Finance Officer"); err != nil {
+		return nil, err
+	}
+	ctx = audit.WithActorUserID(ctx, claims.UserID)
+
+	summary, err := h.service.GetAnnualTaxSummary(ctx, request.Year, request.StartMonth)
+	if err != nil {
+		return nil, mapPayrollError(err)
+	}
+	return summary, nil
+}
+
+func (h *PayrollHandler) SimulatePayroll(ctx context.Context, request SimulatePayrollRequest) (*payroll.PayrollSimulation, error) {
+	claims, err := h.validateClaims(request.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := middleware.RequireRoles(claims, "Admin", "Finance Officer"); err != nil {
+		return nil, err
+	}
+	ctx = audit.WithActorUserID(ctx, claims.UserID)
+
+	simulation, err := h.service.SimulatePayroll(ctx, request.Payload)
+	if err != nil {
+		return nil, mapPayrollError(err)
 	}
+	return simulation, nil
 }
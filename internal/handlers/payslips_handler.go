@@ -0,0 +1,129 @@
+package handlers
+
+import (
+	"context"
+
+	"hrpro/internal/audit"
+	"hrpro/internal/middleware"
+	"hrpro/internal/models"
+	"hrpro/internal/payslips"
+)
+
+type PayslipsAuthService interface {
+	ValidateAccessToken(accessToken string) (*models.Claims, error)
+}
+
+type PayslipsHandler struct {
+	authService PayslipsAuthService
+	service     *payslips.Service
+}
+
+type RenderPayslipPDFRequest struct {
+	AccessToken string `json:"accessToken"`
+	EntryID     int64  `json:"entryId"`
+}
+
+type RenderBatchPayslipsRequest struct {
+	AccessToken string `json:"accessToken"`
+	BatchID     int64  `json:"batchId"`
+}
+
+type RenderTaxCertificateRequest struct {
+	AccessToken string `json:"accessToken"`
+	Year        int    `json:"year"`
+	StartMonth  int    `json:"startMonth"`
+	EmployeeID  int64  `json:"employeeId"`
+}
+
+func NewPayslipsHandler(authService PayslipsAuthService, service *payslips.Service) *PayslipsHandler {
+	return &PayslipsHandler{authService: authService, service: service}
+}
+
+func (h *PayslipsHandler) RenderPayslipPDF(ctx context.Context, request RenderPayslipPDFRequest) (*payslips.FileExport, error) {
+	claims, err := h.validateClaims(request.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := middleware.RequireRoles(claims, "Admin", "Finance Officer"); err != nil {
+		return nil, err
+	}
+	ctx = audit.WithActorUserID(ctx, claims.UserID)
+
+	item, err := h.service.RenderPayslipPDF(ctx, claims, request.EntryID)
+	if err != nil {
+		return nil, mapPayrollError(err)
+	}
+	return item, nil
+}
+
+func (h *PayslipsHandler) RenderBatchPayslipsZip(ctx context.Context, request RenderBatchPayslipsRequest) (*payslips.FileExport, error) {
+	claims, err := h.validateClaims(request.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := middleware.RequireRoles(claims, "Admin", "Finance Officer"); err != nil {
+		return nil, err
+	}
+	ctx = audit.WithActorUserID(ctx, claims.UserID)
+
+	item, err := h.service.RenderBatchPayslipsZip(ctx, claims, request.BatchID)
+	if err != nil {
+		return nil, mapPayrollError(err)
+	}
+	return item, nil
+}
+
+func (h *PayslipsHandler) RenderTaxCertificatePDF(ctx context.Context, request RenderTaxCertificateRequest) (*payslips.FileExport, error) {
+	claims, err := h.validateClaims(request.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := middleware.RequireRoles(claims, "Admin", "Finance Officer"); err != nil {
+		return nil, err
+	}
+	ctx = audit.WithActorUserID(ctx, claims.UserID)
+
+	item, err := h.service.RenderTaxCertificatePDF(ctx, claims, request.Year, request.StartMonth, request.EmployeeID)
+	if err != nil {
+		return nil, mapPayrollError(err)
+	}
+	return item, nil
+}
+
+func (h *PayslipsHandler) RenderTaxCertificatesZip(ctx context.Context, request AnnualTaxSummaryRequest) (*payslips.FileExport, error) {
+	claims, err := h.validateClaims(request.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := middleware.RequireRoles(claims, "Admin", "Finance Officer"); err != nil {
+		return nil, err
+	}
+	ctx = audit.WithActorUserID(ctx, claims.UserID)
+
+	item, err := h.service.RenderTaxCertificatesZip(ctx, claims, request.Year, request.StartMonth)
+	if err != nil {
+		return nil, mapPayrollError(err)
+	}
+	return item, nil
+}
+
+func (h *PayslipsHandler) ExportAnnualTaxReturnCSV(ctx context.Context, request AnnualTaxSummaryRequest) (*payslips.FileExport, error) {
+	claims, err := h.validateClaims(request.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := middleware.RequireRoles(claims, "Admin", "Finance Officer"); err != nil {
+		return nil, err
+	}
+	ctx = audit.WithActorUserID(ctx, claims.UserID)
+
+	item, err := h.service.ExportAnnualTaxReturnCSV(ctx, claims, request.Year, request.StartMonth)
+	if err != nil {
+		return nil, mapPayrollError(err)
+	}
+	return item, nil
+}
+
+func (h *PayslipsHandler) validateClaims(accessToken string) (*models.Claims, error) {
+	return validateAuthClaims(h.authService, accessToken)
+}
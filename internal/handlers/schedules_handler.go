@@ -0,0 +1,191 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"hrpro/internal/audit"
+	"hrpro/internal/middleware"
+	"hrpro/internal/models"
+	"hrpro/internal/schedules"
+)
+
+type WorkSchedulesAuthService interface {
+	ValidateAccessToken(accessToken string) (*models.Claims, error)
+}
+
+type WorkSchedulesHandler struct {
+	authService WorkSchedulesAuthService
+	service     *schedules.Service
+}
+
+type ListWorkSchedulesRequest struct {
+	AccessToken string `json:"accessToken"`
+	ActiveOnly  bool   `json:"activeOnly"`
+}
+
+type CreateWorkScheduleRequest struct {
+	AccessToken string                            `json:"accessToken"`
+	Payload     schedules.WorkScheduleUpsertInput `json:"payload"`
+}
+
+type UpdateWorkScheduleRequest struct {
+	AccessToken string                            `json:"accessToken"`
+	ID          int64                             `json:"id"`
+	Payload     schedules.WorkScheduleUpsertInput `json:"payload"`
+}
+
+type SetWorkScheduleActiveRequest struct {
+	AccessToken string `json:"accessToken"`
+	ID          int64  `json:"id"`
+	Active      bool   `json:"active"`
+}
+
+type ListEmployeeWorkSchedulesRequest struct {
+	AccessToken string `json:"accessToken"`
+	EmployeeID  int64  `json:"employeeId"`
+}
+
+type AssignWorkScheduleRequest struct {
+	AccessToken string                            `json:"accessToken"`
+	Payload     schedules.AssignWorkScheduleInput `json:"payload"`
+}
+
+type DeleteEmployeeWorkScheduleRequest struct {
+	AccessToken string `json:"accessToken"`
+	ID          int64  `json:"id"`
+}
+
+func NewWorkSchedulesHandler(authService WorkSchedulesAuthService, service *schedules.Service) *WorkSchedulesHandler {
+	return &WorkSchedulesHandler{authService: authService, service: service}
+}
+
+func (h *WorkSchedulesHandler) ListWorkSchedules(ctx context.Context, request ListWorkSchedulesRequest) ([]schedules.WorkSchedule, error) {
+	if _, err := h.validateClaims(request.AccessToken); err != nil {
+		return nil, err
+	}
+
+	items, err := h.service.ListWorkSchedules(ctx, request.ActiveOnly)
+	if err != nil {
+		return nil, mapWorkScheduleError(err)
+	}
+	return items, nil
+}
+
+func (h *WorkSchedulesHandler) CreateWorkSchedule(ctx context.Context, request CreateWorkScheduleRequest) (*schedules.WorkSchedule, error) {
+	claims, err := h.validateClaims(request.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := middleware.RequireRoles(claims, "Admin", "HR Officer"); err != nil {
+		return nil, err
+	}
+	ctx = audit.WithActorUserID(ctx, claims.UserID)
+
+	item, err := h.service.CreateWorkSchedule(ctx, claims, request.Payload)
+	if err != nil {
+		return nil, mapWorkScheduleError(err)
+	}
+	return item, nil
+}
+
+func (h *WorkSchedulesHandler) UpdateWorkSchedule(ctx context.Context, request UpdateWorkScheduleRequest) (*schedules.WorkSchedule, error) {
+	claims, err := h.validateClaims(request.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := middleware.RequireRoles(claims, "Admin", "HR Officer"); err != nil {
+		return nil, err
+	}
+	ctx = audit.WithActorUserID(ctx, claims.UserID)
+
+	item, err := h.service.UpdateWorkSchedule(ctx, claims, request.ID, request.Payload)
+	if err != nil {
+		return nil, mapWorkScheduleError(err)
+	}
+	return item, nil
+}
+
+func (h *WorkSchedulesHandler) SetWorkScheduleActive(ctx context.Context, request SetWorkScheduleActiveRequest) (*schedules.WorkSchedule, error) {
+	claims, err := h.validateClaims(request.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := middleware.RequireRoles(claims, "Admin", "HR Officer"); err != nil {
+		return nil, err
+	}
+	ctx = audit.WithActorUserID(ctx, claims.UserID)
+
+	item, err := h.service.SetWorkScheduleActive(ctx, claims, request.ID, request.Active)
+	if err != nil {
+		return nil, mapWorkScheduleError(err)
+	}
+	return item, nil
+}
+
+func (h *WorkSchedulesHandler) ListEmployeeWorkSchedules(ctx context.Context, request ListEmployeeWorkSchedulesRequest) ([]schedules.EmployeeWorkSchedule, error) {
+	claims, err := h.validateClaims(request.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := middleware.RequireRoles(claims, "Admin", "HR Officer"); err != nil {
+		return nil, err
+	}
+
+	items, err := h.service.ListEmployeeWorkSchedules(ctx, request.EmployeeID)
+	if err != nil {
+		return nil, mapWorkScheduleError(err)
+	}
+	return items, nil
+}
+
+func (h *WorkSchedulesHandler) AssignWorkSchedule(ctx context.Context, request AssignWorkScheduleRequest) (*schedules.EmployeeWorkSchedule, error) {
+	claims, err := h.validateClaims(request.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := middleware.RequireRoles(claims, "Admin", "HR Officer"); err != nil {
+		return nil, err
+	}
+	ctx = audit.WithActorUserID(ctx, claims.UserID)
+
+	item, err := h.service.AssignWorkSchedule(ctx, claims, request.Payload)
+	if err != nil {
+		return nil, mapWorkScheduleError(err)
+	}
+	return item, nil
+}
+
+func (h *WorkSchedulesHandler) DeleteEmployeeWorkSchedule(ctx context.Context, request DeleteEmployeeWorkScheduleRequest) error {
+	claims, err := h.validateClaims(request.AccessToken)
+	if err != nil {
+		return err
+	}
+	if err := middleware.RequireRoles(claims, "Admin", "HR Officer"); err != nil {
+		return err
+	}
+	ctx = audit.WithActorUserID(ctx, claims.UserID)
+
+	if err := h.service.DeleteEmployeeWorkSchedule(ctx, claims, request.ID); err != nil {
+		return mapWorkScheduleError(err)
+	}
+	return nil
+}
+
+func (h *WorkSchedulesHandler) validateClaims(accessToken string) (*models.Claims, error) {
+	return validateAuthClaims(h.authService, accessToken)
+}
+
+func mapWorkScheduleError(err error) error {
+	switch {
+	case errors.Is(err, schedules.ErrValidation):
+		return fmt.Errorf("validation error: %w", err)
+	case errors.Is(err, schedules.ErrNotFound):
+		return fmt.Errorf("not found: %w", err)
+	case errors.Is(err, schedules.ErrDuplicateSchedule):
+		return fmt.Errorf("duplicate work schedule: %w", err)
+	default:
+		return err
+	}
+}
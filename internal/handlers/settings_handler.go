@@ -45,6 +45,11 @@ type SaveCompanyProfileRequest struct {
 	Payload     settings.SaveCompanyProfileInput `json:"payload"`
 }
 
+type SavePayrollTaxSettingsRequest struct {
+	AccessToken string                      `json:"accessToken"`
+	Payload     settings.PayrollTaxSettings `json:"payload"`
+}
+
 type ImportCompanyLogoFromURLRequest struct {
 	AccessToken string `json:"accessToken"`
 	URL         string `json:"url"`
@@ -166,6 +171,34 @@ func (h *SettingsHandler) GetCompanyLogo(ctx context.Context, request GetCompany
 	return item, nil
 }
 
+func (h *SettingsHandler) GetPayrollTaxSettings(ctx context.Context, request GetSettingsRequest) (*settings.PayrollTaxSettings, error) {
+	claims, err := h.validateClaims(request.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	ctx = audit.WithActorUserID(ctx, claims.UserID)
+
+	item, err := h.service.GetPayrollTaxSettings(ctx, claims)
+	if err != nil {
+		return nil, mapSettingsError(err)
+	}
+	return item, nil
+}
+
+func (h *SettingsHandler) SavePayrollTaxSettings(ctx context.Context, request SavePayrollTaxSettingsRequest) (*settings.PayrollTaxSettings, error) {
+	claims, err := h.validateClaims(request.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	ctx = audit.WithActorUserID(ctx, claims.UserID)
+
+	item, err := h.service.SavePayrollTaxSettings(ctx, claims, request.Payload)
+	if err != nil {
+		return nil, mapSettingsError(err)
+	}
+	return item, nil
+}
+
 func (h *SettingsHandler) validateClaims(accessToken string) (*models.Claims, error) {
 	return validateAuthClaims(h.authService, accessToken)
 }
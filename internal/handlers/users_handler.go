@@ -176,6 +176,8 @@ func mapUsersError(err error) error {
 		return fmt.Errorf("cannot deactivate self: %w", err)
 	case errors.Is(err, users.ErrCannotRemoveOwnAdmin):
 		return fmt.Errorf("cannot remove own admin role: %w", err)
+	case errors.Is(err, users.ErrEmployeeAlreadyLinked):
+		return fmt.Errorf("employee already linked: %w", err)
 	case errors.Is(err, middleware.ErrForbidden):
 		return middleware.ErrForbidden
 	default:
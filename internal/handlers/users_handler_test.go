@@ -32,12 +32,12 @@ func (f *fakeUsersRepository) ExistsByUsernameCaseInsensitive(_ context.Context,
 	return false, nil
 }
 
-func (f *fakeUsersRepository) Create(_ context.Context, username, _ string, role string) (*users.User, error) {
-	return &users.User{ID: 2, Username: username, Role: role, IsActive: true}, nil
+func (f *fakeUsersRepository) Create(_ context.Context, username, _ string, role string, employeeID *int64) (*users.User, error) {
+	return &users.User{ID: 2, Username: username, Role: role, IsActive: true, EmployeeID: employeeID}, nil
 }
 
-func (f *fakeUsersRepository) Update(_ context.Context, id int64, username, role string) (*users.User, error) {
-	return &users.User{ID: id, Username: username, Role: role, IsActive: true}, nil
+func (f *fakeUsersRepository) Update(_ context.Context, id int64, username, role string, employeeID *int64) (*users.User, error) {
+	return &users.User{ID: id, Username: username, Role: role, IsActive: true, EmployeeID: employeeID}, nil
 }
 
 func (f *fakeUsersRepository) UpdatePasswordHash(_ context.Context, _ int64, _ string) (bool, error) {
@@ -48,6 +48,14 @@ func (f *fakeUsersRepository) SetActive(_ context.Context, id int64, active bool
 	return &users.User{ID: id, Username: "u", Role: "viewer", IsActive: active}, nil
 }
 
+func (f *fakeUsersRepository) EmployeeExists(_ context.Context, _ int64) (bool, error) {
+	return true, nil
+}
+
+func (f *fakeUsersRepository) GetByEmployeeID(_ context.Context, _ int64) (*users.User, error) {
+	return nil, nil
+}
+
 func TestUsersHandlerListUsersRequiresAdmin(t *testing.T) {
 	handler := NewUsersHandler(
 		&fakeUsersAuthService{claims: &models.Claims{UserID: 10, Role: "viewer"}},
@@ -0,0 +1,99 @@
+package holidays
+
+import (
+	"sort"
+	"time"
+)
+
+// EasterSunday returns Western Easter Sunday of year, by the anonymous Gregorian algorithm.
+func EasterSunday(year int) time.Time {
+	a := year % 19
+	b := year / 100
+	c := year % 100
+	d := b / 4
+	e := b % 4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i := c / 4
+	k := c % 4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := (h+l-7*m+114)%31 + 1
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+}
+
+// OccurrenceIn returns the date the holiday falls on in year. It reports false when the holiday does not fall
+// in that year, such as a one-off holiday of another year or 29 February outside a leap year.
+func (h Holiday) OccurrenceIn(year int) (time.Time, bool) {
+	switch h.Kind {
+	case KindFixed:
+		if h.Month == nil || h.Day == nil {
+			return time.Time{}, false
+		}
+		date := time.Date(year, time.Month(*h.Month), *h.Day, 0, 0, 0, 0, time.UTC)
+		if date.Month() != time.Month(*h.Month) {
+			return time.Time{}, false
+		}
+		return date, true
+	case KindEaster:
+		if h.EasterOffset == nil {
+			return time.Time{}, false
+		}
+		return EasterSunday(year).AddDate(0, 0, *h.EasterOffset), true
+	case KindOneOff:
+		if h.HolidayDate == nil || h.HolidayDate.Year() != year {
+			return time.Time{}, false
+		}
+		return truncateDate(*h.HolidayDate), true
+	default:
+		return time.Time{}, false
+	}
+}
+
+// Occurrences lists the dates the active holidays fall on between from and to, both inclusive, ordered by date.
+// Two holidays on the same date are both listed.
+func Occurrences(holidays []Holiday, from, to time.Time) []HolidayOccurrence {
+	from, to = truncateDate(from), truncateDate(to)
+	result := make([]HolidayOccurrence, 0)
+	for year := from.Year(); year <= to.Year(); year++ {
+		for _, holiday := range holidays {
+			if !holiday.Active {
+				continue
+			}
+			date, ok := holiday.OccurrenceIn(year)
+			if !ok || date.Before(from) || date.After(to) {
+				continue
+			}
+			result = append(result, HolidayOccurrence{
+				Date:      date.Format("2006-01-02"),
+				HolidayID: holiday.ID,
+				Name:      holiday.Name,
+				Kind:      holiday.Kind,
+			})
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Date < result[j].Date
+	})
+	return result
+}
+
+// Dates returns the distinct dates the active holidays fall on between from and to, ordered.
+func Dates(holidays []Holiday, from, to time.Time) []time.Time {
+	occurrences := Occurrences(holidays, from, to)
+	result := make([]time.Time, 0, len(occurrences))
+	for _, occurrence := range occurrences {
+		date, _ := time.Parse("2006-01-02", occurrence.Date)
+		if n := len(result); n > 0 && result[n-1].Equal(date) {
+			continue
+		}
+		result = append(result, date)
+	}
+	return result
+}
+
+func truncateDate(value time.Time) time.Time {
+	return time.Date(value.Year(), value.Month(), value.Day(), 0, 0, 0, 0, time.UTC)
+}
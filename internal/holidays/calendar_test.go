@@ -0,0 +1,52 @@
+package holidays
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEasterSunday(t *testing.T) {
+	cases := map[int]string{
+		2024: "2024-03-31",
+		2025: "2025-04-20",
+		2026: "2026-04-05",
+		2027: "2027-03-28",
+	}
+	for year, expected := range cases {
+		if got := EasterSunday(year).Format("2006-01-02"); got != expected {
+			t.Fatalf("expected Easter %d on %s, got %s", year, expected, got)
+		}
+	}
+}
+
+func TestOccurrencesExpandEveryKind(t *testing.T) {
+	month, day := 10, 9
+	leapMonth, leapDay := 2, 29
+	goodFriday := -2
+	eid := time.Date(2026, 3, 20, 0, 0, 0, 0, time.UTC)
+	eidLastYear := time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC)
+	items := []Holiday{
+		{ID: 1, Name: "Independence Day", Kind: KindFixed, Month: &month, Day: &day, Active: true},
+		{ID: 2, Name: "Good Friday", Kind: KindEaster, EasterOffset: &goodFriday, Active: true},
+		{ID: 3, Name: "Eid al-Fitr", Kind: KindOneOff, HolidayDate: &eid, Active: true},
+		{ID: 4, Name: "Eid al-Fitr", Kind: KindOneOff, HolidayDate: &eidLastYear, Active: true},
+		{ID: 5, Name: "Leap Day", Kind: KindFixed, Month: &leapMonth, Day: &leapDay, Active: true},
+		{ID: 6, Name: "Retired", Kind: KindFixed, Month: &month, Day: &day, Active: false},
+	}
+
+	got := Occurrences(items, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC))
+	expected := []string{"2026-03-20 Eid al-Fitr", "2026-04-03 Good Friday", "2026-10-09 Independence Day"}
+	if len(got) != len(expected) {
+		t.Fatalf("expected %d occurrences, got %#v", len(expected), got)
+	}
+	for i, occurrence := range got {
+		if occurrence.Date+" "+occurrence.Name != expected[i] {
+			t.Fatalf("expected %q at %d, got %q", expected[i], i, occurrence.Date+" "+occurrence.Name)
+		}
+	}
+
+	dates := Dates(items, time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC))
+	if len(dates) != 2 || dates[0].Format("2006-01-02") != "2024-02-29" || dates[1].Format("2006-01-02") != "2024-03-29" {
+		t.Fatalf("expected leap day and Good Friday 2024, got %v", dates)
+	}
+}
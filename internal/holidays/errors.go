@@ -0,0 +1,9 @@
+package holidays
+
+import "errors"
+
+var (
+	ErrValidation       = errors.New("validation failed")
+	ErrNotFound         = errors.New("holiday not found")
+	ErrDuplicateHoliday = errors.New("holiday already exists")
+)
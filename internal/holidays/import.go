@@ -0,0 +1,275 @@
+package holidays
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"hrpro/internal/models"
+)
+
+const (
+	maxImportBytes = 1 << 20
+	maxImportRows  = 1000
+)
+
+// importedHoliday is one dated holiday read from an import file.
+type importedHoliday struct {
+	row  int
+	date time.Time
+	name string
+}
+
+// ImportHolidays adds the one-off holidays of a year from a CSV file with "Date" (YYYY-MM-DD) and "Name"
+// columns, or from an iCalendar (ICS) file of all-day events. Every holiday must fall in year. A holiday with
+// the same name and date as an existing one is skipped, so a file can be imported again. If any row fails,
+// the errors are returned and nothing is imported.
+func (s *Service) ImportHolidays(ctx context.Context, claims *models.Claims, year int, data string) (*HolidayImportResult, error) {
+	if year < 1900 || year > 2999 {
+		return nil, fmt.Errorf("%w: year is required", ErrValidation)
+	}
+	if len(data) > maxImportBytes {
+		return nil, fmt.Errorf("%w: import file is larger than 1 MB", ErrValidation)
+	}
+	data = strings.TrimPrefix(data, "\ufeff")
+
+	result := &HolidayImportResult{Year: year, Errors: []HolidayImportError{}}
+	var items []importedHoliday
+	var err error
+	format := "csv"
+	if strings.HasPrefix(strings.ToUpper(strings.TrimSpace(data)), "BEGIN:VCALENDAR") {
+		format = "ics"
+		items, result.Errors = readICSHolidays(data)
+	} else {
+		items, result.Errors, err = readCSVHolidays(data)
+		if err != nil {
+			return nil, err
+		}
+	}
+	result.Rows = len(items) + len(result.Errors)
+	if result.Rows == 0 {
+		return nil, fmt.Errorf("%w: import file has no holidays", ErrValidation)
+	}
+
+	existing, err := s.repository.ListHolidays(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+	toCreate := make([]Holiday, 0, len(items))
+	seen := make(map[string]int, len(items))
+	for _, item := range items {
+		message := ""
+		key := item.date.Format("2006-01-02") + "|" + strings.ToLower(item.name)
+		switch {
+		case item.date.Year() != year:
+			message = fmt.Sprintf("%s is not in %d", item.date.Format("2006-01-02"), year)
+		case len(item.name) > 150:
+			message = "name is too long"
+		case seen[key] > 0:
+			message = fmt.Sprintf("holiday is already on row %d", seen[key])
+		}
+		if message != "" {
+			result.Errors = append(result.Errors, HolidayImportError{Row: item.row, Message: message})
+			continue
+		}
+		seen[key] = item.row
+
+		date := item.date
+		holiday := Holiday{Name: item.name, Kind: KindOneOff, HolidayDate: &date, Active: true}
+		duplicate := false
+		for _, current := range existing {
+			if sameHoliday(current, holiday) {
+				duplicate = true
+				break
+			}
+		}
+		if duplicate {
+			result.Skipped++
+			continue
+		}
+		toCreate = append(toCreate, holiday)
+	}
+	if len(result.Errors) > 0 {
+		return result, nil
+	}
+
+	if len(toCreate) > 0 {
+		if err := s.repository.CreateHolidays(ctx, toCreate); err != nil {
+			return nil, err
+		}
+	}
+	result.Created = len(toCreate)
+	s.audit.RecordAuditEvent(ctx, claimsUserID(claims), "holiday.import", stringPtr("public_holiday"), nil, map[string]any{
+		"year":    year,
+		"format":  format,
+		"rows":    result.Rows,
+		"created": result.Created,
+		"skipped": result.Skipped,
+	})
+	return result, nil
+}
+
+func readCSVHolidays(data string) ([]importedHoliday, []HolidayImportError, error) {
+	reader := csv.NewReader(strings.NewReader(data))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	records := make([][]string, 0)
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: import file is not valid CSV: %v", ErrValidation, err)
+		}
+		records = append(records, record)
+		if len(records) > maxImportRows+1 {
+			return nil, nil, fmt.Errorf("%w: import file has more than %d rows", ErrValidation, maxImportRows)
+		}
+	}
+	if len(records) == 0 {
+		return nil, nil, fmt.Errorf("%w: import file is empty", ErrValidation)
+	}
+
+	dateColumn, nameColumn := -1, -1
+	for i, header := range records[0] {
+		switch strings.ToLower(strings.TrimSpace(header)) {
+		case "date":
+			dateColumn = i
+		case "name":
+			nameColumn = i
+		}
+	}
+	if dateColumn < 0 || nameColumn < 0 {
+		return nil, nil, fmt.Errorf("%w: import file needs \"Date\" and \"Name\" columns", ErrValidation)
+	}
+
+	items := make([]importedHoliday, 0, len(records)-1)
+	rowErrors := make([]HolidayImportError, 0)
+	for i, record := range records[1:] {
+		rowNumber := i + 2
+		cell := func(index int) string {
+			if index >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[index])
+		}
+		if strings.TrimSpace(strings.Join(record, "")) == "" {
+			continue
+		}
+		date, err := time.Parse("2006-01-02", cell(dateColumn))
+		if err != nil {
+			rowErrors = append(rowErrors, HolidayImportError{Row: rowNumber, Message: fmt.Sprintf("date %q must be YYYY-MM-DD", cell(dateColumn))})
+			continue
+		}
+		name := cell(nameColumn)
+		if name == "" {
+			rowErrors = append(rowErrors, HolidayImportError{Row: rowNumber, Message: "name is required"})
+			continue
+		}
+		items = append(items, importedHoliday{row: rowNumber, date: date, name: name})
+	}
+	return items, rowErrors, nil
+}
+
+// readICSHolidays reads the VEVENTs of an iCalendar file. An event spanning several days, with an exclusive
+// DTEND as calendars write all-day events, yields one holiday per day.
+func readICSHolidays(data string) ([]importedHoliday, []HolidayImportError) {
+	items := make([]importedHoliday, 0)
+	rowErrors := make([]HolidayImportError, 0)
+
+	var inEvent bool
+	var eventLine int
+	var summary, start, end string
+	for _, line := range unfoldICSLines(data) {
+		name, value := splitICSLine(line.text)
+		switch {
+		case name == "BEGIN" && strings.EqualFold(value, "VEVENT"):
+			inEvent, eventLine = true, line.number
+			summary, start, end = "", "", ""
+		case name == "END" && strings.EqualFold(value, "VEVENT") && inEvent:
+			inEvent = false
+			if len(items)+len(rowErrors) >= maxImportRows {
+				rowErrors = append(rowErrors, HolidayImportError{Row: eventLine, Message: fmt.Sprintf("import file has more than %d events", maxImportRows)})
+				return items, rowErrors
+			}
+			first, err := parseICSDate(start)
+			if err != nil {
+				rowErrors = append(rowErrors, HolidayImportError{Row: eventLine, Message: "event needs an all-day DTSTART"})
+				continue
+			}
+			if summary == "" {
+				rowErrors = append(rowErrors, HolidayImportError{Row: eventLine, Message: "event needs a SUMMARY"})
+				continue
+			}
+			last := first
+			if end != "" {
+				if exclusiveEnd, err := parseICSDate(end); err == nil && exclusiveEnd.After(first) {
+					last = exclusiveEnd.AddDate(0, 0, -1)
+				}
+			}
+			if last.Sub(first) > 31*24*time.Hour {
+				rowErrors = append(rowErrors, HolidayImportError{Row: eventLine, Message: "event is longer than a month"})
+				continue
+			}
+			for day := first; !day.After(last); day = day.AddDate(0, 0, 1) {
+				items = append(items, importedHoliday{row: eventLine, date: day, name: summary})
+			}
+		case inEvent && name == "SUMMARY":
+			summary = strings.TrimSpace(unescapeICSText(value))
+		case inEvent && name == "DTSTART":
+			start = value
+		case inEvent && name == "DTEND":
+			end = value
+		}
+	}
+	return items, rowErrors
+}
+
+type icsLine struct {
+	number int
+	text   string
+}
+
+// unfoldICSLines joins continuation lines, which start with a space or tab, to the line they continue.
+func unfoldICSLines(data string) []icsLine {
+	raw := strings.Split(strings.ReplaceAll(data, "\r\n", "\n"), "\n")
+	lines := make([]icsLine, 0, len(raw))
+	for i, text := range raw {
+		if (strings.HasPrefix(text, " ") || strings.HasPrefix(text, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1].text += text[1:]
+			continue
+		}
+		lines = append(lines, icsLine{number: i + 1, text: strings.TrimRight(text, "\r")})
+	}
+	return lines
+}
+
+// splitICSLine splits "NAME;PARAM=VALUE:content" into its upper-cased name and its content. Parameters such as
+// VALUE=DATE are not needed to read dates and are dropped.
+func splitICSLine(line string) (string, string) {
+	head, value, ok := strings.Cut(line, ":")
+	if !ok {
+		return "", ""
+	}
+	name, _, _ := strings.Cut(head, ";")
+	return strings.ToUpper(strings.TrimSpace(name)), value
+}
+
+// parseICSDate reads the date of a DATE or DATE-TIME value, ignoring any time part.
+func parseICSDate(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if len(value) < 8 {
+		return time.Time{}, fmt.Errorf("%w: invalid date", ErrValidation)
+	}
+	return time.Parse("20060102", value[:8])
+}
+
+func unescapeICSText(value string) string {
+	return strings.NewReplacer(`\n`, " ", `\N`, " ", `\,`, ",", `\;`, ";", `\\`, `\`).Replace(value)
+}
@@ -0,0 +1,134 @@
+package holidays
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type Repository interface {
+	ListHolidays(ctx context.Context, activeOnly bool) ([]Holiday, error)
+	GetHolidayByID(ctx context.Context, id int64) (*Holiday, error)
+	CreateHoliday(ctx context.Context, holiday Holiday) (*Holiday, error)
+	UpdateHoliday(ctx context.Context, id int64, holiday Holiday) (*Holiday, error)
+	SetHolidayActive(ctx context.Context, id int64, active bool) (*Holiday, error)
+	DeleteHoliday(ctx context.Context, id int64) (bool, error)
+	CreateHolidays(ctx context.Context, holidays []Holiday) error
+}
+
+type SQLXRepository struct {
+	db *sqlx.DB
+}
+
+func NewRepository(db *sqlx.DB) *SQLXRepository {
+	return &SQLXRepository{db: db}
+}
+
+const holidayColumns = `id, name, kind, month, day, easter_offset, holiday_date, active, created_at, updated_at`
+
+func (r *SQLXRepository) ListHolidays(ctx context.Context, activeOnly bool) ([]Holiday, error) {
+	query := `
+		SELECT ` + holidayColumns + `
+		FROM public_holidays
+		WHERE ($1 = FALSE OR active = TRUE)
+		ORDER BY kind ASC, month ASC NULLS LAST, day ASC NULLS LAST, easter_offset ASC NULLS LAST, holiday_date ASC NULLS LAST, id ASC
+	`
+	items := make([]Holiday, 0)
+	if err := r.db.SelectContext(ctx, &items, query, activeOnly); err != nil {
+		return nil, fmt.Errorf("list holidays: %w", err)
+	}
+	return items, nil
+}
+
+func (r *SQLXRepository) GetHolidayByID(ctx context.Context, id int64) (*Holiday, error) {
+	query := `SELECT ` + holidayColumns + ` FROM public_holidays WHERE id = $1`
+	var item Holiday
+	if err := r.db.GetContext(ctx, &item, query, id); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get holiday: %w", err)
+	}
+	return &item, nil
+}
+
+func (r *SQLXRepository) CreateHoliday(ctx context.Context, holiday Holiday) (*Holiday, error) {
+	query := `
+		INSERT INTO public_holidays (name, kind, month, day, easter_offset, holiday_date)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING ` + holidayColumns
+	var item Holiday
+	if err := r.db.GetContext(ctx, &item, query, holiday.Name, holiday.Kind, holiday.Month, holiday.Day, holiday.EasterOffset, holiday.HolidayDate); err != nil {
+		return nil, fmt.Errorf("create holiday: %w", err)
+	}
+	return &item, nil
+}
+
+func (r *SQLXRepository) UpdateHoliday(ctx context.Context, id int64, holiday Holiday) (*Holiday, error) {
+	query := `
+		UPDATE public_holidays
+		SET name = $2, kind = $3, month = $4, day = $5, easter_offset = $6, holiday_date = $7, updated_at = NOW()
+		WHERE id = $1
+		RETURNING ` + holidayColumns
+	var item Holiday
+	if err := r.db.GetContext(ctx, &item, query, id, holiday.Name, holiday.Kind, holiday.Month, holiday.Day, holiday.EasterOffset, holiday.HolidayDate); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("update holiday: %w", err)
+	}
+	return &item, nil
+}
+
+func (r *SQLXRepository) SetHolidayActive(ctx context.Context, id int64, active bool) (*Holiday, error) {
+	query := `
+		UPDATE public_holidays
+		SET active = $2, updated_at = NOW()
+		WHERE id = $1
+		RETURNING ` + holidayColumns
+	var item Holiday
+	if err := r.db.GetContext(ctx, &item, query, id, active); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("set holiday active: %w", err)
+	}
+	return &item, nil
+}
+
+func (r *SQLXRepository) DeleteHoliday(ctx context.Context, id int64) (bool, error) {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM public_holidays WHERE id = $1`, id)
+	if err != nil {
+		return false, fmt.Errorf("delete holiday: %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("delete holiday rows affected: %w", err)
+	}
+	return rows > 0, nil
+}
+
+// CreateHolidays inserts holidays in one transaction, so an import is stored whole or not at all.
+func (r *SQLXRepository) CreateHolidays(ctx context.Context, holidays []Holiday) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin holiday import: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	query := `
+		INSERT INTO public_holidays (name, kind, month, day, easter_offset, holiday_date)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+	for _, holiday := range holidays {
+		if _, err := tx.ExecContext(ctx, query, holiday.Name, holiday.Kind, holiday.Month, holiday.Day, holiday.EasterOffset, holiday.HolidayDate); err != nil {
+			return fmt.Errorf("import holiday: %w", err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit holiday import: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,248 @@
+package holidays
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"hrpro/internal/audit"
+	"hrpro/internal/models"
+)
+
+type Service struct {
+	repository Repository
+	audit      audit.Recorder
+}
+
+func NewService(repository Repository) *Service {
+	return &Service{repository: repository, audit: audit.NewNoopRecorder()}
+}
+
+func (s *Service) SetAuditRecorder(recorder audit.Recorder) {
+	if recorder == nil {
+		s.audit = audit.NewNoopRecorder()
+		return
+	}
+	s.audit = recorder
+}
+
+func (s *Service) ListHolidays(ctx context.Context, activeOnly bool) ([]Holiday, error) {
+	return s.repository.ListHolidays(ctx, activeOnly)
+}
+
+// ListHolidayCalendar lists the dates the active holidays fall on in year, defaulting to the current year.
+func (s *Service) ListHolidayCalendar(ctx context.Context, year int) ([]HolidayOccurrence, error) {
+	if year <= 0 {
+		year = time.Now().Year()
+	}
+	items, err := s.repository.ListHolidays(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	start := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	return Occurrences(items, start, start.AddDate(1, 0, -1)), nil
+}
+
+// HolidayDates returns the distinct public holiday dates between from and to, both inclusive. Leave, attendance
+// reports and payroll use it to leave holidays out of working days.
+func (s *Service) HolidayDates(ctx context.Context, from, to time.Time) ([]time.Time, error) {
+	items, err := s.repository.ListHolidays(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	return Dates(items, from, to), nil
+}
+
+func (s *Service) CreateHoliday(ctx context.Context, claims *models.Claims, input HolidayUpsertInput) (*Holiday, error) {
+	holiday, err := normalizeHolidayInput(input)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.ensureUnique(ctx, holiday, nil); err != nil {
+		return nil, err
+	}
+	created, err := s.repository.CreateHoliday(ctx, holiday)
+	if err != nil {
+		return nil, err
+	}
+	s.audit.RecordAuditEvent(ctx, claimsUserID(claims), "holiday.create", stringPtr("public_holiday"), &created.ID, holidayMetadata(*created))
+	return created, nil
+}
+
+func (s *Service) UpdateHoliday(ctx context.Context, claims *models.Claims, id int64, input HolidayUpsertInput) (*Holiday, error) {
+	if id <= 0 {
+		return nil, fmt.Errorf("%w: holiday id must be positive", ErrValidation)
+	}
+	holiday, err := normalizeHolidayInput(input)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.ensureUnique(ctx, holiday, &id); err != nil {
+		return nil, err
+	}
+	updated, err := s.repository.UpdateHoliday(ctx, id, holiday)
+	if err != nil {
+		return nil, err
+	}
+	if updated == nil {
+		return nil, ErrNotFound
+	}
+	s.audit.RecordAuditEvent(ctx, claimsUserID(claims), "holiday.update", stringPtr("public_holiday"), &updated.ID, holidayMetadata(*updated))
+	return updated, nil
+}
+
+func (s *Service) SetHolidayActive(ctx context.Context, claims *models.Claims, id int64, active bool) (*Holiday, error) {
+	if id <= 0 {
+		return nil, fmt.Errorf("%w: holiday id must be positive", ErrValidation)
+	}
+	updated, err := s.repository.SetHolidayActive(ctx, id, active)
+	if err != nil {
+		return nil, err
+	}
+	if updated == nil {
+		return nil, ErrNotFound
+	}
+	s.audit.RecordAuditEvent(ctx, claimsUserID(claims), "holiday.set_active", stringPtr("public_holiday"), &updated.ID, map[string]any{
+		"name":   updated.Name,
+		"active": updated.Active,
+	})
+	return updated, nil
+}
+
+func (s *Service) DeleteHoliday(ctx context.Context, claims *models.Claims, id int64) error {
+	if id <= 0 {
+		return fmt.Errorf("%w: holiday id must be positive", ErrValidation)
+	}
+	existing, err := s.repository.GetHolidayByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		return ErrNotFound
+	}
+	deleted, err := s.repository.DeleteHoliday(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return ErrNotFound
+	}
+	s.audit.RecordAuditEvent(ctx, claimsUserID(claims), "holiday.delete", stringPtr("public_holiday"), &id, holidayMetadata(*existing))
+	return nil
+}
+
+// ensureUnique rejects a recurring holiday whose name is already used by another recurring holiday, and a
+// one-off holiday with the same name and date as another.
+func (s *Service) ensureUnique(ctx context.Context, holiday Holiday, excludeID *int64) error {
+	existing, err := s.repository.ListHolidays(ctx, false)
+	if err != nil {
+		return err
+	}
+	for _, item := range existing {
+		if excludeID != nil && item.ID == *excludeID {
+			continue
+		}
+		if sameHoliday(item, holiday) {
+			return ErrDuplicateHoliday
+		}
+	}
+	return nil
+}
+
+func sameHoliday(a, b Holiday) bool {
+	if !strings.EqualFold(a.Name, b.Name) || (a.Kind == KindOneOff) != (b.Kind == KindOneOff) {
+		return false
+	}
+	if a.Kind != KindOneOff {
+		return true
+	}
+	return a.HolidayDate != nil && b.HolidayDate != nil && truncateDate(*a.HolidayDate).Equal(truncateDate(*b.HolidayDate))
+}
+
+func normalizeHolidayInput(input HolidayUpsertInput) (Holiday, error) {
+	holiday := Holiday{
+		Name:   strings.TrimSpace(input.Name),
+		Kind:   strings.ToLower(strings.TrimSpace(input.Kind)),
+		Active: true,
+	}
+	if holiday.Name == "" {
+		return Holiday{}, fmt.Errorf("%w: name is required", ErrValidation)
+	}
+	if len(holiday.Name) > 150 {
+		return Holiday{}, fmt.Errorf("%w: name is too long", ErrValidation)
+	}
+
+	switch holiday.Kind {
+	case KindFixed:
+		if input.Month == nil || *input.Month < 1 || *input.Month > 12 {
+			return Holiday{}, fmt.Errorf("%w: month must be between 1 and 12", ErrValidation)
+		}
+		// 2024 is a leap year, so 29 February is accepted and simply skipped in other years.
+		lastDay := time.Date(2024, time.Month(*input.Month)+1, 0, 0, 0, 0, 0, time.UTC).Day()
+		if input.Day == nil || *input.Day < 1 || *input.Day > lastDay {
+			return Holiday{}, fmt.Errorf("%w: day must be between 1 and %d", ErrValidation, lastDay)
+		}
+		month, day := *input.Month, *input.Day
+		holiday.Month, holiday.Day = &month, &day
+	case KindEaster:
+		if input.EasterOffset == nil || *input.EasterOffset < -60 || *input.EasterOffset > 60 {
+			return Holiday{}, fmt.Errorf("%w: easter offset must be between -60 and 60 days", ErrValidation)
+		}
+		offset := *input.EasterOffset
+		holiday.EasterOffset = &offset
+	case KindOneOff:
+		if input.HolidayDate == nil {
+			return Holiday{}, fmt.Errorf("%w: date is required", ErrValidation)
+		}
+		date, err := parseISODate(*input.HolidayDate)
+		if err != nil {
+			return Holiday{}, err
+		}
+		holiday.HolidayDate = &date
+	default:
+		return Holiday{}, fmt.Errorf("%w: kind must be fixed, easter or one_off", ErrValidation)
+	}
+	return holiday, nil
+}
+
+func parseISODate(value string) (time.Time, error) {
+	trimmed := strings.TrimSpace(value)
+	if trimmed == "" {
+		return time.Time{}, fmt.Errorf("%w: date is required", ErrValidation)
+	}
+	parsed, err := time.Parse("2006-01-02", trimmed)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: date must be YYYY-MM-DD", ErrValidation)
+	}
+	return parsed, nil
+}
+
+func holidayMetadata(holiday Holiday) map[string]any {
+	metadata := map[string]any{
+		"name": holiday.Name,
+		"kind": holiday.Kind,
+	}
+	switch {
+	case holiday.Month != nil && holiday.Day != nil:
+		metadata["month"] = *holiday.Month
+		metadata["day"] = *holiday.Day
+	case holiday.EasterOffset != nil:
+		metadata["easter_offset"] = *holiday.EasterOffset
+	case holiday.HolidayDate != nil:
+		metadata["date"] = holiday.HolidayDate.Format("2006-01-02")
+	}
+	return metadata
+}
+
+func claimsUserID(claims *models.Claims) *int64 {
+	if claims == nil || claims.UserID <= 0 {
+		return nil
+	}
+	actor := claims.UserID
+	return &actor
+}
+
+func stringPtr(value string) *string {
+	return &value
+}
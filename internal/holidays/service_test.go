@@ -0,0 +1,116 @@
+package holidays
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"hrpro/internal/models"
+)
+
+type fakeRepository struct {
+	holidays []Holiday
+	created  []Holiday
+}
+
+func (f *fakeRepository) ListHolidays(_ context.Context, activeOnly bool) ([]Holiday, error) {
+	items := make([]Holiday, 0, len(f.holidays))
+	for _, item := range f.holidays {
+		if activeOnly && !item.Active {
+			continue
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+func (f *fakeRepository) GetHolidayByID(_ context.Context, id int64) (*Holiday, error) {
+	for _, item := range f.holidays {
+		if item.ID == id {
+			return &item, nil
+		}
+	}
+	return nil, nil
+}
+
+func (f *fakeRepository) CreateHoliday(_ context.Context, holiday Holiday) (*Holiday, error) {
+	holiday.ID = int64(len(f.holidays) + 1)
+	f.holidays = append(f.holidays, holiday)
+	return &holiday, nil
+}
+
+func (f *fakeRepository) UpdateHoliday(_ context.Context, id int64, holiday Holiday) (*Holiday, error) {
+	holiday.ID = id
+	return &holiday, nil
+}
+
+func (f *fakeRepository) SetHolidayActive(_ context.Context, id int64, active bool) (*Holiday, error) {
+	return &Holiday{ID: id, Active: active}, nil
+}
+
+func (f *fakeRepository) DeleteHoliday(_ context.Context, _ int64) (bool, error) {
+	return true, nil
+}
+
+func (f *fakeRepository) CreateHolidays(_ context.Context, holidays []Holiday) error {
+	f.created = append(f.created, holidays...)
+	return nil
+}
+
+func TestCreateHolidayValidatesKindAndRejectsDuplicates(t *testing.T) {
+	month, day := 10, 9
+	repo := &fakeRepository{holidays: []Holiday{{ID: 1, Name: "Independence Day", Kind: KindFixed, Month: &month, Day: &day, Active: true}}}
+	service := NewService(repo)
+	claims := &models.Claims{UserID: 1, Role: "Admin"}
+
+	if _, err := service.CreateHoliday(context.Background(), claims, HolidayUpsertInput{Name: "independence day", Kind: KindEaster, EasterOffset: intPtr(1)}); !errors.Is(err, ErrDuplicateHoliday) {
+		t.Fatalf("expected duplicate recurring holiday error, got %v", err)
+	}
+	if _, err := service.CreateHoliday(context.Background(), claims, HolidayUpsertInput{Name: "Bad", Kind: KindFixed, Month: intPtr(4), Day: intPtr(31)}); !errors.Is(err, ErrValidation) {
+		t.Fatalf("expected validation error for 31 April, got %v", err)
+	}
+
+	created, err := service.CreateHoliday(context.Background(), claims, HolidayUpsertInput{Name: " Eid al-Adha ", Kind: "ONE_OFF", HolidayDate: stringPtr("2026-05-27")})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if created.Kind != KindOneOff || created.Name != "Eid al-Adha" || created.HolidayDate.Format("2006-01-02") != "2026-05-27" || created.Month != nil {
+		t.Fatalf("unexpected normalized holiday %#v", created)
+	}
+}
+
+func TestImportHolidaysFromCSVAndICS(t *testing.T) {
+	eid := time.Date(2026, 3, 20, 0, 0, 0, 0, time.UTC)
+	repo := &fakeRepository{holidays: []Holiday{{ID: 1, Name: "Eid al-Fitr", Kind: KindOneOff, HolidayDate: &eid, Active: true}}}
+	service := NewService(repo)
+	claims := &models.Claims{UserID: 1, Role: "Admin"}
+
+	result, err := service.ImportHolidays(context.Background(), claims, 2026, "Date,Name\n2026-03-20,Eid al-Fitr\n2027-01-01,New Year\n2026-05-27,Eid al-Adha\n2026-05-27,eid al-adha\n")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(result.Errors) != 2 || result.Errors[0].Row != 3 || result.Errors[1].Row != 5 {
+		t.Fatalf("expected errors on rows 3 and 5, got %#v", result.Errors)
+	}
+	if len(repo.created) != 0 {
+		t.Fatalf("expected nothing imported when a row fails, got %d", len(repo.created))
+	}
+
+	ics := "BEGIN:VCALENDAR\r\nVERSION:2.0\r\nBEGIN:VEVENT\r\nDTSTART;VALUE=DATE:20260320\r\nDTEND;VALUE=DATE:20260321\r\nSUMMARY:Eid al-Fitr\r\nEND:VEVENT\r\n" +
+		"BEGIN:VEVENT\r\nDTSTART;VALUE=DATE:20260527\r\nDTEND;VALUE=DATE:20260529\r\nSUMMARY:Eid al-Adha\\, observed\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n"
+	result, err = service.ImportHolidays(context.Background(), claims, 2026, ics)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(result.Errors) != 0 || result.Rows != 3 || result.Created != 2 || result.Skipped != 1 {
+		t.Fatalf("expected 3 rows, 2 created and 1 skipped, got %#v", result)
+	}
+	if repo.created[0].Name != "Eid al-Adha, observed" || repo.created[1].HolidayDate.Format("2006-01-02") != "2026-05-28" {
+		t.Fatalf("expected a two-day Eid al-Adha, got %#v", repo.created)
+	}
+}
+
+func intPtr(value int) *int {
+	return &value
+}
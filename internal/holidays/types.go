@@ -0,0 +1,61 @@
+package holidays
+
+import "time"
+
+const (
+	// KindFixed falls on the same month and day every year.
+	KindFixed = "fixed"
+	// KindEaster falls a number of days from Western Easter Sunday every year, e.g. Good Friday at -2.
+	KindEaster = "easter"
+	// KindOneOff falls on a single date, e.g. Eid al-Fitr as announced for the year.
+	KindOneOff = "one_off"
+)
+
+type Holiday struct {
+	ID           int64      `db:"id" json:"id"`
+	Name         string     `db:"name" json:"name"`
+	Kind         string     `db:"kind" json:"kind"`
+	Month        *int       `db:"month" json:"month,omitempty"`
+	Day          *int       `db:"day" json:"day,omitempty"`
+	EasterOffset *int       `db:"easter_offset" json:"easterOffset,omitempty"`
+	HolidayDate  *time.Time `db:"holiday_date" json:"holidayDate,omitempty"`
+	Active       bool       `db:"active" json:"active"`
+	CreatedAt    time.Time  `db:"created_at" json:"createdAt"`
+	UpdatedAt    time.Time  `db:"updated_at" json:"updatedAt"`
+}
+
+// HolidayUpsertInput describes a holiday. Month and Day apply to fixed holidays, EasterOffset to Easter-based
+// ones and HolidayDate (YYYY-MM-DD) to one-off holidays.
+type HolidayUpsertInput struct {
+	Name         string  `json:"name"`
+	Kind         string  `json:"kind"`
+	Month        *int    `json:"month,omitempty"`
+	Day          *int    `json:"day,omitempty"`
+	EasterOffset *int    `json:"easterOffset,omitempty"`
+	HolidayDate  *string `json:"holidayDate,omitempty"`
+}
+
+// HolidayOccurrence is the date a holiday falls on in a given year.
+type HolidayOccurrence struct {
+	Date      string `json:"date"`
+	HolidayID int64  `json:"holidayId"`
+	Name      string `json:"name"`
+	Kind      string `json:"kind"`
+}
+
+// HolidayImportResult reports a CSV or ICS import of one year's holidays. When Errors is not empty nothing was
+// imported.
+type HolidayImportResult struct {
+	Year    int                  `json:"year"`
+	Rows    int                  `json:"rows"`
+	Created int                  `json:"created"`
+	Skipped int                  `json:"skipped"`
+	Errors  []HolidayImportError `json:"errors"`
+}
+
+// HolidayImportError is a problem with one CSV row or calendar event. Row is the CSV row, counting the header
+// as row 1, or the line of the event's BEGIN:VEVENT.
+type HolidayImportError struct {
+	Row     int    `json:"row"`
+	Message string `json:"message"`
+}
@@ -10,4 +10,5 @@ var (
 	ErrOverlapApproved     = errors.New("requested dates overlap approved leave")
 	ErrInsufficientBalance = errors.New("insufficient leave balance")
 	ErrInvalidTransition   = errors.New("invalid status transition")
+	ErrEmployeeNotLinked   = errors.New("user account is not linked to an employee")
 )
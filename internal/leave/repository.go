@@ -12,6 +12,7 @@ import (
 
 type Repository interface {
 	EmployeeExists(ctx context.Context, employeeID int64) (bool, error)
+	GetLinkedEmployeeID(ctx context.Context, userID int64) (*int64, error)
 	ListLeaveTypes(ctx context.Context, activeOnly bool) ([]LeaveType, error)
 	GetLeaveTypeByID(ctx context.Context, id int64) (*LeaveType, error)
 	CreateLeaveType(ctx context.Context, input LeaveTypeUpsertInput) (*LeaveType, error)
@@ -23,11 +24,11 @@ type Repository interface {
 	LockDate(ctx context.Context, date time.Time, reason *string, createdBy int64) (*LeaveLockedDate, error)
 	UnlockDate(ctx context.Context, date time.Time) (bool, error)
 
-	GetEntitlement(ctx context.Context, employeeID int64, year int) (*LeaveEntitlement, error)
+	ListEntitlements(ctx context.Context, employeeID int64, year int) ([]LeaveEntitlement, error)
 	UpsertEntitlement(ctx context.Context, input UpsertEntitlementInput) (*LeaveEntitlement, error)
-	SumConsumedDays(ctx context.Context, employeeID int64, year int) (approved float64, pending float64, err error)
+	SumConsumedDaysByType(ctx context.Context, employeeID int64, year int) ([]ConsumedLeaveDays, error)
 
-	ExistsApprovedOverlap(ctx context.Context, employeeID int64, startDate, endDate time.Time, excludeID *int64) (bool, error)
+	ListApprovedOverlaps(ctx context.Context, employeeID int64, startDate, endDate time.Time, excludeID *int64) ([]LeaveRequest, error)
 	CreateLeaveRequest(ctx context.Context, employeeID int64, input ApplyLeaveInput, workingDays float64) (*LeaveRequest, error)
 	GetLeaveRequestByID(ctx context.Context, id int64) (*LeaveRequest, error)
 	ListMyLeaveRequests(ctx context.Context, employeeID int64, filter ListLeaveRequestsFilter) ([]LeaveRequest, error)
@@ -52,6 +53,19 @@ func (r *SQLXRepository) EmployeeExists(ctx context.Context, employeeID int64) (
 	return exists, nil
 }
 
+// GetLinkedEmployeeID returns the employee linked to a user account, or nil when the account has no link.
+func (r *SQLXRepository) GetLinkedEmployeeID(ctx context.Context, userID int64) (*int64, error) {
+	var employeeID *int64
+	query := `SELECT employee_id FROM users WHERE id = $1`
+	if err := r.db.GetContext(ctx, &employeeID, query, userID); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get linked employee: %w", err)
+	}
+	return employeeID, nil
+}
+
 func (r *SQLXRepository) ListLeaveTypes(ctx context.Context, activeOnly bool) ([]LeaveType, error) {
 	query := `
 		SELECT id, name, paid, counts_toward_entitlement, requires_attachment, requires_approval, active, created_at, updated_at
@@ -195,99 +209,125 @@ func (r *SQLXRepository) UnlockDate(ctx context.Context, date time.Time) (bool,
 	return rows > 0, nil
 }
 
-func (r *SQLXRepository) GetEntitlement(ctx context.Context, employeeID int64, year int) (*LeaveEntitlement, error) {
+func (r *SQLXRepository) ListEntitlements(ctx context.Context, employeeID int64, year int) ([]LeaveEntitlement, error) {
 	query := `
-		SELECT id, employee_id, year,
-			CAST(total_days AS DOUBLE PRECISION) AS total_days,
-			CAST(reserved_days AS DOUBLE PRECISION) AS reserved_days,
-			created_at, updated_at
-		FROM leave_entitlements
-		WHERE employee_id = $1 AND year = $2
+		SELECT le.id, le.employee_id, le.year, le.leave_type_id,
+			lt.name AS leave_type_name,
+			CAST(le.total_days AS DOUBLE PRECISION) AS total_days,
+			CAST(le.reserved_days AS DOUBLE PRECISION) AS reserved_days,
+			le.created_at, le.updated_at
+		FROM leave_entitlements le
+		INNER JOIN leave_types lt ON lt.id = le.leave_type_id
+		WHERE le.employee_id = $1 AND le.year = $2
+		ORDER BY lt.name ASC
 	`
-	var item LeaveEntitlement
-	if err := r.db.GetContext(ctx, &item, query, employeeID, year); err != nil {
-		if err == sql.ErrNoRows {
-			return nil, nil
-		}
-		return nil, fmt.Errorf("get entitlement: %w", err)
+	items := make([]LeaveEntitlement, 0)
+	if err := r.db.SelectContext(ctx, &items, query, employeeID, year); err != nil {
+		return nil, fmt.Errorf("list entitlements: %w", err)
 	}
-	return &item, nil
+	return items, nil
 }
 
 func (r *SQLXRepository) UpsertEntitlement(ctx context.Context, input UpsertEntitlementInput) (*LeaveEntitlement, error) {
 	query := `
-		INSERT INTO leave_entitlements (employee_id, year, total_days, reserved_days)
-		VALUES ($1, $2, $3, $4)
-		ON CONFLICT (employee_id, year) DO UPDATE
-		SET total_days = EXCLUDED.total_days,
-			reserved_days = EXCLUDED.reserved_days,
-			updated_at = NOW()
-		RETURNING id, employee_id, year,
-			CAST(total_days AS DOUBLE PRECISION) AS total_days,
-			CAST(reserved_days AS DOUBLE PRECISION) AS reserved_days,
-			created_at, updated_at
+		WITH saved AS (
+			INSERT INTO leave_entitlements (employee_id, year, leave_type_id, total_days, reserved_days)
+			VALUES ($1, $2, $3, $4, $5)
+			ON CONFLICT (employee_id, year, leave_type_id) DO UPDATE
+			SET total_days = EXCLUDED.total_days,
+				reserved_days = EXCLUDED.reserved_days,
+				updated_at = NOW()
+			RETURNING id, employee_id, year, leave_type_id, total_days, reserved_days, created_at, updated_at
+		)
+		SELECT saved.id, saved.employee_id, saved.year, saved.leave_type_id,
+			lt.name AS leave_type_name,
+			CAST(saved.total_days AS DOUBLE PRECISION) AS total_days,
+			CAST(saved.reserved_days AS DOUBLE PRECISION) AS reserved_days,
+			saved.created_at, saved.updated_at
+		FROM saved
+		INNER JOIN leave_types lt ON lt.id = saved.leave_type_id
 	`
 	var item LeaveEntitlement
-	if err := r.db.GetContext(ctx, &item, query, input.EmployeeID, input.Year, input.TotalDays, input.ReservedDays); err != nil {
+	if err := r.db.GetContext(ctx, &item, query, input.EmployeeID, input.Year, input.LeaveTypeID, input.TotalDays, input.ReservedDays); err != nil {
 		return nil, fmt.Errorf("upsert entitlement: %w", err)
 	}
 	return &item, nil
 }
 
-func (r *SQLXRepository) SumConsumedDays(ctx context.Context, employeeID int64, year int) (approved float64, pending float64, err error) {
+// SumConsumedDaysByType sums the approved and pending leave days of an employee in a year per leave type, for the
+// leave types that count toward entitlement.
+func (r *SQLXRepository) SumConsumedDaysByType(ctx context.Context, employeeID int64, year int) ([]ConsumedLeaveDays, error) {
 	query := `
 		SELECT
-			COALESCE(SUM(CASE WHEN lr.status = 'Approved' THEN lr.working_days ELSE 0 END), 0) AS approved_days,
-			COALESCE(SUM(CASE WHEN lr.status = 'Pending' THEN lr.working_days ELSE 0 END), 0) AS pending_days
+			lr.leave_type_id,
+			CAST(COALESCE(SUM(CASE WHEN lr.status = 'Approved' THEN lr.working_days ELSE 0 END), 0) AS DOUBLE PRECISION) AS approved_days,
+			CAST(COALESCE(SUM(CASE WHEN lr.status = 'Pending' THEN lr.working_days ELSE 0 END), 0) AS DOUBLE PRECISION) AS pending_days
 		FROM leave_requests lr
 		INNER JOIN leave_types lt ON lt.id = lr.leave_type_id
 		WHERE lr.employee_id = $1
 			AND EXTRACT(YEAR FROM lr.start_date) = $2
 			AND lt.counts_toward_entitlement = TRUE
+		GROUP BY lr.leave_type_id
 	`
-	row := struct {
-		Approved float64 `db:"approved_days"`
-		Pending  float64 `db:"pending_days"`
-	}{}
-	if err := r.db.GetContext(ctx, &row, query, employeeID, year); err != nil {
-		return 0, 0, fmt.Errorf("sum consumed leave days: %w", err)
-	}
-	return row.Approved, row.Pending, nil
+	items := make([]ConsumedLeaveDays, 0)
+	if err := r.db.SelectContext(ctx, &items, query, employeeID, year); err != nil {
+		return nil, fmt.Errorf("sum consumed leave days: %w", err)
+	}
+	return items, nil
 }
 
-func (r *SQLXRepository) ExistsApprovedOverlap(ctx context.Context, employeeID int64, startDate, endDate time.Time, excludeID *int64) (bool, error) {
+// ListApprovedOverlaps lists the approved requests of an employee that take any date from startDate to endDate.
+func (r *SQLXRepository) ListApprovedOverlaps(ctx context.Context, employeeID int64, startDate, endDate time.Time, excludeID *int64) ([]LeaveRequest, error) {
 	args := []any{employeeID, startDate, endDate}
 	query := `
-		SELECT EXISTS(
-			SELECT 1
-			FROM leave_requests
-			WHERE employee_id = $1
-				AND status = 'Approved'
-				AND start_date <= $3
-				AND end_date >= $2
+		SELECT
+			id,
+			employee_id,
+			leave_type_id,
+			start_date,
+			end_date,
+			start_day_part,
+			end_day_part,
+			CAST(hours AS DOUBLE PRECISION) AS hours,
+			CAST(working_days AS DOUBLE PRECISION) AS working_days,
+			status,
+			created_at,
+			updated_at
+		FROM leave_requests
+		WHERE employee_id = $1
+			AND status = 'Approved'
+			AND start_date <= $3
+			AND end_date >= $2
 	`
 	if excludeID != nil {
 		args = append(args, *excludeID)
 		query += fmt.Sprintf(" AND id <> $%d", len(args))
 	}
 	query += `
-		)
+		ORDER BY start_date ASC, id ASC
 	`
-	var exists bool
-	if err := r.db.GetContext(ctx, &exists, query, args...); err != nil {
-		return false, fmt.Errorf("exists approved overlap: %w", err)
+	items := make([]LeaveRequest, 0)
+	if err := r.db.SelectContext(ctx, &items, query, args...); err != nil {
+		return nil, fmt.Errorf("list approved overlaps: %w", err)
 	}
-	return exists, nil
+	return items, nil
 }
 
 func (r *SQLXRepository) CreateLeaveRequest(ctx context.Context, employeeID int64, input ApplyLeaveInput, workingDays float64) (*LeaveRequest, error) {
 	query := `
-		INSERT INTO leave_requests (employee_id, leave_type_id, start_date, end_date, working_days, status, reason)
-		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		INSERT INTO leave_requests (employee_id, leave_type_id, start_date, end_date, start_day_part, end_day_part, hours, working_days, status, reason)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
 		RETURNING id
 	`
+	startDayPart, endDayPart := input.StartDayPart, input.EndDayPart
+	if startDayPart == "" {
+		startDayPart = DayPartFull
+	}
+	if endDayPart == "" {
+		endDayPart = DayPartFull
+	}
 	var id int64
-	if err := r.db.GetContext(ctx, &id, query, employeeID, input.LeaveTypeID, input.StartDate, input.EndDate, workingDays, StatusPending, input.Reason); err != nil {
+	if err := r.db.GetContext(ctx, &id, query, employeeID, input.LeaveTypeID, input.StartDate, input.EndDate, startDayPart, endDayPart, input.Hours, workingDays, StatusPending, input.Reason); err != nil {
 		return nil, fmt.Errorf("create leave request: %w", err)
 	}
 
@@ -304,6 +344,9 @@ func (r *SQLXRepository) GetLeaveRequestByID(ctx context.Context, id int64) (*Le
 			lt.name AS leave_type_name,
 			lr.start_date,
 			lr.end_date,
+			lr.start_day_part,
+			lr.end_day_part,
+			CAST(lr.hours AS DOUBLE PRECISION) AS hours,
 			CAST(lr.working_days AS DOUBLE PRECISION) AS working_days,
 			lr.status,
 			lr.reason,
@@ -337,6 +380,9 @@ func (r *SQLXRepository) ListMyLeaveRequests(ctx context.Context, employeeID int
 			lt.name AS leave_type_name,
 			lr.start_date,
 			lr.end_date,
+			lr.start_day_part,
+			lr.end_day_part,
+			CAST(lr.hours AS DOUBLE PRECISION) AS hours,
 			CAST(lr.working_days AS DOUBLE PRECISION) AS working_days,
 			lr.status,
 			lr.reason,
@@ -368,6 +414,9 @@ func (r *SQLXRepository) ListAllLeaveRequests(ctx context.Context, filter ListLe
 			lt.name AS leave_type_name,
 			lr.start_date,
 			lr.end_date,
+			lr.start_day_part,
+			lr.end_day_part,
+			CAST(lr.hours AS DOUBLE PRECISION) AS hours,
 			CAST(lr.working_days AS DOUBLE PRECISION) AS working_days,
 			lr.status,
 			lr.reason,
@@ -2,10 +2,15 @@ package leave
 
 import (
 	"fmt"
+	"math"
 	"strings"
 	"time"
+
+	"hrpro/internal/schedules"
 )
 
+var errNoWorkingDays = fmt.Errorf("%w: selected range has no working days", ErrValidation)
+
 func ParseISODate(value string) (time.Time, error) {
 	trimmed := strings.TrimSpace(value)
 	if trimmed == "" {
@@ -20,25 +25,184 @@ func ParseISODate(value string) (time.Time, error) {
 	return parsed, nil
 }
 
-func CalculateWorkingDays(startDate, endDate time.Time) ([]time.Time, float64, error) {
+// CalculateWorkingDays lists the days from startDate to endDate that the employee is scheduled to work and that
+// are not one of holidays, and counts them as leave days. workDays is the employee's work calendar for the range;
+// a half day counts as 0.5. Without a calendar the standard Monday to Friday week applies.
+func CalculateWorkingDays(startDate, endDate time.Time, workDays []schedules.WorkDay, holidays []time.Time) ([]time.Time, float64, error) {
+	return CalculateLeaveDays(LeavePeriod{StartDate: startDate, EndDate: endDate}, workDays, holidays)
+}
+
+// CalculateLeaveDays is CalculateWorkingDays for a period that may start or end on a half day, or take some hours
+// of a single day. A half day is charged as half a working day, or the whole day when the schedule only works
+// half of it. Hours are charged against the daily hours of the employee's schedule and may not exceed the hours
+// scheduled that day. The total is rounded to two decimals, as it is stored.
+func CalculateLeaveDays(period LeavePeriod, workDays []schedules.WorkDay, holidays []time.Time) ([]time.Time, float64, error) {
+	startDate, endDate := period.StartDate, period.EndDate
 	if endDate.Before(startDate) {
 		return nil, 0, fmt.Errorf("%w: end date must be on/after start date", ErrValidation)
 	}
+	if workDays == nil {
+		workDays = schedules.StandardWorkDays(startDate, endDate)
+	}
+	calendar := make(map[time.Time]schedules.WorkDay, len(workDays))
+	for _, day := range workDays {
+		calendar[day.Date] = day
+	}
 
 	workingDates := make([]time.Time, 0)
+	total := 0.0
 	for d := startDate; !d.After(endDate); d = d.AddDate(0, 0, 1) {
-		if d.Weekday() == time.Saturday || d.Weekday() == time.Sunday {
+		workDay := calendar[time.Date(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, time.UTC)]
+		if workDay.Fraction <= 0 {
 			continue
 		}
+		if ContainsDate(holidays, d) {
+			continue
+		}
+
+		charge := workDay.Fraction
+		part, hours := period.dayPart(d)
+		switch part {
+		case DayPartHours:
+			dailyHours := workDay.DailyHours
+			if dailyHours <= 0 {
+				dailyHours = schedules.StandardHoursPerDay
+			}
+			if hours > workDay.Fraction*dailyHours {
+				return nil, 0, fmt.Errorf("%w: %.2f hours exceed the %.2f hours scheduled on %s", ErrValidation, hours, workDay.Fraction*dailyHours, d.Format("2006-01-02"))
+			}
+			charge = hours / dailyHours
+		case DayPartAM, DayPartPM:
+			charge = math.Min(charge, 0.5)
+		}
 
 		workingDates = append(workingDates, d)
+		total += charge
 	}
 
 	if len(workingDates) == 0 {
-		return nil, 0, fmt.Errorf("%w: selected range has no working days", ErrValidation)
+		return nil, 0, errNoWorkingDays
+	}
+
+	return workingDates, math.Round(total*100) / 100, nil
+}
+
+// NormalizeLeavePeriod validates the day parts and hours of a request and returns its period. An empty day part is
+// a full day. A request of several days may start in the afternoon and end in the morning; a single-day request
+// takes the whole day, the morning, the afternoon or a number of hours.
+func NormalizeLeavePeriod(startDate, endDate time.Time, startDayPart, endDayPart string, hours *float64) (LeavePeriod, error) {
+	period := LeavePeriod{StartDate: startDate, EndDate: endDate, StartDayPart: DayPartFull, EndDayPart: DayPartFull}
+	startPart, err := normalizeDayPart(startDayPart)
+	if err != nil {
+		return LeavePeriod{}, err
+	}
+	endPart, err := normalizeDayPart(endDayPart)
+	if err != nil {
+		return LeavePeriod{}, err
+	}
+
+	if hours != nil {
+		if !startDate.Equal(endDate) {
+			return LeavePeriod{}, fmt.Errorf("%w: hourly leave must be on a single day", ErrValidation)
+		}
+		if startPart != DayPartFull || endPart != DayPartFull {
+			return LeavePeriod{}, fmt.Errorf("%w: hourly leave cannot also be a half day", ErrValidation)
+		}
+		if *hours <= 0 || *hours > 24 {
+			return LeavePeriod{}, fmt.Errorf("%w: hours must be between 0 and 24", ErrValidation)
+		}
+		value := math.Round(*hours*100) / 100
+		period.Hours = &value
+		return period, nil
+	}
+
+	if startDate.Equal(endDate) {
+		part := startPart
+		if part == DayPartFull {
+			part = endPart
+		} else if endPart != DayPartFull && endPart != startPart {
+			part = DayPartFull
+		}
+		period.StartDayPart, period.EndDayPart = part, part
+		return period, nil
+	}
+	if startPart == DayPartAM {
+		return LeavePeriod{}, fmt.Errorf("%w: leave over several days can only start in the afternoon of the first day", ErrValidation)
 	}
+	if endPart == DayPartPM {
+		return LeavePeriod{}, fmt.Errorf("%w: leave over several days can only end in the morning of the last day", ErrValidation)
+	}
+	period.StartDayPart, period.EndDayPart = startPart, endPart
+	return period, nil
+}
+
+// PeriodsConflict reports whether two leave periods of one employee take the same time. On a date both take, they
+// conflict unless each takes part of the day and the parts fit together: the morning and the afternoon, or hours
+// that with the other part do not exceed the day. dailyHours gives the length of a full day on a date.
+func PeriodsConflict(a, b LeavePeriod, dailyHours func(time.Time) float64) bool {
+	from, to := a.StartDate, a.EndDate
+	if b.StartDate.After(from) {
+		from = b.StartDate
+	}
+	if b.EndDate.Before(to) {
+		to = b.EndDate
+	}
+	for d := from; !d.After(to); d = d.AddDate(0, 0, 1) {
+		partA, shareA := a.dayShare(d, dailyHours(d))
+		partB, shareB := b.dayShare(d, dailyHours(d))
+		if partA == DayPartFull || partB == DayPartFull {
+			return true
+		}
+		if partA == partB && partA != DayPartHours {
+			return true
+		}
+		if shareA+shareB > 1 {
+			return true
+		}
+	}
+	return false
+}
 
-	return workingDates, float64(len(workingDates)), nil
+// dayPart returns the part of date the period takes, with the hours of an hourly period.
+func (p LeavePeriod) dayPart(date time.Time) (string, float64) {
+	switch {
+	case p.Hours != nil:
+		return DayPartHours, *p.Hours
+	case date.Equal(p.StartDate) && p.StartDayPart != "" && p.StartDayPart != DayPartFull:
+		return p.StartDayPart, 0
+	case date.Equal(p.EndDate) && p.EndDayPart != "" && p.EndDayPart != DayPartFull:
+		return p.EndDayPart, 0
+	default:
+		return DayPartFull, 0
+	}
+}
+
+// dayShare returns the part of date the period takes and the share of a full day it is.
+func (p LeavePeriod) dayShare(date time.Time, dailyHours float64) (string, float64) {
+	part, hours := p.dayPart(date)
+	switch part {
+	case DayPartHours:
+		if dailyHours <= 0 {
+			dailyHours = schedules.StandardHoursPerDay
+		}
+		return part, hours / dailyHours
+	case DayPartAM, DayPartPM:
+		return part, 0.5
+	default:
+		return part, 1
+	}
+}
+
+func normalizeDayPart(value string) (string, error) {
+	part := strings.ToLower(strings.TrimSpace(value))
+	switch part {
+	case "", DayPartFull:
+		return DayPartFull, nil
+	case DayPartAM, DayPartPM:
+		return part, nil
+	default:
+		return "", fmt.Errorf("%w: day part must be full, am or pm", ErrValidation)
+	}
 }
 
 func DatesOverlap(aStart, aEnd, bStart, bEnd time.Time) bool {
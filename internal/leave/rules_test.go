@@ -4,13 +4,15 @@ import (
 	"errors"
 	"testing"
 	"time"
+
+	"hrpro/internal/schedules"
 )
 
 func TestCalculateWorkingDaysExcludesWeekends(t *testing.T) {
 	start := time.Date(2026, time.February, 20, 0, 0, 0, 0, time.UTC) // Friday
 	end := time.Date(2026, time.February, 24, 0, 0, 0, 0, time.UTC)   // Tuesday
 
-	_, days, err := CalculateWorkingDays(start, end)
+	_, days, err := CalculateWorkingDays(start, end, nil, nil)
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
 	}
@@ -23,7 +25,7 @@ func TestCalculateWorkingDaysRejectsInvalidRange(t *testing.T) {
 	start := time.Date(2026, time.February, 24, 0, 0, 0, 0, time.UTC)
 	end := time.Date(2026, time.February, 20, 0, 0, 0, 0, time.UTC)
 
-	_, _, err := CalculateWorkingDays(start, end)
+	_, _, err := CalculateWorkingDays(start, end, nil, nil)
 	if !errors.Is(err, ErrValidation) {
 		t.Fatalf("expected validation error, got %v", err)
 	}
@@ -32,7 +34,7 @@ func TestCalculateWorkingDaysRejectsInvalidRange(t *testing.T) {
 func TestCalculateWorkingDaysSameDayWeekday(t *testing.T) {
 	date := time.Date(2026, time.February, 23, 0, 0, 0, 0, time.UTC) // Monday
 
-	_, days, err := CalculateWorkingDays(date, date)
+	_, days, err := CalculateWorkingDays(date, date, nil, nil)
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
 	}
@@ -40,3 +42,127 @@ func TestCalculateWorkingDaysSameDayWeekday(t *testing.T) {
 		t.Fatalf("expected 1 working day, got %.2f", days)
 	}
 }
+
+func TestCalculateWorkingDaysExcludesHolidays(t *testing.T) {
+	start := time.Date(2026, time.April, 1, 0, 0, 0, 0, time.UTC) // Wednesday
+	end := time.Date(2026, time.April, 7, 0, 0, 0, 0, time.UTC)   // Tuesday
+	holidays := []time.Time{
+		time.Date(2026, time.April, 3, 0, 0, 0, 0, time.UTC), // Good Friday
+		time.Date(2026, time.April, 6, 0, 0, 0, 0, time.UTC), // Easter Monday
+	}
+
+	dates, days, err := CalculateWorkingDays(start, end, nil, holidays)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if days != 3 || ContainsDate(dates, holidays[0]) || ContainsDate(dates, holidays[1]) {
+		t.Fatalf("expected 3 working days without the holidays, got %.2f %v", days, dates)
+	}
+
+	if _, _, err := CalculateWorkingDays(holidays[0], holidays[0], nil, holidays); !errors.Is(err, ErrValidation) {
+		t.Fatalf("expected validation error for a holiday-only range, got %v", err)
+	}
+}
+
+func TestCalculateWorkingDaysFollowsWorkSchedule(t *testing.T) {
+	start := time.Date(2026, time.March, 6, 0, 0, 0, 0, time.UTC) // Friday
+	end := time.Date(2026, time.March, 9, 0, 0, 0, 0, time.UTC)   // Monday
+	workDays := []schedules.WorkDay{
+		{Date: start, Fraction: 1, DailyHours: 8},
+		{Date: start.AddDate(0, 0, 1), Fraction: 0.5, DailyHours: 8},
+		{Date: start.AddDate(0, 0, 2), Fraction: 0, DailyHours: 8},
+		{Date: end, Fraction: 0, DailyHours: 8},
+	}
+
+	dates, days, err := CalculateWorkingDays(start, end, workDays, nil)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if days != 1.5 || len(dates) != 2 {
+		t.Fatalf("expected Friday and the Saturday half day, got %.2f %v", days, dates)
+	}
+}
+
+func TestCalculateLeaveDaysChargesPartsOfDays(t *testing.T) {
+	start := time.Date(2026, time.March, 2, 0, 0, 0, 0, time.UTC) // Monday
+	end := time.Date(2026, time.March, 4, 0, 0, 0, 0, time.UTC)   // Wednesday
+
+	period, err := NormalizeLeavePeriod(start, end, DayPartPM, DayPartAM, nil)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if _, days, err := CalculateLeaveDays(period, nil, nil); err != nil || days != 2 {
+		t.Fatalf("expected an afternoon, a day and a morning to be 2 days, got %.2f %v", days, err)
+	}
+
+	hours := 6.0
+	period, err = NormalizeLeavePeriod(start, start, "", "", &hours)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if _, days, err := CalculateLeaveDays(period, nil, nil); err != nil || days != 0.75 {
+		t.Fatalf("expected 6 of 8 hours to be 0.75 days, got %.2f %v", days, err)
+	}
+
+	halfDay := []schedules.WorkDay{{Date: start, Fraction: 0.5, DailyHours: 8}}
+	if _, _, err := CalculateLeaveDays(period, halfDay, nil); !errors.Is(err, ErrValidation) {
+		t.Fatalf("expected 6 hours on a 4-hour day to be rejected, got %v", err)
+	}
+	period = LeavePeriod{StartDate: start, EndDate: start, StartDayPart: DayPartAM, EndDayPart: DayPartAM}
+	if _, days, err := CalculateLeaveDays(period, halfDay, nil); err != nil || days != 0.5 {
+		t.Fatalf("expected a morning of a half day to be 0.5 days, got %.2f %v", days, err)
+	}
+}
+
+func TestNormalizeLeavePeriodRejectsInvalidParts(t *testing.T) {
+	start := time.Date(2026, time.March, 2, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 0, 1)
+	hours := 2.0
+	cases := []struct {
+		name       string
+		end        time.Time
+		start, fin string
+		hours      *float64
+	}{
+		{name: "unknown part", end: start, start: "evening"},
+		{name: "starts in the morning", end: end, start: DayPartAM},
+		{name: "ends in the afternoon", end: end, fin: DayPartPM},
+		{name: "hours over several days", end: end, hours: &hours},
+		{name: "hours with a half day", end: start, start: DayPartAM, hours: &hours},
+	}
+	for _, tc := range cases {
+		if _, err := NormalizeLeavePeriod(start, tc.end, tc.start, tc.fin, tc.hours); !errors.Is(err, ErrValidation) {
+			t.Fatalf("%s: expected validation error, got %v", tc.name, err)
+		}
+	}
+
+	period, err := NormalizeLeavePeriod(start, start, DayPartAM, DayPartPM, nil)
+	if err != nil || period.StartDayPart != DayPartFull {
+		t.Fatalf("expected a morning and an afternoon to be a full day, got %+v %v", period, err)
+	}
+}
+
+func TestPeriodsConflictAllowsPartsThatFitTheDay(t *testing.T) {
+	day := time.Date(2026, time.March, 2, 0, 0, 0, 0, time.UTC)
+	eightHours := func(time.Time) float64 { return 8 }
+	morning := LeavePeriod{StartDate: day, EndDate: day, StartDayPart: DayPartAM, EndDayPart: DayPartAM}
+	afternoon := LeavePeriod{StartDate: day, EndDate: day, StartDayPart: DayPartPM, EndDayPart: DayPartPM}
+	full := LeavePeriod{StartDate: day.AddDate(0, 0, -1), EndDate: day, StartDayPart: DayPartFull, EndDayPart: DayPartFull}
+	three, six := 3.0, 6.0
+
+	if PeriodsConflict(morning, afternoon, eightHours) {
+		t.Fatalf("expected a morning and an afternoon not to conflict")
+	}
+	if !PeriodsConflict(morning, morning, eightHours) {
+		t.Fatalf("expected two mornings to conflict")
+	}
+	if !PeriodsConflict(afternoon, full, eightHours) {
+		t.Fatalf("expected an afternoon to conflict with a full day")
+	}
+	if PeriodsConflict(morning, LeavePeriod{StartDate: day, EndDate: day, Hours: &three}, eightHours) {
+		t.Fatalf("expected a morning and 3 hours to fit in the day")
+	}
+	if !PeriodsConflict(morning, LeavePeriod{StartDate: day, EndDate: day, Hours: &six}, eightHours) {
+		t.Fatalf("expected a morning and 6 hours to exceed the day")
+	}
+}
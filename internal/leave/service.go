@@ -2,6 +2,7 @@ package leave
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -9,11 +10,24 @@ import (
 	"hrpro/internal/audit"
 	"hrpro/internal/middleware"
 	"hrpro/internal/models"
+	"hrpro/internal/schedules"
 )
 
+// HolidayProvider supplies the public holidays that are not charged as leave days.
+type HolidayProvider interface {
+	HolidayDates(ctx context.Context, from, to time.Time) ([]time.Time, error)
+}
+
+// WorkScheduleProvider supplies the days an employee is scheduled to work, so leave on a day off costs nothing.
+type WorkScheduleProvider interface {
+	EmployeeWorkDays(ctx context.Context, employeeID int64, from, to time.Time) ([]schedules.WorkDay, error)
+}
+
 type Service struct {
 	repository Repository
 	audit      audit.Recorder
+	holidays   HolidayProvider
+	schedules  WorkScheduleProvider
 }
 
 func NewService(repository Repository) *Service {
@@ -28,6 +42,14 @@ func (s *Service) SetAuditRecorder(recorder audit.Recorder) {
 	s.audit = recorder
 }
 
+func (s *Service) SetHolidayProvider(provider HolidayProvider) {
+	s.holidays = provider
+}
+
+func (s *Service) SetWorkScheduleProvider(provider WorkScheduleProvider) {
+	s.schedules = provider
+}
+
 func (s *Service) ListLeaveTypes(ctx context.Context, activeOnly bool) ([]LeaveType, error) {
 	return s.repository.ListLeaveTypes(ctx, activeOnly)
 }
@@ -106,10 +128,11 @@ func (s *Service) UnlockDate(ctx context.Context, date string) error {
 }
 
 func (s *Service) GetMyLeaveBalance(ctx context.Context, claims *models.Claims, year int) (*LeaveBalance, error) {
-	if claims == nil {
-		return nil, ErrForbidden
+	employeeID, err := s.resolveEmployeeID(ctx, claims)
+	if err != nil {
+		return nil, err
 	}
-	return s.GetLeaveBalance(ctx, claims.UserID, year)
+	return s.GetLeaveBalance(ctx, employeeID, year)
 }
 
 func (s *Service) GetLeaveBalance(ctx context.Context, employeeID int64, year int) (*LeaveBalance, error) {
@@ -128,36 +151,64 @@ func (s *Service) GetLeaveBalance(ctx context.Context, employeeID int64, year in
 		return nil, ErrNotFound
 	}
 
-	entitlement, err := s.repository.GetEntitlement(ctx, employeeID, year)
+	leaveTypes, err := s.repository.ListLeaveTypes(ctx, false)
 	if err != nil {
 		return nil, err
 	}
-	approvedDays, pendingDays, err := s.repository.SumConsumedDays(ctx, employeeID, year)
+	entitlements, err := s.repository.ListEntitlements(ctx, employeeID, year)
 	if err != nil {
 		return nil, err
 	}
-
-	totalDays := 0.0
-	reservedDays := 0.0
-	if entitlement != nil {
-		totalDays = entitlement.TotalDays
-		reservedDays = entitlement.ReservedDays
+	consumed, err := s.repository.SumConsumedDaysByType(ctx, employeeID, year)
+	if err != nil {
+		return nil, err
 	}
 
-	available := totalDays - reservedDays - approvedDays - pendingDays
-	if available < 0 {
-		available = 0
+	return buildLeaveBalance(employeeID, year, leaveTypes, entitlements, consumed), nil
+}
+
+// buildLeaveBalance works out the balance of each leave type that counts toward entitlement. Inactive types are
+// only listed while they still have an entitlement or leave in the year.
+func buildLeaveBalance(employeeID int64, year int, leaveTypes []LeaveType, entitlements []LeaveEntitlement, consumed []ConsumedLeaveDays) *LeaveBalance {
+	used := make(map[int64]bool, len(entitlements)+len(consumed))
+	for _, item := range entitlements {
+		used[item.LeaveTypeID] = true
+	}
+	for _, item := range consumed {
+		used[item.LeaveTypeID] = true
 	}
 
-	return &LeaveBalance{
-		EmployeeID:    employeeID,
-		Year:          year,
-		TotalDays:     totalDays,
-		ReservedDays:  reservedDays,
-		ApprovedDays:  approvedDays,
-		PendingDays:   pendingDays,
-		AvailableDays: available,
-	}, nil
+	balance := &LeaveBalance{EmployeeID: employeeID, Year: year, Types: make([]LeaveTypeBalance, 0, len(leaveTypes))}
+	for _, leaveType := range leaveTypes {
+		if !leaveType.CountsTowardEntitlement || (!leaveType.Active && !used[leaveType.ID]) {
+			continue
+		}
+		item := LeaveTypeBalance{LeaveTypeID: leaveType.ID, LeaveTypeName: leaveType.Name}
+		for _, entitlement := range entitlements {
+			if entitlement.LeaveTypeID == leaveType.ID {
+				item.TotalDays = entitlement.TotalDays
+				item.ReservedDays = entitlement.ReservedDays
+			}
+		}
+		for _, days := range consumed {
+			if days.LeaveTypeID == leaveType.ID {
+				item.ApprovedDays = days.ApprovedDays
+				item.PendingDays = days.PendingDays
+			}
+		}
+		item.AvailableDays = item.TotalDays - item.ReservedDays - item.ApprovedDays - item.PendingDays
+		if item.AvailableDays < 0 {
+			item.AvailableDays = 0
+		}
+
+		balance.Types = append(balance.Types, item)
+		balance.TotalDays += item.TotalDays
+		balance.ReservedDays += item.ReservedDays
+		balance.ApprovedDays += item.ApprovedDays
+		balance.PendingDays += item.PendingDays
+		balance.AvailableDays += item.AvailableDays
+	}
+	return balance
 }
 
 func (s *Service) UpsertEntitlement(ctx context.Context, input UpsertEntitlementInput) (*LeaveEntitlement, error) {
@@ -173,6 +224,9 @@ func (s *Service) UpsertEntitlement(ctx context.Context, input UpsertEntitlement
 	if input.ReservedDays > input.TotalDays {
 		return nil, fmt.Errorf("%w: reserved days cannot exceed total days", ErrValidation)
 	}
+	if input.LeaveTypeID <= 0 {
+		return nil, fmt.Errorf("%w: leave type is required", ErrValidation)
+	}
 
 	exists, err := s.repository.EmployeeExists(ctx, input.EmployeeID)
 	if err != nil {
@@ -181,10 +235,58 @@ func (s *Service) UpsertEntitlement(ctx context.Context, input UpsertEntitlement
 	if !exists {
 		return nil, ErrNotFound
 	}
+	leaveType, err := s.repository.GetLeaveTypeByID(ctx, input.LeaveTypeID)
+	if err != nil {
+		return nil, err
+	}
+	if leaveType == nil {
+		return nil, ErrNotFound
+	}
+	if !leaveType.CountsTowardEntitlement {
+		return nil, fmt.Errorf("%w: %s does not count toward entitlement", ErrValidation, leaveType.Name)
+	}
 
 	return s.repository.UpsertEntitlement(ctx, input)
 }
 
+// PreviewLeaveDays counts the leave days the caller would be charged for input under their work schedule and the
+// public holidays. A range without working days previews as zero days rather than failing.
+func (s *Service) PreviewLeaveDays(ctx context.Context, claims *models.Claims, input ApplyLeaveInput) (*LeaveDaysPreview, error) {
+	if claims == nil {
+		return nil, ErrForbidden
+	}
+	startDate, err := ParseISODate(input.StartDate)
+	if err != nil {
+		return nil, err
+	}
+	endDate, err := ParseISODate(input.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	if endDate.Before(startDate) {
+		return nil, fmt.Errorf("%w: end date must be on/after start date", ErrValidation)
+	}
+	period, err := NormalizeLeavePeriod(startDate, endDate, input.StartDayPart, input.EndDayPart, input.Hours)
+	if err != nil {
+		return nil, err
+	}
+
+	employeeID, err := s.resolveEmployeeID(ctx, claims)
+	if err != nil {
+		return nil, err
+	}
+	preview := &LeaveDaysPreview{StartDate: input.StartDate, EndDate: input.EndDate}
+	_, workingDays, _, err := s.calculateLeaveDays(ctx, employeeID, period)
+	if err != nil {
+		if errors.Is(err, errNoWorkingDays) {
+			return preview, nil
+		}
+		return nil, err
+	}
+	preview.WorkingDays = workingDays
+	return preview, nil
+}
+
 func (s *Service) ApplyLeave(ctx context.Context, claims *models.Claims, input ApplyLeaveInput) (*LeaveRequest, error) {
 	if claims == nil {
 		return nil, ErrForbidden
@@ -206,12 +308,18 @@ func (s *Service) ApplyLeave(ctx context.Context, claims *models.Claims, input A
 		return nil, fmt.Errorf("%w: leave request must be in a single calendar year", ErrValidation)
 	}
 
-	workingDates, workingDays, err := CalculateWorkingDays(startDate, endDate)
+	if endDate.Before(startDate) {
+		return nil, fmt.Errorf("%w: end date must be on/after start date", ErrValidation)
+	}
+	period, err := NormalizeLeavePeriod(startDate, endDate, input.StartDayPart, input.EndDayPart, input.Hours)
 	if err != nil {
 		return nil, err
 	}
 
-	employeeID := claims.UserID
+	employeeID, err := s.resolveEmployeeID(ctx, claims)
+	if err != nil {
+		return nil, err
+	}
 	exists, err := s.repository.EmployeeExists(ctx, employeeID)
 	if err != nil {
 		return nil, err
@@ -220,6 +328,11 @@ func (s *Service) ApplyLeave(ctx context.Context, claims *models.Claims, input A
 		return nil, ErrNotFound
 	}
 
+	workingDates, workingDays, workDays, err := s.calculateLeaveDays(ctx, employeeID, period)
+	if err != nil {
+		return nil, err
+	}
+
 	leaveType, err := s.repository.GetLeaveTypeByID(ctx, input.LeaveTypeID)
 	if err != nil {
 		return nil, err
@@ -236,20 +349,16 @@ func (s *Service) ApplyLeave(ctx context.Context, claims *models.Claims, input A
 		return nil, ErrLockedDateConflict
 	}
 
-	overlap, err := s.repository.ExistsApprovedOverlap(ctx, employeeID, startDate, endDate, nil)
-	if err != nil {
+	if err := s.checkApprovedOverlap(ctx, employeeID, period, workDays); err != nil {
 		return nil, err
 	}
-	if overlap {
-		return nil, ErrOverlapApproved
-	}
 
 	if leaveType.CountsTowardEntitlement {
 		balance, err := s.GetLeaveBalance(ctx, employeeID, startDate.Year())
 		if err != nil {
 			return nil, err
 		}
-		if workingDays > balance.AvailableDays {
+		if workingDays > balance.ForType(leaveType.ID).AvailableDays {
 			return nil, ErrInsufficientBalance
 		}
 	}
@@ -257,6 +366,9 @@ func (s *Service) ApplyLeave(ctx context.Context, claims *models.Claims, input A
 	requestInput := input
 	requestInput.StartDate = startDate.Format("2006-01-02")
 	requestInput.EndDate = endDate.Format("2006-01-02")
+	requestInput.StartDayPart = period.StartDayPart
+	requestInput.EndDayPart = period.EndDayPart
+	requestInput.Hours = period.Hours
 	requestInput.Reason = normalizeOptionalPtr(input.Reason)
 
 	created, err := s.repository.CreateLeaveRequest(ctx, employeeID, requestInput, workingDays)
@@ -264,21 +376,29 @@ func (s *Service) ApplyLeave(ctx context.Context, claims *models.Claims, input A
 		return nil, err
 	}
 
-	s.audit.RecordAuditEvent(ctx, claimsUserID(claims), "leave.request.create", stringPtr("leave_request"), &created.ID, map[string]any{
-		"employee_id":   created.EmployeeID,
-		"leave_type_id": created.LeaveTypeID,
-		"start_date":    requestInput.StartDate,
-		"end_date":      requestInput.EndDate,
-	})
+	metadata := map[string]any{
+		"employee_id":    created.EmployeeID,
+		"leave_type_id":  created.LeaveTypeID,
+		"start_date":     requestInput.StartDate,
+		"end_date":       requestInput.EndDate,
+		"start_day_part": requestInput.StartDayPart,
+		"end_day_part":   requestInput.EndDayPart,
+		"working_days":   created.WorkingDays,
+	}
+	if requestInput.Hours != nil {
+		metadata["hours"] = *requestInput.Hours
+	}
+	s.audit.RecordAuditEvent(ctx, claimsUserID(claims), "leave.request.create", stringPtr("leave_request"), &created.ID, metadata)
 
 	return created, nil
 }
 
 func (s *Service) ListMyLeaveRequests(ctx context.Context, claims *models.Claims, filter ListLeaveRequestsFilter) ([]LeaveRequest, error) {
-	if claims == nil {
-		return nil, ErrForbidden
+	employeeID, err := s.resolveEmployeeID(ctx, claims)
+	if err != nil {
+		return nil, err
 	}
-	return s.repository.ListMyLeaveRequests(ctx, claims.UserID, filter)
+	return s.repository.ListMyLeaveRequests(ctx, employeeID, filter)
 }
 
 func (s *Service) ListAllLeaveRequests(ctx context.Context, filter ListLeaveRequestsFilter) ([]LeaveRequest, error) {
@@ -359,7 +479,11 @@ func (s *Service) CancelLeave(ctx context.Context, claims *models.Claims, reques
 	}
 
 	isAdminOrHR := hasAdminOrHRRole(claims.Role)
-	isSelf := item.EmployeeID == claims.UserID
+	linkedEmployeeID, err := s.repository.GetLinkedEmployeeID(ctx, claims.UserID)
+	if err != nil {
+		return nil, err
+	}
+	isSelf := linkedEmployeeID != nil && item.EmployeeID == *linkedEmployeeID
 	if !CanTransitionStatus(item.Status, StatusCancelled, isAdminOrHR, isSelf) {
 		return nil, ErrInvalidTransition
 	}
@@ -403,6 +527,11 @@ func (s *Service) CreateSingleDayLeaveFromAttendance(ctx context.Context, claims
 	if !exists {
 		return 0, ErrNotFound
 	}
+	period := LeavePeriod{StartDate: targetDate, EndDate: targetDate, StartDayPart: DayPartFull, EndDayPart: DayPartFull}
+	_, workingDays, workDays, err := s.calculateLeaveDays(ctx, employeeID, period)
+	if err != nil {
+		return 0, err
+	}
 
 	leaveTypes, err := s.repository.ListLeaveTypes(ctx, true)
 	if err != nil {
@@ -430,20 +559,16 @@ func (s *Service) CreateSingleDayLeaveFromAttendance(ctx context.Context, claims
 		return 0, ErrLockedDateConflict
 	}
 
-	overlap, err := s.repository.ExistsApprovedOverlap(ctx, employeeID, targetDate, targetDate, nil)
-	if err != nil {
+	if err := s.checkApprovedOverlap(ctx, employeeID, period, workDays); err != nil {
 		return 0, err
 	}
-	if overlap {
-		return 0, ErrOverlapApproved
-	}
 
 	if countsTowardEntitlement {
 		balance, err := s.GetLeaveBalance(ctx, employeeID, targetDate.Year())
 		if err != nil {
 			return 0, err
 		}
-		if balance.AvailableDays < 1 {
+		if balance.ForType(leaveTypeID).AvailableDays < workingDays {
 			return 0, ErrInsufficientBalance
 		}
 	}
@@ -453,7 +578,7 @@ func (s *Service) CreateSingleDayLeaveFromAttendance(ctx context.Context, claims
 		StartDate:   targetDate.Format("2006-01-02"),
 		EndDate:     targetDate.Format("2006-01-02"),
 		Reason:      stringPtr("Posted from attendance absence"),
-	}, 1)
+	}, workingDays)
 	if err != nil {
 		return 0, err
 	}
@@ -482,6 +607,57 @@ func normalizeLeaveTypeInput(input LeaveTypeUpsertInput) (LeaveTypeUpsertInput,
 	}, nil
 }
 
+// calculateLeaveDays counts the leave days of a period for an employee, leaving out the days off of their work
+// schedule and public holidays. It also returns the work calendar of the period, which gives the daily hours.
+func (s *Service) calculateLeaveDays(ctx context.Context, employeeID int64, period LeavePeriod) ([]time.Time, float64, []schedules.WorkDay, error) {
+	if period.EndDate.Before(period.StartDate) {
+		return nil, 0, nil, fmt.Errorf("%w: end date must be on/after start date", ErrValidation)
+	}
+	workDays := schedules.StandardWorkDays(period.StartDate, period.EndDate)
+	if s.schedules != nil {
+		days, err := s.schedules.EmployeeWorkDays(ctx, employeeID, period.StartDate, period.EndDate)
+		if err != nil {
+			return nil, 0, nil, err
+		}
+		workDays = days
+	}
+	var holidays []time.Time
+	if s.holidays != nil {
+		dates, err := s.holidays.HolidayDates(ctx, period.StartDate, period.EndDate)
+		if err != nil {
+			return nil, 0, nil, err
+		}
+		holidays = dates
+	}
+	dates, days, err := CalculateLeaveDays(period, workDays, holidays)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+	return dates, days, workDays, nil
+}
+
+// checkApprovedOverlap rejects a period that takes time already taken by approved leave of the employee. Half
+// days and hours may share a date as long as together they fit in the day.
+func (s *Service) checkApprovedOverlap(ctx context.Context, employeeID int64, period LeavePeriod, workDays []schedules.WorkDay) error {
+	approved, err := s.repository.ListApprovedOverlaps(ctx, employeeID, period.StartDate, period.EndDate, nil)
+	if err != nil {
+		return err
+	}
+	dailyHours := make(map[time.Time]float64, len(workDays))
+	for _, day := range workDays {
+		dailyHours[day.Date] = day.DailyHours
+	}
+	hoursOn := func(date time.Time) float64 {
+		return dailyHours[time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)]
+	}
+	for _, item := range approved {
+		if PeriodsConflict(period, item.Period(), hoursOn) {
+			return ErrOverlapApproved
+		}
+	}
+	return nil
+}
+
 func normalizeOptional(value string) *string {
 	trimmed := strings.TrimSpace(value)
 	if trimmed == "" {
@@ -514,6 +690,21 @@ func hasLockedWorkingDate(workingDates []time.Time, lockedDates []time.Time) boo
 	return false
 }
 
+// resolveEmployeeID returns the employee linked to the signed-in user account for self-service operations.
+func (s *Service) resolveEmployeeID(ctx context.Context, claims *models.Claims) (int64, error) {
+	if claims == nil {
+		return 0, ErrForbidden
+	}
+	employeeID, err := s.repository.GetLinkedEmployeeID(ctx, claims.UserID)
+	if err != nil {
+		return 0, err
+	}
+	if employeeID == nil {
+		return 0, ErrEmployeeNotLinked
+	}
+	return *employeeID, nil
+}
+
 func hasAdminOrHRRole(role string) bool {
 	normalized := middleware.NormalizeRole(role)
 	return normalized == "admin" || normalized == "hr_officer"
@@ -7,18 +7,20 @@ import (
 	"time"
 
 	"hrpro/internal/models"
+	"hrpro/internal/schedules"
 )
 
 type fakeRepository struct {
-	employeeExists bool
-	leaveType      *LeaveType
-	lockedDates    []time.Time
-	overlap        bool
-	entitlement    *LeaveEntitlement
-	approvedDays   float64
-	pendingDays    float64
-	requestByID    map[int64]*LeaveRequest
-	createdRequest *LeaveRequest
+	employeeExists  bool
+	linkedEmployees map[int64]int64
+	leaveType       *LeaveType
+	leaveTypes      []LeaveType
+	lockedDates     []time.Time
+	overlaps        []LeaveRequest
+	entitlements    []LeaveEntitlement
+	consumed        []ConsumedLeaveDays
+	requestByID     map[int64]*LeaveRequest
+	createdRequest  *LeaveRequest
 }
 
 type captureAuditRecorder struct {
@@ -29,12 +31,45 @@ func (c *captureAuditRecorder) RecordAuditEvent(_ context.Context, _ *int64, act
 	c.actions = append(c.actions, action)
 }
 
+type fakeHolidayProvider struct {
+	dates []time.Time
+}
+
+func (f fakeHolidayProvider) HolidayDates(_ context.Context, from, to time.Time) ([]time.Time, error) {
+	result := make([]time.Time, 0, len(f.dates))
+	for _, date := range f.dates {
+		if !date.Before(from) && !date.After(to) {
+			result = append(result, date)
+		}
+	}
+	return result, nil
+}
+
+type fakeWorkScheduleProvider struct {
+	schedule schedules.WorkSchedule
+}
+
+func (f fakeWorkScheduleProvider) EmployeeWorkDays(_ context.Context, _ int64, from, to time.Time) ([]schedules.WorkDay, error) {
+	return schedules.WorkDays(from, to, nil, nil, f.schedule), nil
+}
+
 func (f *fakeRepository) EmployeeExists(_ context.Context, _ int64) (bool, error) {
 	return f.employeeExists, nil
 }
 
+func (f *fakeRepository) GetLinkedEmployeeID(_ context.Context, userID int64) (*int64, error) {
+	employeeID, ok := f.linkedEmployees[userID]
+	if !ok {
+		return nil, nil
+	}
+	return &employeeID, nil
+}
+
 func (f *fakeRepository) ListLeaveTypes(_ context.Context, _ bool) ([]LeaveType, error) {
-	return []LeaveType{}, nil
+	if f.leaveTypes == nil && f.leaveType != nil {
+		return []LeaveType{*f.leaveType}, nil
+	}
+	return f.leaveTypes, nil
 }
 
 func (f *fakeRepository) GetLeaveTypeByID(_ context.Context, _ int64) (*LeaveType, error) {
@@ -69,8 +104,8 @@ func (f *fakeRepository) UnlockDate(_ context.Context, _ time.Time) (bool, error
 	return true, nil
 }
 
-func (f *fakeRepository) GetEntitlement(_ context.Context, _ int64, _ int) (*LeaveEntitlement, error) {
-	return f.entitlement, nil
+func (f *fakeRepository) ListEntitlements(_ context.Context, _ int64, _ int) ([]LeaveEntitlement, error) {
+	return f.entitlements, nil
 }
 
 func (f *fakeRepository) UpsertEntitlement(_ context.Context, input UpsertEntitlementInput) (*LeaveEntitlement, error) {
@@ -78,30 +113,40 @@ func (f *fakeRepository) UpsertEntitlement(_ context.Context, input UpsertEntitl
 		ID:           1,
 		EmployeeID:   input.EmployeeID,
 		Year:         input.Year,
+		LeaveTypeID:  input.LeaveTypeID,
 		TotalDays:    input.TotalDays,
 		ReservedDays: input.ReservedDays,
 	}, nil
 }
 
-func (f *fakeRepository) SumConsumedDays(_ context.Context, _ int64, _ int) (approved float64, pending float64, err error) {
-	return f.approvedDays, f.pendingDays, nil
+func (f *fakeRepository) SumConsumedDaysByType(_ context.Context, _ int64, _ int) ([]ConsumedLeaveDays, error) {
+	return f.consumed, nil
 }
 
-func (f *fakeRepository) ExistsApprovedOverlap(_ context.Context, _ int64, _, _ time.Time, _ *int64) (bool, error) {
-	return f.overlap, nil
+func (f *fakeRepository) ListApprovedOverlaps(_ context.Context, _ int64, startDate, endDate time.Time, _ *int64) ([]LeaveRequest, error) {
+	items := make([]LeaveRequest, 0)
+	for _, item := range f.overlaps {
+		if DatesOverlap(startDate, endDate, item.StartDate, item.EndDate) {
+			items = append(items, item)
+		}
+	}
+	return items, nil
 }
 
 func (f *fakeRepository) CreateLeaveRequest(_ context.Context, employeeID int64, input ApplyLeaveInput, workingDays float64) (*LeaveRequest, error) {
 	startDate, _ := time.Parse("2006-01-02", input.StartDate)
 	endDate, _ := time.Parse("2006-01-02", input.EndDate)
 	request := &LeaveRequest{
-		ID:          100,
-		EmployeeID:  employeeID,
-		LeaveTypeID: input.LeaveTypeID,
-		StartDate:   startDate,
-		EndDate:     endDate,
-		WorkingDays: workingDays,
-		Status:      StatusPending,
+		ID:           100,
+		EmployeeID:   employeeID,
+		LeaveTypeID:  input.LeaveTypeID,
+		StartDate:    startDate,
+		EndDate:      endDate,
+		StartDayPart: input.StartDayPart,
+		EndDayPart:   input.EndDayPart,
+		Hours:        input.Hours,
+		WorkingDays:  workingDays,
+		Status:       StatusPending,
 	}
 	f.createdRequest = request
 	return request, nil
@@ -130,10 +175,11 @@ func (f *fakeRepository) UpdateLeaveRequestStatus(_ context.Context, id int64, s
 
 func TestApplyLeaveRejectsLockedDates(t *testing.T) {
 	repo := &fakeRepository{
-		employeeExists: true,
-		leaveType:      &LeaveType{ID: 1, Active: true, CountsTowardEntitlement: true},
-		entitlement:    &LeaveEntitlement{EmployeeID: 10, Year: 2026, TotalDays: 20, ReservedDays: 0},
-		lockedDates:    []time.Time{time.Date(2026, time.February, 23, 0, 0, 0, 0, time.UTC)},
+		employeeExists:  true,
+		linkedEmployees: map[int64]int64{10: 10},
+		leaveType:       &LeaveType{ID: 1, Active: true, CountsTowardEntitlement: true},
+		entitlements:    []LeaveEntitlement{{EmployeeID: 10, Year: 2026, LeaveTypeID: 1, TotalDays: 20}},
+		lockedDates:     []time.Time{time.Date(2026, time.February, 23, 0, 0, 0, 0, time.UTC)},
 	}
 	service := NewService(repo)
 
@@ -151,9 +197,9 @@ func TestApplyLeaveRejectsLockedDates(t *testing.T) {
 func TestGetLeaveBalanceIncludesPendingAndApproved(t *testing.T) {
 	repo := &fakeRepository{
 		employeeExists: true,
-		entitlement:    &LeaveEntitlement{EmployeeID: 9, Year: 2026, TotalDays: 25, ReservedDays: 3},
-		approvedDays:   5,
-		pendingDays:    4,
+		leaveTypes:     []LeaveType{{ID: 1, Name: "Annual Leave", Active: true, CountsTowardEntitlement: true}},
+		entitlements:   []LeaveEntitlement{{EmployeeID: 9, Year: 2026, LeaveTypeID: 1, TotalDays: 25, ReservedDays: 3}},
+		consumed:       []ConsumedLeaveDays{{LeaveTypeID: 1, ApprovedDays: 5, PendingDays: 4}},
 	}
 	service := NewService(repo)
 
@@ -169,10 +215,17 @@ func TestGetLeaveBalanceIncludesPendingAndApproved(t *testing.T) {
 
 func TestApplyLeaveRejectsOverlapWithApprovedLeave(t *testing.T) {
 	repo := &fakeRepository{
-		employeeExists: true,
-		leaveType:      &LeaveType{ID: 1, Active: true, CountsTowardEntitlement: true},
-		entitlement:    &LeaveEntitlement{EmployeeID: 10, Year: 2026, TotalDays: 20, ReservedDays: 0},
-		overlap:        true,
+		employeeExists:  true,
+		linkedEmployees: map[int64]int64{10: 10},
+		leaveType:       &LeaveType{ID: 1, Active: true, CountsTowardEntitlement: true},
+		entitlements:    []LeaveEntitlement{{EmployeeID: 10, Year: 2026, LeaveTypeID: 1, TotalDays: 20}},
+		overlaps: []LeaveRequest{{
+			ID:         7,
+			EmployeeID: 10,
+			StartDate:  time.Date(2026, time.February, 24, 0, 0, 0, 0, time.UTC),
+			EndDate:    time.Date(2026, time.February, 25, 0, 0, 0, 0, time.UTC),
+			Status:     StatusApproved,
+		}},
 	}
 	service := NewService(repo)
 
@@ -189,6 +242,7 @@ func TestApplyLeaveRejectsOverlapWithApprovedLeave(t *testing.T) {
 
 func TestStatusTransitionsAndRBAC(t *testing.T) {
 	repo := &fakeRepository{
+		linkedEmployees: map[int64]int64{10: 10},
 		requestByID: map[int64]*LeaveRequest{
 			1: {
 				ID:         1,
@@ -226,8 +280,9 @@ func TestStatusTransitionsAndRBAC(t *testing.T) {
 
 func TestApplyLeaveRecordsAuditEvent(t *testing.T) {
 	repo := &fakeRepository{
-		employeeExists: true,
-		leaveType:      &LeaveType{ID: 1, Active: true, CountsTowardEntitlement: false},
+		employeeExists:  true,
+		linkedEmployees: map[int64]int64{10: 10},
+		leaveType:       &LeaveType{ID: 1, Active: true, CountsTowardEntitlement: false},
 	}
 	service := NewService(repo)
 	recorder := &captureAuditRecorder{}
@@ -246,3 +301,255 @@ func TestApplyLeaveRecordsAuditEvent(t *testing.T) {
 		t.Fatalf("expected leave.request.create audit action, got %v", recorder.actions)
 	}
 }
+
+func TestSelfServiceResolvesLinkedEmployee(t *testing.T) {
+	repo := &fakeRepository{
+		employeeExists:  true,
+		linkedEmployees: map[int64]int64{3: 10},
+		leaveType:       &LeaveType{ID: 1, Active: true, CountsTowardEntitlement: false},
+	}
+	service := NewService(repo)
+
+	created, err := service.ApplyLeave(context.Background(), &models.Claims{UserID: 3, Role: "Viewer"}, ApplyLeaveInput{
+		LeaveTypeID: 1,
+		StartDate:   "2026-02-23",
+		EndDate:     "2026-02-24",
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if created.EmployeeID != 10 {
+		t.Fatalf("expected the request for linked employee 10, got %d", created.EmployeeID)
+	}
+
+	unlinked := &models.Claims{UserID: 10, Role: "Viewer"}
+	if _, err := service.GetMyLeaveBalance(context.Background(), unlinked, 2026); !errors.Is(err, ErrEmployeeNotLinked) {
+		t.Fatalf("expected not linked error for balance, got %v", err)
+	}
+	if _, err := service.ListMyLeaveRequests(context.Background(), unlinked, ListLeaveRequestsFilter{}); !errors.Is(err, ErrEmployeeNotLinked) {
+		t.Fatalf("expected not linked error for requests, got %v", err)
+	}
+}
+
+func TestApplyLeaveSkipsPublicHolidays(t *testing.T) {
+	repo := &fakeRepository{
+		employeeExists:  true,
+		linkedEmployees: map[int64]int64{10: 10},
+		leaveType:       &LeaveType{ID: 1, Active: true, CountsTowardEntitlement: false},
+	}
+	service := NewService(repo)
+	service.SetHolidayProvider(fakeHolidayProvider{dates: []time.Time{
+		time.Date(2026, time.April, 3, 0, 0, 0, 0, time.UTC),
+		time.Date(2026, time.April, 6, 0, 0, 0, 0, time.UTC),
+	}})
+
+	created, err := service.ApplyLeave(context.Background(), &models.Claims{UserID: 10, Role: "Viewer"}, ApplyLeaveInput{
+		LeaveTypeID: 1,
+		StartDate:   "2026-03-30",
+		EndDate:     "2026-04-10",
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if created.WorkingDays != 8 {
+		t.Fatalf("expected 8 working days over Easter, got %.2f", created.WorkingDays)
+	}
+}
+
+func TestApplyLeaveCountsDaysOnTheEmployeeSchedule(t *testing.T) {
+	repo := &fakeRepository{
+		employeeExists:  true,
+		linkedEmployees: map[int64]int64{10: 10},
+		leaveType:       &LeaveType{ID: 1, Active: true, CountsTowardEntitlement: false},
+	}
+	service := NewService(repo)
+	service.SetWorkScheduleProvider(fakeWorkScheduleProvider{schedule: schedules.WorkSchedule{HoursPerDay: 8, Days: []schedules.WorkScheduleDay{
+		{Weekday: int(time.Monday), Fraction: 1},
+		{Weekday: int(time.Wednesday), Fraction: 1},
+		{Weekday: int(time.Saturday), Fraction: 0.5},
+	}}})
+
+	created, err := service.ApplyLeave(context.Background(), &models.Claims{UserID: 10, Role: "Viewer"}, ApplyLeaveInput{
+		LeaveTypeID: 1,
+		StartDate:   "2026-03-02",
+		EndDate:     "2026-03-08",
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if created.WorkingDays != 2.5 {
+		t.Fatalf("expected Monday, Wednesday and the Saturday half day, got %.2f", created.WorkingDays)
+	}
+
+	_, err = service.ApplyLeave(context.Background(), &models.Claims{UserID: 10, Role: "Viewer"}, ApplyLeaveInput{
+		LeaveTypeID: 1,
+		StartDate:   "2026-03-03",
+		EndDate:     "2026-03-03",
+	})
+	if !errors.Is(err, ErrValidation) {
+		t.Fatalf("expected validation error for a day off, got %v", err)
+	}
+}
+
+func TestPreviewLeaveDaysReturnsZeroForDaysOff(t *testing.T) {
+	repo := &fakeRepository{employeeExists: true, linkedEmployees: map[int64]int64{10: 10}}
+	service := NewService(repo)
+	claims := &models.Claims{UserID: 10, Role: "Viewer"}
+
+	preview, err := service.PreviewLeaveDays(context.Background(), claims, ApplyLeaveInput{StartDate: "2026-03-07", EndDate: "2026-03-08"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if preview.WorkingDays != 0 {
+		t.Fatalf("expected a weekend to preview as zero days, got %.2f", preview.WorkingDays)
+	}
+
+	preview, err = service.PreviewLeaveDays(context.Background(), claims, ApplyLeaveInput{StartDate: "2026-03-06", EndDate: "2026-03-09"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if preview.WorkingDays != 2 {
+		t.Fatalf("expected Friday and Monday, got %.2f", preview.WorkingDays)
+	}
+}
+
+func TestApplyLeaveChargesHalfDaysAndHours(t *testing.T) {
+	repo := &fakeRepository{
+		employeeExists:  true,
+		linkedEmployees: map[int64]int64{10: 10},
+		leaveType:       &LeaveType{ID: 1, Active: true, CountsTowardEntitlement: true},
+		entitlements:    []LeaveEntitlement{{EmployeeID: 10, Year: 2026, LeaveTypeID: 1, TotalDays: 2}},
+		consumed:        []ConsumedLeaveDays{{LeaveTypeID: 1, ApprovedDays: 1}},
+		overlaps: []LeaveRequest{{
+			ID:           7,
+			EmployeeID:   10,
+			StartDate:    time.Date(2026, time.March, 2, 0, 0, 0, 0, time.UTC),
+			EndDate:      time.Date(2026, time.March, 2, 0, 0, 0, 0, time.UTC),
+			StartDayPart: DayPartPM,
+			EndDayPart:   DayPartPM,
+			Status:       StatusApproved,
+		}},
+	}
+	service := NewService(repo)
+	service.SetWorkScheduleProvider(fakeWorkScheduleProvider{schedule: schedules.WorkSchedule{HoursPerDay: 7.5, Days: []schedules.WorkScheduleDay{
+		{Weekday: int(time.Monday), Fraction: 1},
+		{Weekday: int(time.Tuesday), Fraction: 1},
+		{Weekday: int(time.Wednesday), Fraction: 1},
+	}}})
+	claims := &models.Claims{UserID: 10, Role: "Viewer"}
+
+	created, err := service.ApplyLeave(context.Background(), claims, ApplyLeaveInput{
+		LeaveTypeID:  1,
+		StartDate:    "2026-03-02",
+		EndDate:      "2026-03-02",
+		StartDayPart: DayPartAM,
+	})
+	if err != nil {
+		t.Fatalf("expected the morning next to an approved afternoon to pass, got %v", err)
+	}
+	if created.WorkingDays != 0.5 || created.StartDayPart != DayPartAM || created.EndDayPart != DayPartAM {
+		t.Fatalf("expected a morning charged as half a day, got %.2f %s-%s", created.WorkingDays, created.StartDayPart, created.EndDayPart)
+	}
+
+	_, err = service.ApplyLeave(context.Background(), claims, ApplyLeaveInput{
+		LeaveTypeID: 1,
+		StartDate:   "2026-03-02",
+		EndDate:     "2026-03-03",
+		EndDayPart:  DayPartAM,
+	})
+	if !errors.Is(err, ErrOverlapApproved) {
+		t.Fatalf("expected a full day over the approved afternoon to overlap, got %v", err)
+	}
+
+	hours := 3.0
+	created, err = service.ApplyLeave(context.Background(), claims, ApplyLeaveInput{
+		LeaveTypeID: 1,
+		StartDate:   "2026-03-04",
+		EndDate:     "2026-03-04",
+		Hours:       &hours,
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if created.WorkingDays != 0.4 {
+		t.Fatalf("expected 3 of 7.5 hours to be 0.4 days, got %.2f", created.WorkingDays)
+	}
+
+	_, err = service.ApplyLeave(context.Background(), claims, ApplyLeaveInput{
+		LeaveTypeID: 1,
+		StartDate:   "2026-03-03",
+		EndDate:     "2026-03-04",
+		EndDayPart:  DayPartAM,
+	})
+	if !errors.Is(err, ErrInsufficientBalance) {
+		t.Fatalf("expected 1.5 days to exceed the remaining day, got %v", err)
+	}
+}
+
+func TestLeaveBalanceIsKeptPerLeaveType(t *testing.T) {
+	annual := LeaveType{ID: 1, Name: "Annual Leave", Active: true, CountsTowardEntitlement: true}
+	sick := LeaveType{ID: 2, Name: "Sick Leave", Active: true, CountsTowardEntitlement: true}
+	repo := &fakeRepository{
+		employeeExists:  true,
+		linkedEmployees: map[int64]int64{10: 10},
+		leaveType:       &sick,
+		leaveTypes: []LeaveType{
+			annual,
+			sick,
+			{ID: 3, Name: "Unpaid Leave", Active: true},
+			{ID: 4, Name: "Study Leave", CountsTowardEntitlement: true},
+		},
+		entitlements: []LeaveEntitlement{
+			{EmployeeID: 10, Year: 2026, LeaveTypeID: 1, TotalDays: 20},
+			{EmployeeID: 10, Year: 2026, LeaveTypeID: 2, TotalDays: 5},
+		},
+		consumed: []ConsumedLeaveDays{{LeaveTypeID: 1, ApprovedDays: 3}, {LeaveTypeID: 2, ApprovedDays: 4, PendingDays: 0.5}},
+	}
+	service := NewService(repo)
+
+	balance, err := service.GetLeaveBalance(context.Background(), 10, 2026)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(balance.Types) != 2 {
+		t.Fatalf("expected annual and sick leave balances, got %+v", balance.Types)
+	}
+	if balance.ForType(1).AvailableDays != 17 || balance.ForType(2).AvailableDays != 0.5 {
+		t.Fatalf("expected 17 annual and 0.5 sick days available, got %+v", balance.Types)
+	}
+	if balance.TotalDays != 25 || balance.AvailableDays != 17.5 {
+		t.Fatalf("expected totals summed over types, got %.2f total %.2f available", balance.TotalDays, balance.AvailableDays)
+	}
+
+	_, err = service.ApplyLeave(context.Background(), &models.Claims{UserID: 10, Role: "Viewer"}, ApplyLeaveInput{
+		LeaveTypeID: 2,
+		StartDate:   "2026-03-02",
+		EndDate:     "2026-03-02",
+	})
+	if !errors.Is(err, ErrInsufficientBalance) {
+		t.Fatalf("expected sick leave to be limited to its own balance, got %v", err)
+	}
+
+	repo.leaveType = &annual
+	if _, err := service.ApplyLeave(context.Background(), &models.Claims{UserID: 10, Role: "Viewer"}, ApplyLeaveInput{
+		LeaveTypeID: 1,
+		StartDate:   "2026-03-02",
+		EndDate:     "2026-03-06",
+	}); err != nil {
+		t.Fatalf("expected annual leave to draw on its own balance, got %v", err)
+	}
+}
+
+func TestUpsertEntitlementRequiresEntitlementLeaveType(t *testing.T) {
+	repo := &fakeRepository{employeeExists: true, leaveType: &LeaveType{ID: 3, Name: "Unpaid Leave", Active: true}}
+	service := NewService(repo)
+
+	_, err := service.UpsertEntitlement(context.Background(), UpsertEntitlementInput{EmployeeID: 10, Year: 2026, TotalDays: 5})
+	if !errors.Is(err, ErrValidation) {
+		t.Fatalf("expected validation error without a leave type, got %v", err)
+	}
+	_, err = service.UpsertEntitlement(context.Background(), UpsertEntitlementInput{EmployeeID: 10, Year: 2026, LeaveTypeID: 3, TotalDays: 5})
+	if !errors.Is(err, ErrValidation) {
+		t.Fatalf("expected validation error for a type outside entitlement, got %v", err)
+	}
+}
@@ -9,6 +9,14 @@ const (
 	StatusCancelled = "Cancelled"
 )
 
+// Day parts of the first and last day of a leave request. DayPartHours marks hourly leave and is not stored.
+const (
+	DayPartFull  = "full"
+	DayPartAM    = "am"
+	DayPartPM    = "pm"
+	DayPartHours = "hours"
+)
+
 type LeaveType struct {
 	ID                      int64     `db:"id" json:"id"`
 	Name                    string    `db:"name" json:"name"`
@@ -29,14 +37,17 @@ type LeaveTypeUpsertInput struct {
 	RequiresApproval        bool   `json:"requiresApproval"`
 }
 
+// LeaveEntitlement is the number of days of one leave type an employee may take in a year.
 type LeaveEntitlement struct {
-	ID           int64     `db:"id" json:"id"`
-	EmployeeID   int64     `db:"employee_id" json:"employeeId"`
-	Year         int       `db:"year" json:"year"`
-	TotalDays    float64   `db:"total_days" json:"totalDays"`
-	ReservedDays float64   `db:"reserved_days" json:"reservedDays"`
-	CreatedAt    time.Time `db:"created_at" json:"createdAt"`
-	UpdatedAt    time.Time `db:"updated_at" json:"updatedAt"`
+	ID            int64     `db:"id" json:"id"`
+	EmployeeID    int64     `db:"employee_id" json:"employeeId"`
+	Year          int       `db:"year" json:"year"`
+	LeaveTypeID   int64     `db:"leave_type_id" json:"leaveTypeId"`
+	LeaveTypeName string    `db:"leave_type_name" json:"leaveTypeName"`
+	TotalDays     float64   `db:"total_days" json:"totalDays"`
+	ReservedDays  float64   `db:"reserved_days" json:"reservedDays"`
+	CreatedAt     time.Time `db:"created_at" json:"createdAt"`
+	UpdatedAt     time.Time `db:"updated_at" json:"updatedAt"`
 }
 
 type LeaveLockedDate struct {
@@ -56,6 +67,9 @@ type LeaveRequest struct {
 	LeaveTypeName string     `db:"leave_type_name" json:"leaveTypeName"`
 	StartDate     time.Time  `db:"start_date" json:"startDate"`
 	EndDate       time.Time  `db:"end_date" json:"endDate"`
+	StartDayPart  string     `db:"start_day_part" json:"startDayPart"`
+	EndDayPart    string     `db:"end_day_part" json:"endDayPart"`
+	Hours         *float64   `db:"hours" json:"hours,omitempty"`
 	WorkingDays   float64    `db:"working_days" json:"workingDays"`
 	Status        string     `db:"status" json:"status"`
 	Reason        *string    `db:"reason" json:"reason,omitempty"`
@@ -65,9 +79,23 @@ type LeaveRequest struct {
 	UpdatedAt     time.Time  `db:"updated_at" json:"updatedAt"`
 }
 
+// LeaveBalance is an employee's leave balance for a year. Types holds the balance of each leave type that counts
+// toward entitlement; the day totals are their sums.
 type LeaveBalance struct {
-	EmployeeID    int64   `json:"employeeId"`
-	Year          int     `json:"year"`
+	EmployeeID    int64              `json:"employeeId"`
+	Year          int                `json:"year"`
+	TotalDays     float64            `json:"totalDays"`
+	ReservedDays  float64            `json:"reservedDays"`
+	ApprovedDays  float64            `json:"approvedDays"`
+	PendingDays   float64            `json:"pendingDays"`
+	AvailableDays float64            `json:"availableDays"`
+	Types         []LeaveTypeBalance `json:"types"`
+}
+
+// LeaveTypeBalance is the balance of one leave type within a LeaveBalance.
+type LeaveTypeBalance struct {
+	LeaveTypeID   int64   `json:"leaveTypeId"`
+	LeaveTypeName string  `json:"leaveTypeName"`
 	TotalDays     float64 `json:"totalDays"`
 	ReservedDays  float64 `json:"reservedDays"`
 	ApprovedDays  float64 `json:"approvedDays"`
@@ -75,18 +103,63 @@ type LeaveBalance struct {
 	AvailableDays float64 `json:"availableDays"`
 }
 
+// ForType returns the balance of a leave type, or an empty balance when the type has none.
+func (b LeaveBalance) ForType(leaveTypeID int64) LeaveTypeBalance {
+	for _, item := range b.Types {
+		if item.LeaveTypeID == leaveTypeID {
+			return item
+		}
+	}
+	return LeaveTypeBalance{LeaveTypeID: leaveTypeID}
+}
+
+// ConsumedLeaveDays is the approved and pending leave days of one leave type taken by an employee in a year.
+type ConsumedLeaveDays struct {
+	LeaveTypeID  int64   `db:"leave_type_id"`
+	ApprovedDays float64 `db:"approved_days"`
+	PendingDays  float64 `db:"pending_days"`
+}
+
 type UpsertEntitlementInput struct {
 	EmployeeID   int64   `json:"employeeId"`
 	Year         int     `json:"year"`
+	LeaveTypeID  int64   `json:"leaveTypeId"`
 	TotalDays    float64 `json:"totalDays"`
 	ReservedDays float64 `json:"reservedDays"`
 }
 
+// ApplyLeaveInput requests leave from StartDate to EndDate. StartDayPart "pm" starts it in the afternoon of the first
+// day and EndDayPart "am" ends it in the morning of the last; on a single day either takes that half. Hours, on a
+// single day, requests that many hours instead.
 type ApplyLeaveInput struct {
-	LeaveTypeID int64   `json:"leaveTypeId"`
+	LeaveTypeID  int64    `json:"leaveTypeId"`
+	StartDate    string   `json:"startDate"`
+	EndDate      string   `json:"endDate"`
+	StartDayPart string   `json:"startDayPart"`
+	EndDayPart   string   `json:"endDayPart"`
+	Hours        *float64 `json:"hours,omitempty"`
+	Reason       *string  `json:"reason"`
+}
+
+// LeavePeriod is the time a leave request takes.
+type LeavePeriod struct {
+	StartDate    time.Time
+	EndDate      time.Time
+	StartDayPart string
+	EndDayPart   string
+	Hours        *float64
+}
+
+// Period returns the time the request takes.
+func (r LeaveRequest) Period() LeavePeriod {
+	return LeavePeriod{StartDate: r.StartDate, EndDate: r.EndDate, StartDayPart: r.StartDayPart, EndDayPart: r.EndDayPart, Hours: r.Hours}
+}
+
+// LeaveDaysPreview is the number of leave days a request would be charged, before it is submitted.
+type LeaveDaysPreview struct {
 	StartDate   string  `json:"startDate"`
 	EndDate     string  `json:"endDate"`
-	Reason      *string `json:"reason"`
+	WorkingDays float64 `json:"workingDays"`
 }
 
 type ListLeaveRequestsFilter struct {
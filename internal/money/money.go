@@ -0,0 +1,309 @@
+package money
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Scale is the number of minor units in one currency unit. Amounts are held to 2 decimals, the precision of
+// the NUMERIC(14,2) columns they are stored in.
+const (
+	Scale    = 100
+	Decimals = 2
+)
+
+var ErrInvalidAmount = errors.New("invalid money amount")
+
+// Amount is an exact money value in minor units (hundredths). Arithmetic on amounts is integer arithmetic;
+// multiplication by rates and quantities rounds half away from zero back to minor units.
+type Amount int64
+
+// FromMinor returns the amount of minor units.
+func FromMinor(minor int64) Amount {
+	return Amount(minor)
+}
+
+// FromUnits returns a whole number of currency units.
+func FromUnits(units int64) Amount {
+	return Amount(units * Scale)
+}
+
+// FromFloat converts a float read from configuration or JSON, using its shortest decimal representation so
+// 1.005 becomes 1.01 rather than 1.00. NaN, infinities and values beyond the range of Amount are rejected.
+func FromFloat(value float64) (Amount, error) {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return 0, fmt.Errorf("%w: %v", ErrInvalidAmount, value)
+	}
+	return Parse(strconv.FormatFloat(value, 'f', -1, 64))
+}
+
+// Parse reads a decimal string such as "-1250000.50". Digits beyond 2 decimals are rounded half away from zero.
+func Parse(value string) (Amount, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, fmt.Errorf("%w: empty value", ErrInvalidAmount)
+	}
+	rat, ok := new(big.Rat).SetString(value)
+	if !ok {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidAmount, value)
+	}
+	return fromRat(rat.Mul(rat, big.NewRat(Scale, 1)))
+}
+
+// Sum adds amounts.
+func Sum(values ...Amount) Amount {
+	var total Amount
+	for _, value := range values {
+		total += value
+	}
+	return total
+}
+
+// Minor returns the amount in minor units.
+func (a Amount) Minor() int64 {
+	return int64(a)
+}
+
+// Float64 is for display and charting only; calculations stay on Amount.
+func (a Amount) Float64() float64 {
+	return float64(a) / Scale
+}
+
+func (a Amount) IsZero() bool {
+	return a == 0
+}
+
+func (a Amount) Abs() Amount {
+	if a < 0 {
+		return -a
+	}
+	return a
+}
+
+// Percent returns rate percent of the amount, e.g. Percent(7.5) of 1000.00 is 75.00.
+func (a Amount) Percent(rate float64) Amount {
+	return a.mulRat(new(big.Rat).Quo(floatRat(rate), big.NewRat(100, 1)))
+}
+
+// Mul multiplies the amount by a quantity such as a number of days.
+func (a Amount) Mul(quantity float64) Amount {
+	return a.mulRat(floatRat(quantity))
+}
+
+// MulFrac returns the amount times numerator/denominator, e.g. the payable days of a month.
+func (a Amount) MulFrac(numerator, denominator int64) Amount {
+	if denominator == 0 {
+		return 0
+	}
+	return a.mulRat(big.NewRat(numerator, denominator))
+}
+
+// Div splits the amount into parts equal shares.
+func (a Amount) Div(parts int64) Amount {
+	return a.MulFrac(1, parts)
+}
+
+// Round rounds the amount half away from zero to the given number of decimals; 2 or more leaves it unchanged.
+func (a Amount) Round(decimals int) Amount {
+	if decimals >= Decimals {
+		return a
+	}
+	if decimals < 0 {
+		decimals = 0
+	}
+	step := int64(1)
+	for i := decimals; i < Decimals; i++ {
+		step *= 10
+	}
+	return Amount(roundDiv(int64(a), step) * step)
+}
+
+// String returns the amount with 2 decimals, e.g. "-1250000.50".
+func (a Amount) String() string {
+	return a.format(Decimals)
+}
+
+// Format returns the amount rounded half away from zero to decimals (0 to 6), prefixed by symbol when set.
+func (a Amount) Format(decimals int, symbol string) string {
+	if decimals < 0 || decimals > 6 {
+		decimals = Decimals
+	}
+	return withSymbol(a.Round(decimals).format(decimals), symbol)
+}
+
+// FormatGrouped is Format with thousands separators, e.g. "UGX 1,250,000.00".
+func (a Amount) FormatGrouped(decimals int, symbol string) string {
+	if decimals < 0 || decimals > 6 {
+		decimals = Decimals
+	}
+	formatted := a.Round(decimals).Abs().format(decimals)
+	integer, fraction, _ := strings.Cut(formatted, ".")
+
+	var grouped strings.Builder
+	if a.Round(decimals) < 0 {
+		grouped.WriteByte('-')
+	}
+	for i, digit := range integer {
+		if i > 0 && (len(integer)-i)%3 == 0 {
+			grouped.WriteByte(',')
+		}
+		grouped.WriteRune(digit)
+	}
+	if fraction != "" {
+		grouped.WriteString("." + fraction)
+	}
+	return withSymbol(grouped.String(), symbol)
+}
+
+// MarshalJSON writes the amount as a JSON number with 2 decimals.
+func (a Amount) MarshalJSON() ([]byte, error) {
+	return []byte(a.String()), nil
+}
+
+// UnmarshalJSON accepts a JSON number or a quoted decimal string.
+func (a *Amount) UnmarshalJSON(data []byte) error {
+	text := strings.TrimSpace(string(data))
+	if text == "null" {
+		return nil
+	}
+	text = strings.Trim(text, `"`)
+	parsed, err := Parse(text)
+	if err != nil {
+		return err
+	}
+	*a = parsed
+	return nil
+}
+
+// Scan reads NUMERIC columns, which drivers return as text, as well as float and integer columns.
+func (a *Amount) Scan(src any) error {
+	switch value := src.(type) {
+	case nil:
+		*a = 0
+		return nil
+	case []byte:
+		parsed, err := Parse(string(value))
+		if err != nil {
+			return err
+		}
+		*a = parsed
+		return nil
+	case string:
+		parsed, err := Parse(value)
+		if err != nil {
+			return err
+		}
+		*a = parsed
+		return nil
+	case float64:
+		parsed, err := FromFloat(value)
+		if err != nil {
+			return err
+		}
+		*a = parsed
+		return nil
+	case int64:
+		*a = FromUnits(value)
+		return nil
+	default:
+		return fmt.Errorf("%w: cannot scan %T", ErrInvalidAmount, src)
+	}
+}
+
+// Value writes the amount as a decimal string so NUMERIC columns receive it exactly.
+func (a Amount) Value() (driver.Value, error) {
+	return a.String(), nil
+}
+
+// mulRat panics when the product overflows Amount; amounts and factors that large are a programming error.
+func (a Amount) mulRat(factor *big.Rat) Amount {
+	product := new(big.Rat).Mul(new(big.Rat).SetInt64(int64(a)), factor)
+	result, err := fromRat(product)
+	if err != nil {
+		panic(fmt.Sprintf("money: %s times %s: %v", a, factor.FloatString(6), err))
+	}
+	return result
+}
+
+func (a Amount) format(decimals int) string {
+	minor := int64(a)
+	negative := minor < 0
+	if negative {
+		minor = -minor
+	}
+	units := minor / Scale
+	fraction := fmt.Sprintf("%02d", minor%Scale)
+	switch {
+	case decimals < Decimals:
+		fraction = fraction[:decimals]
+	case decimals > Decimals:
+		fraction += strings.Repeat("0", decimals-Decimals)
+	}
+	text := strconv.FormatInt(units, 10)
+	if fraction != "" {
+		text += "." + fraction
+	}
+	if negative && minor != 0 {
+		text = "-" + text
+	}
+	return text
+}
+
+func withSymbol(amount string, symbol string) string {
+	symbol = strings.TrimSpace(symbol)
+	if symbol == "" {
+		return amount
+	}
+	return symbol + " " + amount
+}
+
+// fromRat rounds a value in minor units half away from zero.
+func fromRat(value *big.Rat) (Amount, error) {
+	numerator := new(big.Int).Set(value.Num())
+	denominator := value.Denom()
+	negative := numerator.Sign() < 0
+	numerator.Abs(numerator)
+
+	quotient, remainder := new(big.Int).QuoRem(numerator, denominator, new(big.Int))
+	if remainder.Lsh(remainder, 1).Cmp(denominator) >= 0 {
+		quotient.Add(quotient, big.NewInt(1))
+	}
+	if !quotient.IsInt64() {
+		return 0, fmt.Errorf("%w: out of range", ErrInvalidAmount)
+	}
+	if negative {
+		return Amount(-quotient.Int64()), nil
+	}
+	return Amount(quotient.Int64()), nil
+}
+
+// floatRat panics on NaN and infinities, which no rate or quantity may be.
+func floatRat(value float64) *big.Rat {
+	rat, ok := new(big.Rat).SetString(strconv.FormatFloat(value, 'f', -1, 64))
+	if !ok {
+		panic(fmt.Sprintf("money: invalid factor %v", value))
+	}
+	return rat
+}
+
+// roundDiv divides half away from zero.
+func roundDiv(value, divisor int64) int64 {
+	quotient := value / divisor
+	remainder := value % divisor
+	if remainder < 0 {
+		remainder = -remainder
+	}
+	if remainder*2 >= divisor {
+		if value < 0 {
+			quotient--
+		} else {
+			quotient++
+		}
+	}
+	return quotient
+}
@@ -0,0 +1,133 @@
+package money
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestParseAndFormat(t *testing.T) {
+	cases := map[string]string{
+		"1250000":     "1250000.00",
+		"1250000.5":   "1250000.50",
+		"0.005":       "0.01",
+		"-0.005":      "-0.01",
+		"19.994":      "19.99",
+		" -45000.10 ": "-45000.10",
+	}
+	for input, expected := range cases {
+		amount, err := Parse(input)
+		if err != nil {
+			t.Fatalf("expected %q to parse, got %v", input, err)
+		}
+		if amount.String() != expected {
+			t.Fatalf("expected %q to format as %s, got %s", input, expected, amount.String())
+		}
+	}
+	if _, err := Parse("12,000"); err == nil {
+		t.Fatalf("expected invalid amount error")
+	}
+}
+
+func TestFromFloatUsesShortestDecimal(t *testing.T) {
+	if got, err := FromFloat(1.005); err != nil || got != 101 {
+		t.Fatalf("expected 1.005 to round to 1.01, got %s (%v)", got, err)
+	}
+	if got, err := FromFloat(0.1 + 0.2); err != nil || got != 30 {
+		t.Fatalf("expected 0.30, got %s (%v)", got, err)
+	}
+	for _, value := range []float64{math.NaN(), math.Inf(1), 1e30} {
+		if _, err := FromFloat(value); !errors.Is(err, ErrInvalidAmount) {
+			t.Fatalf("expected %v to be rejected, got %v", value, err)
+		}
+	}
+}
+
+func TestMulPanicsOnOverflow(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("expected overflow to panic")
+		}
+	}()
+	FromUnits(1000000).Mul(1e20)
+}
+
+func TestArithmeticRoundsHalfAwayFromZero(t *testing.T) {
+	base := FromUnits(1000000)
+	if got := base.Percent(7.5); got != FromUnits(75000) {
+		t.Fatalf("expected 75000.00, got %s", got)
+	}
+	if got := FromUnits(2300000).MulFrac(14, 23); got != FromUnits(1400000) {
+		t.Fatalf("expected 1400000.00, got %s", got)
+	}
+	if got := FromUnits(100).Div(3); got != FromMinor(3333) {
+		t.Fatalf("expected 33.33, got %s", got)
+	}
+	if got := FromMinor(4348).Mul(2.5); got != FromMinor(10870) {
+		t.Fatalf("expected 108.70, got %s", got)
+	}
+	if got := FromMinor(-250).Round(0); got != FromUnits(-3) {
+		t.Fatalf("expected -3.00, got %s", got)
+	}
+	if got := FromMinor(99950).Round(0); got != FromUnits(1000) {
+		t.Fatalf("expected 1000.00, got %s", got)
+	}
+}
+
+func TestFormatting(t *testing.T) {
+	cases := map[string]string{
+		FromUnits(1250000).FormatGrouped(2, "UGX"): "UGX 1,250,000.00",
+		FromMinor(99950).FormatGrouped(0, ""):      "1,000",
+		FromUnits(-45000).FormatGrouped(0, ""):     "-45,000",
+		FromMinor(123456).Format(0, "UGX"):         "UGX 1235",
+		FromMinor(123456).Format(3, ""):            "1234.560",
+	}
+	for got, expected := range cases {
+		if got != expected {
+			t.Fatalf("expected %q, got %q", expected, got)
+		}
+	}
+}
+
+func TestJSONAndScanRoundTrip(t *testing.T) {
+	payload, err := json.Marshal(struct {
+		Amount Amount `json:"amount"`
+	}{Amount: FromMinor(123450)})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if string(payload) != `{"amount":1234.50}` {
+		t.Fatalf("expected number encoding, got %s", payload)
+	}
+
+	var decoded struct {
+		Amount Amount `json:"amount"`
+		Text   Amount `json:"text"`
+	}
+	if err := json.Unmarshal([]byte(`{"amount":1234.5,"text":"99.99"}`), &decoded); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if decoded.Amount != FromMinor(123450) || decoded.Text != FromMinor(9999) {
+		t.Fatalf("unexpected decoded amounts %+v", decoded)
+	}
+
+	scans := []struct {
+		src      any
+		expected Amount
+	}{
+		{src: "1250000.50", expected: FromMinor(125000050)},
+		{src: []byte("0.10"), expected: FromMinor(10)},
+		{src: 12.5, expected: FromMinor(1250)},
+		{src: int64(7), expected: FromUnits(7)},
+	}
+	for _, tc := range scans {
+		var scanned Amount
+		if err := scanned.Scan(tc.src); err != nil {
+			t.Fatalf("expected %v to scan, got %v", tc.src, err)
+		}
+		if scanned != tc.expected {
+			t.Fatalf("expected %v to scan as %s, got %s", tc.src, tc.expected, scanned)
+		}
+	}
+}
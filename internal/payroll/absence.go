@@ -0,0 +1,128 @@
+package payroll
+
+import (
+	"math"
+	"time"
+
+	"hrpro/internal/money"
+)
+
+// AbsenceLines turns the unpaid leave and unexcused absences of one employee into deduction lines at a per-day
+// rate of the full monthly salary over the period days. Only days inside the month and the employee's employment
+// are counted, and the deductions never exceed payableBase.
+func AbsenceLines(month string, basis string, calendar WorkCalendar, employee EmployeeSalary, periodDays int, payableBase money.Amount, records []AbsenceRecord) ([]PayrollEntryLine, error) {
+	if len(records) == 0 || periodDays <= 0 {
+		return nil, nil
+	}
+	start, end, err := MonthBounds(month)
+	if err != nil {
+		return nil, err
+	}
+	basis, err = NormalizeProrationBasis(basis)
+	if err != nil {
+		return nil, err
+	}
+	from := start
+	if hire := truncateDate(employee.DateOfHire); hire.After(from) {
+		from = hire
+	}
+	to := end
+	if employee.DateOfExit != nil {
+		if exit := truncateDate(*employee.DateOfExit); exit.Before(to) {
+			to = exit
+		}
+	}
+
+	rate := employee.BaseSalary.Div(int64(periodDays))
+	unpaidLeave := PayrollEntryLine{Code: LineCodeUnpaidLeave, Name: "Unpaid leave", Kind: ComponentKindDeduction}
+	absence := PayrollEntryLine{Code: LineCodeAbsence, Name: "Unexcused absence", Kind: ComponentKindDeduction}
+	for _, record := range records {
+		days := absenceDays(record, from, to, basis, calendar)
+		if days <= 0 {
+			continue
+		}
+		target := &absence
+		if record.SourceType == LineSourceLeaveRequest {
+			target = &unpaidLeave
+		}
+		target.Sources = append(target.Sources, PayrollEntryLineSource{
+			SourceType: record.SourceType,
+			SourceID:   record.SourceID,
+			Days:       days,
+		})
+	}
+
+	lines := make([]PayrollEntryLine, 0, 2)
+	remaining := payableBase
+	for _, line := range []PayrollEntryLine{unpaidLeave, absence} {
+		if len(line.Sources) == 0 {
+			continue
+		}
+		days := 0.0
+		for _, source := range line.Sources {
+			days += source.Days
+		}
+		days = roundDays(days)
+		amount := rate.Mul(days)
+		if amount > remaining {
+			amount = remaining
+		}
+		remaining -= amount
+		lineRate := rate
+		line.Quantity = &days
+		line.Rate = &lineRate
+		line.Amount = amount
+		lines = append(lines, line)
+	}
+	return lines, nil
+}
+
+// IsAbsenceLine reports whether line deducts unpaid leave or absence days rather than a pay component.
+func IsAbsenceLine(line PayrollEntryLine) bool {
+	if line.Kind != ComponentKindDeduction || line.ComponentID != nil || line.SchemeID != nil {
+		return false
+	}
+	return line.Code == LineCodeUnpaidLeave || line.Code == LineCodeAbsence
+}
+
+// EarnedBaseSalary is the base salary less unpaid leave and absence deductions; PAYE and contributions use it.
+func EarnedBaseSalary(baseSalary money.Amount, lines []PayrollEntryLine) money.Amount {
+	earned := baseSalary
+	for _, line := range lines {
+		if IsAbsenceLine(line) {
+			earned -= line.Amount
+		}
+	}
+	if earned < 0 {
+		return 0
+	}
+	return earned
+}
+
+// roundDays keeps day counts to 2 decimals so half days add up exactly.
+func roundDays(days float64) float64 {
+	return math.Round(days*100) / 100
+}
+
+// absenceDays counts the days of record inside [from, to]. A leave request wholly inside the window keeps
+// its recorded working days on the working-day basis so half days are respected.
+func absenceDays(record AbsenceRecord, from, to time.Time, basis string, calendar WorkCalendar) float64 {
+	recordStart := truncateDate(record.StartDate)
+	recordEnd := truncateDate(record.EndDate)
+	overlapStart := recordStart
+	if from.After(overlapStart) {
+		overlapStart = from
+	}
+	overlapEnd := recordEnd
+	if to.Before(overlapEnd) {
+		overlapEnd = to
+	}
+	if overlapStart.After(overlapEnd) {
+		return 0
+	}
+	if record.SourceType == LineSourceLeaveRequest && basis == ProrationWorkingDays &&
+		overlapStart.Equal(recordStart) && overlapEnd.Equal(recordEnd) && record.Days > 0 {
+		return record.Days
+	}
+	return float64(countDays(overlapStart, overlapEnd, basis, calendar))
+}
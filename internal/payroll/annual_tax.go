@@ -0,0 +1,158 @@
+package payroll
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"hrpro/internal/money"
+)
+
+// GetAnnualTaxSummary totals the locked batches of the twelve months starting at startMonth of year per
+// employee. A startMonth of 0 or 1 is the calendar year; any other month is a fiscal year labelled by both
+// calendar years it spans (2025/26). Reversal batches are locked too, so a reversed month nets out.
+func (s *Service) GetAnnualTaxSummary(ctx context.Context, year, startMonth int) (*AnnualTaxSummary, error) {
+	if year < 2000 || year > 2100 {
+		return nil, fmt.Errorf("%w: year must be between 2000 and 2100", ErrValidation)
+	}
+	if startMonth == 0 {
+		startMonth = 1
+	}
+	if startMonth < 1 || startMonth > 12 {
+		return nil, fmt.Errorf("%w: start month must be between 1 and 12", ErrValidation)
+	}
+
+	start := time.Date(year, time.Month(startMonth), 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 11, 0)
+	summary := &AnnualTaxSummary{
+		Year:       year,
+		StartMonth: startMonth,
+		Label:      annualTaxLabel(start, end),
+		FromMonth:  start.Format("2006-01"),
+		ToMonth:    end.Format("2006-01"),
+		Schemes:    make([]AnnualSchemeColumn, 0),
+		Employees:  make([]AnnualEmployeeTax, 0),
+	}
+
+	entries, err := s.repository.ListLockedEntriesForPeriod(ctx, summary.FromMonth, summary.ToMonth)
+	if err != nil {
+		return nil, err
+	}
+	lines, err := s.repository.ListLockedEntryLinesForPeriod(ctx, summary.FromMonth, summary.ToMonth)
+	if err != nil {
+		return nil, err
+	}
+	linesByEntry := make(map[int64][]PayrollEntryLine, len(entries))
+	for _, line := range lines {
+		linesByEntry[line.EntryID] = append(linesByEntry[line.EntryID], line)
+	}
+
+	type employeeTotals struct {
+		item          AnnualEmployeeTax
+		grossByMonth  map[string]money.Amount
+		contributions map[int64]*AnnualContribution
+	}
+	totals := make(map[int64]*employeeTotals)
+	order := make([]int64, 0)
+	schemes := make(map[int64]AnnualSchemeColumn)
+	for _, entry := range entries {
+		current := totals[entry.EmployeeID]
+		if current == nil {
+			current = &employeeTotals{
+				item:          AnnualEmployeeTax{EmployeeID: entry.EmployeeID, EmployeeName: entry.EmployeeName, NationalID: entry.NationalID},
+				grossByMonth:  make(map[string]money.Amount),
+				contributions: make(map[int64]*AnnualContribution),
+			}
+			totals[entry.EmployeeID] = current
+			order = append(order, entry.EmployeeID)
+		}
+		entryLines := linesByEntry[entry.ID]
+		current.item.GrossPay += entry.GrossPay
+		current.item.TaxablePay += annualEntryTaxablePay(entry.PayrollEntry, entryLines)
+		current.item.TaxTotal += entry.TaxTotal
+		current.item.NetPay += entry.NetPay
+		current.grossByMonth[entry.Month] += entry.GrossPay
+
+		for _, line := range entryLines {
+			if line.SchemeID == nil {
+				continue
+			}
+			schemeID := *line.SchemeID
+			if _, ok := schemes[schemeID]; !ok {
+				schemes[schemeID] = AnnualSchemeColumn{SchemeID: schemeID, Code: line.Code, Name: line.Name}
+			}
+			contribution := current.contributions[schemeID]
+			if contribution == nil {
+				contribution = &AnnualContribution{SchemeID: schemeID, Code: line.Code, Name: line.Name}
+				current.contributions[schemeID] = contribution
+			}
+			contribution.EmployeeAmount += line.Amount
+			contribution.EmployerAmount += line.EmployerAmount
+		}
+	}
+
+	for _, scheme := range schemes {
+		summary.Schemes = append(summary.Schemes, scheme)
+	}
+	sort.Slice(summary.Schemes, func(i, j int) bool {
+		if summary.Schemes[i].Code != summary.Schemes[j].Code {
+			return summary.Schemes[i].Code < summary.Schemes[j].Code
+		}
+		return summary.Schemes[i].SchemeID < summary.Schemes[j].SchemeID
+	})
+
+	for _, employeeID := range order {
+		current := totals[employeeID]
+		item := current.item
+		for _, gross := range current.grossByMonth {
+			if gross != 0 {
+				item.Months++
+			}
+		}
+		item.Contributions = make([]AnnualContribution, 0, len(summary.Schemes))
+		hasContributions := false
+		for _, scheme := range summary.Schemes {
+			contribution := AnnualContribution{SchemeID: scheme.SchemeID, Code: scheme.Code, Name: scheme.Name}
+			if recorded := current.contributions[scheme.SchemeID]; recorded != nil {
+				contribution.EmployeeAmount = recorded.EmployeeAmount
+				contribution.EmployerAmount = recorded.EmployerAmount
+			}
+			if contribution.EmployeeAmount != 0 || contribution.EmployerAmount != 0 {
+				hasContributions = true
+			}
+			item.Contributions = append(item.Contributions, contribution)
+		}
+		if item.GrossPay == 0 && item.TaxTotal == 0 && item.NetPay == 0 && !hasContributions {
+			continue
+		}
+
+		summary.Employees = append(summary.Employees, item)
+		summary.GrossPay += item.GrossPay
+		summary.TaxablePay += item.TaxablePay
+		summary.TaxTotal += item.TaxTotal
+		summary.NetPay += item.NetPay
+	}
+
+	return summary, nil
+}
+
+// annualEntryTaxablePay works out the taxable pay of a locked entry. Reversal entries carry negated amounts,
+// which CalculateTaxablePay would clamp to zero, so they are computed on the original amounts and negated back.
+func annualEntryTaxablePay(entry PayrollEntry, lines []PayrollEntryLine) money.Amount {
+	if entry.GrossPay >= 0 {
+		return CalculateTaxablePay(entry.BaseSalary, entry.AllowancesTotal, lines)
+	}
+	original := make([]PayrollEntryLine, 0, len(lines))
+	for _, line := range lines {
+		original = append(original, reversalLine(line))
+	}
+	return -CalculateTaxablePay(-entry.BaseSalary, -entry.AllowancesTotal, original)
+}
+
+func annualTaxLabel(start, end time.Time) string {
+	if start.Year() == end.Year() {
+		return fmt.Sprintf("%d", start.Year())
+	}
+	return fmt.Sprintf("%d/%02d", start.Year(), end.Year()%100)
+}
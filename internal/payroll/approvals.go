@@ -0,0 +1,253 @@
+package payroll
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"hrpro/internal/middleware"
+	"hrpro/internal/models"
+)
+
+const maxApprovalSteps = 5
+
+var approvalRoles = map[string]bool{
+	"admin":           true,
+	"hr_officer":      true,
+	"finance_officer": true,
+}
+
+// DefaultApprovalSteps is a single approval by an admin or finance officer.
+func DefaultApprovalSteps() []ApprovalStep {
+	return []ApprovalStep{{Name: "Approval", Roles: []string{"admin", "finance_officer"}}}
+}
+
+// NormalizeApprovalSteps trims step names and normalizes role names. An empty chain is the default single step.
+func NormalizeApprovalSteps(steps []ApprovalStep) ([]ApprovalStep, error) {
+	if len(steps) == 0 {
+		return DefaultApprovalSteps(), nil
+	}
+	if len(steps) > maxApprovalSteps {
+		return nil, fmt.Errorf("%w: approval chain can have at most %d steps", ErrValidation, maxApprovalSteps)
+	}
+	result := make([]ApprovalStep, 0, len(steps))
+	for i, step := range steps {
+		name := strings.TrimSpace(step.Name)
+		if name == "" {
+			return nil, fmt.Errorf("%w: approval step %d needs a name", ErrValidation, i+1)
+		}
+		if len(name) > 60 {
+			return nil, fmt.Errorf("%w: approval step %d name is too long", ErrValidation, i+1)
+		}
+		seen := make(map[string]bool, len(step.Roles))
+		roles := make([]string, 0, len(step.Roles))
+		for _, role := range step.Roles {
+			role = middleware.NormalizeRole(role)
+			if !approvalRoles[role] {
+				return nil, fmt.Errorf("%w: approval step %q role must be admin, hr_officer or finance_officer", ErrValidation, name)
+			}
+			if seen[role] {
+				continue
+			}
+			seen[role] = true
+			roles = append(roles, role)
+		}
+		if len(roles) == 0 {
+			return nil, fmt.Errorf("%w: approval step %q needs at least one role", ErrValidation, name)
+		}
+		result = append(result, ApprovalStep{Name: name, Roles: roles})
+	}
+	return result, nil
+}
+
+// RejectPayrollBatch sends a batch that is part-way through the approval chain, or approved but not locked,
+// back to Draft. Only the roles of the step being rejected may do so, and a reason is required.
+func (s *Service) RejectPayrollBatch(ctx context.Context, claims *models.Claims, batchID int64, comment string) (*PayrollBatch, error) {
+	if claims == nil {
+		return nil, ErrForbidden
+	}
+	if batchID <= 0 {
+		return nil, fmt.Errorf("%w: batch id must be positive", ErrValidation)
+	}
+	comment, err := normalizeApprovalComment(comment)
+	if err != nil {
+		return nil, err
+	}
+	if comment == "" {
+		return nil, fmt.Errorf("%w: a reason is required to reject a batch", ErrValidation)
+	}
+
+	batch, err := s.repository.GetBatchByID(ctx, batchID)
+	if err != nil {
+		return nil, err
+	}
+	if batch == nil {
+		return nil, ErrNotFound
+	}
+	policy, err := s.resolveApprovalPolicy(ctx)
+	if err != nil {
+		return nil, err
+	}
+	progress, err := s.approvalProgress(ctx, *batch, policy.Steps)
+	if err != nil {
+		return nil, err
+	}
+
+	stepNumber := 0
+	switch batch.Status {
+	case StatusDraft:
+		if progress.NextStep <= 1 {
+			return nil, fmt.Errorf("%w: batch has no approvals to reject", ErrInvalidTransition)
+		}
+		stepNumber = progress.NextStep
+	case StatusApproved:
+		stepNumber = len(policy.Steps)
+	default:
+		return nil, ErrInvalidTransition
+	}
+	step := policy.Steps[stepNumber-1]
+	if !stepAllowsRole(step, claims.Role) {
+		return nil, fmt.Errorf("%w: %q must be rejected by %s", ErrApprovalStepRole, step.Name, strings.Join(step.Roles, " or "))
+	}
+
+	updated := batch
+	err = s.repository.WithTx(ctx, func(tx TxRepository) error {
+		if err := tx.CreateBatchApproval(ctx, BatchApprovalInput{
+			BatchID:    batchID,
+			StepNumber: stepNumber,
+			StepName:   step.Name,
+			Action:     ApprovalActionRejected,
+			UserID:     claims.UserID,
+			Comment:    comment,
+		}); err != nil {
+			return err
+		}
+		if batch.Status != StatusApproved {
+			return nil
+		}
+		updated, err = tx.SetBatchDraft(ctx, batchID)
+		if err != nil {
+			return err
+		}
+		if updated == nil {
+			return ErrNotFound
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.audit.RecordAuditEvent(ctx, claimsUserID(claims), "payroll.batch.reject", stringPtr("payroll_batch"), &updated.ID, map[string]any{
+		"month":       updated.Month,
+		"status":      updated.Status,
+		"step_number": stepNumber,
+		"step_name":   step.Name,
+		"comment":     comment,
+	})
+	return updated, nil
+}
+
+// resolveApprovalPolicy reads the approval chain from settings, defaulting to a single step without
+// self-approval.
+func (s *Service) resolveApprovalPolicy(ctx context.Context) (ApprovalPolicy, error) {
+	if s.policy == nil {
+		return ApprovalPolicy{Steps: DefaultApprovalSteps()}, nil
+	}
+	policy, err := s.policy.GetPayrollApprovalPolicy(ctx)
+	if err != nil {
+		return ApprovalPolicy{}, err
+	}
+	policy.Steps, err = NormalizeApprovalSteps(policy.Steps)
+	if err != nil {
+		return ApprovalPolicy{}, err
+	}
+	return policy, nil
+}
+
+// approvalProgress matches the batch approvals to the chain. Only approvals of the current round count: those
+// recorded after the last rejection or reopening and after the last change to the batch entries, taken in step
+// order.
+func (s *Service) approvalProgress(ctx context.Context, batch PayrollBatch, steps []ApprovalStep) (ApprovalProgress, error) {
+	history, err := s.repository.ListBatchApprovals(ctx, batch.ID)
+	if err != nil {
+		return ApprovalProgress{}, err
+	}
+	entries, err := s.repository.ListEntriesByBatchID(ctx, batch.ID)
+	if err != nil {
+		return ApprovalProgress{}, err
+	}
+	var lastChange time.Time
+	for _, entry := range entries {
+		if entry.UpdatedAt.After(lastChange) {
+			lastChange = entry.UpdatedAt
+		}
+	}
+	return buildApprovalProgress(batch, steps, history, lastChange), nil
+}
+
+func buildApprovalProgress(batch PayrollBatch, steps []ApprovalStep, history []PayrollBatchApproval, lastChange time.Time) ApprovalProgress {
+	signed := make([]PayrollBatchApproval, 0, len(steps))
+	for _, approval := range history {
+		switch {
+		case approval.Action != ApprovalActionApproved:
+			signed = signed[:0]
+		case !approval.CreatedAt.After(lastChange):
+			continue
+		case approval.StepNumber == len(signed)+1 && len(signed) < len(steps):
+			signed = append(signed, approval)
+		}
+	}
+	// A Draft batch with every step signed only comes from a shortened chain; its last step is signed again.
+	if batch.Status == StatusDraft && len(signed) > 0 && len(signed) == len(steps) {
+		signed = signed[:len(signed)-1]
+	}
+
+	progress := ApprovalProgress{
+		Steps:   make([]ApprovalStepStatus, 0, len(steps)),
+		History: history,
+	}
+	for i, step := range steps {
+		status := ApprovalStepStatus{StepNumber: i + 1, Name: step.Name, Roles: step.Roles}
+		if i < len(signed) {
+			approval := signed[i]
+			status.Approval = &approval
+		}
+		progress.Steps = append(progress.Steps, status)
+	}
+	if batch.Status == StatusDraft {
+		progress.NextStep = len(signed) + 1
+	}
+	return progress
+}
+
+// checkSegregationOfDuties blocks the batch creator and anyone who signed an earlier step of the round.
+func checkSegregationOfDuties(batch PayrollBatch, progress ApprovalProgress, userID int64) error {
+	if batch.CreatedBy == userID {
+		return fmt.Errorf("%w: the creator of a batch cannot approve it", ErrSelfApproval)
+	}
+	for _, step := range progress.Steps {
+		if step.Approval != nil && step.Approval.UserID != nil && *step.Approval.UserID == userID {
+			return fmt.Errorf("%w: %q was already approved by this user", ErrSelfApproval, step.Name)
+		}
+	}
+	return nil
+}
+
+func stepAllowsRole(step ApprovalStep, role string) bool {
+	role = middleware.NormalizeRole(role)
+	for _, allowed := range step.Roles {
+		if allowed == role {
+			return true
+		}
+	}
+	return false
+}
+
+func normalizeApprovalComment(comment string) (string, error) {
+	comment = strings.TrimSpace(comment)
+	if len(comment) > 500 {
+		return "", fmt.Errorf("%w: comment is too long", ErrValidation)
+	}
+	return comment, nil
+}
@@ -0,0 +1,116 @@
+package payroll
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// CalculateRetroArrears lists the base salary still owed, as of an arrears batch for month, for locked months
+// that a back-dated salary change affects. employeeID 0 covers everyone.
+func (s *Service) CalculateRetroArrears(ctx context.Context, month string, employeeID int64) ([]RetroArrears, error) {
+	month = strings.TrimSpace(month)
+	if !payrollMonthPattern.MatchString(month) {
+		return nil, fmt.Errorf("%w: month must be in YYYY-MM format", ErrValidation)
+	}
+	if employeeID < 0 {
+		return nil, fmt.Errorf("%w: employee id must be positive", ErrValidation)
+	}
+	decimals, err := s.calculationDecimals(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.retroArrears(ctx, month, employeeID, decimals)
+}
+
+// retroArrears recomputes the base salary of each locked regular month before month from the salary history.
+// A month is only reconsidered when a rate effective on or before its last day was recorded after the batch
+// was locked; the arrears are what is now due less what was paid and any arrears already paid for it.
+func (s *Service) retroArrears(ctx context.Context, month string, employeeID int64, decimals int) ([]RetroArrears, error) {
+	prorationBasis, err := s.resolveProrationBasis(ctx)
+	if err != nil {
+		return nil, err
+	}
+	paid, err := s.repository.ListLockedBasePay(ctx, month, employeeID)
+	if err != nil {
+		return nil, err
+	}
+	salaryRates, err := s.repository.ListSalaryRates(ctx, employeeID)
+	if err != nil {
+		return nil, err
+	}
+	rates := salaryRatesByEmployee(salaryRates)
+
+	items := make([]RetroArrears, 0)
+	for _, item := range paid {
+		employeeRates := rates[item.EmployeeID]
+		monthStart, monthEnd, err := MonthBounds(item.Month)
+		if err != nil {
+			return nil, err
+		}
+		backdated := false
+		for _, rate := range employeeRates {
+			if !truncateDate(rate.EffectiveFrom).After(monthEnd) && rate.CreatedAt.After(item.LockedAt) {
+				backdated = true
+				break
+			}
+		}
+		if !backdated {
+			continue
+		}
+
+		fallback := item.BaseSalary
+		if item.FullBaseSalary != nil {
+			fallback = *item.FullBaseSalary
+		}
+		calendar, err := s.workCalendar(ctx, monthStart, monthEnd)
+		if err != nil {
+			return nil, err
+		}
+		due, _, _, err := PeriodBaseSalary(item.Month, prorationBasis, calendar, EmployeeSalary{
+			EmployeeID: item.EmployeeID,
+			BaseSalary: fallback,
+			DateOfHire: item.DateOfHire,
+			DateOfExit: item.DateOfExit,
+		}, employeeRates)
+		if err != nil {
+			return nil, err
+		}
+		due = due.Round(decimals)
+		arrears := due - item.BaseSalary - item.ArrearsPaid
+		if arrears <= 0 {
+			continue
+		}
+		items = append(items, RetroArrears{
+			EmployeeID:     item.EmployeeID,
+			EmployeeName:   item.EmployeeName,
+			Month:          item.Month,
+			BatchID:        item.BatchID,
+			PaidBaseSalary: item.BaseSalary,
+			DueBaseSalary:  due,
+			ArrearsPaid:    item.ArrearsPaid,
+			Arrears:        arrears,
+		})
+	}
+	return items, nil
+}
+
+func salaryRatesByEmployee(rates []SalaryRate) map[int64][]SalaryRate {
+	result := make(map[int64][]SalaryRate)
+	for _, rate := range rates {
+		result[rate.EmployeeID] = append(result[rate.EmployeeID], rate)
+	}
+	return result
+}
+
+func arrearsLine(item RetroArrears) PayrollEntryLine {
+	month := item.Month
+	return PayrollEntryLine{
+		Code:         LineCodeArrears,
+		Name:         "Salary arrears " + item.Month,
+		Kind:         ComponentKindEarning,
+		Taxable:      true,
+		Amount:       item.Arrears,
+		ArrearsMonth: &month,
+	}
+}
@@ -1,15 +1,64 @@
 package payroll
 
-func CalculateGrossPay(baseSalary, allowancesTotal float64) float64 {
+import "hrpro/internal/money"
+
+func CalculateGrossPay(baseSalary, allowancesTotal money.Amount) money.Amount {
 	return baseSalary + allowancesTotal
 }
 
-func CalculateNetPay(grossPay, deductionsTotal, taxTotal float64) float64 {
+func CalculateNetPay(grossPay, deductionsTotal, taxTotal money.Amount) money.Amount {
 	return grossPay - deductionsTotal - taxTotal
 }
 
-func CalculateTotals(baseSalary, allowancesTotal, deductionsTotal, taxTotal float64) (grossPay float64, netPay float64) {
+func CalculateTotals(baseSalary, allowancesTotal, deductionsTotal, taxTotal money.Amount) (grossPay money.Amount, netPay money.Amount) {
 	grossPay = CalculateGrossPay(baseSalary, allowancesTotal)
 	netPay = CalculateNetPay(grossPay, deductionsTotal, taxTotal)
 	return grossPay, netPay
 }
+
+// CalculateComponentAmount resolves a catalog component to a line amount for the given base salary.
+func CalculateComponentAmount(component PayComponent, baseSalary money.Amount) money.Amount {
+	if component.CalculationType == CalculationPercentage {
+		return baseSalary.Percent(component.Value)
+	}
+	return money.FromUnits(1).Mul(component.Value)
+}
+
+// CalculateLineTotals rolls itemized lines up into the entry allowances and deductions totals.
+func CalculateLineTotals(lines []PayrollEntryLine) (allowancesTotal money.Amount, deductionsTotal money.Amount) {
+	for _, line := range lines {
+		switch line.Kind {
+		case ComponentKindEarning:
+			allowancesTotal += line.Amount
+		case ComponentKindDeduction:
+			deductionsTotal += line.Amount
+		}
+	}
+	return allowancesTotal, deductionsTotal
+}
+
+// CalculateContribution returns the employee and employer shares of a scheme for an entry.
+func CalculateContribution(scheme ContributionScheme, baseSalary, allowancesTotal money.Amount) (employeeAmount money.Amount, employerAmount money.Amount) {
+	base := baseSalary
+	if scheme.Base == ContributionBaseGross {
+		base = CalculateGrossPay(baseSalary, allowancesTotal)
+	}
+	return base.Percent(scheme.EmployeeRate), base.Percent(scheme.EmployerRate)
+}
+
+// CalculateEmployerContributions sums the employer shares carried on contribution lines.
+func CalculateEmployerContributions(lines []PayrollEntryLine) money.Amount {
+	var total money.Amount
+	for _, line := range lines {
+		total += line.EmployerAmount
+	}
+	return total
+}
+
+// roundLines rounds line amounts to the calculation precision in place.
+func roundLines(lines []PayrollEntryLine, decimals int) {
+	for i := range lines {
+		lines[i].Amount = lines[i].Amount.Round(decimals)
+		lines[i].EmployerAmount = lines[i].EmployerAmount.Round(decimals)
+	}
+}
@@ -1,14 +1,72 @@
 package payroll
 
-import "testing"
+import (
+	"testing"
+
+	"hrpro/internal/money"
+)
 
 func TestCalculateTotals(t *testing.T) {
-	gross, net := CalculateTotals(1200, 250, 100, 50)
+	gross, net := CalculateTotals(money.FromUnits(1200), money.FromUnits(250), money.FromUnits(100), money.FromUnits(50))
+
+	if gross != money.FromUnits(1450) {
+		t.Fatalf("expected gross 1450, got %s", gross)
+	}
+	if net != money.FromUnits(1300) {
+		t.Fatalf("expected net 1300, got %s", net)
+	}
+}
+
+func TestCalculateComponentAmount(t *testing.T) {
+	fixed := PayComponent{CalculationType: CalculationFixed, Value: 150000}
+	if amount := CalculateComponentAmount(fixed, money.FromUnits(2000000)); amount != money.FromUnits(150000) {
+		t.Fatalf("expected fixed amount 150000, got %s", amount)
+	}
+
+	percentage := PayComponent{CalculationType: CalculationPercentage, Value: 12.5}
+	if amount := CalculateComponentAmount(percentage, money.FromUnits(2500000)); amount != money.FromUnits(312500) {
+		t.Fatalf("expected percentage amount 312500, got %s", amount)
+	}
+}
+
+func TestCalculateLineTotals(t *testing.T) {
+	allowances, deductions := CalculateLineTotals([]PayrollEntryLine{
+		{Code: "HOUSING", Kind: ComponentKindEarning, Amount: money.FromUnits(300000)},
+		{Code: "TRANSPORT", Kind: ComponentKindEarning, Amount: money.FromUnits(100000)},
+		{Code: "SACCO", Kind: ComponentKindDeduction, Amount: money.FromUnits(50000)},
+		{Code: "LOAN", Kind: ComponentKindDeduction, Amount: money.FromMinor(7500050)},
+	})
 
-	if gross != 1450 {
-		t.Fatalf("expected gross 1450, got %.2f", gross)
+	if allowances != money.FromUnits(400000) {
+		t.Fatalf("expected allowances 400000, got %s", allowances)
 	}
-	if net != 1300 {
-		t.Fatalf("expected net 1300, got %.2f", net)
+	if deductions != money.FromMinor(12500050) {
+		t.Fatalf("expected deductions 125000.50, got %s", deductions)
+	}
+}
+
+func TestCalculateContribution(t *testing.T) {
+	nssf := ContributionScheme{EmployeeRate: 5, EmployerRate: 10, Base: ContributionBaseGross}
+	employee, employer := CalculateContribution(nssf, money.FromUnits(1000000), money.FromUnits(250000))
+	if employee != money.FromUnits(62500) || employer != money.FromUnits(125000) {
+		t.Fatalf("expected NSSF shares 62500/125000 on gross, got %s/%s", employee, employer)
+	}
+
+	pension := ContributionScheme{EmployeeRate: 2.5, EmployerRate: 7.5, Base: ContributionBaseSalary}
+	employee, employer = CalculateContribution(pension, money.FromUnits(1000000), money.FromUnits(250000))
+	if employee != money.FromUnits(25000) || employer != money.FromUnits(75000) {
+		t.Fatalf("expected pension shares 25000/75000 on base salary, got %s/%s", employee, employer)
+	}
+}
+
+func TestRoundLinesRoundsToDisplayDecimals(t *testing.T) {
+	lines := []PayrollEntryLine{
+		{Code: "UNPAID", Kind: ComponentKindDeduction, Amount: money.FromMinor(4347850)},
+		{Code: "NSSF", Kind: ComponentKindDeduction, Amount: money.FromMinor(1249), EmployerAmount: money.FromMinor(2499)},
+	}
+	roundLines(lines, 0)
+
+	if lines[0].Amount != money.FromUnits(43479) || lines[1].Amount != money.FromUnits(12) || lines[1].EmployerAmount != money.FromUnits(25) {
+		t.Fatalf("expected lines rounded to whole units, got %+v", lines)
 	}
 }
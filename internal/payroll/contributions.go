@@ -0,0 +1,364 @@
+package payroll
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"hrpro/internal/money"
+)
+
+func (s *Service) ListContributionSchemes(ctx context.Context, activeOnly bool) ([]ContributionScheme, error) {
+	return s.repository.ListContributionSchemes(ctx, activeOnly)
+}
+
+func (s *Service) CreateContributionScheme(ctx context.Context, input ContributionSchemeUpsertInput) (*ContributionScheme, error) {
+	normalized, err := normalizeContributionSchemeInput(input)
+	if err != nil {
+		return nil, err
+	}
+	item, err := s.repository.CreateContributionScheme(ctx, normalized)
+	if err != nil {
+		return nil, err
+	}
+	s.audit.RecordAuditEvent(ctx, nil, "payroll.scheme.create", stringPtr("contribution_scheme"), &item.ID, map[string]any{
+		"code":          item.Code,
+		"employee_rate": item.EmployeeRate,
+		"employer_rate": item.EmployerRate,
+	})
+	return item, nil
+}
+
+func (s *Service) UpdateContributionScheme(ctx context.Context, id int64, input ContributionSchemeUpsertInput) (*ContributionScheme, error) {
+	if id <= 0 {
+		return nil, fmt.Errorf("%w: scheme id must be positive", ErrValidation)
+	}
+	normalized, err := normalizeContributionSchemeInput(input)
+	if err != nil {
+		return nil, err
+	}
+	item, err := s.repository.UpdateContributionScheme(ctx, id, normalized)
+	if err != nil {
+		return nil, err
+	}
+	if item == nil {
+		return nil, ErrNotFound
+	}
+	s.audit.RecordAuditEvent(ctx, nil, "payroll.scheme.update", stringPtr("contribution_scheme"), &item.ID, map[string]any{
+		"code":          item.Code,
+		"employee_rate": item.EmployeeRate,
+		"employer_rate": item.EmployerRate,
+	})
+	return item, nil
+}
+
+func (s *Service) SetContributionSchemeActive(ctx context.Context, id int64, active bool) (*ContributionScheme, error) {
+	if id <= 0 {
+		return nil, fmt.Errorf("%w: scheme id must be positive", ErrValidation)
+	}
+	item, err := s.repository.SetContributionSchemeActive(ctx, id, active)
+	if err != nil {
+		return nil, err
+	}
+	if item == nil {
+		return nil, ErrNotFound
+	}
+	s.audit.RecordAuditEvent(ctx, nil, "payroll.scheme.set_active", stringPtr("contribution_scheme"), &item.ID, map[string]any{
+		"code":   item.Code,
+		"active": item.Active,
+	})
+	return item, nil
+}
+
+func (s *Service) ListContributionSchemeMembers(ctx context.Context, schemeID int64) ([]ContributionSchemeMember, error) {
+	if _, err := s.requireContributionScheme(ctx, schemeID); err != nil {
+		return nil, err
+	}
+	return s.repository.ListContributionSchemeMembers(ctx, schemeID)
+}
+
+// SetContributionSchemeMember records an employee's member number for a scheme; an empty number removes the membership.
+func (s *Service) SetContributionSchemeMember(ctx context.Context, schemeID int64, input SetContributionSchemeMemberInput) (*ContributionSchemeMember, error) {
+	if input.EmployeeID <= 0 {
+		return nil, fmt.Errorf("%w: employee id must be positive", ErrValidation)
+	}
+	memberNumber := strings.TrimSpace(input.MemberNumber)
+	if len(memberNumber) > 60 {
+		return nil, fmt.Errorf("%w: member number is too long", ErrValidation)
+	}
+	scheme, err := s.requireContributionScheme(ctx, schemeID)
+	if err != nil {
+		return nil, err
+	}
+
+	if memberNumber == "" {
+		removed, err := s.repository.DeleteContributionSchemeMember(ctx, schemeID, input.EmployeeID)
+		if err != nil {
+			return nil, err
+		}
+		if !removed {
+			return nil, ErrNotFound
+		}
+		s.audit.RecordAuditEvent(ctx, nil, "payroll.scheme.member.remove", stringPtr("contribution_scheme"), &scheme.ID, map[string]any{
+			"code":        scheme.Code,
+			"employee_id": input.EmployeeID,
+		})
+		return nil, nil
+	}
+
+	member, err := s.repository.UpsertContributionSchemeMember(ctx, schemeID, input.EmployeeID, memberNumber)
+	if err != nil {
+		return nil, err
+	}
+	if member == nil {
+		return nil, ErrNotFound
+	}
+	s.audit.RecordAuditEvent(ctx, nil, "payroll.scheme.member.set", stringPtr("contribution_scheme"), &scheme.ID, map[string]any{
+		"code":          scheme.Code,
+		"employee_id":   member.EmployeeID,
+		"member_number": member.MemberNumber,
+	})
+	return member, nil
+}
+
+// ExportContributionScheduleCSV writes the monthly remittance schedule for one scheme in the
+// member-per-row layout used for NSSF e-returns.
+func (s *Service) ExportContributionScheduleCSV(ctx context.Context, batchID int64, schemeID int64) (*CSVExport, error) {
+	if batchID <= 0 {
+		return nil, fmt.Errorf("%w: batch id must be positive", ErrValidation)
+	}
+
+	batch, err := s.repository.GetBatchByID(ctx, batchID)
+	if err != nil {
+		return nil, err
+	}
+	if batch == nil {
+		return nil, ErrNotFound
+	}
+	if batch.Status != StatusApproved && batch.Status != StatusLocked {
+		return nil, ErrExportNotAllowed
+	}
+	scheme, err := s.requireContributionScheme(ctx, schemeID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.repository.ListContributionSchedule(ctx, batchID, schemeID)
+	if err != nil {
+		return nil, err
+	}
+	_, decimals := s.payrollFormatting(ctx)
+
+	baseLabel := "Gross Salary"
+	if scheme.Base == ContributionBaseSalary {
+		baseLabel = "Base Salary"
+	}
+
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	header := []string{
+		"Member Number",
+		"Employee ID",
+		"Member Name",
+		"National ID",
+		"Contribution Period",
+		baseLabel,
+		fmt.Sprintf("Employee Contribution (%s%%)", strconv.FormatFloat(scheme.EmployeeRate, 'f', -1, 64)),
+		fmt.Sprintf("Employer Contribution (%s%%)", strconv.FormatFloat(scheme.EmployerRate, 'f', -1, 64)),
+		"Total Contribution",
+	}
+	if err := writer.Write(header); err != nil {
+		return nil, fmt.Errorf("write contribution schedule header: %w", err)
+	}
+
+	var baseTotal, employeeTotal, employerTotal money.Amount
+	for _, row := range rows {
+		base := row.GrossPay
+		if scheme.Base == ContributionBaseSalary {
+			base = row.BaseSalary
+		}
+		baseTotal += base
+		employeeTotal += row.EmployeeAmount
+		employerTotal += row.EmployerAmount
+
+		record := []string{
+			valueOrEmpty(row.MemberNumber),
+			strconv.FormatInt(row.EmployeeID, 10),
+			row.EmployeeName,
+			valueOrEmpty(row.NationalID),
+			batch.Month,
+			base.Format(decimals, ""),
+			row.EmployeeAmount.Format(decimals, ""),
+			row.EmployerAmount.Format(decimals, ""),
+			(row.EmployeeAmount + row.EmployerAmount).Format(decimals, ""),
+		}
+		if err := writer.Write(record); err != nil {
+			return nil, fmt.Errorf("write contribution schedule record: %w", err)
+		}
+	}
+
+	footer := []string{
+		"TOTAL",
+		"",
+		fmt.Sprintf("%d members", len(rows)),
+		"",
+		batch.Month,
+		baseTotal.Format(decimals, ""),
+		employeeTotal.Format(decimals, ""),
+		employerTotal.Format(decimals, ""),
+		(employeeTotal + employerTotal).Format(decimals, ""),
+	}
+	if err := writer.Write(footer); err != nil {
+		return nil, fmt.Errorf("write contribution schedule totals: %w", err)
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return nil, fmt.Errorf("flush contribution schedule csv: %w", err)
+	}
+
+	return &CSVExport{
+		Filename: fmt.Sprintf("%s_schedule_%s.csv", strings.ToLower(scheme.Code), batch.Month),
+		Data:     buf.String(),
+		MimeType: "text/csv;charset=utf-8",
+	}, nil
+}
+
+func (s *Service) requireContributionScheme(ctx context.Context, schemeID int64) (*ContributionScheme, error) {
+	if schemeID <= 0 {
+		return nil, fmt.Errorf("%w: scheme id must be positive", ErrValidation)
+	}
+	scheme, err := s.repository.GetContributionSchemeByID(ctx, schemeID)
+	if err != nil {
+		return nil, err
+	}
+	if scheme == nil {
+		return nil, ErrNotFound
+	}
+	return scheme, nil
+}
+
+// contributionMemberships indexes scheme members as scheme id -> employee id.
+func contributionMemberships(members []ContributionSchemeMember) map[int64]map[int64]bool {
+	result := make(map[int64]map[int64]bool)
+	for _, member := range members {
+		if result[member.SchemeID] == nil {
+			result[member.SchemeID] = make(map[int64]bool)
+		}
+		result[member.SchemeID][member.EmployeeID] = true
+	}
+	return result
+}
+
+// contributionLines builds one deduction line per active scheme that applies to the employee.
+// The employee share is the line amount; the employer share rides along as an employer cost.
+func contributionLines(schemes []ContributionScheme, memberships map[int64]map[int64]bool, employeeID int64, baseSalary money.Amount, lines []PayrollEntryLine, decimals int) []PayrollEntryLine {
+	allowancesTotal, _ := CalculateLineTotals(lines)
+	earnedBase := EarnedBaseSalary(baseSalary, lines)
+	result := make([]PayrollEntryLine, 0, len(schemes))
+	for _, scheme := range schemes {
+		if !scheme.Active {
+			continue
+		}
+		if scheme.MembersOnly && !memberships[scheme.ID][employeeID] {
+			continue
+		}
+		employeeAmount, employerAmount := CalculateContribution(scheme, earnedBase, allowancesTotal)
+		employeeAmount, employerAmount = employeeAmount.Round(decimals), employerAmount.Round(decimals)
+		if employeeAmount == 0 && employerAmount == 0 {
+			continue
+		}
+		schemeID := scheme.ID
+		result = append(result, PayrollEntryLine{
+			SchemeID:       &schemeID,
+			Code:           scheme.Code,
+			Name:           scheme.Name,
+			Kind:           ComponentKindDeduction,
+			Amount:         employeeAmount,
+			EmployerAmount: employerAmount,
+		})
+	}
+	return result
+}
+
+// refreshContributionLines re-derives contribution shares after the earnings of an entry change.
+func refreshContributionLines(ctx context.Context, tx TxRepository, baseSalary money.Amount, lines []PayrollEntryLine, decimals int) error {
+	hasContributions := false
+	for _, line := range lines {
+		if line.SchemeID != nil {
+			hasContributions = true
+			break
+		}
+	}
+	if !hasContributions {
+		return nil
+	}
+
+	schemes, err := tx.ListContributionSchemes(ctx)
+	if err != nil {
+		return err
+	}
+	schemesByID := make(map[int64]ContributionScheme, len(schemes))
+	for _, scheme := range schemes {
+		schemesByID[scheme.ID] = scheme
+	}
+
+	allowancesTotal, _ := CalculateLineTotals(lines)
+	earnedBase := EarnedBaseSalary(baseSalary, lines)
+	for i := range lines {
+		if lines[i].SchemeID == nil {
+			continue
+		}
+		scheme, ok := schemesByID[*lines[i].SchemeID]
+		if !ok {
+			continue
+		}
+		employeeAmount, employerAmount := CalculateContribution(scheme, earnedBase, allowancesTotal)
+		employeeAmount, employerAmount = employeeAmount.Round(decimals), employerAmount.Round(decimals)
+		if employeeAmount == lines[i].Amount && employerAmount == lines[i].EmployerAmount {
+			continue
+		}
+		if err := tx.UpdateEntryLineAmounts(ctx, lines[i].ID, employeeAmount, employerAmount); err != nil {
+			return err
+		}
+		lines[i].Amount = employeeAmount
+		lines[i].EmployerAmount = employerAmount
+	}
+	return nil
+}
+
+func normalizeContributionSchemeInput(input ContributionSchemeUpsertInput) (ContributionSchemeUpsertInput, error) {
+	input.Code = strings.ToUpper(strings.TrimSpace(input.Code))
+	input.Name = strings.TrimSpace(input.Name)
+	input.Base = strings.ToLower(strings.TrimSpace(input.Base))
+	if input.Base == "" {
+		input.Base = ContributionBaseGross
+	}
+
+	if !componentCodePattern.MatchString(input.Code) {
+		return ContributionSchemeUpsertInput{}, fmt.Errorf("%w: scheme code must be 2-40 letters, numbers, '_' or '-'", ErrValidation)
+	}
+	if input.Name == "" {
+		return ContributionSchemeUpsertInput{}, fmt.Errorf("%w: scheme name is required", ErrValidation)
+	}
+	if len(input.Name) > 120 {
+		return ContributionSchemeUpsertInput{}, fmt.Errorf("%w: scheme name is too long", ErrValidation)
+	}
+	if input.Base != ContributionBaseGross && input.Base != ContributionBaseSalary {
+		return ContributionSchemeUpsertInput{}, fmt.Errorf("%w: scheme base must be gross or base_salary", ErrValidation)
+	}
+	if input.EmployeeRate < 0 || input.EmployeeRate > 100 || input.EmployerRate < 0 || input.EmployerRate > 100 {
+		return ContributionSchemeUpsertInput{}, fmt.Errorf("%w: contribution rates must be between 0 and 100", ErrValidation)
+	}
+	return input, nil
+}
+
+func valueOrEmpty(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
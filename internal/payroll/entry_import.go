@@ -0,0 +1,407 @@
+package payroll
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"hrpro/internal/money"
+)
+
+const (
+	maxEntryImportBytes = 2 << 20
+	maxEntryImportRows  = 5000
+)
+
+var importAmountPattern = regexp.MustCompile(`^-?[0-9]+(\.[0-9]+)?$`)
+
+// The import reads these headers, as written by the batch CSV export. Other columns, such as "Employee Name",
+// are ignored.
+const (
+	importColumnEmployeeID = "employee id"
+	importColumnAllowances = "allowances"
+	importColumnDeductions = "deductions"
+	importColumnTax        = "tax"
+)
+
+type entryImportRow struct {
+	row   int
+	entry PayrollEntry
+	input UpdateEntryAmountsInput
+}
+
+// errEntryImportRejected rolls back an import in which some row failed once PAYE was worked out.
+var errEntryImportRejected = errors.New("entry import rejected")
+
+// ImportPayrollEntryAmounts sets the allowances, deductions and tax of many entries of a Draft batch from a
+// CSV keyed by employee ID. All entries are updated in one transaction; if any row fails, the errors are
+// returned and nothing is changed. A blank cell keeps the current amount. On an itemized entry the change is
+// booked on an import-adjustment line, after which contributions and PAYE are worked out again; a Tax cell
+// must then match PAYE whenever a tax table applies.
+func (s *Service) ImportPayrollEntryAmounts(ctx context.Context, batchID int64, data string) (*EntryAmountsImportResult, error) {
+	if batchID <= 0 {
+		return nil, fmt.Errorf("%w: batch id must be positive", ErrValidation)
+	}
+	if len(data) > maxEntryImportBytes {
+		return nil, fmt.Errorf("%w: import file is larger than 2 MB", ErrValidation)
+	}
+
+	batch, err := s.repository.GetBatchByID(ctx, batchID)
+	if err != nil {
+		return nil, err
+	}
+	if batch == nil {
+		return nil, ErrNotFound
+	}
+	if err := requireEditable(*batch); err != nil {
+		return nil, err
+	}
+
+	records, err := readEntryImportCSV(data)
+	if err != nil {
+		return nil, err
+	}
+	columns, err := entryImportHeader(records[0])
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := s.listEntriesWithLines(ctx, batchID)
+	if err != nil {
+		return nil, err
+	}
+	entriesByEmployee := make(map[int64]PayrollEntry, len(entries))
+	for _, entry := range entries {
+		entriesByEmployee[entry.EmployeeID] = entry
+	}
+	symbol, _ := s.payrollFormatting(ctx)
+	decimals, err := s.calculationDecimals(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &EntryAmountsImportResult{BatchID: batchID, Errors: []EntryImportRowError{}}
+	rows := make([]entryImportRow, 0, len(records)-1)
+	seen := make(map[int64]int, len(records)-1)
+	for i, record := range records[1:] {
+		rowNumber := i + 2
+		if blankRecord(record) {
+			continue
+		}
+		result.Rows++
+		row, rowErr := parseEntryImportRow(record, columns, entriesByEmployee, symbol, decimals)
+		if rowErr == nil {
+			if first, ok := seen[row.entry.EmployeeID]; ok {
+				rowErr = &EntryImportRowError{EmployeeID: row.entry.EmployeeID, Message: fmt.Sprintf("employee is already on row %d", first)}
+			}
+		}
+		if rowErr != nil {
+			rowErr.Row = rowNumber
+			result.Errors = append(result.Errors, *rowErr)
+			continue
+		}
+		seen[row.entry.EmployeeID] = rowNumber
+		row.row = rowNumber
+		rows = append(rows, row)
+	}
+	if result.Rows == 0 {
+		return nil, fmt.Errorf("%w: import file has no rows", ErrValidation)
+	}
+	if len(result.Errors) > 0 {
+		return result, nil
+	}
+
+	taxTable, err := s.resolveTaxTable(ctx, batch.Month)
+	if err != nil {
+		return nil, err
+	}
+	monthToDate, err := s.monthToDate(ctx, *batch)
+	if err != nil {
+		return nil, err
+	}
+	var allowancesTotal, deductionsTotal, taxTotal money.Amount
+	err = s.repository.WithTx(ctx, func(tx TxRepository) error {
+		for _, row := range rows {
+			totals, changed, rowErr, err := applyEntryImportRow(ctx, tx, row, taxTable, monthToDate[row.entry.EmployeeID], decimals)
+			if err != nil {
+				return err
+			}
+			if rowErr != nil {
+				rowErr.Row = row.row
+				result.Errors = append(result.Errors, *rowErr)
+				continue
+			}
+			if !changed {
+				result.Unchanged++
+				continue
+			}
+			if err := tx.UpdateEntryTotals(ctx, row.entry.ID, totals); err != nil {
+				return err
+			}
+			allowancesTotal += totals.AllowancesTotal
+			deductionsTotal += totals.DeductionsTotal
+			taxTotal += totals.TaxTotal
+			result.Updated++
+		}
+		if len(result.Errors) > 0 {
+			return errEntryImportRejected
+		}
+		return nil
+	})
+	if errors.Is(err, errEntryImportRejected) {
+		result.Updated, result.Unchanged = 0, 0
+		return result, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	s.audit.RecordAuditEvent(ctx, nil, "payroll.batch.import_amounts", stringPtr("payroll_batch"), &batch.ID, map[string]any{
+		"month":            batch.Month,
+		"rows":             result.Rows,
+		"updated":          result.Updated,
+		"unchanged":        result.Unchanged,
+		"allowances_total": allowancesTotal,
+		"deductions_total": deductionsTotal,
+		"tax_total":        taxTotal,
+	})
+	return result, nil
+}
+
+func readEntryImportCSV(data string) ([][]string, error) {
+	data = strings.TrimPrefix(data, "\ufeff")
+	reader := csv.NewReader(strings.NewReader(data))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	records := make([][]string, 0)
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: import file is not valid CSV: %v", ErrValidation, err)
+		}
+		records = append(records, record)
+		if len(records) > maxEntryImportRows+1 {
+			return nil, fmt.Errorf("%w: import file has more than %d rows", ErrValidation, maxEntryImportRows)
+		}
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("%w: import file is empty", ErrValidation)
+	}
+	return records, nil
+}
+
+// entryImportHeader maps the known column names to their positions.
+func entryImportHeader(header []string) (map[string]int, error) {
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+		switch name {
+		case importColumnEmployeeID, importColumnAllowances, importColumnDeductions, importColumnTax:
+			if _, ok := columns[name]; ok {
+				return nil, fmt.Errorf("%w: column %q appears more than once", ErrValidation, strings.TrimSpace(header[i]))
+			}
+			columns[name] = i
+		}
+	}
+	if _, ok := columns[importColumnEmployeeID]; !ok {
+		return nil, fmt.Errorf("%w: import file needs an \"Employee ID\" column", ErrValidation)
+	}
+	if len(columns) == 1 {
+		return nil, fmt.Errorf("%w: import file needs an \"Allowances\", \"Deductions\" or \"Tax\" column", ErrValidation)
+	}
+	return columns, nil
+}
+
+func parseEntryImportRow(record []string, columns map[string]int, entriesByEmployee map[int64]PayrollEntry, symbol string, decimals int) (entryImportRow, *EntryImportRowError) {
+	cell := func(column string) string {
+		index, ok := columns[column]
+		if !ok || index >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[index])
+	}
+
+	employeeID, err := strconv.ParseInt(cell(importColumnEmployeeID), 10, 64)
+	if err != nil || employeeID <= 0 {
+		return entryImportRow{}, &EntryImportRowError{Message: "employee id must be a positive number"}
+	}
+	entry, ok := entriesByEmployee[employeeID]
+	if !ok {
+		return entryImportRow{}, &EntryImportRowError{EmployeeID: employeeID, Message: "employee has no entry in this batch"}
+	}
+
+	input := UpdateEntryAmountsInput{
+		AllowancesTotal: entry.AllowancesTotal,
+		DeductionsTotal: entry.DeductionsTotal,
+	}
+	var taxTotal money.Amount
+	targets := []struct {
+		column string
+		label  string
+		value  *money.Amount
+	}{
+		{importColumnAllowances, "allowances", &input.AllowancesTotal},
+		{importColumnDeductions, "deductions", &input.DeductionsTotal},
+		{importColumnTax, "tax", &taxTotal},
+	}
+	for _, target := range targets {
+		value := cell(target.column)
+		if value == "" {
+			continue
+		}
+		amount, err := parseImportAmount(value, symbol)
+		if err != nil {
+			return entryImportRow{}, &EntryImportRowError{EmployeeID: employeeID, Message: fmt.Sprintf("%s %q is not an amount", target.label, value)}
+		}
+		if amount < 0 {
+			return entryImportRow{}, &EntryImportRowError{EmployeeID: employeeID, Message: fmt.Sprintf("%s must be non-negative", target.label)}
+		}
+		*target.value = amount.Round(decimals)
+		if target.column == importColumnTax {
+			input.TaxTotal = &taxTotal
+		}
+	}
+	return entryImportRow{entry: entry, input: input}, nil
+}
+
+// applyEntryImportRow works out the totals of an imported entry inside the import transaction. An itemized
+// entry keeps its lines: the difference between the imported and current allowances or deductions is added
+// to its import-adjustment line of that kind, then contribution lines and PAYE are refreshed. Deductions may
+// not drop below the other deduction lines. An entry without lines takes the imported totals as they are.
+func applyEntryImportRow(ctx context.Context, tx TxRepository, row entryImportRow, taxTable *TaxTable, monthToDate MonthToDate, decimals int) (EntryTotalsInput, bool, *EntryImportRowError, error) {
+	entry := row.entry
+	manualTax := entry.TaxTotal
+	if row.input.TaxTotal != nil {
+		manualTax = *row.input.TaxTotal
+	}
+	if len(entry.Lines) == 0 {
+		tax := calculateEntryTax(taxTable, entry.BaseSalary, row.input.AllowancesTotal, nil, manualTax, monthToDate, decimals)
+		if rowErr := importTaxMismatch(row, taxTable, tax, decimals); rowErr != nil {
+			return EntryTotalsInput{}, false, rowErr, nil
+		}
+		grossPay, netPay := CalculateTotals(entry.BaseSalary, row.input.AllowancesTotal, row.input.DeductionsTotal, tax)
+		totals := EntryTotalsInput{
+			AllowancesTotal:            row.input.AllowancesTotal,
+			DeductionsTotal:            row.input.DeductionsTotal,
+			TaxTotal:                   tax,
+			EmployerContributionsTotal: entry.EmployerContributionsTotal,
+			GrossPay:                   grossPay,
+			NetPay:                     netPay,
+		}
+		return totals, !sameEntryTotals(entry, totals), nil, nil
+	}
+
+	lines, err := tx.ListEntryLines(ctx, entry.ID)
+	if err != nil {
+		return EntryTotalsInput{}, false, nil, err
+	}
+	allowancesTotal, deductionsTotal := CalculateLineTotals(lines)
+	adjustments := []struct {
+		kind  string
+		delta money.Amount
+	}{
+		{ComponentKindEarning, row.input.AllowancesTotal - allowancesTotal},
+		{ComponentKindDeduction, row.input.DeductionsTotal - deductionsTotal},
+	}
+	// A negative deduction adjustment would silently offset loan recoveries and statutory lines.
+	itemizedDeductions := deductionsTotal - importAdjustment(lines, ComponentKindDeduction)
+	if row.input.DeductionsTotal < itemizedDeductions {
+		message := fmt.Sprintf("deductions cannot go below the deduction lines (%s); change those lines instead", itemizedDeductions.Format(decimals, ""))
+		return EntryTotalsInput{}, false, &EntryImportRowError{EmployeeID: entry.EmployeeID, Message: message}, nil
+	}
+	for _, adjustment := range adjustments {
+		if adjustment.delta == 0 {
+			continue
+		}
+		if err := adjustImportLine(ctx, tx, entry.ID, lines, adjustment.kind, adjustment.delta); err != nil {
+			return EntryTotalsInput{}, false, nil, err
+		}
+		if lines, err = tx.ListEntryLines(ctx, entry.ID); err != nil {
+			return EntryTotalsInput{}, false, nil, err
+		}
+	}
+	if err := refreshContributionLines(ctx, tx, entry.BaseSalary, lines, decimals); err != nil {
+		return EntryTotalsInput{}, false, nil, err
+	}
+	totals := calculateEntryTotals(entry.BaseSalary, lines, taxTable, manualTax, monthToDate, decimals)
+	if rowErr := importTaxMismatch(row, taxTable, totals.TaxTotal, decimals); rowErr != nil {
+		return EntryTotalsInput{}, false, rowErr, nil
+	}
+	return totals, !sameEntryTotals(entry, totals), nil, nil
+}
+
+// adjustImportLine adds delta to the entry's import-adjustment line of kind, creating the line when there is
+// none and removing it once it comes back to zero.
+func adjustImportLine(ctx context.Context, tx TxRepository, entryID int64, lines []PayrollEntryLine, kind string, delta money.Amount) error {
+	for _, line := range lines {
+		if line.Code != LineCodeImportAdjustment || line.Kind != kind {
+			continue
+		}
+		if line.Amount+delta == 0 {
+			return tx.DeleteEntryLine(ctx, line.ID)
+		}
+		return tx.UpdateEntryLineAmounts(ctx, line.ID, line.Amount+delta, 0)
+	}
+	return tx.CreateEntryLine(ctx, entryLineCreateInput(entryID, PayrollEntryLine{
+		Code:    LineCodeImportAdjustment,
+		Name:    "Import adjustment",
+		Kind:    kind,
+		Taxable: kind == ComponentKindEarning,
+		Amount:  delta,
+	}))
+}
+
+// importAdjustment returns the amount of the entry's import-adjustment line of kind, or 0 when it has none.
+func importAdjustment(lines []PayrollEntryLine, kind string) money.Amount {
+	for _, line := range lines {
+		if line.Code == LineCodeImportAdjustment && line.Kind == kind {
+			return line.Amount
+		}
+	}
+	return 0
+}
+
+func sameEntryTotals(entry PayrollEntry, totals EntryTotalsInput) bool {
+	return entry.AllowancesTotal == totals.AllowancesTotal && entry.DeductionsTotal == totals.DeductionsTotal &&
+		entry.TaxTotal == totals.TaxTotal && entry.EmployerContributionsTotal == totals.EmployerContributionsTotal &&
+		entry.GrossPay == totals.GrossPay && entry.NetPay == totals.NetPay
+}
+
+// importTaxMismatch rejects a Tax cell that differs from the PAYE worked out for the row.
+func importTaxMismatch(row entryImportRow, taxTable *TaxTable, tax money.Amount, decimals int) *EntryImportRowError {
+	if taxTable == nil || row.input.TaxTotal == nil || *row.input.TaxTotal == tax {
+		return nil
+	}
+	return &EntryImportRowError{EmployeeID: row.entry.EmployeeID, Message: fmt.Sprintf("tax is worked out by PAYE as %s, not %s", tax.Format(decimals, ""), row.input.TaxTotal.Format(decimals, ""))}
+}
+
+// parseImportAmount accepts plain amounts and amounts formatted by the CSV exports, with the currency symbol
+// and thousands separators.
+func parseImportAmount(value, symbol string) (money.Amount, error) {
+	if symbol != "" {
+		value = strings.ReplaceAll(value, symbol, "")
+	}
+	value = strings.NewReplacer(",", "", " ", "", "\u00a0", "").Replace(value)
+	if !importAmountPattern.MatchString(value) {
+		return 0, money.ErrInvalidAmount
+	}
+	return money.Parse(value)
+}
+
+func blankRecord(record []string) bool {
+	for _, value := range record {
+		if strings.TrimSpace(value) != "" {
+			return false
+		}
+	}
+	return true
+}
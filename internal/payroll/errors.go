@@ -3,11 +3,21 @@ package payroll
 import "errors"
 
 var (
-	ErrValidation        = errors.New("validation failed")
-	ErrForbidden         = errors.New("forbidden")
-	ErrNotFound          = errors.New("record not found")
-	ErrDuplicateMonth    = errors.New("payroll batch already exists for month")
-	ErrInvalidTransition = errors.New("invalid payroll status transition")
-	ErrImmutableBatch    = errors.New("batch is immutable")
-	ErrExportNotAllowed  = errors.New("export allowed only for approved or locked batches")
+	ErrValidation          = errors.New("validation failed")
+	ErrForbidden           = errors.New("forbidden")
+	ErrNotFound            = errors.New("record not found")
+	ErrDuplicateMonth      = errors.New("regular payroll batch already exists for month")
+	ErrInvalidTransition   = errors.New("invalid payroll status transition")
+	ErrImmutableBatch      = errors.New("batch is immutable")
+	ErrExportNotAllowed    = errors.New("export allowed only for approved or locked batches")
+	ErrDuplicateComponent  = errors.New("pay component code already exists")
+	ErrDuplicateScheme     = errors.New("contribution scheme code already exists")
+	ErrPaymentNotAllowed   = errors.New("payment files allowed only for locked batches")
+	ErrControlTotal        = errors.New("payment file control total does not match batch net pay")
+	ErrVarianceNotReviewed = errors.New("variance review must be acknowledged before approval")
+	ErrApprovalStepRole    = errors.New("role cannot approve this step")
+	ErrSelfApproval        = errors.New("approver already prepared or approved this batch")
+	ErrJournalNotAllowed   = errors.New("journal allowed only for locked batches")
+	ErrDuplicateGLMapping  = errors.New("GL account mapping already exists")
+	ErrDuplicateProject    = errors.New("project code already exists")
 )
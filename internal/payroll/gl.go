@@ -0,0 +1,460 @@
+package payroll
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"sort"
+	"strings"
+
+	"hrpro/internal/money"
+)
+
+var glCategories = map[string]bool{
+	GLCategorySalaryExpense:               true,
+	GLCategoryEarning:                     true,
+	GLCategoryEmployerContributionExpense: true,
+	GLCategoryTaxPayable:                  true,
+	GLCategoryContributionPayable:         true,
+	GLCategoryDeduction:                   true,
+	GLCategoryLoanReceivable:              true,
+	GLCategoryNetPayClearing:              true,
+}
+
+// glCategoryFallbacks is used when a category has no mapping that matches a posting.
+var glCategoryFallbacks = map[string]string{
+	GLCategoryEarning:                     GLCategorySalaryExpense,
+	GLCategoryEmployerContributionExpense: GLCategorySalaryExpense,
+	GLCategoryLoanReceivable:              GLCategoryDeduction,
+}
+
+// Expense postings always carry the employee's department and cost centre; other postings only when their
+// mapping is department-specific.
+var glExpenseCategories = map[string]bool{
+	GLCategorySalaryExpense:               true,
+	GLCategoryEarning:                     true,
+	GLCategoryEmployerContributionExpense: true,
+}
+
+func (s *Service) ListGLAccountMappings(ctx context.Context) ([]GLAccountMapping, error) {
+	return s.repository.ListGLAccountMappings(ctx)
+}
+
+func (s *Service) CreateGLAccountMapping(ctx context.Context, input GLAccountMappingInput) (*GLAccountMapping, error) {
+	normalized, err := normalizeGLAccountMappingInput(input)
+	if err != nil {
+		return nil, err
+	}
+	item, err := s.repository.CreateGLAccountMapping(ctx, normalized)
+	if err != nil {
+		return nil, err
+	}
+	s.audit.RecordAuditEvent(ctx, nil, "payroll.gl_mapping.create", stringPtr("gl_account_mapping"), &item.ID, glAccountMappingMetadata(*item))
+	return item, nil
+}
+
+func (s *Service) UpdateGLAccountMapping(ctx context.Context, id int64, input GLAccountMappingInput) (*GLAccountMapping, error) {
+	if id <= 0 {
+		return nil, fmt.Errorf("%w: mapping id must be positive", ErrValidation)
+	}
+	normalized, err := normalizeGLAccountMappingInput(input)
+	if err != nil {
+		return nil, err
+	}
+	item, err := s.repository.UpdateGLAccountMapping(ctx, id, normalized)
+	if err != nil {
+		return nil, err
+	}
+	if item == nil {
+		return nil, ErrNotFound
+	}
+	s.audit.RecordAuditEvent(ctx, nil, "payroll.gl_mapping.update", stringPtr("gl_account_mapping"), &item.ID, glAccountMappingMetadata(*item))
+	return item, nil
+}
+
+func (s *Service) DeleteGLAccountMapping(ctx context.Context, id int64) error {
+	if id <= 0 {
+		return fmt.Errorf("%w: mapping id must be positive", ErrValidation)
+	}
+	deleted, err := s.repository.DeleteGLAccountMapping(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return ErrNotFound
+	}
+	s.audit.RecordAuditEvent(ctx, nil, "payroll.gl_mapping.delete", stringPtr("gl_account_mapping"), &id, nil)
+	return nil
+}
+
+// GetPayrollJournal posts a locked batch to the ledger accounts of the GL mappings.
+func (s *Service) GetPayrollJournal(ctx context.Context, batchID int64) (*PayrollJournal, error) {
+	_, journal, err := s.payrollJournal(ctx, batchID)
+	return journal, err
+}
+
+// ExportPayrollJournal writes the journal of a locked batch either as a readable CSV with a totals row
+// (JournalFormatCSV) or as one row per line in a generic accounting-import layout (JournalFormatImport).
+func (s *Service) ExportPayrollJournal(ctx context.Context, batchID int64, format string) (*CSVExport, error) {
+	format = strings.ToLower(strings.TrimSpace(format))
+	if format == "" {
+		format = JournalFormatCSV
+	}
+	if format != JournalFormatCSV && format != JournalFormatImport {
+		return nil, fmt.Errorf("%w: journal format must be csv or import", ErrValidation)
+	}
+	batch, journal, err := s.payrollJournal(ctx, batchID)
+	if err != nil {
+		return nil, err
+	}
+	symbol, decimals := s.payrollFormatting(ctx)
+
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	filename := fmt.Sprintf("payroll-journal-%s-%d.csv", batch.Month, batch.ID)
+	if format == JournalFormatImport {
+		err = writeJournalImport(writer, *journal, decimals)
+		filename = fmt.Sprintf("payroll-journal-%s-%d-import.csv", batch.Month, batch.ID)
+	} else {
+		err = writeJournalCSV(writer, *journal, decimals, symbol)
+	}
+	if err != nil {
+		return nil, err
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return nil, fmt.Errorf("flush payroll journal: %w", err)
+	}
+
+	s.audit.RecordAuditEvent(ctx, nil, "payroll.batch.journal_export", stringPtr("payroll_batch"), &batch.ID, map[string]any{
+		"month":  batch.Month,
+		"format": format,
+		"lines":  len(journal.Lines),
+		"total":  journal.TotalDebit,
+	})
+	return &CSVExport{
+		Filename: filename,
+		Data:     buf.String(),
+		MimeType: "text/csv;charset=utf-8",
+	}, nil
+}
+
+func (s *Service) payrollJournal(ctx context.Context, batchID int64) (*PayrollBatch, *PayrollJournal, error) {
+	if batchID <= 0 {
+		return nil, nil, fmt.Errorf("%w: batch id must be positive", ErrValidation)
+	}
+	batch, err := s.repository.GetBatchByID(ctx, batchID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if batch == nil {
+		return nil, nil, ErrNotFound
+	}
+	if batch.Status != StatusLocked {
+		return nil, nil, ErrJournalNotAllowed
+	}
+	entries, err := s.listEntriesWithLines(ctx, batchID)
+	if err != nil {
+		return nil, nil, err
+	}
+	departments, err := s.repository.ListBatchEmployeeDepartments(ctx, batchID)
+	if err != nil {
+		return nil, nil, err
+	}
+	mappings, err := s.repository.ListGLAccountMappings(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	journal, err := BuildPayrollJournal(*batch, entries, departments, mappings)
+	if err != nil {
+		return nil, nil, err
+	}
+	return batch, journal, nil
+}
+
+type journalPosting struct {
+	category    string
+	componentID *int64
+	schemeID    *int64
+	// amount is a debit when positive and a credit when negative.
+	amount money.Amount
+}
+
+type journalKey struct {
+	accountCode  string
+	departmentID int64
+}
+
+// BuildPayrollJournal posts each entry to the accounts of its employee's department: salary, earnings and
+// employer contributions are debited to expense; deductions, tax, contributions payable and net pay are
+// credited. Postings are netted per account and department, so the negative amounts of a reversal batch
+// swap sides. The journal must balance.
+func BuildPayrollJournal(batch PayrollBatch, entries []PayrollEntry, departments []EmployeeDepartment, mappings []GLAccountMapping) (*PayrollJournal, error) {
+	departmentsByEmployee := make(map[int64]EmployeeDepartment, len(departments))
+	for _, department := range departments {
+		departmentsByEmployee[department.EmployeeID] = department
+	}
+
+	lines := make(map[journalKey]*JournalLine)
+	net := make(map[journalKey]money.Amount)
+	for _, entry := range entries {
+		department := departmentsByEmployee[entry.EmployeeID]
+		for _, posting := range entryPostings(entry) {
+			if posting.amount == 0 {
+				continue
+			}
+			mapping := resolveGLMapping(mappings, posting, department.DepartmentID)
+			if mapping == nil {
+				if department.DepartmentName != nil {
+					return nil, fmt.Errorf("%w: no GL account is mapped for %s in %s", ErrValidation, posting.category, *department.DepartmentName)
+				}
+				return nil, fmt.Errorf("%w: no GL account is mapped for %s", ErrValidation, posting.category)
+			}
+
+			key := journalKey{accountCode: mapping.AccountCode}
+			line := JournalLine{AccountCode: mapping.AccountCode, AccountName: mapping.AccountName}
+			if department.DepartmentID != nil && (glExpenseCategories[posting.category] || mapping.DepartmentID != nil) {
+				key.departmentID = *department.DepartmentID
+				line.DepartmentID = department.DepartmentID
+				line.DepartmentName = valueOrEmpty(department.DepartmentName)
+				line.CostCentre = valueOrEmpty(department.CostCentre)
+			}
+			if lines[key] == nil {
+				lines[key] = &line
+			}
+			net[key] += posting.amount
+		}
+	}
+
+	_, periodEnd, err := MonthBounds(batch.Month)
+	if err != nil {
+		return nil, err
+	}
+	journal := &PayrollJournal{
+		BatchID:   batch.ID,
+		Month:     batch.Month,
+		BatchType: batch.BatchType,
+		Reference: fmt.Sprintf("PAYROLL-%d", batch.ID),
+		Date:      periodEnd.Format("2006-01-02"),
+		Lines:     make([]JournalLine, 0, len(lines)),
+	}
+	if batch.ReversesBatchID != nil {
+		journal.Reference = fmt.Sprintf("PAYROLL-%d-REV-%d", batch.ID, *batch.ReversesBatchID)
+	}
+	for key, line := range lines {
+		amount := net[key]
+		switch {
+		case amount > 0:
+			line.Debit = amount
+		case amount < 0:
+			line.Credit = -amount
+		default:
+			continue
+		}
+		journal.TotalDebit += line.Debit
+		journal.TotalCredit += line.Credit
+		journal.Lines = append(journal.Lines, *line)
+	}
+	sort.Slice(journal.Lines, func(i, j int) bool {
+		left, right := journal.Lines[i], journal.Lines[j]
+		if (left.Debit > 0) != (right.Debit > 0) {
+			return left.Debit > 0
+		}
+		if left.AccountCode != right.AccountCode {
+			return left.AccountCode < right.AccountCode
+		}
+		return left.DepartmentName < right.DepartmentName
+	})
+	if journal.TotalDebit != journal.TotalCredit {
+		return nil, fmt.Errorf("%w: journal does not balance: debits %s, credits %s", ErrValidation, journal.TotalDebit, journal.TotalCredit)
+	}
+	return journal, nil
+}
+
+// entryPostings splits an entry into debits and credits. Entries edited by hand may carry totals that are not
+// backed by lines; the difference is posted to the earning, deduction and employer contribution categories.
+func entryPostings(entry PayrollEntry) []journalPosting {
+	postings := []journalPosting{{category: GLCategorySalaryExpense, amount: entry.BaseSalary}}
+	var earnings, deductions, employer money.Amount
+	for _, line := range entry.Lines {
+		switch {
+		case line.Kind == ComponentKindEarning:
+			earnings += line.Amount
+			postings = append(postings, journalPosting{category: GLCategoryEarning, componentID: line.ComponentID, amount: line.Amount})
+		case line.SchemeID != nil:
+			deductions += line.Amount
+			postings = append(postings, journalPosting{category: GLCategoryContributionPayable, schemeID: line.SchemeID, amount: -line.Amount})
+		case line.LoanID != nil || line.Code == LineCodeLoan || line.Code == LineCodeAdvance:
+			deductions += line.Amount
+			postings = append(postings, journalPosting{category: GLCategoryLoanReceivable, componentID: line.ComponentID, amount: -line.Amount})
+		case line.Code == LineCodeUnpaidLeave || line.Code == LineCodeAbsence:
+			// Unpaid days reduce the salary cost rather than being owed to anyone.
+			deductions += line.Amount
+			postings = append(postings, journalPosting{category: GLCategorySalaryExpense, amount: -line.Amount})
+		default:
+			deductions += line.Amount
+			postings = append(postings, journalPosting{category: GLCategoryDeduction, componentID: line.ComponentID, amount: -line.Amount})
+		}
+		if line.EmployerAmount != 0 {
+			employer += line.EmployerAmount
+			postings = append(postings,
+				journalPosting{category: GLCategoryEmployerContributionExpense, schemeID: line.SchemeID, amount: line.EmployerAmount},
+				journalPosting{category: GLCategoryContributionPayable, schemeID: line.SchemeID, amount: -line.EmployerAmount},
+			)
+		}
+	}
+	residualEmployer := entry.EmployerContributionsTotal - employer
+	return append(postings,
+		journalPosting{category: GLCategoryEarning, amount: entry.AllowancesTotal - earnings},
+		journalPosting{category: GLCategoryDeduction, amount: -(entry.DeductionsTotal - deductions)},
+		journalPosting{category: GLCategoryEmployerContributionExpense, amount: residualEmployer},
+		journalPosting{category: GLCategoryContributionPayable, amount: -residualEmployer},
+		journalPosting{category: GLCategoryTaxPayable, amount: -entry.TaxTotal},
+		journalPosting{category: GLCategoryNetPayClearing, amount: -entry.NetPay},
+	)
+}
+
+// resolveGLMapping picks the most specific mapping of the posting's category: a component or scheme match
+// outranks a department match, which outranks a company-wide mapping. Without any match the fallback category
+// is tried.
+func resolveGLMapping(mappings []GLAccountMapping, posting journalPosting, departmentID *int64) *GLAccountMapping {
+	for category := posting.category; category != ""; category = glCategoryFallbacks[category] {
+		var best *GLAccountMapping
+		bestScore := -1
+		for i := range mappings {
+			mapping := &mappings[i]
+			if mapping.Category != category {
+				continue
+			}
+			score := 0
+			if mapping.ComponentID != nil {
+				if !sameID(mapping.ComponentID, posting.componentID) {
+					continue
+				}
+				score += 2
+			}
+			if mapping.SchemeID != nil {
+				if !sameID(mapping.SchemeID, posting.schemeID) {
+					continue
+				}
+				score += 2
+			}
+			if mapping.DepartmentID != nil {
+				if !sameID(mapping.DepartmentID, departmentID) {
+					continue
+				}
+				score++
+			}
+			if score > bestScore {
+				best, bestScore = mapping, score
+			}
+		}
+		if best != nil {
+			return best
+		}
+	}
+	return nil
+}
+
+func writeJournalCSV(writer *csv.Writer, journal PayrollJournal, decimals int, symbol string) error {
+	if err := writer.Write([]string{"Account Code", "Account Name", "Department", "Cost Centre", "Debit", "Credit"}); err != nil {
+		return fmt.Errorf("write payroll journal header: %w", err)
+	}
+	for _, line := range journal.Lines {
+		record := []string{
+			line.AccountCode,
+			line.AccountName,
+			line.DepartmentName,
+			line.CostCentre,
+			journalAmount(line.Debit, decimals, symbol),
+			journalAmount(line.Credit, decimals, symbol),
+		}
+		if err := writer.Write(record); err != nil {
+			return fmt.Errorf("write payroll journal record: %w", err)
+		}
+	}
+	if err := writer.Write([]string{"", "Total", "", "", journal.TotalDebit.Format(decimals, symbol), journal.TotalCredit.Format(decimals, symbol)}); err != nil {
+		return fmt.Errorf("write payroll journal totals: %w", err)
+	}
+	return nil
+}
+
+// writeJournalImport writes plain amounts without a currency symbol, with the journal number, date and
+// description repeated on every row as most accounting packages expect.
+func writeJournalImport(writer *csv.Writer, journal PayrollJournal, decimals int) error {
+	header := []string{"JournalNumber", "JournalDate", "AccountCode", "AccountName", "Description", "Debit", "Credit", "CostCentre", "Department"}
+	if err := writer.Write(header); err != nil {
+		return fmt.Errorf("write payroll journal header: %w", err)
+	}
+	description := fmt.Sprintf("Payroll %s %s", journal.Month, journal.BatchType)
+	for _, line := range journal.Lines {
+		record := []string{
+			journal.Reference,
+			journal.Date,
+			line.AccountCode,
+			line.AccountName,
+			description,
+			line.Debit.Format(decimals, ""),
+			line.Credit.Format(decimals, ""),
+			line.CostCentre,
+			line.DepartmentName,
+		}
+		if err := writer.Write(record); err != nil {
+			return fmt.Errorf("write payroll journal record: %w", err)
+		}
+	}
+	return nil
+}
+
+func journalAmount(amount money.Amount, decimals int, symbol string) string {
+	if amount == 0 {
+		return ""
+	}
+	return amount.Format(decimals, symbol)
+}
+
+func normalizeGLAccountMappingInput(input GLAccountMappingInput) (GLAccountMappingInput, error) {
+	input.Category = strings.ToLower(strings.TrimSpace(input.Category))
+	input.AccountCode = strings.TrimSpace(input.AccountCode)
+	input.AccountName = strings.TrimSpace(input.AccountName)
+
+	if !glCategories[input.Category] {
+		return input, fmt.Errorf("%w: unknown GL mapping category", ErrValidation)
+	}
+	if input.AccountCode == "" {
+		return input, fmt.Errorf("%w: account code is required", ErrValidation)
+	}
+	if len(input.AccountCode) > 40 {
+		return input, fmt.Errorf("%w: account code is too long", ErrValidation)
+	}
+	if len(input.AccountName) > 120 {
+		return input, fmt.Errorf("%w: account name is too long", ErrValidation)
+	}
+	for _, id := range []*int64{input.ComponentID, input.SchemeID, input.DepartmentID} {
+		if id != nil && *id <= 0 {
+			return input, fmt.Errorf("%w: mapping ids must be positive", ErrValidation)
+		}
+	}
+	if input.ComponentID != nil && input.Category != GLCategoryEarning && input.Category != GLCategoryDeduction && input.Category != GLCategoryLoanReceivable {
+		return input, fmt.Errorf("%w: only earning, deduction and loan receivable mappings can name a pay component", ErrValidation)
+	}
+	if input.SchemeID != nil && input.Category != GLCategoryContributionPayable && input.Category != GLCategoryEmployerContributionExpense {
+		return input, fmt.Errorf("%w: only contribution mappings can name a contribution scheme", ErrValidation)
+	}
+	return input, nil
+}
+
+func glAccountMappingMetadata(item GLAccountMapping) map[string]any {
+	return map[string]any{
+		"category":      item.Category,
+		"component_id":  item.ComponentID,
+		"scheme_id":     item.SchemeID,
+		"department_id": item.DepartmentID,
+		"account_code":  item.AccountCode,
+	}
+}
+
+func sameID(left, right *int64) bool {
+	return left != nil && right != nil && *left == *right
+}
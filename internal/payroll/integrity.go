@@ -0,0 +1,375 @@
+package payroll
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"hrpro/internal/money"
+)
+
+// integritySchema is hashed in front of every seal so the canonical form can change without old seals
+// silently verifying against a different layout.
+const integritySchema = "hrpro.payroll.batch.v1"
+
+// integrityManifest records the hash of the batch header and of each entry with its lines. The batch hash is
+// taken over the manifest, so a verification can name the parts that drifted.
+type integrityManifest struct {
+	Header  string           `json:"header"`
+	Entries map[int64]string `json:"entries"`
+}
+
+// canonicalBatchHeader holds the header fields that must not change once a batch is locked.
+type canonicalBatchHeader struct {
+	ID              int64   `json:"id"`
+	Month           string  `json:"month"`
+	BatchType       string  `json:"batchType"`
+	Description     string  `json:"description"`
+	Status          string  `json:"status"`
+	CreatedBy       int64   `json:"createdBy"`
+	ApprovedBy      *int64  `json:"approvedBy"`
+	ApprovedAt      *string `json:"approvedAt"`
+	LockedAt        *string `json:"lockedAt"`
+	ReversesBatchID *int64  `json:"reversesBatchId"`
+}
+
+// canonicalEntry leaves out the employee name, which follows the employee record, and the row timestamps.
+// Amounts are in minor units so the hash does not depend on float formatting.
+type canonicalEntry struct {
+	ID                         int64           `json:"id"`
+	BatchID                    int64           `json:"batchId"`
+	EmployeeID                 int64           `json:"employeeId"`
+	BaseSalary                 int64           `json:"baseSalary"`
+	AllowancesTotal            int64           `json:"allowancesTotal"`
+	DeductionsTotal            int64           `json:"deductionsTotal"`
+	TaxTotal                   int64           `json:"taxTotal"`
+	GrossPay                   int64           `json:"grossPay"`
+	NetPay                     int64           `json:"netPay"`
+	EmployerContributionsTotal int64           `json:"employerContributionsTotal"`
+	FullBaseSalary             *int64          `json:"fullBaseSalary"`
+	ProrationBasis             *string         `json:"prorationBasis"`
+	PayableDays                *int            `json:"payableDays"`
+	PeriodDays                 *int            `json:"periodDays"`
+	Lines                      []canonicalLine `json:"lines"`
+}
+
+type canonicalLine struct {
+	ID             int64   `json:"id"`
+	ComponentID    *int64  `json:"componentId"`
+	SchemeID       *int64  `json:"schemeId"`
+	Code           string  `json:"code"`
+	Name           string  `json:"name"`
+	Kind           string  `json:"kind"`
+	Taxable        bool    `json:"taxable"`
+	Amount         int64   `json:"amount"`
+	EmployerAmount int64   `json:"employerAmount"`
+	Quantity       *string `json:"quantity"`
+	Rate           *int64  `json:"rate"`
+	LoanID         *int64  `json:"loanId"`
+	ArrearsMonth   *string `json:"arrearsMonth"`
+}
+
+// VerifyPayrollBatchIntegrity recomputes the hash of a locked batch and compares it with the seal stored when
+// it was locked. Drift lists what no longer matches; batches locked before sealing was introduced are Unsealed.
+func (s *Service) VerifyPayrollBatchIntegrity(ctx context.Context, batchID int64) (*BatchIntegrityReport, error) {
+	if batchID <= 0 {
+		return nil, fmt.Errorf("%w: batch id must be positive", ErrValidation)
+	}
+	reports, err := s.verifyBatches(ctx, []int64{batchID})
+	if err != nil {
+		return nil, err
+	}
+	if len(reports) == 0 {
+		return nil, ErrNotFound
+	}
+	report := reports[0]
+
+	s.audit.RecordAuditEvent(ctx, nil, "payroll.batch.verify_integrity", stringPtr("payroll_batch"), &report.BatchID, map[string]any{
+		"month":  report.Month,
+		"status": report.Status,
+		"drift":  len(report.Drift),
+	})
+	return &report, nil
+}
+
+// PayrollBatchIntegrityStatuses verifies several batches at once for reports, keyed by batch ID.
+func (s *Service) PayrollBatchIntegrityStatuses(ctx context.Context, batchIDs []int64) (map[int64]string, error) {
+	statuses := make(map[int64]string, len(batchIDs))
+	if len(batchIDs) == 0 {
+		return statuses, nil
+	}
+	reports, err := s.verifyBatches(ctx, batchIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, report := range reports {
+		statuses[report.BatchID] = report.Status
+	}
+	return statuses, nil
+}
+
+func (s *Service) verifyBatches(ctx context.Context, batchIDs []int64) ([]BatchIntegrityReport, error) {
+	seals, err := s.repository.ListBatchIntegritySeals(ctx, batchIDs)
+	if err != nil {
+		return nil, err
+	}
+	lockedIDs := make([]int64, 0, len(seals))
+	for _, seal := range seals {
+		if seal.Status == StatusLocked {
+			lockedIDs = append(lockedIDs, seal.ID)
+		}
+	}
+	entriesByBatch := make(map[int64][]PayrollEntry, len(lockedIDs))
+	if len(lockedIDs) > 0 {
+		entries, err := s.repository.ListEntriesByBatchIDs(ctx, lockedIDs)
+		if err != nil {
+			return nil, err
+		}
+		lines, err := s.repository.ListEntryLinesByBatchIDs(ctx, lockedIDs)
+		if err != nil {
+			return nil, err
+		}
+		linesByEntry := make(map[int64][]PayrollEntryLine, len(entries))
+		for _, line := range lines {
+			linesByEntry[line.EntryID] = append(linesByEntry[line.EntryID], line)
+		}
+		for _, entry := range entries {
+			entry.Lines = linesByEntry[entry.ID]
+			entriesByBatch[entry.BatchID] = append(entriesByBatch[entry.BatchID], entry)
+		}
+	}
+
+	checkedAt := time.Now().UTC()
+	reports := make([]BatchIntegrityReport, 0, len(seals))
+	for _, seal := range seals {
+		report, err := compareIntegritySeal(seal, entriesByBatch[seal.ID])
+		if err != nil {
+			return nil, err
+		}
+		report.CheckedAt = checkedAt
+		reports = append(reports, report)
+	}
+	return reports, nil
+}
+
+func compareIntegritySeal(seal BatchIntegritySeal, entries []PayrollEntry) (BatchIntegrityReport, error) {
+	report := BatchIntegrityReport{BatchID: seal.ID, Month: seal.Month, Drift: make([]string, 0), DriftedEntryIDs: make([]int64, 0)}
+	if seal.Status != StatusLocked {
+		// A sealed batch that is no longer locked was changed behind the reversal workflow.
+		if seal.IntegrityHash != nil {
+			report.Status = IntegrityDrift
+			report.StoredHash = *seal.IntegrityHash
+			report.HeaderDrift = true
+			report.Drift = append(report.Drift, fmt.Sprintf("batch status changed from Locked to %s", seal.Status))
+			return report, nil
+		}
+		report.Status = IntegrityNotLocked
+		return report, nil
+	}
+
+	manifest, hash, err := buildIntegrityManifest(seal.PayrollBatch, entries)
+	if err != nil {
+		return report, err
+	}
+	report.ComputedHash = hash
+	if seal.IntegrityHash == nil {
+		report.Status = IntegrityUnsealed
+		return report, nil
+	}
+	report.StoredHash = *seal.IntegrityHash
+	if report.StoredHash == hash {
+		report.Status = IntegrityVerified
+		return report, nil
+	}
+
+	report.Status = IntegrityDrift
+	var stored integrityManifest
+	if seal.IntegrityManifest == nil || json.Unmarshal([]byte(*seal.IntegrityManifest), &stored) != nil || manifestHash(stored) != report.StoredHash {
+		report.HeaderDrift = true
+		report.Drift = append(report.Drift, "stored integrity seal was altered")
+		return report, nil
+	}
+
+	if stored.Header != manifest.Header {
+		report.HeaderDrift = true
+		report.Drift = append(report.Drift, "batch header changed")
+	}
+	for _, entry := range entries {
+		storedEntry, ok := stored.Entries[entry.ID]
+		switch {
+		case !ok:
+			report.DriftedEntryIDs = append(report.DriftedEntryIDs, entry.ID)
+			report.Drift = append(report.Drift, fmt.Sprintf("entry %d (employee %d) was added", entry.ID, entry.EmployeeID))
+		case storedEntry != manifest.Entries[entry.ID]:
+			report.DriftedEntryIDs = append(report.DriftedEntryIDs, entry.ID)
+			report.Drift = append(report.Drift, fmt.Sprintf("entry %d (employee %d) changed", entry.ID, entry.EmployeeID))
+		}
+	}
+	removed := make([]int64, 0)
+	for entryID := range stored.Entries {
+		if _, ok := manifest.Entries[entryID]; !ok {
+			removed = append(removed, entryID)
+		}
+	}
+	sort.Slice(removed, func(i, j int) bool { return removed[i] < removed[j] })
+	for _, entryID := range removed {
+		report.Drift = append(report.Drift, fmt.Sprintf("entry %d was removed", entryID))
+	}
+	return report, nil
+}
+
+// entryIntegrityStatus narrows a batch verification to one entry: an entry is only in drift when it or the
+// batch header no longer matches the seal.
+func entryIntegrityStatus(reports []BatchIntegrityReport, entryID int64) string {
+	if len(reports) == 0 {
+		return ""
+	}
+	report := reports[0]
+	if report.Status != IntegrityDrift || report.HeaderDrift {
+		return report.Status
+	}
+	for _, driftedID := range report.DriftedEntryIDs {
+		if driftedID == entryID {
+			return IntegrityDrift
+		}
+	}
+	return IntegrityVerified
+}
+
+// sealBatch computes the seal of a batch that has just been locked.
+func sealBatch(batch PayrollBatch, entries []PayrollEntry) (hash string, manifest string, err error) {
+	built, hash, err := buildIntegrityManifest(batch, entries)
+	if err != nil {
+		return "", "", err
+	}
+	encoded, err := json.Marshal(built)
+	if err != nil {
+		return "", "", fmt.Errorf("encode integrity manifest: %w", err)
+	}
+	return hash, string(encoded), nil
+}
+
+func buildIntegrityManifest(batch PayrollBatch, entries []PayrollEntry) (integrityManifest, string, error) {
+	header, err := integrityDigest(canonicalBatchHeader{
+		ID:              batch.ID,
+		Month:           batch.Month,
+		BatchType:       batch.BatchType,
+		Description:     batch.Description,
+		Status:          batch.Status,
+		CreatedBy:       batch.CreatedBy,
+		ApprovedBy:      batch.ApprovedBy,
+		ApprovedAt:      canonicalTime(batch.ApprovedAt),
+		LockedAt:        canonicalTime(batch.LockedAt),
+		ReversesBatchID: batch.ReversesBatchID,
+	})
+	if err != nil {
+		return integrityManifest{}, "", err
+	}
+
+	manifest := integrityManifest{Header: header, Entries: make(map[int64]string, len(entries))}
+	for _, entry := range entries {
+		digest, err := integrityDigest(canonicalizeEntry(entry))
+		if err != nil {
+			return integrityManifest{}, "", err
+		}
+		manifest.Entries[entry.ID] = digest
+	}
+	return manifest, manifestHash(manifest), nil
+}
+
+// manifestHash chains the header and entry hashes in entry ID order.
+func manifestHash(manifest integrityManifest) string {
+	entryIDs := make([]int64, 0, len(manifest.Entries))
+	for entryID := range manifest.Entries {
+		entryIDs = append(entryIDs, entryID)
+	}
+	sort.Slice(entryIDs, func(i, j int) bool { return entryIDs[i] < entryIDs[j] })
+
+	var builder strings.Builder
+	builder.WriteString(integritySchema + "\n")
+	builder.WriteString("header:" + manifest.Header + "\n")
+	for _, entryID := range entryIDs {
+		builder.WriteString("entry:" + strconv.FormatInt(entryID, 10) + ":" + manifest.Entries[entryID] + "\n")
+	}
+	sum := sha256.Sum256([]byte(builder.String()))
+	return hex.EncodeToString(sum[:])
+}
+
+func integrityDigest(value any) (string, error) {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return "", fmt.Errorf("encode integrity payload: %w", err)
+	}
+	sum := sha256.Sum256(append([]byte(integritySchema+"\n"), encoded...))
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func canonicalizeEntry(entry PayrollEntry) canonicalEntry {
+	lines := make([]PayrollEntryLine, len(entry.Lines))
+	copy(lines, entry.Lines)
+	sort.Slice(lines, func(i, j int) bool { return lines[i].ID < lines[j].ID })
+
+	canonical := canonicalEntry{
+		ID:                         entry.ID,
+		BatchID:                    entry.BatchID,
+		EmployeeID:                 entry.EmployeeID,
+		BaseSalary:                 entry.BaseSalary.Minor(),
+		AllowancesTotal:            entry.AllowancesTotal.Minor(),
+		DeductionsTotal:            entry.DeductionsTotal.Minor(),
+		TaxTotal:                   entry.TaxTotal.Minor(),
+		GrossPay:                   entry.GrossPay.Minor(),
+		NetPay:                     entry.NetPay.Minor(),
+		EmployerContributionsTotal: entry.EmployerContributionsTotal.Minor(),
+		FullBaseSalary:             canonicalAmount(entry.FullBaseSalary),
+		ProrationBasis:             entry.ProrationBasis,
+		PayableDays:                entry.PayableDays,
+		PeriodDays:                 entry.PeriodDays,
+		Lines:                      make([]canonicalLine, 0, len(lines)),
+	}
+	for _, line := range lines {
+		var quantity *string
+		if line.Quantity != nil {
+			formatted := strconv.FormatFloat(*line.Quantity, 'f', -1, 64)
+			quantity = &formatted
+		}
+		canonical.Lines = append(canonical.Lines, canonicalLine{
+			ID:             line.ID,
+			ComponentID:    line.ComponentID,
+			SchemeID:       line.SchemeID,
+			Code:           line.Code,
+			Name:           line.Name,
+			Kind:           line.Kind,
+			Taxable:        line.Taxable,
+			Amount:         line.Amount.Minor(),
+			EmployerAmount: line.EmployerAmount.Minor(),
+			Quantity:       quantity,
+			Rate:           canonicalAmount(line.Rate),
+			LoanID:         line.LoanID,
+			ArrearsMonth:   line.ArrearsMonth,
+		})
+	}
+	return canonical
+}
+
+func canonicalAmount(value *money.Amount) *int64 {
+	if value == nil {
+		return nil
+	}
+	minor := value.Minor()
+	return &minor
+}
+
+// canonicalTime keeps microseconds, the precision Postgres stores, in UTC.
+func canonicalTime(value *time.Time) *string {
+	if value == nil {
+		return nil
+	}
+	formatted := value.UTC().Truncate(time.Microsecond).Format("2006-01-02T15:04:05.000000Z")
+	return &formatted
+}
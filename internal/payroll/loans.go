@@ -0,0 +1,281 @@
+package payroll
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"hrpro/internal/models"
+	"hrpro/internal/money"
+)
+
+const maxLoanInstallments = 120
+
+func (s *Service) ListLoans(ctx context.Context, filter ListLoansFilter) ([]EmployeeLoan, error) {
+	filter.Status = strings.ToLower(strings.TrimSpace(filter.Status))
+	if filter.Status != "" && filter.Status != LoanStatusActive && filter.Status != LoanStatusSettled && filter.Status != LoanStatusCancelled {
+		return nil, fmt.Errorf("%w: loan status must be active, settled or cancelled", ErrValidation)
+	}
+	if filter.EmployeeID < 0 {
+		return nil, fmt.Errorf("%w: employee id must be positive", ErrValidation)
+	}
+	return s.repository.ListLoans(ctx, filter)
+}
+
+// CreateLoan records a staff loan or salary advance. Installments are recovered from payroll starting with
+// StartMonth; the outstanding balance only moves when a batch is locked.
+func (s *Service) CreateLoan(ctx context.Context, claims *models.Claims, input LoanCreateInput) (*EmployeeLoan, error) {
+	normalized, err := normalizeLoanInput(input)
+	if err != nil {
+		return nil, err
+	}
+	decimals, err := s.calculationDecimals(ctx)
+	if err != nil {
+		return nil, err
+	}
+	normalized.Principal = normalized.Principal.Round(decimals)
+	totalRepayable, installmentAmount := CalculateLoanTerms(normalized.Principal, normalized.InterestRate, normalized.Installments)
+	totalRepayable, installmentAmount = totalRepayable.Round(decimals), installmentAmount.Round(decimals)
+
+	item, err := s.repository.CreateLoan(ctx, LoanInsertInput{
+		LoanCreateInput:   normalized,
+		TotalRepayable:    totalRepayable,
+		InstallmentAmount: installmentAmount,
+		CreatedBy:         claimsUserID(claims),
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.audit.RecordAuditEvent(ctx, claimsUserID(claims), "payroll.loan.create", stringPtr("employee_loan"), &item.ID, map[string]any{
+		"employee_id":     item.EmployeeID,
+		"loan_type":       item.LoanType,
+		"principal":       item.Principal,
+		"total_repayable": item.TotalRepayable,
+		"installments":    item.Installments,
+		"start_month":     item.StartMonth,
+	})
+	return item, nil
+}
+
+// CancelLoan stops further recoveries of an active loan. Repayments already made stay in the ledger.
+func (s *Service) CancelLoan(ctx context.Context, loanID int64) (*EmployeeLoan, error) {
+	loan, err := s.requireLoan(ctx, loanID)
+	if err != nil {
+		return nil, err
+	}
+	if loan.Status != LoanStatusActive {
+		return nil, ErrInvalidTransition
+	}
+	item, err := s.repository.SetLoanStatus(ctx, loanID, LoanStatusCancelled)
+	if err != nil {
+		return nil, err
+	}
+	if item == nil {
+		return nil, ErrNotFound
+	}
+	s.audit.RecordAuditEvent(ctx, nil, "payroll.loan.cancel", stringPtr("employee_loan"), &item.ID, map[string]any{
+		"employee_id":         item.EmployeeID,
+		"outstanding_balance": item.OutstandingBalance,
+	})
+	return item, nil
+}
+
+func (s *Service) GetLoanLedger(ctx context.Context, loanID int64) (*LoanLedger, error) {
+	loan, err := s.requireLoan(ctx, loanID)
+	if err != nil {
+		return nil, err
+	}
+	repayments, err := s.repository.ListLoanRepayments(ctx, loanID)
+	if err != nil {
+		return nil, err
+	}
+	schedule, err := LoanSchedule(*loan)
+	if err != nil {
+		return nil, err
+	}
+	return &LoanLedger{Loan: *loan, Schedule: schedule, Repayments: repayments}, nil
+}
+
+// ExportLoanLedgerCSV writes the loan ledger report: one row per recovery with the running balance,
+// across all loans or the loans of one employee.
+func (s *Service) ExportLoanLedgerCSV(ctx context.Context, employeeID int64) (*CSVExport, error) {
+	loans, err := s.ListLoans(ctx, ListLoansFilter{EmployeeID: employeeID})
+	if err != nil {
+		return nil, err
+	}
+	_, decimals := s.payrollFormatting(ctx)
+
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	if err := writer.Write([]string{"Loan ID", "Employee ID", "Employee Name", "Type", "Status", "Month", "Batch ID", "Total Repayable", "Recovered", "Balance"}); err != nil {
+		return nil, fmt.Errorf("write loan ledger header: %w", err)
+	}
+	for _, loan := range loans {
+		repayments, err := s.repository.ListLoanRepayments(ctx, loan.ID)
+		if err != nil {
+			return nil, err
+		}
+		base := []string{
+			strconv.FormatInt(loan.ID, 10),
+			strconv.FormatInt(loan.EmployeeID, 10),
+			loan.EmployeeName,
+			loan.LoanType,
+			loan.Status,
+		}
+		if len(repayments) == 0 {
+			record := append(append([]string{}, base...), loan.StartMonth, "", loan.TotalRepayable.Format(decimals, ""), money.Amount(0).Format(decimals, ""), loan.OutstandingBalance.Format(decimals, ""))
+			if err := writer.Write(record); err != nil {
+				return nil, fmt.Errorf("write loan ledger record: %w", err)
+			}
+			continue
+		}
+		for _, repayment := range repayments {
+			record := append(append([]string{}, base...),
+				repayment.Month,
+				strconv.FormatInt(repayment.BatchID, 10),
+				loan.TotalRepayable.Format(decimals, ""),
+				repayment.Amount.Format(decimals, ""),
+				repayment.BalanceAfter.Format(decimals, ""),
+			)
+			if err := writer.Write(record); err != nil {
+				return nil, fmt.Errorf("write loan ledger record: %w", err)
+			}
+		}
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return nil, fmt.Errorf("flush loan ledger csv: %w", err)
+	}
+
+	filename := fmt.Sprintf("loan_ledger_%s.csv", time.Now().Format("2006-01-02"))
+	if employeeID > 0 {
+		filename = fmt.Sprintf("loan_ledger_employee_%d_%s.csv", employeeID, time.Now().Format("2006-01-02"))
+	}
+	return &CSVExport{
+		Filename: filename,
+		Data:     buf.String(),
+		MimeType: "text/csv;charset=utf-8",
+	}, nil
+}
+
+// CalculateLoanTerms adds flat interest (annual rate over the repayment months) to the principal and splits
+// the total into equal installments.
+func CalculateLoanTerms(principal money.Amount, interestRate float64, installments int) (totalRepayable money.Amount, installmentAmount money.Amount) {
+	if installments <= 0 {
+		return principal, principal
+	}
+	interest := principal.MulFrac(int64(installments), 12).Percent(interestRate)
+	totalRepayable = principal + interest
+	return totalRepayable, totalRepayable.Div(int64(installments))
+}
+
+// LoanSchedule lists the planned installments of a loan; the last one absorbs rounding.
+func LoanSchedule(loan EmployeeLoan) ([]LoanInstallment, error) {
+	start, err := time.Parse("2006-01", loan.StartMonth)
+	if err != nil {
+		return nil, fmt.Errorf("%w: start month must be in YYYY-MM format", ErrValidation)
+	}
+	schedule := make([]LoanInstallment, 0, loan.Installments)
+	balance := loan.TotalRepayable
+	for i := 0; i < loan.Installments && balance > 0; i++ {
+		amount := loan.InstallmentAmount
+		if i == loan.Installments-1 || amount > balance {
+			amount = balance
+		}
+		balance -= amount
+		schedule = append(schedule, LoanInstallment{
+			Number:       i + 1,
+			Month:        start.AddDate(0, i, 0).Format("2006-01"),
+			Amount:       amount,
+			BalanceAfter: balance,
+		})
+	}
+	return schedule, nil
+}
+
+// loanLines builds the installment deductions of an employee's loans for one entry. Recoveries never take
+// net pay below zero; whatever cannot be recovered stays on the balance for later months.
+func loanLines(loans []EmployeeLoan, netPay money.Amount) []PayrollEntryLine {
+	lines := make([]PayrollEntryLine, 0, len(loans))
+	available := netPay
+	for _, loan := range loans {
+		amount := loan.InstallmentAmount
+		if amount > loan.OutstandingBalance {
+			amount = loan.OutstandingBalance
+		}
+		if amount > available {
+			amount = available
+		}
+		if amount <= 0 {
+			continue
+		}
+		available -= amount
+		loanID := loan.ID
+		code, name := LineCodeLoan, "Staff loan"
+		if loan.LoanType == LoanTypeAdvance {
+			code, name = LineCodeAdvance, "Salary advance"
+		}
+		lines = append(lines, PayrollEntryLine{
+			Code:   code,
+			Name:   name,
+			Kind:   ComponentKindDeduction,
+			Amount: amount,
+			LoanID: &loanID,
+		})
+	}
+	return lines
+}
+
+func (s *Service) requireLoan(ctx context.Context, loanID int64) (*EmployeeLoan, error) {
+	if loanID <= 0 {
+		return nil, fmt.Errorf("%w: loan id must be positive", ErrValidation)
+	}
+	loan, err := s.repository.GetLoanByID(ctx, loanID)
+	if err != nil {
+		return nil, err
+	}
+	if loan == nil {
+		return nil, ErrNotFound
+	}
+	return loan, nil
+}
+
+func normalizeLoanInput(input LoanCreateInput) (LoanCreateInput, error) {
+	input.LoanType = strings.ToLower(strings.TrimSpace(input.LoanType))
+	input.Description = strings.TrimSpace(input.Description)
+	input.StartMonth = strings.TrimSpace(input.StartMonth)
+
+	if input.EmployeeID <= 0 {
+		return input, fmt.Errorf("%w: employee id must be positive", ErrValidation)
+	}
+	switch input.LoanType {
+	case "":
+		input.LoanType = LoanTypeLoan
+	case LoanTypeLoan, LoanTypeAdvance:
+	default:
+		return input, fmt.Errorf("%w: loan type must be loan or advance", ErrValidation)
+	}
+	if input.Principal <= 0 {
+		return input, fmt.Errorf("%w: principal must be positive", ErrValidation)
+	}
+	if input.InterestRate < 0 || input.InterestRate > 100 {
+		return input, fmt.Errorf("%w: interest rate must be between 0 and 100", ErrValidation)
+	}
+	if input.LoanType == LoanTypeAdvance && input.Installments == 0 {
+		input.Installments = 1
+	}
+	if input.Installments <= 0 || input.Installments > maxLoanInstallments {
+		return input, fmt.Errorf("%w: installments must be between 1 and %d", ErrValidation, maxLoanInstallments)
+	}
+	if _, err := time.Parse("2006-01", input.StartMonth); err != nil {
+		return input, fmt.Errorf("%w: start month must be in YYYY-MM format", ErrValidation)
+	}
+	if len(input.Description) > 500 {
+		return input, fmt.Errorf("%w: description is too long", ErrValidation)
+	}
+	return input, nil
+}
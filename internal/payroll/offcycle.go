@@ -0,0 +1,247 @@
+package payroll
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// generateOffCycleEntries pays the selected components to the selected employees of a bonus, arrears or
+// correction batch. Arrears batches also pay the retro arrears owed for earlier locked months. There is no
+// base salary, proration, absence deduction or loan recovery; contributions and month-to-date PAYE apply as
+// in a regular batch. Arrears and correction batches also pay selected employees who have left.
+func (s *Service) generateOffCycleEntries(ctx context.Context, batch PayrollBatch, taxTable *TaxTable, decimals int, monthToDate map[int64]MonthToDate) error {
+	selection, err := s.repository.GetBatchSelection(ctx, batch.ID)
+	if err != nil {
+		return err
+	}
+	if len(selection.EmployeeIDs) == 0 || (len(selection.ComponentIDs) == 0 && batch.BatchType != BatchTypeArrears) {
+		return fmt.Errorf("%w: off-cycle batch has no employees or components selected", ErrValidation)
+	}
+	arrearsByEmployee := make(map[int64][]RetroArrears)
+	if batch.BatchType == BatchTypeArrears {
+		arrears, err := s.retroArrears(ctx, batch.Month, 0, decimals)
+		if err != nil {
+			return err
+		}
+		for _, item := range arrears {
+			arrearsByEmployee[item.EmployeeID] = append(arrearsByEmployee[item.EmployeeID], item)
+		}
+	}
+	components := make([]PayComponent, 0, len(selection.ComponentIDs))
+	for _, componentID := range selection.ComponentIDs {
+		component, err := s.repository.GetPayComponentByID(ctx, componentID)
+		if err != nil {
+			return err
+		}
+		if component == nil {
+			return ErrNotFound
+		}
+		components = append(components, *component)
+	}
+	selected := make(map[int64]bool, len(selection.EmployeeIDs))
+	for _, employeeID := range selection.EmployeeIDs {
+		selected[employeeID] = true
+	}
+	periodStart, periodEnd, err := MonthBounds(batch.Month)
+	if err != nil {
+		return err
+	}
+
+	entriesGenerated := 0
+	arrearsLines := 0
+	err = s.repository.WithTx(ctx, func(tx TxRepository) error {
+		if err := tx.DeleteEntriesByBatchID(ctx, batch.ID); err != nil {
+			return err
+		}
+
+		var employees []EmployeeSalary
+		if batch.BatchType == BatchTypeArrears || batch.BatchType == BatchTypeCorrection {
+			// Arrears and corrections may be owed to staff who have since left.
+			employees, err = tx.ListEmployeeSalariesByIDs(ctx, selection.EmployeeIDs, periodEnd)
+		} else {
+			employees, err = tx.ListActiveEmployeeSalaries(ctx, periodStart, periodEnd)
+		}
+		if err != nil {
+			return err
+		}
+		schemes, err := tx.ListContributionSchemes(ctx)
+		if err != nil {
+			return err
+		}
+		members, err := tx.ListContributionSchemeMembers(ctx)
+		if err != nil {
+			return err
+		}
+		memberships := contributionMemberships(members)
+
+		for _, employee := range employees {
+			if !selected[employee.EmployeeID] {
+				continue
+			}
+			lines := make([]PayrollEntryLine, 0, len(components)+len(schemes))
+			for _, component := range components {
+				// Percentage components are worked out on the full monthly salary, e.g. 100% for a 13th cheque.
+				lines = append(lines, newComponentLine(component, CalculateComponentAmount(component, employee.BaseSalary).Round(decimals)))
+			}
+			for _, item := range arrearsByEmployee[employee.EmployeeID] {
+				lines = append(lines, arrearsLine(item))
+			}
+			if len(lines) == 0 {
+				continue
+			}
+			arrearsLines += len(arrearsByEmployee[employee.EmployeeID])
+			lines = append(lines, contributionLines(schemes, memberships, employee.EmployeeID, 0, lines, decimals)...)
+			totals := calculateEntryTotals(0, lines, taxTable, 0, monthToDate[employee.EmployeeID], decimals)
+
+			entryID, err := tx.CreateEntry(ctx, EntryCreateInput{
+				BatchID:                    batch.ID,
+				EmployeeID:                 employee.EmployeeID,
+				AllowancesTotal:            totals.AllowancesTotal,
+				DeductionsTotal:            totals.DeductionsTotal,
+				TaxTotal:                   totals.TaxTotal,
+				GrossPay:                   totals.GrossPay,
+				NetPay:                     totals.NetPay,
+				EmployerContributionsTotal: totals.EmployerContributionsTotal,
+			})
+			if err != nil {
+				return err
+			}
+			for _, line := range lines {
+				if err := tx.CreateEntryLine(ctx, entryLineCreateInput(entryID, line)); err != nil {
+					return err
+				}
+			}
+			entriesGenerated++
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	metadata := map[string]any{
+		"month":             batch.Month,
+		"batch_type":        batch.BatchType,
+		"entries_generated": entriesGenerated,
+		"employees_skipped": len(selection.EmployeeIDs) - entriesGenerated,
+	}
+	if batch.BatchType == BatchTypeArrears {
+		metadata["arrears_lines"] = arrearsLines
+	}
+	if taxTable != nil {
+		metadata["tax_table_effective_from"] = taxTable.EffectiveFrom
+	}
+	s.audit.RecordAuditEvent(ctx, nil, "payroll.batch.generate", stringPtr("payroll_batch"), &batch.ID, metadata)
+	return nil
+}
+
+// monthToDate sums, per employee, the taxable pay and tax of the month's other approved and locked batches.
+func (s *Service) monthToDate(ctx context.Context, batch PayrollBatch) (map[int64]MonthToDate, error) {
+	entries, err := s.repository.ListMonthToDateEntries(ctx, batch.Month, batch.ID)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[int64]MonthToDate)
+	for _, entry := range entries {
+		total := result[entry.EmployeeID]
+		total.TaxablePay += CalculateTaxablePay(entry.BaseSalary, entry.AllowancesTotal, entry.Lines)
+		total.TaxTotal += entry.TaxTotal
+		result[entry.EmployeeID] = total
+	}
+	return result, nil
+}
+
+// requireCurrentPAYE checks that the batch's PAYE still matches the month-to-date of the month's other
+// approved and locked batches. PAYE is worked out at generation, so two batches drafted side by side each
+// ignore the other; whichever is approved second must be regenerated first.
+func (s *Service) requireCurrentPAYE(ctx context.Context, batch PayrollBatch) error {
+	if batch.ReversesBatchID != nil {
+		return nil
+	}
+	taxTable, err := s.resolveTaxTable(ctx, batch.Month)
+	if err != nil || taxTable == nil {
+		return err
+	}
+	decimals, err := s.calculationDecimals(ctx)
+	if err != nil {
+		return err
+	}
+	monthToDate, err := s.monthToDate(ctx, batch)
+	if err != nil {
+		return err
+	}
+	entries, err := s.listEntriesWithLines(ctx, batch.ID)
+	if err != nil {
+		return err
+	}
+	stale := make([]string, 0)
+	for _, entry := range entries {
+		tax := calculateEntryTax(taxTable, entry.BaseSalary, entry.AllowancesTotal, entry.Lines, entry.TaxTotal, monthToDate[entry.EmployeeID], decimals)
+		if tax != entry.TaxTotal {
+			stale = append(stale, entry.EmployeeName)
+		}
+	}
+	if len(stale) > 0 {
+		return fmt.Errorf("%w: PAYE no longer matches the month's other approved batches for %s; regenerate the batch", ErrValidation, strings.Join(stale, ", "))
+	}
+	return nil
+}
+
+func normalizeCreateBatchInput(input CreateBatchInput) (CreateBatchInput, error) {
+	input.Month = strings.TrimSpace(input.Month)
+	input.BatchType = strings.ToLower(strings.TrimSpace(input.BatchType))
+	input.Description = strings.TrimSpace(input.Description)
+
+	if !payrollMonthPattern.MatchString(input.Month) {
+		return input, fmt.Errorf("%w: month must be in YYYY-MM format", ErrValidation)
+	}
+	if len(input.Description) > 200 {
+		return input, fmt.Errorf("%w: description is too long", ErrValidation)
+	}
+	switch input.BatchType {
+	case "", BatchTypeRegular:
+		input.BatchType = BatchTypeRegular
+		if len(input.EmployeeIDs) > 0 || len(input.ComponentIDs) > 0 {
+			return input, fmt.Errorf("%w: regular batches include all active employees and auto-apply components", ErrValidation)
+		}
+		return input, nil
+	case BatchTypeBonus, BatchTypeArrears, BatchTypeCorrection:
+	default:
+		return input, fmt.Errorf("%w: batch type must be regular, bonus, arrears or correction", ErrValidation)
+	}
+
+	var err error
+	if input.EmployeeIDs, err = normalizeSelection(input.EmployeeIDs, "employee"); err != nil {
+		return input, err
+	}
+	// Arrears batches may pay only the retro arrears worked out from the salary history.
+	if input.BatchType == BatchTypeArrears && len(input.ComponentIDs) == 0 {
+		return input, nil
+	}
+	if input.ComponentIDs, err = normalizeSelection(input.ComponentIDs, "pay component"); err != nil {
+		return input, err
+	}
+	return input, nil
+}
+
+func normalizeSelection(ids []int64, label string) ([]int64, error) {
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("%w: select at least one %s for an off-cycle batch", ErrValidation, label)
+	}
+	seen := make(map[int64]bool, len(ids))
+	result := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		if id <= 0 {
+			return nil, fmt.Errorf("%w: %s id must be positive", ErrValidation, label)
+		}
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		result = append(result, id)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i] < result[j] })
+	return result, nil
+}
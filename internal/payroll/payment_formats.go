@@ -0,0 +1,141 @@
+package payroll
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// PaymentFileFormat describes the layout of one bulk payment upload file. Formats are keyed by
+// Code and only receive the instructions that match Method (and Provider, when set).
+type PaymentFileFormat struct {
+	Code     string
+	Name     string
+	Method   string
+	Provider string
+	Columns  []PaymentFileColumn
+	// Trailer, when set, is written after the last row with the row count and the file total.
+	Trailer func(count int, total string) []string
+}
+
+type PaymentFileColumn struct {
+	Header string
+	Value  func(row PaymentFileRow) string
+}
+
+// PaymentFileRow is a payment instruction as handed to a format, with its 1-based position in the
+// file and the amount already formatted for the configured payroll decimals.
+type PaymentFileRow struct {
+	PaymentInstruction
+	Sequence int
+	Amount   string
+}
+
+func (f PaymentFileFormat) info() PaymentFileFormatInfo {
+	return PaymentFileFormatInfo{Code: f.Code, Name: f.Name, Method: f.Method, Provider: f.Provider}
+}
+
+func (f PaymentFileFormat) accepts(instruction PaymentInstruction) bool {
+	if instruction.Method != f.Method {
+		return false
+	}
+	return f.Provider == "" || strings.EqualFold(instruction.Provider, f.Provider)
+}
+
+// RegisterPaymentFileFormat adds or replaces a bulk payment file format.
+func (s *Service) RegisterPaymentFileFormat(format PaymentFileFormat) error {
+	format.Code = strings.ToUpper(strings.TrimSpace(format.Code))
+	format.Provider = strings.ToUpper(strings.TrimSpace(format.Provider))
+	if !componentCodePattern.MatchString(format.Code) {
+		return fmt.Errorf("%w: payment file format code must be 2-40 letters, numbers, '_' or '-'", ErrValidation)
+	}
+	if strings.TrimSpace(format.Name) == "" {
+		return fmt.Errorf("%w: payment file format name is required", ErrValidation)
+	}
+	if format.Method != PaymentMethodBank && format.Method != PaymentMethodMobileMoney {
+		return fmt.Errorf("%w: payment file format method must be bank or mobile_money", ErrValidation)
+	}
+	if len(format.Columns) == 0 {
+		return fmt.Errorf("%w: payment file format has no columns", ErrValidation)
+	}
+	for _, column := range format.Columns {
+		if column.Value == nil {
+			return fmt.Errorf("%w: payment file column %q has no value", ErrValidation, column.Header)
+		}
+	}
+	if s.paymentFormats == nil {
+		s.paymentFormats = make(map[string]PaymentFileFormat)
+	}
+	s.paymentFormats[format.Code] = format
+	return nil
+}
+
+// ListPaymentFileFormats returns the registered formats ordered by code.
+func (s *Service) ListPaymentFileFormats() []PaymentFileFormatInfo {
+	items := make([]PaymentFileFormatInfo, 0, len(s.paymentFormats))
+	for _, format := range s.paymentFormats {
+		items = append(items, format.info())
+	}
+	sort.Slice(items, func(i, j int) bool { return items[i].Code < items[j].Code })
+	return items
+}
+
+func defaultPaymentFileFormats() map[string]PaymentFileFormat {
+	formats := []PaymentFileFormat{
+		{
+			Code:   "BANK_EFT_CSV",
+			Name:   "Bank EFT bulk transfer (CSV)",
+			Method: PaymentMethodBank,
+			Columns: []PaymentFileColumn{
+				{Header: "Seq", Value: func(row PaymentFileRow) string { return strconv.Itoa(row.Sequence) }},
+				{Header: "Beneficiary Name", Value: func(row PaymentFileRow) string { return beneficiaryName(row) }},
+				{Header: "Bank Name", Value: func(row PaymentFileRow) string { return row.BankName }},
+				{Header: "Bank Code", Value: func(row PaymentFileRow) string { return row.BankCode }},
+				{Header: "Branch", Value: func(row PaymentFileRow) string { return row.Branch }},
+				{Header: "Account Number", Value: func(row PaymentFileRow) string { return row.AccountNumber }},
+				{Header: "Amount", Value: func(row PaymentFileRow) string { return row.Amount }},
+				{Header: "Reference", Value: func(row PaymentFileRow) string { return row.Reference }},
+			},
+			Trailer: func(count int, total string) []string {
+				return []string{"TOTAL", fmt.Sprintf("%d payments", count), "", "", "", "", total, ""}
+			},
+		},
+		{
+			Code:     "MTN_MOMO_CSV",
+			Name:     "MTN Mobile Money bulk payment (CSV)",
+			Method:   PaymentMethodMobileMoney,
+			Provider: "MTN",
+			Columns:  mobileMoneyColumns(),
+		},
+		{
+			Code:     "AIRTEL_MONEY_CSV",
+			Name:     "Airtel Money bulk payment (CSV)",
+			Method:   PaymentMethodMobileMoney,
+			Provider: "AIRTEL",
+			Columns:  mobileMoneyColumns(),
+		},
+	}
+
+	result := make(map[string]PaymentFileFormat, len(formats))
+	for _, format := range formats {
+		result[format.Code] = format
+	}
+	return result
+}
+
+func mobileMoneyColumns() []PaymentFileColumn {
+	return []PaymentFileColumn{
+		{Header: "MSISDN", Value: func(row PaymentFileRow) string { return strings.TrimPrefix(row.MSISDN, "+") }},
+		{Header: "Amount", Value: func(row PaymentFileRow) string { return row.Amount }},
+		{Header: "Name", Value: func(row PaymentFileRow) string { return beneficiaryName(row) }},
+		{Header: "Reason", Value: func(row PaymentFileRow) string { return row.Reference }},
+	}
+}
+
+func beneficiaryName(row PaymentFileRow) string {
+	if row.AccountName != "" {
+		return row.AccountName
+	}
+	return row.EmployeeName
+}
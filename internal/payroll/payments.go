@@ -0,0 +1,287 @@
+package payroll
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"math"
+	"strings"
+
+	"hrpro/internal/money"
+	"hrpro/internal/phone"
+)
+
+const maxPaymentMethodsPerEmployee = 5
+
+func (s *Service) ListEmployeePaymentMethods(ctx context.Context, employeeID int64) ([]EmployeePaymentMethod, error) {
+	if employeeID <= 0 {
+		return nil, fmt.Errorf("%w: employee id must be positive", ErrValidation)
+	}
+	return s.repository.ListEmployeePaymentMethods(ctx, employeeID)
+}
+
+// SetEmployeePaymentMethods replaces the payment destinations of an employee. Split percentages must total 100;
+// an empty list clears the payment details.
+func (s *Service) SetEmployeePaymentMethods(ctx context.Context, employeeID int64, inputs []EmployeePaymentMethodInput) ([]EmployeePaymentMethod, error) {
+	if employeeID <= 0 {
+		return nil, fmt.Errorf("%w: employee id must be positive", ErrValidation)
+	}
+	normalized, err := normalizePaymentMethodInputs(inputs)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.repository.WithTx(ctx, func(tx TxRepository) error {
+		if err := tx.DeleteEmployeePaymentMethods(ctx, employeeID); err != nil {
+			return err
+		}
+		for _, input := range normalized {
+			if err := tx.CreateEmployeePaymentMethod(ctx, employeeID, input); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := s.repository.ListEmployeePaymentMethods(ctx, employeeID)
+	if err != nil {
+		return nil, err
+	}
+	methods := make([]string, 0, len(items))
+	for _, item := range items {
+		methods = append(methods, item.Method)
+	}
+	s.audit.RecordAuditEvent(ctx, nil, "payroll.payment_method.set", stringPtr("employee"), &employeeID, map[string]any{
+		"methods": methods,
+	})
+	return items, nil
+}
+
+// ExportPaymentFile writes the bulk payment upload file of a locked batch in the given format. Every
+// entry with positive net pay must have payment details, and the instructions across all destinations
+// must add up to the batch net pay before any file is produced.
+func (s *Service) ExportPaymentFile(ctx context.Context, batchID int64, formatCode string) (*CSVExport, error) {
+	if batchID <= 0 {
+		return nil, fmt.Errorf("%w: batch id must be positive", ErrValidation)
+	}
+	format, ok := s.paymentFormats[strings.ToUpper(strings.TrimSpace(formatCode))]
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown payment file format", ErrValidation)
+	}
+
+	batch, err := s.repository.GetBatchByID(ctx, batchID)
+	if err != nil {
+		return nil, err
+	}
+	if batch == nil {
+		return nil, ErrNotFound
+	}
+	if batch.Status != StatusLocked {
+		return nil, ErrPaymentNotAllowed
+	}
+	if batch.ReversesBatchID != nil {
+		return nil, fmt.Errorf("%w: a reversal batch pays nothing", ErrPaymentNotAllowed)
+	}
+
+	entries, err := s.repository.ListEntriesByBatchID(ctx, batchID)
+	if err != nil {
+		return nil, err
+	}
+	methods, err := s.repository.ListPaymentMethodsByBatchID(ctx, batchID)
+	if err != nil {
+		return nil, err
+	}
+	instructions, err := BuildPaymentInstructions(*batch, entries, methods)
+	if err != nil {
+		return nil, err
+	}
+
+	_, decimals := s.payrollFormatting(ctx)
+
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	header := make([]string, 0, len(format.Columns))
+	for _, column := range format.Columns {
+		header = append(header, column.Header)
+	}
+	if err := writer.Write(header); err != nil {
+		return nil, fmt.Errorf("write payment file header: %w", err)
+	}
+
+	count := 0
+	var fileTotal money.Amount
+	for _, instruction := range instructions {
+		if !format.accepts(instruction) {
+			continue
+		}
+		count++
+		fileTotal += instruction.Amount
+		row := PaymentFileRow{
+			PaymentInstruction: instruction,
+			Sequence:           count,
+			Amount:             instruction.Amount.Format(decimals, ""),
+		}
+		record := make([]string, 0, len(format.Columns))
+		for _, column := range format.Columns {
+			record = append(record, column.Value(row))
+		}
+		if err := writer.Write(record); err != nil {
+			return nil, fmt.Errorf("write payment file record: %w", err)
+		}
+	}
+	if count == 0 {
+		return nil, fmt.Errorf("%w: batch has no payments for %s", ErrValidation, format.Name)
+	}
+
+	if format.Trailer != nil {
+		if err := writer.Write(format.Trailer(count, fileTotal.Format(decimals, ""))); err != nil {
+			return nil, fmt.Errorf("write payment file trailer: %w", err)
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return nil, fmt.Errorf("flush payment file: %w", err)
+	}
+
+	s.audit.RecordAuditEvent(ctx, nil, "payroll.batch.payment_file", stringPtr("payroll_batch"), &batch.ID, map[string]any{
+		"month":    batch.Month,
+		"format":   format.Code,
+		"payments": count,
+		"total":    fileTotal,
+	})
+	return &CSVExport{
+		Filename: fmt.Sprintf("%s_%s.csv", strings.ToLower(format.Code), batch.Month),
+		Data:     buf.String(),
+		MimeType: "text/csv;charset=utf-8",
+	}, nil
+}
+
+// BuildPaymentInstructions splits the net pay of each entry across the employee's payment methods.
+// The last split absorbs rounding so each employee is paid exactly their net pay, and the result is
+// checked against the batch net pay total.
+func BuildPaymentInstructions(batch PayrollBatch, entries []PayrollEntry, methods []EmployeePaymentMethod) ([]PaymentInstruction, error) {
+	methodsByEmployee := make(map[int64][]EmployeePaymentMethod, len(entries))
+	for _, method := range methods {
+		methodsByEmployee[method.EmployeeID] = append(methodsByEmployee[method.EmployeeID], method)
+	}
+
+	reference := fmt.Sprintf("Salary %s", batch.Month)
+	instructions := make([]PaymentInstruction, 0, len(entries))
+	missing := make([]string, 0)
+	var netTotal, paidTotal money.Amount
+	for _, entry := range entries {
+		if entry.NetPay <= 0 {
+			continue
+		}
+		netTotal += entry.NetPay
+		employeeMethods := methodsByEmployee[entry.EmployeeID]
+		if len(employeeMethods) == 0 {
+			missing = append(missing, entry.EmployeeName)
+			continue
+		}
+
+		remaining := entry.NetPay
+		for i, method := range employeeMethods {
+			amount := remaining
+			if i < len(employeeMethods)-1 {
+				amount = entry.NetPay.Percent(method.SplitPercent)
+			}
+			remaining -= amount
+			if amount < 0 {
+				return nil, fmt.Errorf("%w: split percentages of %s exceed 100", ErrControlTotal, entry.EmployeeName)
+			}
+			if amount == 0 {
+				continue
+			}
+			paidTotal += amount
+			instructions = append(instructions, PaymentInstruction{
+				EntryID:       entry.ID,
+				EmployeeID:    entry.EmployeeID,
+				EmployeeName:  entry.EmployeeName,
+				Method:        method.Method,
+				BankName:      valueOrEmpty(method.BankName),
+				BankCode:      valueOrEmpty(method.BankCode),
+				Branch:        valueOrEmpty(method.Branch),
+				AccountName:   valueOrEmpty(method.AccountName),
+				AccountNumber: valueOrEmpty(method.AccountNumber),
+				Provider:      valueOrEmpty(method.Provider),
+				MSISDN:        valueOrEmpty(method.MSISDN),
+				Amount:        amount,
+				Reference:     reference,
+			})
+		}
+	}
+
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("%w: missing payment details for %s", ErrValidation, strings.Join(missing, ", "))
+	}
+	if netTotal != paidTotal {
+		return nil, fmt.Errorf("%w: expected %s, got %s", ErrControlTotal, netTotal, paidTotal)
+	}
+	return instructions, nil
+}
+
+func normalizePaymentMethodInputs(inputs []EmployeePaymentMethodInput) ([]EmployeePaymentMethodInput, error) {
+	if len(inputs) > maxPaymentMethodsPerEmployee {
+		return nil, fmt.Errorf("%w: at most %d payment methods are allowed", ErrValidation, maxPaymentMethodsPerEmployee)
+	}
+
+	result := make([]EmployeePaymentMethodInput, 0, len(inputs))
+	splitTotal := 0.0
+	for _, input := range inputs {
+		input.Method = strings.ToLower(strings.TrimSpace(input.Method))
+		input.BankName = strings.TrimSpace(input.BankName)
+		input.BankCode = strings.TrimSpace(input.BankCode)
+		input.Branch = strings.TrimSpace(input.Branch)
+		input.AccountName = strings.TrimSpace(input.AccountName)
+		input.AccountNumber = strings.ReplaceAll(strings.TrimSpace(input.AccountNumber), " ", "")
+		input.Provider = strings.ToUpper(strings.TrimSpace(input.Provider))
+		input.MSISDN = strings.TrimSpace(input.MSISDN)
+
+		switch input.Method {
+		case PaymentMethodBank:
+			if input.BankName == "" || input.AccountNumber == "" {
+				return nil, fmt.Errorf("%w: bank name and account number are required", ErrValidation)
+			}
+			if len(input.AccountNumber) > 40 {
+				return nil, fmt.Errorf("%w: account number is too long", ErrValidation)
+			}
+			input.Provider = ""
+			input.MSISDN = ""
+		case PaymentMethodMobileMoney:
+			if input.Provider == "" {
+				return nil, fmt.Errorf("%w: mobile money provider is required", ErrValidation)
+			}
+			msisdn, err := phone.NormalizePhone(input.MSISDN, "UG")
+			if err != nil {
+				return nil, fmt.Errorf("%w: mobile money number is invalid", ErrValidation)
+			}
+			input.MSISDN = msisdn
+			input.BankName = ""
+			input.BankCode = ""
+			input.Branch = ""
+			input.AccountNumber = ""
+		default:
+			return nil, fmt.Errorf("%w: payment method must be bank or mobile_money", ErrValidation)
+		}
+
+		if len(inputs) == 1 && input.SplitPercent == 0 {
+			input.SplitPercent = 100
+		}
+		if input.SplitPercent <= 0 || input.SplitPercent > 100 {
+			return nil, fmt.Errorf("%w: split percent must be between 0 and 100", ErrValidation)
+		}
+		splitTotal += input.SplitPercent
+		result = append(result, input)
+	}
+
+	if len(result) > 0 && math.Abs(splitTotal-100) > 0.001 {
+		return nil, fmt.Errorf("%w: split percentages must total 100", ErrValidation)
+	}
+	return result, nil
+}
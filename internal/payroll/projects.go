@@ -0,0 +1,472 @@
+package payroll
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"hrpro/internal/money"
+)
+
+const maxProjectAllocationsPerEmployee = 20
+
+func (s *Service) ListProjects(ctx context.Context, activeOnly bool) ([]Project, error) {
+	return s.repository.ListProjects(ctx, activeOnly)
+}
+
+func (s *Service) CreateProject(ctx context.Context, input ProjectUpsertInput) (*Project, error) {
+	normalized, err := normalizeProjectInput(input)
+	if err != nil {
+		return nil, err
+	}
+	item, err := s.repository.CreateProject(ctx, normalized)
+	if err != nil {
+		return nil, err
+	}
+	s.audit.RecordAuditEvent(ctx, nil, "payroll.project.create", stringPtr("project"), &item.ID, map[string]any{
+		"code":  item.Code,
+		"donor": item.Donor,
+	})
+	return item, nil
+}
+
+func (s *Service) UpdateProject(ctx context.Context, id int64, input ProjectUpsertInput) (*Project, error) {
+	if id <= 0 {
+		return nil, fmt.Errorf("%w: project id must be positive", ErrValidation)
+	}
+	normalized, err := normalizeProjectInput(input)
+	if err != nil {
+		return nil, err
+	}
+	item, err := s.repository.UpdateProject(ctx, id, normalized)
+	if err != nil {
+		return nil, err
+	}
+	if item == nil {
+		return nil, ErrNotFound
+	}
+	s.audit.RecordAuditEvent(ctx, nil, "payroll.project.update", stringPtr("project"), &item.ID, map[string]any{
+		"code":  item.Code,
+		"donor": item.Donor,
+	})
+	return item, nil
+}
+
+// SetProjectActive closes or reopens a project. Existing allocations are kept; closing a project does not
+// stop them from being charged.
+func (s *Service) SetProjectActive(ctx context.Context, id int64, active bool) (*Project, error) {
+	if id <= 0 {
+		return nil, fmt.Errorf("%w: project id must be positive", ErrValidation)
+	}
+	item, err := s.repository.SetProjectActive(ctx, id, active)
+	if err != nil {
+		return nil, err
+	}
+	if item == nil {
+		return nil, ErrNotFound
+	}
+	s.audit.RecordAuditEvent(ctx, nil, "payroll.project.set_active", stringPtr("project"), &item.ID, map[string]any{
+		"code":   item.Code,
+		"active": item.Active,
+	})
+	return item, nil
+}
+
+func (s *Service) ListEmployeeProjectAllocations(ctx context.Context, employeeID int64) ([]EmployeeProjectAllocation, error) {
+	if employeeID <= 0 {
+		return nil, fmt.Errorf("%w: employee id must be positive", ErrValidation)
+	}
+	return s.repository.ListEmployeeProjectAllocations(ctx, employeeID)
+}
+
+// SetEmployeeProjectAllocations replaces the project allocations of an employee. On any day the allocations
+// may total at most 100%; the rest of the employee's cost is unallocated. An empty list clears them.
+func (s *Service) SetEmployeeProjectAllocations(ctx context.Context, employeeID int64, inputs []ProjectAllocationInput) ([]EmployeeProjectAllocation, error) {
+	if employeeID <= 0 {
+		return nil, fmt.Errorf("%w: employee id must be positive", ErrValidation)
+	}
+	normalized, err := normalizeProjectAllocationInputs(inputs)
+	if err != nil {
+		return nil, err
+	}
+	checked := make(map[int64]bool, len(normalized))
+	for _, allocation := range normalized {
+		if checked[allocation.ProjectID] {
+			continue
+		}
+		project, err := s.repository.GetProjectByID(ctx, allocation.ProjectID)
+		if err != nil {
+			return nil, err
+		}
+		if project == nil {
+			return nil, fmt.Errorf("%w: project %d", ErrNotFound, allocation.ProjectID)
+		}
+		checked[allocation.ProjectID] = true
+	}
+
+	err = s.repository.WithTx(ctx, func(tx TxRepository) error {
+		if err := tx.DeleteEmployeeProjectAllocations(ctx, employeeID); err != nil {
+			return err
+		}
+		for _, allocation := range normalized {
+			if err := tx.CreateEmployeeProjectAllocation(ctx, employeeID, allocation); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := s.repository.ListEmployeeProjectAllocations(ctx, employeeID)
+	if err != nil {
+		return nil, err
+	}
+	codes := make([]string, 0, len(items))
+	for _, item := range items {
+		codes = append(codes, fmt.Sprintf("%s %.2f%%", item.ProjectCode, item.Percent))
+	}
+	s.audit.RecordAuditEvent(ctx, nil, "payroll.project_allocation.set", stringPtr("employee"), &employeeID, map[string]any{
+		"allocations": codes,
+	})
+	return items, nil
+}
+
+// GetProjectChargingReport lists the cost charged to each project per employee over the locked batches of a
+// month. Shares are of the employee's total cost in the month. A projectID of 0 includes every project and
+// the unallocated remainder.
+func (s *Service) GetProjectChargingReport(ctx context.Context, month string, projectID int64) (*ProjectChargingReport, error) {
+	month = strings.TrimSpace(month)
+	if !payrollMonthPattern.MatchString(month) {
+		return nil, fmt.Errorf("%w: month must be in YYYY-MM format", ErrValidation)
+	}
+	if projectID < 0 {
+		return nil, fmt.Errorf("%w: project id must be positive", ErrValidation)
+	}
+	rows, err := s.repository.ListProjectChargingRows(ctx, month)
+	if err != nil {
+		return nil, err
+	}
+
+	employeeCost := make(map[int64]money.Amount)
+	for _, row := range rows {
+		employeeCost[row.EmployeeID] += row.GrossAmount + row.EmployerAmount
+	}
+	report := &ProjectChargingReport{Month: month, Rows: make([]ProjectChargingRow, 0, len(rows))}
+	for _, row := range rows {
+		if projectID > 0 && (row.ProjectID == nil || *row.ProjectID != projectID) {
+			continue
+		}
+		if row.ProjectID == nil {
+			row.ProjectName = "Unallocated"
+		}
+		row.TotalCost = row.GrossAmount + row.EmployerAmount
+		if total := employeeCost[row.EmployeeID]; total != 0 {
+			row.SharePercent = math.Round(float64(row.TotalCost.Minor())/float64(total.Minor())*10000) / 100
+		}
+		report.GrossAmount += row.GrossAmount
+		report.EmployerAmount += row.EmployerAmount
+		report.TotalCost += row.TotalCost
+		report.Rows = append(report.Rows, row)
+	}
+	return report, nil
+}
+
+// ExportProjectChargingCSV writes the charging report for donor financial reporting, with a subtotal after
+// each project and a grand total.
+func (s *Service) ExportProjectChargingCSV(ctx context.Context, month string, projectID int64) (*CSVExport, error) {
+	report, err := s.GetProjectChargingReport(ctx, month, projectID)
+	if err != nil {
+		return nil, err
+	}
+	symbol, decimals := s.payrollFormatting(ctx)
+
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	header := []string{"Project Code", "Project Name", "Donor", "Employee ID", "Employee Name", "Share %", "Gross Pay", "Employer Contributions", "Total Cost"}
+	if err := writer.Write(header); err != nil {
+		return nil, fmt.Errorf("write project charging header: %w", err)
+	}
+
+	var subtotal ProjectChargingRow
+	employees := 0
+	writeSubtotal := func() error {
+		if employees == 0 {
+			return nil
+		}
+		record := []string{
+			projectChargingCode(subtotal),
+			"Subtotal",
+			subtotal.Donor,
+			"",
+			fmt.Sprintf("%d employees", employees),
+			"",
+			subtotal.GrossAmount.Format(decimals, symbol),
+			subtotal.EmployerAmount.Format(decimals, symbol),
+			subtotal.TotalCost.Format(decimals, symbol),
+		}
+		if err := writer.Write(record); err != nil {
+			return fmt.Errorf("write project charging subtotal: %w", err)
+		}
+		return nil
+	}
+	for _, row := range report.Rows {
+		if employees > 0 && !sameProject(subtotal.ProjectID, row.ProjectID) {
+			if err := writeSubtotal(); err != nil {
+				return nil, err
+			}
+			employees = 0
+		}
+		if employees == 0 {
+			subtotal = ProjectChargingRow{ProjectID: row.ProjectID, ProjectCode: row.ProjectCode, Donor: row.Donor}
+		}
+		subtotal.GrossAmount += row.GrossAmount
+		subtotal.EmployerAmount += row.EmployerAmount
+		subtotal.TotalCost += row.TotalCost
+		employees++
+
+		record := []string{
+			projectChargingCode(row),
+			row.ProjectName,
+			row.Donor,
+			strconv.FormatInt(row.EmployeeID, 10),
+			row.EmployeeName,
+			strconv.FormatFloat(row.SharePercent, 'f', 2, 64),
+			row.GrossAmount.Format(decimals, symbol),
+			row.EmployerAmount.Format(decimals, symbol),
+			row.TotalCost.Format(decimals, symbol),
+		}
+		if err := writer.Write(record); err != nil {
+			return nil, fmt.Errorf("write project charging record: %w", err)
+		}
+	}
+	if err := writeSubtotal(); err != nil {
+		return nil, err
+	}
+	total := []string{"TOTAL", "", "", "", "", "", report.GrossAmount.Format(decimals, symbol), report.EmployerAmount.Format(decimals, symbol), report.TotalCost.Format(decimals, symbol)}
+	if err := writer.Write(total); err != nil {
+		return nil, fmt.Errorf("write project charging total: %w", err)
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return nil, fmt.Errorf("flush project charging csv: %w", err)
+	}
+
+	filename := fmt.Sprintf("project-charging-%s.csv", report.Month)
+	if projectID > 0 && len(report.Rows) > 0 {
+		filename = fmt.Sprintf("project-charging-%s-%s.csv", strings.ToLower(report.Rows[0].ProjectCode), report.Month)
+	}
+	return &CSVExport{
+		Filename: filename,
+		Data:     buf.String(),
+		MimeType: "text/csv;charset=utf-8",
+	}, nil
+}
+
+// lockedProjectCharges splits the cost of each entry of a batch being locked. A reversal batch offsets the
+// charges of the batch it reverses, so allocation changes in between leave no project over- or under-charged.
+func lockedProjectCharges(ctx context.Context, tx TxRepository, batch PayrollBatch, entries []PayrollEntry, reversed []ProjectCharge, basis string, calendar WorkCalendar, decimals int) ([]ProjectCharge, error) {
+	if batch.ReversesBatchID != nil && len(reversed) > 0 {
+		entryByEmployee := make(map[int64]int64, len(entries))
+		for _, entry := range entries {
+			entryByEmployee[entry.EmployeeID] = entry.ID
+		}
+		result := make([]ProjectCharge, 0, len(reversed))
+		for _, charge := range reversed {
+			entryID, ok := entryByEmployee[charge.EmployeeID]
+			if !ok {
+				continue
+			}
+			result = append(result, ProjectCharge{
+				BatchID:        batch.ID,
+				EntryID:        entryID,
+				EmployeeID:     charge.EmployeeID,
+				ProjectID:      charge.ProjectID,
+				GrossAmount:    -charge.GrossAmount,
+				EmployerAmount: -charge.EmployerAmount,
+			})
+		}
+		return result, nil
+	}
+
+	start, end, err := MonthBounds(batch.Month)
+	if err != nil {
+		return nil, err
+	}
+	employees, err := tx.ListActiveEmployeeSalaries(ctx, start, end)
+	if err != nil {
+		return nil, err
+	}
+	allocations, err := tx.ListProjectAllocations(ctx, start, end)
+	if err != nil {
+		return nil, err
+	}
+	employeesByID := make(map[int64]EmployeeSalary, len(employees))
+	for _, employee := range employees {
+		employeesByID[employee.EmployeeID] = employee
+	}
+	allocationsByEmployee := make(map[int64][]EmployeeProjectAllocation)
+	for _, allocation := range allocations {
+		allocationsByEmployee[allocation.EmployeeID] = append(allocationsByEmployee[allocation.EmployeeID], allocation)
+	}
+
+	result := make([]ProjectCharge, 0, len(entries))
+	for _, entry := range entries {
+		from, to := start, end
+		if employee, ok := employeesByID[entry.EmployeeID]; ok {
+			from, to = payableWindow(start, end, employee.DateOfHire, employee.DateOfExit)
+			if to.Before(from) {
+				from, to = start, end
+			}
+		}
+		for _, charge := range SplitEntryCost(entry, allocationsByEmployee[entry.EmployeeID], from, to, basis, calendar, decimals) {
+			charge.BatchID = batch.ID
+			result = append(result, charge)
+		}
+	}
+	return result, nil
+}
+
+// SplitEntryCost charges the gross pay and employer contributions of an entry to projects. Each allocation
+// takes its percentage of the share of the employee's days between from and to that it covers; the remainder
+// is returned with a nil project so the charges always add up to the entry cost.
+func SplitEntryCost(entry PayrollEntry, allocations []EmployeeProjectAllocation, from, to time.Time, basis string, calendar WorkCalendar, decimals int) []ProjectCharge {
+	windowDays := countDays(from, to, basis, calendar)
+	if windowDays == 0 {
+		basis = ProrationCalendarDays
+		windowDays = countDays(from, to, basis, calendar)
+	}
+
+	result := make([]ProjectCharge, 0, len(allocations)+1)
+	indexByProject := make(map[int64]int, len(allocations))
+	var gross, employer money.Amount
+	for _, allocation := range allocations {
+		allocationFrom, allocationTo := from, to
+		if start := truncateDate(allocation.StartDate); start.After(allocationFrom) {
+			allocationFrom = start
+		}
+		if allocation.EndDate != nil {
+			if end := truncateDate(*allocation.EndDate); end.Before(allocationTo) {
+				allocationTo = end
+			}
+		}
+		days := int64(countDays(allocationFrom, allocationTo, basis, calendar))
+		if days == 0 || windowDays == 0 {
+			continue
+		}
+		grossShare := entry.GrossPay.Percent(allocation.Percent).MulFrac(days, int64(windowDays)).Round(decimals)
+		employerShare := entry.EmployerContributionsTotal.Percent(allocation.Percent).MulFrac(days, int64(windowDays)).Round(decimals)
+		gross += grossShare
+		employer += employerShare
+
+		index, ok := indexByProject[allocation.ProjectID]
+		if !ok {
+			projectID := allocation.ProjectID
+			result = append(result, ProjectCharge{EntryID: entry.ID, EmployeeID: entry.EmployeeID, ProjectID: &projectID})
+			index = len(result) - 1
+			indexByProject[allocation.ProjectID] = index
+		}
+		result[index].GrossAmount += grossShare
+		result[index].EmployerAmount += employerShare
+	}
+	if remainderGross, remainderEmployer := entry.GrossPay-gross, entry.EmployerContributionsTotal-employer; remainderGross != 0 || remainderEmployer != 0 {
+		result = append(result, ProjectCharge{
+			EntryID:        entry.ID,
+			EmployeeID:     entry.EmployeeID,
+			GrossAmount:    remainderGross,
+			EmployerAmount: remainderEmployer,
+		})
+	}
+	return result
+}
+
+func normalizeProjectInput(input ProjectUpsertInput) (ProjectUpsertInput, error) {
+	input.Code = strings.ToUpper(strings.TrimSpace(input.Code))
+	input.Name = strings.TrimSpace(input.Name)
+	input.Donor = strings.TrimSpace(input.Donor)
+
+	if !componentCodePattern.MatchString(input.Code) {
+		return ProjectUpsertInput{}, fmt.Errorf("%w: project code must be 2-40 letters, numbers, '_' or '-'", ErrValidation)
+	}
+	if input.Name == "" {
+		return ProjectUpsertInput{}, fmt.Errorf("%w: project name is required", ErrValidation)
+	}
+	if len(input.Name) > 150 {
+		return ProjectUpsertInput{}, fmt.Errorf("%w: project name is too long", ErrValidation)
+	}
+	if len(input.Donor) > 150 {
+		return ProjectUpsertInput{}, fmt.Errorf("%w: donor is too long", ErrValidation)
+	}
+	return input, nil
+}
+
+func normalizeProjectAllocationInputs(inputs []ProjectAllocationInput) ([]ProjectAllocationInsert, error) {
+	if len(inputs) > maxProjectAllocationsPerEmployee {
+		return nil, fmt.Errorf("%w: at most %d project allocations are allowed", ErrValidation, maxProjectAllocationsPerEmployee)
+	}
+	result := make([]ProjectAllocationInsert, 0, len(inputs))
+	for i, input := range inputs {
+		if input.ProjectID <= 0 {
+			return nil, fmt.Errorf("%w: allocation %d needs a project", ErrValidation, i+1)
+		}
+		if input.Percent <= 0 || input.Percent > 100 {
+			return nil, fmt.Errorf("%w: allocation %d percent must be above 0 and at most 100", ErrValidation, i+1)
+		}
+		startDate, err := time.Parse("2006-01-02", strings.TrimSpace(input.StartDate))
+		if err != nil {
+			return nil, fmt.Errorf("%w: allocation %d start date must use YYYY-MM-DD", ErrValidation, i+1)
+		}
+		allocation := ProjectAllocationInsert{
+			ProjectID: input.ProjectID,
+			Percent:   math.Round(input.Percent*100) / 100,
+			StartDate: startDate,
+		}
+		if endDate := strings.TrimSpace(input.EndDate); endDate != "" {
+			parsed, err := time.Parse("2006-01-02", endDate)
+			if err != nil {
+				return nil, fmt.Errorf("%w: allocation %d end date must use YYYY-MM-DD", ErrValidation, i+1)
+			}
+			if parsed.Before(startDate) {
+				return nil, fmt.Errorf("%w: allocation %d ends before it starts", ErrValidation, i+1)
+			}
+			allocation.EndDate = &parsed
+		}
+		result = append(result, allocation)
+	}
+	sort.SliceStable(result, func(i, j int) bool { return result[i].StartDate.Before(result[j].StartDate) })
+
+	// The total only rises when an allocation starts, so checking each start date covers every day.
+	for _, current := range result {
+		total := 0.0
+		for _, other := range result {
+			if !other.StartDate.After(current.StartDate) && (other.EndDate == nil || !other.EndDate.Before(current.StartDate)) {
+				total += other.Percent
+			}
+		}
+		if total > 100.0001 {
+			return nil, fmt.Errorf("%w: allocations total %.2f%% on %s; at most 100%% is allowed", ErrValidation, total, current.StartDate.Format("2006-01-02"))
+		}
+	}
+	return result, nil
+}
+
+func projectChargingCode(row ProjectChargingRow) string {
+	if row.ProjectID == nil {
+		return "UNALLOCATED"
+	}
+	return row.ProjectCode
+}
+
+func sameProject(left, right *int64) bool {
+	if left == nil || right == nil {
+		return left == nil && right == nil
+	}
+	return *left == *right
+}
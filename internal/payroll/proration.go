@@ -0,0 +1,173 @@
+package payroll
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"hrpro/internal/money"
+)
+
+// Proration describes how much of a payroll month an employee is paid for.
+type Proration struct {
+	Basis       string
+	PayableDays int
+	PeriodDays  int
+}
+
+// Full reports whether the employee was employed for the whole period.
+func (p Proration) Full() bool {
+	return p.PayableDays == p.PeriodDays
+}
+
+// NormalizeProrationBasis validates a proration basis, defaulting to working days.
+func NormalizeProrationBasis(basis string) (string, error) {
+	basis = strings.ToLower(strings.TrimSpace(basis))
+	switch basis {
+	case "":
+		return ProrationWorkingDays, nil
+	case ProrationWorkingDays, ProrationCalendarDays:
+		return basis, nil
+	default:
+		return "", fmt.Errorf("%w: proration basis must be working_days or calendar_days", ErrValidation)
+	}
+}
+
+// MonthBounds returns the first and last day of a YYYY-MM payroll month.
+func MonthBounds(month string) (time.Time, time.Time, error) {
+	start, err := time.Parse("2006-01", strings.TrimSpace(month))
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("%w: month must be in YYYY-MM format", ErrValidation)
+	}
+	return start, start.AddDate(0, 1, -1), nil
+}
+
+// WorkCalendar holds the public holidays that are not working days. The zero value has none.
+type WorkCalendar struct {
+	holidays map[time.Time]struct{}
+}
+
+// NewWorkCalendar builds a calendar from public holiday dates.
+func NewWorkCalendar(holidays []time.Time) WorkCalendar {
+	calendar := WorkCalendar{holidays: make(map[time.Time]struct{}, len(holidays))}
+	for _, day := range holidays {
+		calendar.holidays[truncateDate(day)] = struct{}{}
+	}
+	return calendar
+}
+
+// IsWorkingDay reports whether day is Monday to Friday and not a public holiday.
+func (c WorkCalendar) IsWorkingDay(day time.Time) bool {
+	if day.Weekday() == time.Saturday || day.Weekday() == time.Sunday {
+		return false
+	}
+	_, holiday := c.holidays[truncateDate(day)]
+	return !holiday
+}
+
+// CalculateProration counts the days of month between hire and exit (both inclusive) against the days
+// of the whole month, on the given basis. Working days are the working days of calendar.
+func CalculateProration(month string, basis string, calendar WorkCalendar, dateOfHire time.Time, dateOfExit *time.Time) (Proration, error) {
+	start, end, err := MonthBounds(month)
+	if err != nil {
+		return Proration{}, err
+	}
+	basis, err = NormalizeProrationBasis(basis)
+	if err != nil {
+		return Proration{}, err
+	}
+
+	from, to := payableWindow(start, end, dateOfHire, dateOfExit)
+	return Proration{
+		Basis:       basis,
+		PayableDays: countDays(from, to, basis, calendar),
+		PeriodDays:  countDays(start, end, basis, calendar),
+	}, nil
+}
+
+// ProrateSalary scales a monthly salary to the payable share of the period.
+func ProrateSalary(baseSalary money.Amount, proration Proration) money.Amount {
+	if proration.PeriodDays <= 0 || proration.Full() {
+		return baseSalary
+	}
+	return baseSalary.MulFrac(int64(proration.PayableDays), int64(proration.PeriodDays))
+}
+
+// payableWindow narrows the period to the days between hire and exit.
+func payableWindow(start, end time.Time, dateOfHire time.Time, dateOfExit *time.Time) (time.Time, time.Time) {
+	from := start
+	if hire := truncateDate(dateOfHire); hire.After(from) {
+		from = hire
+	}
+	to := end
+	if dateOfExit != nil {
+		if exit := truncateDate(*dateOfExit); exit.Before(to) {
+			to = exit
+		}
+	}
+	return from, to
+}
+
+func countDays(from, to time.Time, basis string, calendar WorkCalendar) int {
+	count := 0
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		if countsOnBasis(day, basis, calendar) {
+			count++
+		}
+	}
+	return count
+}
+
+func countsOnBasis(day time.Time, basis string, calendar WorkCalendar) bool {
+	return basis != ProrationWorkingDays || calendar.IsWorkingDay(day)
+}
+
+func truncateDate(value time.Time) time.Time {
+	return time.Date(value.Year(), value.Month(), value.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// RateOn returns the salary rate in effect on day. rates must be sorted by effective date; before the first
+// one, fallback applies.
+func RateOn(rates []SalaryRate, day time.Time, fallback money.Amount) money.Amount {
+	rate := fallback
+	for _, item := range rates {
+		if truncateDate(item.EffectiveFrom).After(day) {
+			break
+		}
+		rate = item.Amount
+	}
+	return rate
+}
+
+// PeriodBaseSalary pays each payable day of the month at the rate in effect that day, so a salary change
+// part-way through the month is split on the proration basis. It also returns the monthly rate in effect on
+// the last payable day. Employees without salary history are paid their current BaseSalary.
+func PeriodBaseSalary(month string, basis string, calendar WorkCalendar, employee EmployeeSalary, rates []SalaryRate) (money.Amount, money.Amount, Proration, error) {
+	proration, err := CalculateProration(month, basis, calendar, employee.DateOfHire, employee.DateOfExit)
+	if err != nil {
+		return 0, 0, Proration{}, err
+	}
+	start, end, err := MonthBounds(month)
+	if err != nil {
+		return 0, 0, Proration{}, err
+	}
+	from, to := payableWindow(start, end, employee.DateOfHire, employee.DateOfExit)
+	if proration.PayableDays == 0 || proration.PeriodDays <= 0 {
+		return 0, RateOn(rates, to, employee.BaseSalary), proration, nil
+	}
+
+	var base money.Amount
+	rate := RateOn(rates, from, employee.BaseSalary)
+	days := 0
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		if dayRate := RateOn(rates, day, employee.BaseSalary); dayRate != rate {
+			base += rate.MulFrac(int64(days), int64(proration.PeriodDays))
+			rate, days = dayRate, 0
+		}
+		if countsOnBasis(day, proration.Basis, calendar) {
+			days++
+		}
+	}
+	base += rate.MulFrac(int64(days), int64(proration.PeriodDays))
+	return base, rate, proration, nil
+}
@@ -0,0 +1,110 @@
+package payroll
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"hrpro/internal/money"
+)
+
+func TestCalculateProration(t *testing.T) {
+	exit := time.Date(2025, 7, 11, 0, 0, 0, 0, time.UTC)
+	beforeMonth := time.Date(2020, 1, 6, 0, 0, 0, 0, time.UTC)
+	midMonth := time.Date(2025, 7, 14, 0, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name     string
+		basis    string
+		hire     time.Time
+		exit     *time.Time
+		expected Proration
+	}{
+		{name: "full month", basis: ProrationWorkingDays, hire: beforeMonth, expected: Proration{Basis: ProrationWorkingDays, PayableDays: 23, PeriodDays: 23}},
+		{name: "hired mid-month working days", basis: ProrationWorkingDays, hire: midMonth, expected: Proration{Basis: ProrationWorkingDays, PayableDays: 14, PeriodDays: 23}},
+		{name: "hired mid-month calendar days", basis: ProrationCalendarDays, hire: midMonth, expected: Proration{Basis: ProrationCalendarDays, PayableDays: 18, PeriodDays: 31}},
+		{name: "exited mid-month", basis: "", hire: beforeMonth, exit: &exit, expected: Proration{Basis: ProrationWorkingDays, PayableDays: 9, PeriodDays: 23}},
+	}
+
+	for _, tc := range cases {
+		proration, err := CalculateProration("2025-07", tc.basis, WorkCalendar{}, tc.hire, tc.exit)
+		if err != nil {
+			t.Fatalf("%s: expected no error, got %v", tc.name, err)
+		}
+		if proration != tc.expected {
+			t.Fatalf("%s: expected %#v, got %#v", tc.name, tc.expected, proration)
+		}
+	}
+
+	if _, err := CalculateProration("2025-07", "hours", WorkCalendar{}, beforeMonth, nil); !errors.Is(err, ErrValidation) {
+		t.Fatalf("expected ErrValidation for unknown basis, got %v", err)
+	}
+}
+
+func TestCalculateProrationSkipsPublicHolidays(t *testing.T) {
+	// Independence Day, Thursday 9 October, is not a working day.
+	calendar := NewWorkCalendar([]time.Time{time.Date(2025, 10, 9, 0, 0, 0, 0, time.UTC)})
+	hire := time.Date(2025, 10, 13, 0, 0, 0, 0, time.UTC)
+
+	proration, err := CalculateProration("2025-10", ProrationWorkingDays, calendar, hire, nil)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if proration.PayableDays != 15 || proration.PeriodDays != 22 {
+		t.Fatalf("expected 15 of 22 working days, got %#v", proration)
+	}
+
+	proration, err = CalculateProration("2025-10", ProrationCalendarDays, calendar, hire, nil)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if proration.PayableDays != 19 || proration.PeriodDays != 31 {
+		t.Fatalf("expected holidays to count on calendar days, got %#v", proration)
+	}
+}
+
+func TestProrateSalary(t *testing.T) {
+	if amount := ProrateSalary(money.FromUnits(2300000), Proration{PayableDays: 14, PeriodDays: 23}); amount != money.FromUnits(1400000) {
+		t.Fatalf("expected 1400000, got %s", amount)
+	}
+	if amount := ProrateSalary(money.FromUnits(1000000), Proration{PayableDays: 10, PeriodDays: 31}); amount != money.FromMinor(32258065) {
+		t.Fatalf("expected 322580.65, got %s", amount)
+	}
+	if amount := ProrateSalary(money.FromUnits(1000000), Proration{PayableDays: 23, PeriodDays: 23}); amount != money.FromUnits(1000000) {
+		t.Fatalf("expected full salary, got %s", amount)
+	}
+}
+
+func TestPeriodBaseSalaryUsesRatesInEffect(t *testing.T) {
+	employee := EmployeeSalary{EmployeeID: 1, BaseSalary: money.FromUnits(1000000), DateOfHire: time.Date(2020, 1, 6, 0, 0, 0, 0, time.UTC)}
+	rates := []SalaryRate{
+		{EmployeeID: 1, Amount: money.FromUnits(2300000), EffectiveFrom: time.Date(2020, 1, 6, 0, 0, 0, 0, time.UTC)},
+		{EmployeeID: 1, Amount: money.FromUnits(2760000), EffectiveFrom: time.Date(2025, 7, 16, 0, 0, 0, 0, time.UTC)},
+		{EmployeeID: 1, Amount: money.FromUnits(5000000), EffectiveFrom: time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	// 11 of 23 working days at 2,300,000 and 12 at 2,760,000.
+	base, rate, proration, err := PeriodBaseSalary("2025-07", ProrationWorkingDays, WorkCalendar{}, employee, rates)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if base != money.FromUnits(2540000) || rate != money.FromUnits(2760000) || !proration.Full() {
+		t.Fatalf("expected split base 2540000 at rate 2760000, got %s at %s (%#v)", base, rate, proration)
+	}
+
+	base, rate, _, err = PeriodBaseSalary("2025-06", ProrationWorkingDays, WorkCalendar{}, employee, rates)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if base != money.FromUnits(2300000) || rate != money.FromUnits(2300000) {
+		t.Fatalf("expected the June rate only, got %s at %s", base, rate)
+	}
+
+	base, _, _, err = PeriodBaseSalary("2025-07", ProrationWorkingDays, WorkCalendar{}, employee, nil)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if base != money.FromUnits(1000000) {
+		t.Fatalf("expected the current salary without history, got %s", base)
+	}
+}
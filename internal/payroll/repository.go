@@ -6,38 +6,170 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jmoiron/sqlx"
+
+	"hrpro/internal/money"
 )
 
 type EntryCreateInput struct {
 	BatchID         int64
 	EmployeeID      int64
-	BaseSalary      float64
-	AllowancesTotal float64
-	DeductionsTotal float64
-	TaxTotal        float64
-	GrossPay        float64
-	NetPay          float64
+	BaseSalary      money.Amount
+	AllowancesTotal money.Amount
+	DeductionsTotal money.Amount
+	TaxTotal        money.Amount
+	GrossPay        money.Amount
+	NetPay          money.Amount
+
+	EmployerContributionsTotal money.Amount
+
+	FullBaseSalary *money.Amount
+	ProrationBasis *string
+	PayableDays    *int
+	PeriodDays     *int
+}
+
+type EntryTotalsInput struct {
+	AllowancesTotal            money.Amount
+	DeductionsTotal            money.Amount
+	TaxTotal                   money.Amount
+	EmployerContributionsTotal money.Amount
+	GrossPay                   money.Amount
+	NetPay                     money.Amount
+}
+
+type EntryLineCreateInput struct {
+	EntryID        int64
+	ComponentID    *int64
+	SchemeID       *int64
+	Code           string
+	Name           string
+	Kind           string
+	Taxable        bool
+	Amount         money.Amount
+	EmployerAmount money.Amount
+	Quantity       *float64
+	Rate           *money.Amount
+	LoanID         *int64
+	ArrearsMonth   *string
+	Sources        []PayrollEntryLineSource
+}
+
+// LoanInsertInput is a validated loan with its repayment terms worked out.
+type LoanInsertInput struct {
+	LoanCreateInput
+	TotalRepayable    money.Amount
+	InstallmentAmount money.Amount
+	CreatedBy         *int64
 }
 
 type Repository interface {
-	CreateBatch(ctx context.Context, month string, createdBy int64) (*PayrollBatch, error)
+	CreateBatch(ctx context.Context, input CreateBatchInput, createdBy int64) (*PayrollBatch, error)
 	ListBatches(ctx context.Context, filter ListBatchesFilter) ([]PayrollBatch, int64, int, int, error)
 	GetBatchByID(ctx context.Context, batchID int64) (*PayrollBatch, error)
+	GetRegularBatchByMonth(ctx context.Context, month string) (*PayrollBatch, error)
+	GetBatchSelection(ctx context.Context, batchID int64) (*BatchSelection, error)
+	GetReversalBatch(ctx context.Context, batchID int64) (*PayrollBatch, error)
+	ListSalaryRates(ctx context.Context, employeeID int64) ([]SalaryRate, error)
+	ListLockedBasePay(ctx context.Context, beforeMonth string, employeeID int64) ([]LockedBasePay, error)
+	ListMonthToDateEntries(ctx context.Context, month string, excludeBatchID int64) ([]PayrollEntry, error)
 	GetBatchByEntryID(ctx context.Context, entryID int64) (*PayrollBatch, error)
 	ListEntriesByBatchID(ctx context.Context, batchID int64) ([]PayrollEntry, error)
-	UpdateEntryAmounts(ctx context.Context, entryID int64, allowancesTotal, deductionsTotal, taxTotal, grossPay, netPay float64) (*PayrollEntry, error)
-	SetBatchApproved(ctx context.Context, batchID int64, approvedBy int64) (*PayrollBatch, error)
-	SetBatchLocked(ctx context.Context, batchID int64) (*PayrollBatch, error)
+	GetEntryByID(ctx context.Context, entryID int64) (*PayrollEntry, error)
+	ListEntryLinesByBatchID(ctx context.Context, batchID int64) ([]PayrollEntryLine, error)
+	ListEntryLinesByEntryID(ctx context.Context, entryID int64) ([]PayrollEntryLine, error)
+	GetEntryLineByID(ctx context.Context, lineID int64) (*PayrollEntryLine, error)
+	ListEntryLineSourcesByBatchID(ctx context.Context, batchID int64) ([]PayrollEntryLineSource, error)
+	ListEntryLineSourcesByEntryID(ctx context.Context, entryID int64) ([]PayrollEntryLineSource, error)
+	UpdateEntryAmounts(ctx context.Context, entryID int64, allowancesTotal, deductionsTotal, taxTotal, grossPay, netPay money.Amount) (*PayrollEntry, error)
+	ListBatchApprovals(ctx context.Context, batchID int64) ([]PayrollBatchApproval, error)
+	SetBatchVarianceReviewed(ctx context.Context, batchID int64, reviewedBy int64) (*PayrollBatch, error)
 	WithTx(ctx context.Context, fn func(tx TxRepository) error) error
+
+	ListPayComponents(ctx context.Context, activeOnly bool) ([]PayComponent, error)
+	GetPayComponentByID(ctx context.Context, id int64) (*PayComponent, error)
+	CreatePayComponent(ctx context.Context, input PayComponentUpsertInput) (*PayComponent, error)
+	UpdatePayComponent(ctx context.Context, id int64, input PayComponentUpsertInput) (*PayComponent, error)
+	SetPayComponentActive(ctx context.Context, id int64, active bool) (*PayComponent, error)
+
+	ListContributionSchemes(ctx context.Context, activeOnly bool) ([]ContributionScheme, error)
+	GetContributionSchemeByID(ctx context.Context, id int64) (*ContributionScheme, error)
+	CreateContributionScheme(ctx context.Context, input ContributionSchemeUpsertInput) (*ContributionScheme, error)
+	UpdateContributionScheme(ctx context.Context, id int64, input ContributionSchemeUpsertInput) (*ContributionScheme, error)
+	SetContributionSchemeActive(ctx context.Context, id int64, active bool) (*ContributionScheme, error)
+	ListContributionSchemeMembers(ctx context.Context, schemeID int64) ([]ContributionSchemeMember, error)
+	UpsertContributionSchemeMember(ctx context.Context, schemeID int64, employeeID int64, memberNumber string) (*ContributionSchemeMember, error)
+	DeleteContributionSchemeMember(ctx context.Context, schemeID int64, employeeID int64) (bool, error)
+	ListContributionSchedule(ctx context.Context, batchID int64, schemeID int64) ([]ContributionScheduleRow, error)
+
+	ListGLAccountMappings(ctx context.Context) ([]GLAccountMapping, error)
+	CreateGLAccountMapping(ctx context.Context, input GLAccountMappingInput) (*GLAccountMapping, error)
+	UpdateGLAccountMapping(ctx context.Context, id int64, input GLAccountMappingInput) (*GLAccountMapping, error)
+	DeleteGLAccountMapping(ctx context.Context, id int64) (bool, error)
+	ListBatchEmployeeDepartments(ctx context.Context, batchID int64) ([]EmployeeDepartment, error)
+
+	ListProjects(ctx context.Context, activeOnly bool) ([]Project, error)
+	GetProjectByID(ctx context.Context, id int64) (*Project, error)
+	CreateProject(ctx context.Context, input ProjectUpsertInput) (*Project, error)
+	UpdateProject(ctx context.Context, id int64, input ProjectUpsertInput) (*Project, error)
+	SetProjectActive(ctx context.Context, id int64, active bool) (*Project, error)
+	ListEmployeeProjectAllocations(ctx context.Context, employeeID int64) ([]EmployeeProjectAllocation, error)
+	ListProjectCharges(ctx context.Context, batchID int64) ([]ProjectCharge, error)
+	ListProjectChargingRows(ctx context.Context, month string) ([]ProjectChargingRow, error)
+
+	ListLockedEntriesForPeriod(ctx context.Context, fromMonth, toMonth string) ([]AnnualTaxEntry, error)
+	ListBatchIntegritySeals(ctx context.Context, batchIDs []int64) ([]BatchIntegritySeal, error)
+	ListEntriesByBatchIDs(ctx context.Context, batchIDs []int64) ([]PayrollEntry, error)
+	ListEntryLinesByBatchIDs(ctx context.Context, batchIDs []int64) ([]PayrollEntryLine, error)
+	ListLockedEntryLinesForPeriod(ctx context.Context, fromMonth, toMonth string) ([]PayrollEntryLine, error)
+
+	ListEmployeePaymentMethods(ctx context.Context, employeeID int64) ([]EmployeePaymentMethod, error)
+	ListPaymentMethodsByBatchID(ctx context.Context, batchID int64) ([]EmployeePaymentMethod, error)
+
+	ListLoans(ctx context.Context, filter ListLoansFilter) ([]EmployeeLoan, error)
+	GetLoanByID(ctx context.Context, id int64) (*EmployeeLoan, error)
+	CreateLoan(ctx context.Context, input LoanInsertInput) (*EmployeeLoan, error)
+	SetLoanStatus(ctx context.Context, id int64, status string) (*EmployeeLoan, error)
+	ListLoanRepayments(ctx context.Context, loanID int64) ([]LoanRepayment, error)
 }
 
 type TxRepository interface {
 	DeleteEntriesByBatchID(ctx context.Context, batchID int64) error
-	ListActiveEmployeeSalaries(ctx context.Context) ([]EmployeeSalary, error)
-	CreateEntry(ctx context.Context, input EntryCreateInput) error
+	ListActiveEmployeeSalaries(ctx context.Context, periodStart, periodEnd time.Time) ([]EmployeeSalary, error)
+	ListEmployeeSalariesByIDs(ctx context.Context, employeeIDs []int64, periodEnd time.Time) ([]EmployeeSalary, error)
+	ListSalaryRates(ctx context.Context, employeeID int64) ([]SalaryRate, error)
+	ListAutoApplyComponents(ctx context.Context) ([]PayComponent, error)
+	ListContributionSchemes(ctx context.Context) ([]ContributionScheme, error)
+	ListContributionSchemeMembers(ctx context.Context) ([]ContributionSchemeMember, error)
+	ListAbsenceRecords(ctx context.Context, periodStart, periodEnd time.Time) ([]AbsenceRecord, error)
+	ListRecoverableLoans(ctx context.Context, month string) ([]EmployeeLoan, error)
+	CreateEntry(ctx context.Context, input EntryCreateInput) (int64, error)
+	GetEntryByID(ctx context.Context, entryID int64) (*PayrollEntry, error)
+	ListEntryLines(ctx context.Context, entryID int64) ([]PayrollEntryLine, error)
+	ListEntriesByBatchID(ctx context.Context, batchID int64) ([]PayrollEntry, error)
+	ListEntryLinesByBatchID(ctx context.Context, batchID int64) ([]PayrollEntryLine, error)
+	ListEntryLineSourcesByBatchID(ctx context.Context, batchID int64) ([]PayrollEntryLineSource, error)
+	CreateEntryLine(ctx context.Context, input EntryLineCreateInput) error
+	DeleteEntryLine(ctx context.Context, lineID int64) error
+	UpdateEntryLineAmounts(ctx context.Context, lineID int64, amount, employerAmount money.Amount) error
+	UpdateEntryTotals(ctx context.Context, entryID int64, input EntryTotalsInput) error
+	DeleteEmployeePaymentMethods(ctx context.Context, employeeID int64) error
+	CreateEmployeePaymentMethod(ctx context.Context, employeeID int64, input EmployeePaymentMethodInput) error
+	DeleteEmployeeProjectAllocations(ctx context.Context, employeeID int64) error
+	CreateEmployeeProjectAllocation(ctx context.Context, employeeID int64, input ProjectAllocationInsert) error
+	ListProjectAllocations(ctx context.Context, periodStart, periodEnd time.Time) ([]EmployeeProjectAllocation, error)
+	CreateProjectCharge(ctx context.Context, charge ProjectCharge) error
+	CreateReversalBatch(ctx context.Context, source PayrollBatch, description string, createdBy int64) (*PayrollBatch, error)
+	CreateBatchApproval(ctx context.Context, input BatchApprovalInput) error
+	SetBatchApproved(ctx context.Context, batchID int64, approvedBy int64) (*PayrollBatch, error)
+	SetBatchDraft(ctx context.Context, batchID int64) (*PayrollBatch, error)
+	SetBatchLocked(ctx context.Context, batchID int64) (*PayrollBatch, error)
+	SetBatchIntegritySeal(ctx context.Context, batchID int64, hash, manifest string) error
+	ListLoanRecoveries(ctx context.Context, batchID int64) ([]LoanRecovery, error)
+	ApplyLoanRepayment(ctx context.Context, batch PayrollBatch, recovery LoanRecovery) (*LoanRepayment, error)
 }
 
 type SQLXRepository struct {
@@ -48,21 +180,48 @@ func NewRepository(db *sqlx.DB) *SQLXRepository {
 	return &SQLXRepository{db: db}
 }
 
-func (r *SQLXRepository) CreateBatch(ctx context.Context, month string, createdBy int64) (*PayrollBatch, error) {
+// CreateBatch inserts the batch and, for off-cycle batches, its employee and component selection.
+func (r *SQLXRepository) CreateBatch(ctx context.Context, input CreateBatchInput, createdBy int64) (*PayrollBatch, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin create payroll batch: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
 	query := `
-		INSERT INTO payroll_batches (month, status, created_by)
-		VALUES ($1, $2, $3)
-		RETURNING id, month, status, created_by, created_at, approved_by, approved_at, locked_at
+		INSERT INTO payroll_batches (month, batch_type, description, status, created_by)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, month, batch_type, description, status, created_by, created_at, approved_by, approved_at, locked_at, variance_reviewed_by, variance_reviewed_at, reverses_batch_id, integrity_hash
 	`
 
 	var batch PayrollBatch
-	if err := r.db.GetContext(ctx, &batch, query, month, StatusDraft, createdBy); err != nil {
+	if err := tx.GetContext(ctx, &batch, query, input.Month, input.BatchType, input.Description, StatusDraft, createdBy); err != nil {
 		if isUniqueViolation(err) {
 			return nil, ErrDuplicateMonth
 		}
 		return nil, fmt.Errorf("create payroll batch: %w", err)
 	}
 
+	for _, employeeID := range input.EmployeeIDs {
+		if _, err := tx.ExecContext(ctx, `INSERT INTO payroll_batch_employees (batch_id, employee_id) VALUES ($1, $2)`, batch.ID, employeeID); err != nil {
+			if isForeignKeyViolation(err) {
+				return nil, fmt.Errorf("%w: employee %d does not exist", ErrValidation, employeeID)
+			}
+			return nil, fmt.Errorf("create payroll batch employee: %w", err)
+		}
+	}
+	for _, componentID := range input.ComponentIDs {
+		if _, err := tx.ExecContext(ctx, `INSERT INTO payroll_batch_components (batch_id, component_id) VALUES ($1, $2)`, batch.ID, componentID); err != nil {
+			if isForeignKeyViolation(err) {
+				return nil, fmt.Errorf("%w: pay component %d does not exist", ErrValidation, componentID)
+			}
+			return nil, fmt.Errorf("create payroll batch component: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit create payroll batch: %w", err)
+	}
 	return &batch, nil
 }
 
@@ -92,6 +251,11 @@ func (r *SQLXRepository) ListBatches(ctx context.Context, filter ListBatchesFilt
 		where = append(where, "month = "+ph)
 	}
 
+	if batchType := strings.TrimSpace(filter.BatchType); batchType != "" {
+		ph := addArg(batchType)
+		where = append(where, "batch_type = "+ph)
+	}
+
 	if status := strings.TrimSpace(filter.Status); status != "" {
 		ph := addArg(status)
 		where = append(where, "status = "+ph)
@@ -113,9 +277,9 @@ func (r *SQLXRepository) ListBatches(ctx context.Context, filter ListBatchesFilt
 	offsetPH := addArg(offset)
 
 	listQuery := `
-		SELECT id, month, status, created_by, created_at, approved_by, approved_at, locked_at
+		SELECT id, month, batch_type, description, status, created_by, created_at, approved_by, approved_at, locked_at, variance_reviewed_by, variance_reviewed_at, reverses_batch_id, integrity_hash
 		FROM payroll_batches` + whereClause + `
-		ORDER BY month DESC
+		ORDER BY month DESC, id DESC
 		LIMIT ` + limitPH + ` OFFSET ` + offsetPH
 
 	items := make([]PayrollBatch, 0)
@@ -128,7 +292,7 @@ func (r *SQLXRepository) ListBatches(ctx context.Context, filter ListBatchesFilt
 
 func (r *SQLXRepository) GetBatchByID(ctx context.Context, batchID int64) (*PayrollBatch, error) {
 	query := `
-		SELECT id, month, status, created_by, created_at, approved_by, approved_at, locked_at
+		SELECT id, month, batch_type, description, status, created_by, created_at, approved_by, approved_at, locked_at, variance_reviewed_by, variance_reviewed_at, reverses_batch_id, integrity_hash
 		FROM payroll_batches
 		WHERE id = $1
 	`
@@ -144,55 +308,171 @@ func (r *SQLXRepository) GetBatchByID(ctx context.Context, batchID int64) (*Payr
 	return &batch, nil
 }
 
-func (r *SQLXRepository) GetBatchByEntryID(ctx context.Context, entryID int64) (*PayrollBatch, error) {
+func (r *SQLXRepository) GetRegularBatchByMonth(ctx context.Context, month string) (*PayrollBatch, error) {
 	query := `
-		SELECT pb.id, pb.month, pb.status, pb.created_by, pb.created_at, pb.approved_by, pb.approved_at, pb.locked_at
-		FROM payroll_batches pb
-		INNER JOIN payroll_entries pe ON pe.batch_id = pb.id
-		WHERE pe.id = $1
+		SELECT id, month, batch_type, description, status, created_by, created_at, approved_by, approved_at, locked_at, variance_reviewed_by, variance_reviewed_at, reverses_batch_id, integrity_hash
+		FROM payroll_batches
+		WHERE month = $1 AND batch_type = $2
 	`
 
 	var batch PayrollBatch
-	if err := r.db.GetContext(ctx, &batch, query, entryID); err != nil {
+	if err := r.db.GetContext(ctx, &batch, query, month, BatchTypeRegular); err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
 		}
-		return nil, fmt.Errorf("get payroll batch by entry id: %w", err)
+		return nil, fmt.Errorf("get payroll batch by month: %w", err)
 	}
 
 	return &batch, nil
 }
 
-func (r *SQLXRepository) ListEntriesByBatchID(ctx context.Context, batchID int64) ([]PayrollEntry, error) {
+// GetReversalBatch returns the batch that reverses batchID, or nil when it has not been reversed.
+func (r *SQLXRepository) GetReversalBatch(ctx context.Context, batchID int64) (*PayrollBatch, error) {
+	query := `
+		SELECT id, month, batch_type, description, status, created_by, created_at, approved_by, approved_at, locked_at, variance_reviewed_by, variance_reviewed_at, reverses_batch_id, integrity_hash
+		FROM payroll_batches
+		WHERE reverses_batch_id = $1
+	`
+
+	var batch PayrollBatch
+	if err := r.db.GetContext(ctx, &batch, query, batchID); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get payroll reversal batch: %w", err)
+	}
+
+	return &batch, nil
+}
+
+func (r *SQLXRepository) GetBatchSelection(ctx context.Context, batchID int64) (*BatchSelection, error) {
+	selection := &BatchSelection{EmployeeIDs: make([]int64, 0), ComponentIDs: make([]int64, 0)}
+	if err := r.db.SelectContext(ctx, &selection.EmployeeIDs, `SELECT employee_id FROM payroll_batch_employees WHERE batch_id = $1 ORDER BY employee_id`, batchID); err != nil {
+		return nil, fmt.Errorf("list payroll batch employees: %w", err)
+	}
+	if err := r.db.SelectContext(ctx, &selection.ComponentIDs, `SELECT component_id FROM payroll_batch_components WHERE batch_id = $1 ORDER BY component_id`, batchID); err != nil {
+		return nil, fmt.Errorf("list payroll batch components: %w", err)
+	}
+	return selection, nil
+}
+
+func (r *SQLXRepository) ListSalaryRates(ctx context.Context, employeeID int64) ([]SalaryRate, error) {
+	return listSalaryRates(ctx, r.db, employeeID)
+}
+
+// ListLockedBasePay returns the base salary paid by locked regular batches of months before beforeMonth, for
+// one employee or, with employeeID 0, everyone.
+func (r *SQLXRepository) ListLockedBasePay(ctx context.Context, beforeMonth string, employeeID int64) ([]LockedBasePay, error) {
+	args := []any{beforeMonth, StatusLocked, BatchTypeRegular, StatusApproved, LineCodeArrears}
+	employeeFilter := ""
+	if employeeID > 0 {
+		args = append(args, employeeID)
+		employeeFilter = fmt.Sprintf(" AND pe.employee_id = $%d", len(args))
+	}
 	query := `
 		SELECT
-			pe.id,
-			pe.batch_id,
 			pe.employee_id,
 			TRIM(CONCAT(e.first_name, ' ', e.last_name)) AS employee_name,
-			CAST(pe.base_salary AS DOUBLE PRECISION) AS base_salary,
-			CAST(pe.allowances_total AS DOUBLE PRECISION) AS allowances_total,
-			CAST(pe.deductions_total AS DOUBLE PRECISION) AS deductions_total,
-			CAST(pe.tax_total AS DOUBLE PRECISION) AS tax_total,
-			CAST(pe.gross_pay AS DOUBLE PRECISION) AS gross_pay,
-			CAST(pe.net_pay AS DOUBLE PRECISION) AS net_pay,
-			pe.created_at,
-			pe.updated_at
+			pb.id AS batch_id,
+			pb.month,
+			pb.locked_at,
+			pe.base_salary,
+			pe.full_base_salary,
+			COALESCE((
+				SELECT SUM(apel.amount)
+				FROM payroll_entry_lines apel
+				INNER JOIN payroll_entries ape ON ape.id = apel.entry_id
+				INNER JOIN payroll_batches apb ON apb.id = ape.batch_id
+				WHERE ape.employee_id = pe.employee_id
+				  AND apel.code = $5
+				  AND apel.arrears_month = pb.month
+				  AND apb.status IN ($4, $2)
+			), 0) AS arrears_paid,
+			e.date_of_hire,
+			e.date_of_exit
 		FROM payroll_entries pe
+		INNER JOIN payroll_batches pb ON pb.id = pe.batch_id
 		INNER JOIN employees e ON e.id = pe.employee_id
-		WHERE pe.batch_id = $1
-		ORDER BY e.last_name ASC, e.first_name ASC
+		WHERE pb.month < $1 AND pb.status = $2 AND pb.batch_type = $3` + employeeFilter + `
+		ORDER BY e.last_name ASC, e.first_name ASC, pe.employee_id ASC, pb.month ASC
 	`
+	items := make([]LockedBasePay, 0)
+	if err := r.db.SelectContext(ctx, &items, query, args...); err != nil {
+		return nil, fmt.Errorf("list locked base pay: %w", err)
+	}
+	return items, nil
+}
 
-	items := make([]PayrollEntry, 0)
-	if err := r.db.SelectContext(ctx, &items, query, batchID); err != nil {
-		return nil, fmt.Errorf("list payroll entries: %w", err)
+// ListMonthToDateEntries returns the entries, with their lines, of the approved and locked batches of month
+// other than excludeBatchID.
+func (r *SQLXRepository) ListMonthToDateEntries(ctx context.Context, month string, excludeBatchID int64) ([]PayrollEntry, error) {
+	entryQuery := `
+		SELECT
+			pe.id,
+			pe.batch_id,
+			pe.employee_id,
+			pe.base_salary,
+			pe.allowances_total,
+			pe.tax_total
+		FROM payroll_entries pe
+		INNER JOIN payroll_batches pb ON pb.id = pe.batch_id
+		WHERE pb.month = $1 AND pb.id <> $2 AND pb.status IN ($3, $4)
+		ORDER BY pe.id ASC
+	`
+	entries := make([]PayrollEntry, 0)
+	if err := r.db.SelectContext(ctx, &entries, entryQuery, month, excludeBatchID, StatusApproved, StatusLocked); err != nil {
+		return nil, fmt.Errorf("list month-to-date payroll entries: %w", err)
+	}
+	if len(entries) == 0 {
+		return entries, nil
 	}
 
-	return items, nil
+	lineQuery := `
+		SELECT pel.entry_id, pel.code, pel.kind, pel.taxable, pel.amount
+		FROM payroll_entry_lines pel
+		INNER JOIN payroll_entries pe ON pe.id = pel.entry_id
+		INNER JOIN payroll_batches pb ON pb.id = pe.batch_id
+		WHERE pb.month = $1 AND pb.id <> $2 AND pb.status IN ($3, $4)
+		ORDER BY pel.id ASC
+	`
+	lines := make([]PayrollEntryLine, 0)
+	if err := r.db.SelectContext(ctx, &lines, lineQuery, month, excludeBatchID, StatusApproved, StatusLocked); err != nil {
+		return nil, fmt.Errorf("list month-to-date payroll entry lines: %w", err)
+	}
+	linesByEntry := make(map[int64][]PayrollEntryLine, len(entries))
+	for _, line := range lines {
+		linesByEntry[line.EntryID] = append(linesByEntry[line.EntryID], line)
+	}
+	for i := range entries {
+		entries[i].Lines = linesByEntry[entries[i].ID]
+	}
+	return entries, nil
+}
+
+func (r *SQLXRepository) GetBatchByEntryID(ctx context.Context, entryID int64) (*PayrollBatch, error) {
+	query := `
+		SELECT pb.id, pb.month, pb.batch_type, pb.description, pb.status, pb.created_by, pb.created_at, pb.approved_by, pb.approved_at, pb.locked_at, pb.variance_reviewed_by, pb.variance_reviewed_at, pb.reverses_batch_id, pb.integrity_hash
+		FROM payroll_batches pb
+		INNER JOIN payroll_entries pe ON pe.batch_id = pb.id
+		WHERE pe.id = $1
+	`
+
+	var batch PayrollBatch
+	if err := r.db.GetContext(ctx, &batch, query, entryID); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get payroll batch by entry id: %w", err)
+	}
+
+	return &batch, nil
+}
+
+func (r *SQLXRepository) ListEntriesByBatchID(ctx context.Context, batchID int64) ([]PayrollEntry, error) {
+	return listEntriesByBatchID(ctx, r.db, batchID)
 }
 
-func (r *SQLXRepository) UpdateEntryAmounts(ctx context.Context, entryID int64, allowancesTotal, deductionsTotal, taxTotal, grossPay, netPay float64) (*PayrollEntry, error) {
+func (r *SQLXRepository) UpdateEntryAmounts(ctx context.Context, entryID int64, allowancesTotal, deductionsTotal, taxTotal, grossPay, netPay money.Amount) (*PayrollEntry, error) {
 	query := `
 		UPDATE payroll_entries
 		SET
@@ -214,23 +494,138 @@ func (r *SQLXRepository) UpdateEntryAmounts(ctx context.Context, entryID int64,
 		return nil, fmt.Errorf("update payroll entry amounts: %w", err)
 	}
 
-	entry, err := r.getEntryByID(ctx, id)
+	entry, err := r.GetEntryByID(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 	return entry, nil
 }
 
-func (r *SQLXRepository) SetBatchApproved(ctx context.Context, batchID int64, approvedBy int64) (*PayrollBatch, error) {
+func (r *SQLXRepository) SetBatchVarianceReviewed(ctx context.Context, batchID int64, reviewedBy int64) (*PayrollBatch, error) {
+	query := `
+		UPDATE payroll_batches
+		SET variance_reviewed_by = $2, variance_reviewed_at = NOW()
+		WHERE id = $1
+		RETURNING id, month, batch_type, description, status, created_by, created_at, approved_by, approved_at, locked_at, variance_reviewed_by, variance_reviewed_at, reverses_batch_id, integrity_hash
+	`
+
+	var batch PayrollBatch
+	if err := r.db.GetContext(ctx, &batch, query, batchID, reviewedBy); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("set payroll batch variance reviewed: %w", err)
+	}
+
+	return &batch, nil
+}
+
+func (r *SQLXRepository) ListBatchApprovals(ctx context.Context, batchID int64) ([]PayrollBatchApproval, error) {
+	query := `
+		SELECT pba.id, pba.batch_id, pba.step_number, pba.step_name, pba.action, pba.user_id, u.username AS user_name,
+			pba.comment, pba.created_at
+		FROM payroll_batch_approvals pba
+		LEFT JOIN users u ON u.id = pba.user_id
+		WHERE pba.batch_id = $1
+		ORDER BY pba.created_at ASC, pba.id ASC
+	`
+
+	items := make([]PayrollBatchApproval, 0)
+	if err := r.db.SelectContext(ctx, &items, query, batchID); err != nil {
+		return nil, fmt.Errorf("list payroll batch approvals: %w", err)
+	}
+	return items, nil
+}
+
+func (r *sqlxTxRepository) DeleteEmployeeProjectAllocations(ctx context.Context, employeeID int64) error {
+	query := `DELETE FROM employee_project_allocations WHERE employee_id = $1`
+	if _, err := r.tx.ExecContext(ctx, query, employeeID); err != nil {
+		return fmt.Errorf("delete employee project allocations: %w", err)
+	}
+	return nil
+}
+
+func (r *sqlxTxRepository) CreateEmployeeProjectAllocation(ctx context.Context, employeeID int64, input ProjectAllocationInsert) error {
+	query := `
+		INSERT INTO employee_project_allocations (employee_id, project_id, percent, start_date, end_date)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+	if _, err := r.tx.ExecContext(ctx, query, employeeID, input.ProjectID, input.Percent, input.StartDate, input.EndDate); err != nil {
+		if isForeignKeyViolation(err) {
+			return ErrNotFound
+		}
+		return fmt.Errorf("create employee project allocation: %w", err)
+	}
+	return nil
+}
+
+// ListProjectAllocations returns the allocations that cover at least one day of the period.
+func (r *sqlxTxRepository) ListProjectAllocations(ctx context.Context, periodStart, periodEnd time.Time) ([]EmployeeProjectAllocation, error) {
+	query := `
+		SELECT ` + projectAllocationColumns + `
+		FROM employee_project_allocations a
+		INNER JOIN projects p ON p.id = a.project_id
+		WHERE a.start_date <= $2 AND (a.end_date IS NULL OR a.end_date >= $1)
+		ORDER BY a.employee_id ASC, a.start_date ASC, a.id ASC
+	`
+
+	items := make([]EmployeeProjectAllocation, 0)
+	if err := r.tx.SelectContext(ctx, &items, query, periodStart, periodEnd); err != nil {
+		return nil, fmt.Errorf("list project allocations: %w", err)
+	}
+	return items, nil
+}
+
+func (r *sqlxTxRepository) CreateProjectCharge(ctx context.Context, charge ProjectCharge) error {
+	query := `
+		INSERT INTO payroll_project_charges (batch_id, entry_id, project_id, gross_amount, employer_amount)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+	if _, err := r.tx.ExecContext(ctx, query, charge.BatchID, charge.EntryID, charge.ProjectID, charge.GrossAmount, charge.EmployerAmount); err != nil {
+		return fmt.Errorf("create payroll project charge: %w", err)
+	}
+	return nil
+}
+
+func (r *sqlxTxRepository) CreateReversalBatch(ctx context.Context, source PayrollBatch, description string, createdBy int64) (*PayrollBatch, error) {
+	query := `
+		INSERT INTO payroll_batches (month, batch_type, description, status, created_by, reverses_batch_id)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id, month, batch_type, description, status, created_by, created_at, approved_by, approved_at, locked_at, variance_reviewed_by, variance_reviewed_at, reverses_batch_id, integrity_hash
+	`
+
+	var batch PayrollBatch
+	if err := r.tx.GetContext(ctx, &batch, query, source.Month, BatchTypeCorrection, description, StatusDraft, createdBy, source.ID); err != nil {
+		if isUniqueViolation(err) {
+			return nil, fmt.Errorf("%w: batch has already been reversed", ErrInvalidTransition)
+		}
+		return nil, fmt.Errorf("create payroll reversal batch: %w", err)
+	}
+
+	return &batch, nil
+}
+
+func (r *sqlxTxRepository) CreateBatchApproval(ctx context.Context, input BatchApprovalInput) error {
+	query := `
+		INSERT INTO payroll_batch_approvals (batch_id, step_number, step_name, action, user_id, comment)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+	if _, err := r.tx.ExecContext(ctx, query, input.BatchID, input.StepNumber, input.StepName, input.Action, input.UserID, input.Comment); err != nil {
+		return fmt.Errorf("create payroll batch approval: %w", err)
+	}
+	return nil
+}
+
+func (r *sqlxTxRepository) SetBatchApproved(ctx context.Context, batchID int64, approvedBy int64) (*PayrollBatch, error) {
 	query := `
 		UPDATE payroll_batches
 		SET status = $2, approved_by = $3, approved_at = NOW()
 		WHERE id = $1
-		RETURNING id, month, status, created_by, created_at, approved_by, approved_at, locked_at
+		RETURNING id, month, batch_type, description, status, created_by, created_at, approved_by, approved_at, locked_at, variance_reviewed_by, variance_reviewed_at, reverses_batch_id, integrity_hash
 	`
 
 	var batch PayrollBatch
-	if err := r.db.GetContext(ctx, &batch, query, batchID, StatusApproved, approvedBy); err != nil {
+	if err := r.tx.GetContext(ctx, &batch, query, batchID, StatusApproved, approvedBy); err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
 		}
@@ -240,16 +635,35 @@ func (r *SQLXRepository) SetBatchApproved(ctx context.Context, batchID int64, ap
 	return &batch, nil
 }
 
-func (r *SQLXRepository) SetBatchLocked(ctx context.Context, batchID int64) (*PayrollBatch, error) {
+func (r *sqlxTxRepository) SetBatchDraft(ctx context.Context, batchID int64) (*PayrollBatch, error) {
+	query := `
+		UPDATE payroll_batches
+		SET status = $2, approved_by = NULL, approved_at = NULL
+		WHERE id = $1
+		RETURNING id, month, batch_type, description, status, created_by, created_at, approved_by, approved_at, locked_at, variance_reviewed_by, variance_reviewed_at, reverses_batch_id, integrity_hash
+	`
+
+	var batch PayrollBatch
+	if err := r.tx.GetContext(ctx, &batch, query, batchID, StatusDraft); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("set payroll batch draft: %w", err)
+	}
+
+	return &batch, nil
+}
+
+func (r *sqlxTxRepository) SetBatchLocked(ctx context.Context, batchID int64) (*PayrollBatch, error) {
 	query := `
 		UPDATE payroll_batches
 		SET status = $2, locked_at = NOW()
 		WHERE id = $1
-		RETURNING id, month, status, created_by, created_at, approved_by, approved_at, locked_at
+		RETURNING id, month, batch_type, description, status, created_by, created_at, approved_by, approved_at, locked_at, variance_reviewed_by, variance_reviewed_at, reverses_batch_id, integrity_hash
 	`
 
 	var batch PayrollBatch
-	if err := r.db.GetContext(ctx, &batch, query, batchID, StatusLocked); err != nil {
+	if err := r.tx.GetContext(ctx, &batch, query, batchID, StatusLocked); err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
 		}
@@ -259,6 +673,14 @@ func (r *SQLXRepository) SetBatchLocked(ctx context.Context, batchID int64) (*Pa
 	return &batch, nil
 }
 
+func (r *sqlxTxRepository) SetBatchIntegritySeal(ctx context.Context, batchID int64, hash, manifest string) error {
+	query := `UPDATE payroll_batches SET integrity_hash = $2, integrity_manifest = $3::jsonb WHERE id = $1`
+	if _, err := r.tx.ExecContext(ctx, query, batchID, hash, manifest); err != nil {
+		return fmt.Errorf("set payroll batch integrity seal: %w", err)
+	}
+	return nil
+}
+
 func (r *SQLXRepository) WithTx(ctx context.Context, fn func(tx TxRepository) error) error {
 	tx, err := r.db.BeginTxx(ctx, nil)
 	if err != nil {
@@ -278,81 +700,934 @@ func (r *SQLXRepository) WithTx(ctx context.Context, fn func(tx TxRepository) er
 	return nil
 }
 
-func (r *SQLXRepository) getEntryByID(ctx context.Context, entryID int64) (*PayrollEntry, error) {
+func (r *SQLXRepository) GetEntryByID(ctx context.Context, entryID int64) (*PayrollEntry, error) {
+	return getEntryByID(ctx, r.db, entryID)
+}
+
+func (r *SQLXRepository) ListEntryLinesByBatchID(ctx context.Context, batchID int64) ([]PayrollEntryLine, error) {
+	return listEntryLinesByBatchID(ctx, r.db, batchID)
+}
+
+func (r *SQLXRepository) ListEntryLinesByEntryID(ctx context.Context, entryID int64) ([]PayrollEntryLine, error) {
+	return listEntryLines(ctx, r.db, entryID)
+}
+
+func (r *SQLXRepository) ListEntryLineSourcesByBatchID(ctx context.Context, batchID int64) ([]PayrollEntryLineSource, error) {
+	return listEntryLineSourcesByBatchID(ctx, r.db, batchID)
+}
+
+func (r *SQLXRepository) ListEntryLineSourcesByEntryID(ctx context.Context, entryID int64) ([]PayrollEntryLineSource, error) {
 	query := `
-		SELECT
-			pe.id,
-			pe.batch_id,
-			pe.employee_id,
-			TRIM(CONCAT(e.first_name, ' ', e.last_name)) AS employee_name,
-			CAST(pe.base_salary AS DOUBLE PRECISION) AS base_salary,
-			CAST(pe.allowances_total AS DOUBLE PRECISION) AS allowances_total,
-			CAST(pe.deductions_total AS DOUBLE PRECISION) AS deductions_total,
-			CAST(pe.tax_total AS DOUBLE PRECISION) AS tax_total,
-			CAST(pe.gross_pay AS DOUBLE PRECISION) AS gross_pay,
-			CAST(pe.net_pay AS DOUBLE PRECISION) AS net_pay,
-			pe.created_at,
-			pe.updated_at
-		FROM payroll_entries pe
-		INNER JOIN employees e ON e.id = pe.employee_id
-		WHERE pe.id = $1
+		SELECT pels.id, pels.line_id, pels.source_type, pels.source_id, pels.days
+		FROM payroll_entry_line_sources pels
+		INNER JOIN payroll_entry_lines pel ON pel.id = pels.line_id
+		WHERE pel.entry_id = $1
+		ORDER BY pels.line_id ASC, pels.source_type ASC, pels.source_id ASC
 	`
 
-	var entry PayrollEntry
-	if err := r.db.GetContext(ctx, &entry, query, entryID); err != nil {
+	items := make([]PayrollEntryLineSource, 0)
+	if err := r.db.SelectContext(ctx, &items, query, entryID); err != nil {
+		return nil, fmt.Errorf("list payroll entry line sources by entry id: %w", err)
+	}
+	return items, nil
+}
+
+func (r *SQLXRepository) GetEntryLineByID(ctx context.Context, lineID int64) (*PayrollEntryLine, error) {
+	query := `
+		SELECT id, entry_id, component_id, scheme_id, code, name, kind, taxable, amount, employer_amount, created_at,
+			quantity, rate, loan_id, arrears_month
+		FROM payroll_entry_lines
+		WHERE id = $1
+	`
+
+	var line PayrollEntryLine
+	if err := r.db.GetContext(ctx, &line, query, lineID); err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
 		}
-		return nil, fmt.Errorf("get payroll entry by id: %w", err)
+		return nil, fmt.Errorf("get payroll entry line by id: %w", err)
 	}
-	return &entry, nil
+	return &line, nil
 }
 
-type sqlxTxRepository struct {
-	tx *sqlx.Tx
-}
+func (r *SQLXRepository) ListPayComponents(ctx context.Context, activeOnly bool) ([]PayComponent, error) {
+	query := `
+		SELECT id, code, name, kind, taxable, calculation_type, value, auto_apply, active, created_at, updated_at
+		FROM pay_components
+	`
+	args := make([]any, 0)
+	if activeOnly {
+		query += " WHERE active = $1"
+		args = append(args, true)
+	}
+	query += " ORDER BY kind ASC, name ASC"
 
-func (r *sqlxTxRepository) DeleteEntriesByBatchID(ctx context.Context, batchID int64) error {
-	query := `DELETE FROM payroll_entries WHERE batch_id = $1`
-	if _, err := r.tx.ExecContext(ctx, query, batchID); err != nil {
-		return fmt.Errorf("delete payroll entries by batch id: %w", err)
+	items := make([]PayComponent, 0)
+	if err := r.db.SelectContext(ctx, &items, query, args...); err != nil {
+		return nil, fmt.Errorf("list pay components: %w", err)
 	}
-	return nil
+	return items, nil
 }
 
-func (r *sqlxTxRepository) ListActiveEmployeeSalaries(ctx context.Context) ([]EmployeeSalary, error) {
+func (r *SQLXRepository) GetPayComponentByID(ctx context.Context, id int64) (*PayComponent, error) {
 	query := `
-		SELECT
-			e.id AS employee_id,
-			TRIM(CONCAT(e.first_name, ' ', e.last_name)) AS employee_name,
-			CAST(e.base_salary_amount AS DOUBLE PRECISION) AS base_salary
-		FROM employees e
-		WHERE LOWER(TRIM(e.employment_status)) = 'active'
-		ORDER BY e.last_name ASC, e.first_name ASC
+		SELECT id, code, name, kind, taxable, calculation_type, value, auto_apply, active, created_at, updated_at
+		FROM pay_components
+		WHERE id = $1
 	`
-	items := make([]EmployeeSalary, 0)
-	if err := r.tx.SelectContext(ctx, &items, query); err != nil {
-		return nil, fmt.Errorf("list active employee salaries: %w", err)
+
+	var item PayComponent
+	if err := r.db.GetContext(ctx, &item, query, id); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get pay component by id: %w", err)
 	}
-	return items, nil
+	return &item, nil
 }
 
-func (r *sqlxTxRepository) CreateEntry(ctx context.Context, input EntryCreateInput) error {
+func (r *SQLXRepository) CreatePayComponent(ctx context.Context, input PayComponentUpsertInput) (*PayComponent, error) {
 	query := `
-		INSERT INTO payroll_entries (
-			batch_id,
-			employee_id,
-			base_salary,
-			allowances_total,
-			deductions_total,
-			tax_total,
-			gross_pay,
-			net_pay
-		)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		INSERT INTO pay_components (code, name, kind, taxable, calculation_type, value, auto_apply)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id, code, name, kind, taxable, calculation_type, value, auto_apply, active, created_at, updated_at
 	`
-	if _, err := r.tx.ExecContext(
+
+	var item PayComponent
+	if err := r.db.GetContext(ctx, &item, query, input.Code, input.Name, input.Kind, input.Taxable, input.CalculationType, input.Value, input.AutoApply); err != nil {
+		if isUniqueViolation(err) {
+			return nil, ErrDuplicateComponent
+		}
+		return nil, fmt.Errorf("create pay component: %w", err)
+	}
+	return &item, nil
+}
+
+func (r *SQLXRepository) UpdatePayComponent(ctx context.Context, id int64, input PayComponentUpsertInput) (*PayComponent, error) {
+	query := `
+		UPDATE pay_components
+		SET
+			code = $2,
+			name = $3,
+			kind = $4,
+			taxable = $5,
+			calculation_type = $6,
+			value = $7,
+			auto_apply = $8,
+			updated_at = NOW()
+		WHERE id = $1
+		RETURNING id, code, name, kind, taxable, calculation_type, value, auto_apply, active, created_at, updated_at
+	`
+
+	var item PayComponent
+	if err := r.db.GetContext(ctx, &item, query, id, input.Code, input.Name, input.Kind, input.Taxable, input.CalculationType, input.Value, input.AutoApply); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		if isUniqueViolation(err) {
+			return nil, ErrDuplicateComponent
+		}
+		return nil, fmt.Errorf("update pay component: %w", err)
+	}
+	return &item, nil
+}
+
+func (r *SQLXRepository) SetPayComponentActive(ctx context.Context, id int64, active bool) (*PayComponent, error) {
+	query := `
+		UPDATE pay_components
+		SET active = $2, updated_at = NOW()
+		WHERE id = $1
+		RETURNING id, code, name, kind, taxable, calculation_type, value, auto_apply, active, created_at, updated_at
+	`
+
+	var item PayComponent
+	if err := r.db.GetContext(ctx, &item, query, id, active); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("set pay component active: %w", err)
+	}
+	return &item, nil
+}
+
+func (r *SQLXRepository) ListContributionSchemes(ctx context.Context, activeOnly bool) ([]ContributionScheme, error) {
+	return listContributionSchemes(ctx, r.db, activeOnly)
+}
+
+func (r *SQLXRepository) GetContributionSchemeByID(ctx context.Context, id int64) (*ContributionScheme, error) {
+	query := `
+		SELECT ` + contributionSchemeColumns + `
+		FROM contribution_schemes
+		WHERE id = $1
+	`
+
+	var item ContributionScheme
+	if err := r.db.GetContext(ctx, &item, query, id); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get contribution scheme by id: %w", err)
+	}
+	return &item, nil
+}
+
+func (r *SQLXRepository) CreateContributionScheme(ctx context.Context, input ContributionSchemeUpsertInput) (*ContributionScheme, error) {
+	query := `
+		INSERT INTO contribution_schemes (code, name, employee_rate, employer_rate, base, members_only)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING ` + contributionSchemeColumns
+
+	var item ContributionScheme
+	if err := r.db.GetContext(ctx, &item, query, input.Code, input.Name, input.EmployeeRate, input.EmployerRate, input.Base, input.MembersOnly); err != nil {
+		if isUniqueViolation(err) {
+			return nil, ErrDuplicateScheme
+		}
+		return nil, fmt.Errorf("create contribution scheme: %w", err)
+	}
+	return &item, nil
+}
+
+func (r *SQLXRepository) UpdateContributionScheme(ctx context.Context, id int64, input ContributionSchemeUpsertInput) (*ContributionScheme, error) {
+	query := `
+		UPDATE contribution_schemes
+		SET
+			code = $2,
+			name = $3,
+			employee_rate = $4,
+			employer_rate = $5,
+			base = $6,
+			members_only = $7,
+			updated_at = NOW()
+		WHERE id = $1
+		RETURNING ` + contributionSchemeColumns
+
+	var item ContributionScheme
+	if err := r.db.GetContext(ctx, &item, query, id, input.Code, input.Name, input.EmployeeRate, input.EmployerRate, input.Base, input.MembersOnly); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		if isUniqueViolation(err) {
+			return nil, ErrDuplicateScheme
+		}
+		return nil, fmt.Errorf("update contribution scheme: %w", err)
+	}
+	return &item, nil
+}
+
+func (r *SQLXRepository) SetContributionSchemeActive(ctx context.Context, id int64, active bool) (*ContributionScheme, error) {
+	query := `
+		UPDATE contribution_schemes
+		SET active = $2, updated_at = NOW()
+		WHERE id = $1
+		RETURNING ` + contributionSchemeColumns
+
+	var item ContributionScheme
+	if err := r.db.GetContext(ctx, &item, query, id, active); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("set contribution scheme active: %w", err)
+	}
+	return &item, nil
+}
+
+func (r *SQLXRepository) ListContributionSchemeMembers(ctx context.Context, schemeID int64) ([]ContributionSchemeMember, error) {
+	query := `
+		SELECT csm.scheme_id, csm.employee_id, TRIM(CONCAT(e.first_name, ' ', e.last_name)) AS employee_name, csm.member_number
+		FROM contribution_scheme_members csm
+		INNER JOIN employees e ON e.id = csm.employee_id
+		WHERE csm.scheme_id = $1
+		ORDER BY e.last_name ASC, e.first_name ASC
+	`
+
+	items := make([]ContributionSchemeMember, 0)
+	if err := r.db.SelectContext(ctx, &items, query, schemeID); err != nil {
+		return nil, fmt.Errorf("list contribution scheme members: %w", err)
+	}
+	return items, nil
+}
+
+func (r *SQLXRepository) UpsertContributionSchemeMember(ctx context.Context, schemeID int64, employeeID int64, memberNumber string) (*ContributionSchemeMember, error) {
+	query := `
+		INSERT INTO contribution_scheme_members (scheme_id, employee_id, member_number)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (scheme_id, employee_id)
+		DO UPDATE SET member_number = EXCLUDED.member_number, updated_at = NOW()
+	`
+	if _, err := r.db.ExecContext(ctx, query, schemeID, employeeID, memberNumber); err != nil {
+		if isForeignKeyViolation(err) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("upsert contribution scheme member: %w", err)
+	}
+
+	members, err := r.ListContributionSchemeMembers(ctx, schemeID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range members {
+		if members[i].EmployeeID == employeeID {
+			return &members[i], nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *SQLXRepository) DeleteContributionSchemeMember(ctx context.Context, schemeID int64, employeeID int64) (bool, error) {
+	query := `DELETE FROM contribution_scheme_members WHERE scheme_id = $1 AND employee_id = $2`
+	result, err := r.db.ExecContext(ctx, query, schemeID, employeeID)
+	if err != nil {
+		return false, fmt.Errorf("delete contribution scheme member: %w", err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("delete contribution scheme member rows affected: %w", err)
+	}
+	return affected > 0, nil
+}
+
+func (r *SQLXRepository) ListContributionSchedule(ctx context.Context, batchID int64, schemeID int64) ([]ContributionScheduleRow, error) {
+	query := `
+		SELECT
+			pe.employee_id,
+			TRIM(CONCAT(e.first_name, ' ', e.last_name)) AS employee_name,
+			e.national_id,
+			csm.member_number,
+			pe.base_salary,
+			pe.gross_pay,
+			SUM(pel.amount) AS employee_amount,
+			SUM(pel.employer_amount) AS employer_amount
+		FROM payroll_entry_lines pel
+		INNER JOIN payroll_entries pe ON pe.id = pel.entry_id
+		INNER JOIN employees e ON e.id = pe.employee_id
+		LEFT JOIN contribution_scheme_members csm ON csm.scheme_id = pel.scheme_id AND csm.employee_id = pe.employee_id
+		WHERE pe.batch_id = $1 AND pel.scheme_id = $2
+		GROUP BY pe.employee_id, e.first_name, e.last_name, e.national_id, csm.member_number, pe.base_salary, pe.gross_pay
+		ORDER BY e.last_name ASC, e.first_name ASC
+	`
+
+	items := make([]ContributionScheduleRow, 0)
+	if err := r.db.SelectContext(ctx, &items, query, batchID, schemeID); err != nil {
+		return nil, fmt.Errorf("list contribution schedule: %w", err)
+	}
+	return items, nil
+}
+
+const glAccountMappingColumns = `
+	m.id, m.category, m.component_id, pc.code AS component_code, m.scheme_id, cs.code AS scheme_code,
+	m.department_id, d.name AS department_name, m.account_code, m.account_name, m.created_at, m.updated_at
+`
+
+const glAccountMappingJoins = `
+	FROM gl_account_mappings m
+	LEFT JOIN pay_components pc ON pc.id = m.component_id
+	LEFT JOIN contribution_schemes cs ON cs.id = m.scheme_id
+	LEFT JOIN departments d ON d.id = m.department_id
+`
+
+func (r *SQLXRepository) ListGLAccountMappings(ctx context.Context) ([]GLAccountMapping, error) {
+	query := "SELECT " + glAccountMappingColumns + glAccountMappingJoins + " ORDER BY m.category ASC, m.account_code ASC, m.id ASC"
+
+	items := make([]GLAccountMapping, 0)
+	if err := r.db.SelectContext(ctx, &items, query); err != nil {
+		return nil, fmt.Errorf("list gl account mappings: %w", err)
+	}
+	return items, nil
+}
+
+func (r *SQLXRepository) getGLAccountMappingByID(ctx context.Context, id int64) (*GLAccountMapping, error) {
+	query := "SELECT " + glAccountMappingColumns + glAccountMappingJoins + " WHERE m.id = $1"
+
+	var item GLAccountMapping
+	if err := r.db.GetContext(ctx, &item, query, id); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get gl account mapping by id: %w", err)
+	}
+	return &item, nil
+}
+
+func (r *SQLXRepository) CreateGLAccountMapping(ctx context.Context, input GLAccountMappingInput) (*GLAccountMapping, error) {
+	query := `
+		INSERT INTO gl_account_mappings (category, component_id, scheme_id, department_id, account_code, account_name)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id
+	`
+
+	var id int64
+	if err := r.db.GetContext(ctx, &id, query, input.Category, input.ComponentID, input.SchemeID, input.DepartmentID, input.AccountCode, input.AccountName); err != nil {
+		if isUniqueViolation(err) {
+			return nil, ErrDuplicateGLMapping
+		}
+		if isForeignKeyViolation(err) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("create gl account mapping: %w", err)
+	}
+	return r.getGLAccountMappingByID(ctx, id)
+}
+
+func (r *SQLXRepository) UpdateGLAccountMapping(ctx context.Context, id int64, input GLAccountMappingInput) (*GLAccountMapping, error) {
+	query := `
+		UPDATE gl_account_mappings
+		SET
+			category = $2,
+			component_id = $3,
+			scheme_id = $4,
+			department_id = $5,
+			account_code = $6,
+			account_name = $7,
+			updated_at = NOW()
+		WHERE id = $1
+		RETURNING id
+	`
+
+	if err := r.db.GetContext(ctx, &id, query, id, input.Category, input.ComponentID, input.SchemeID, input.DepartmentID, input.AccountCode, input.AccountName); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		if isUniqueViolation(err) {
+			return nil, ErrDuplicateGLMapping
+		}
+		if isForeignKeyViolation(err) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("update gl account mapping: %w", err)
+	}
+	return r.getGLAccountMappingByID(ctx, id)
+}
+
+func (r *SQLXRepository) DeleteGLAccountMapping(ctx context.Context, id int64) (bool, error) {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM gl_account_mappings WHERE id = $1`, id)
+	if err != nil {
+		return false, fmt.Errorf("delete gl account mapping: %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("delete gl account mapping rows affected: %w", err)
+	}
+	return rows > 0, nil
+}
+
+// ListBatchEmployeeDepartments returns the current department of each employee in a batch.
+func (r *SQLXRepository) ListBatchEmployeeDepartments(ctx context.Context, batchID int64) ([]EmployeeDepartment, error) {
+	query := `
+		SELECT pe.employee_id, e.department_id, d.name AS department_name, d.cost_centre
+		FROM payroll_entries pe
+		INNER JOIN employees e ON e.id = pe.employee_id
+		LEFT JOIN departments d ON d.id = e.department_id
+		WHERE pe.batch_id = $1
+	`
+
+	items := make([]EmployeeDepartment, 0)
+	if err := r.db.SelectContext(ctx, &items, query, batchID); err != nil {
+		return nil, fmt.Errorf("list batch employee departments: %w", err)
+	}
+	return items, nil
+}
+
+const projectColumns = "id, code, name, donor, active, created_at, updated_at"
+
+func (r *SQLXRepository) ListProjects(ctx context.Context, activeOnly bool) ([]Project, error) {
+	query := "SELECT " + projectColumns + " FROM projects"
+	if activeOnly {
+		query += " WHERE active = TRUE"
+	}
+	query += " ORDER BY code ASC"
+
+	items := make([]Project, 0)
+	if err := r.db.SelectContext(ctx, &items, query); err != nil {
+		return nil, fmt.Errorf("list projects: %w", err)
+	}
+	return items, nil
+}
+
+func (r *SQLXRepository) GetProjectByID(ctx context.Context, id int64) (*Project, error) {
+	query := "SELECT " + projectColumns + " FROM projects WHERE id = $1"
+
+	var item Project
+	if err := r.db.GetContext(ctx, &item, query, id); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get project by id: %w", err)
+	}
+	return &item, nil
+}
+
+func (r *SQLXRepository) CreateProject(ctx context.Context, input ProjectUpsertInput) (*Project, error) {
+	query := `
+		INSERT INTO projects (code, name, donor)
+		VALUES ($1, $2, $3)
+		RETURNING ` + projectColumns
+
+	var item Project
+	if err := r.db.GetContext(ctx, &item, query, input.Code, input.Name, input.Donor); err != nil {
+		if isUniqueViolation(err) {
+			return nil, ErrDuplicateProject
+		}
+		return nil, fmt.Errorf("create project: %w", err)
+	}
+	return &item, nil
+}
+
+func (r *SQLXRepository) UpdateProject(ctx context.Context, id int64, input ProjectUpsertInput) (*Project, error) {
+	query := `
+		UPDATE projects
+		SET code = $2, name = $3, donor = $4, updated_at = NOW()
+		WHERE id = $1
+		RETURNING ` + projectColumns
+
+	var item Project
+	if err := r.db.GetContext(ctx, &item, query, id, input.Code, input.Name, input.Donor); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		if isUniqueViolation(err) {
+			return nil, ErrDuplicateProject
+		}
+		return nil, fmt.Errorf("update project: %w", err)
+	}
+	return &item, nil
+}
+
+func (r *SQLXRepository) SetProjectActive(ctx context.Context, id int64, active bool) (*Project, error) {
+	query := `
+		UPDATE projects
+		SET active = $2, updated_at = NOW()
+		WHERE id = $1
+		RETURNING ` + projectColumns
+
+	var item Project
+	if err := r.db.GetContext(ctx, &item, query, id, active); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("set project active: %w", err)
+	}
+	return &item, nil
+}
+
+const projectAllocationColumns = `
+	a.id,
+	a.employee_id,
+	a.project_id,
+	p.code AS project_code,
+	p.name AS project_name,
+	a.percent,
+	a.start_date,
+	a.end_date,
+	a.created_at
+`
+
+func (r *SQLXRepository) ListEmployeeProjectAllocations(ctx context.Context, employeeID int64) ([]EmployeeProjectAllocation, error) {
+	query := `
+		SELECT ` + projectAllocationColumns + `
+		FROM employee_project_allocations a
+		INNER JOIN projects p ON p.id = a.project_id
+		WHERE a.employee_id = $1
+		ORDER BY a.start_date ASC, p.code ASC
+	`
+
+	items := make([]EmployeeProjectAllocation, 0)
+	if err := r.db.SelectContext(ctx, &items, query, employeeID); err != nil {
+		return nil, fmt.Errorf("list employee project allocations: %w", err)
+	}
+	return items, nil
+}
+
+func (r *SQLXRepository) ListProjectCharges(ctx context.Context, batchID int64) ([]ProjectCharge, error) {
+	query := `
+		SELECT ppc.id, ppc.batch_id, ppc.entry_id, pe.employee_id, ppc.project_id, ppc.gross_amount, ppc.employer_amount
+		FROM payroll_project_charges ppc
+		INNER JOIN payroll_entries pe ON pe.id = ppc.entry_id
+		WHERE ppc.batch_id = $1
+		ORDER BY ppc.id ASC
+	`
+
+	items := make([]ProjectCharge, 0)
+	if err := r.db.SelectContext(ctx, &items, query, batchID); err != nil {
+		return nil, fmt.Errorf("list project charges: %w", err)
+	}
+	return items, nil
+}
+
+// ListProjectChargingRows sums the project charges of the locked batches of a month per project and
+// employee. Reversal batches carry negative charges, so reversed pay nets out.
+func (r *SQLXRepository) ListProjectChargingRows(ctx context.Context, month string) ([]ProjectChargingRow, error) {
+	query := `
+		SELECT
+			ppc.project_id,
+			COALESCE(p.code, '') AS project_code,
+			COALESCE(p.name, '') AS project_name,
+			COALESCE(p.donor, '') AS donor,
+			pe.employee_id,
+			TRIM(CONCAT(e.first_name, ' ', e.last_name)) AS employee_name,
+			SUM(ppc.gross_amount) AS gross_amount,
+			SUM(ppc.employer_amount) AS employer_amount
+		FROM payroll_project_charges ppc
+		INNER JOIN payroll_batches pb ON pb.id = ppc.batch_id
+		INNER JOIN payroll_entries pe ON pe.id = ppc.entry_id
+		INNER JOIN employees e ON e.id = pe.employee_id
+		LEFT JOIN projects p ON p.id = ppc.project_id
+		WHERE pb.month = $1 AND pb.status = $2
+		GROUP BY ppc.project_id, p.code, p.name, p.donor, pe.employee_id, e.first_name, e.last_name
+		HAVING SUM(ppc.gross_amount) <> 0 OR SUM(ppc.employer_amount) <> 0
+		ORDER BY p.code ASC NULLS LAST, e.last_name ASC, e.first_name ASC, pe.employee_id ASC
+	`
+
+	items := make([]ProjectChargingRow, 0)
+	if err := r.db.SelectContext(ctx, &items, query, month, StatusLocked); err != nil {
+		return nil, fmt.Errorf("list project charging rows: %w", err)
+	}
+	return items, nil
+}
+
+// ListLockedEntriesForPeriod returns the entries of every locked batch whose month falls between fromMonth
+// and toMonth inclusive, reversals included.
+func (r *SQLXRepository) ListLockedEntriesForPeriod(ctx context.Context, fromMonth, toMonth string) ([]AnnualTaxEntry, error) {
+	query := `
+		SELECT
+			pe.id,
+			pe.batch_id,
+			pe.employee_id,
+			TRIM(CONCAT(e.first_name, ' ', e.last_name)) AS employee_name,
+			pe.base_salary,
+			pe.allowances_total,
+			pe.deductions_total,
+			pe.tax_total,
+			pe.gross_pay,
+			pe.net_pay,
+			pe.employer_contributions_total,
+			pe.full_base_salary,
+			pe.proration_basis,
+			pe.payable_days,
+			pe.period_days,
+			pe.created_at,
+			pe.updated_at,
+			pb.month,
+			e.national_id
+		FROM payroll_entries pe
+		INNER JOIN payroll_batches pb ON pb.id = pe.batch_id
+		INNER JOIN employees e ON e.id = pe.employee_id
+		WHERE pb.status = $1 AND pb.month BETWEEN $2 AND $3
+		ORDER BY e.last_name ASC, e.first_name ASC, pe.employee_id ASC, pb.month ASC, pe.id ASC
+	`
+
+	items := make([]AnnualTaxEntry, 0)
+	if err := r.db.SelectContext(ctx, &items, query, StatusLocked, fromMonth, toMonth); err != nil {
+		return nil, fmt.Errorf("list locked entries for period: %w", err)
+	}
+	return items, nil
+}
+
+func (r *SQLXRepository) ListLockedEntryLinesForPeriod(ctx context.Context, fromMonth, toMonth string) ([]PayrollEntryLine, error) {
+	query := `
+		SELECT
+			pel.id,
+			pel.entry_id,
+			pel.component_id,
+			pel.scheme_id,
+			pel.code,
+			pel.name,
+			pel.kind,
+			pel.taxable,
+			pel.amount,
+			pel.employer_amount,
+			pel.created_at,
+			pel.quantity,
+			pel.rate,
+			pel.loan_id,
+			pel.arrears_month
+		FROM payroll_entry_lines pel
+		INNER JOIN payroll_entries pe ON pe.id = pel.entry_id
+		INNER JOIN payroll_batches pb ON pb.id = pe.batch_id
+		WHERE pb.status = $1 AND pb.month BETWEEN $2 AND $3
+		ORDER BY pel.entry_id ASC, pel.id ASC
+	`
+
+	items := make([]PayrollEntryLine, 0)
+	if err := r.db.SelectContext(ctx, &items, query, StatusLocked, fromMonth, toMonth); err != nil {
+		return nil, fmt.Errorf("list locked entry lines for period: %w", err)
+	}
+	return items, nil
+}
+
+func (r *SQLXRepository) ListBatchIntegritySeals(ctx context.Context, batchIDs []int64) ([]BatchIntegritySeal, error) {
+	query := `
+		SELECT id, month, batch_type, description, status, created_by, created_at, approved_by, approved_at, locked_at, variance_reviewed_by, variance_reviewed_at, reverses_batch_id, integrity_hash,
+			integrity_manifest::text AS integrity_manifest
+		FROM payroll_batches
+		WHERE id = ANY($1)
+		ORDER BY id ASC
+	`
+
+	items := make([]BatchIntegritySeal, 0)
+	if err := r.db.SelectContext(ctx, &items, query, batchIDs); err != nil {
+		return nil, fmt.Errorf("list payroll batch integrity seals: %w", err)
+	}
+	return items, nil
+}
+
+func (r *SQLXRepository) ListEntriesByBatchIDs(ctx context.Context, batchIDs []int64) ([]PayrollEntry, error) {
+	query := `
+		SELECT
+			pe.id,
+			pe.batch_id,
+			pe.employee_id,
+			TRIM(CONCAT(e.first_name, ' ', e.last_name)) AS employee_name,
+			pe.base_salary,
+			pe.allowances_total,
+			pe.deductions_total,
+			pe.tax_total,
+			pe.gross_pay,
+			pe.net_pay,
+			pe.employer_contributions_total,
+			pe.full_base_salary,
+			pe.proration_basis,
+			pe.payable_days,
+			pe.period_days,
+			pe.created_at,
+			pe.updated_at
+		FROM payroll_entries pe
+		INNER JOIN employees e ON e.id = pe.employee_id
+		WHERE pe.batch_id = ANY($1)
+		ORDER BY pe.batch_id ASC, pe.id ASC
+	`
+
+	items := make([]PayrollEntry, 0)
+	if err := r.db.SelectContext(ctx, &items, query, batchIDs); err != nil {
+		return nil, fmt.Errorf("list payroll entries by batch ids: %w", err)
+	}
+	return items, nil
+}
+
+func (r *SQLXRepository) ListEntryLinesByBatchIDs(ctx context.Context, batchIDs []int64) ([]PayrollEntryLine, error) {
+	query := `
+		SELECT
+			pel.id,
+			pel.entry_id,
+			pel.component_id,
+			pel.scheme_id,
+			pel.code,
+			pel.name,
+			pel.kind,
+			pel.taxable,
+			pel.amount,
+			pel.employer_amount,
+			pel.created_at,
+			pel.quantity,
+			pel.rate,
+			pel.loan_id,
+			pel.arrears_month
+		FROM payroll_entry_lines pel
+		INNER JOIN payroll_entries pe ON pe.id = pel.entry_id
+		WHERE pe.batch_id = ANY($1)
+		ORDER BY pel.entry_id ASC, pel.id ASC
+	`
+
+	items := make([]PayrollEntryLine, 0)
+	if err := r.db.SelectContext(ctx, &items, query, batchIDs); err != nil {
+		return nil, fmt.Errorf("list payroll entry lines by batch ids: %w", err)
+	}
+	return items, nil
+}
+
+func (r *SQLXRepository) ListEmployeePaymentMethods(ctx context.Context, employeeID int64) ([]EmployeePaymentMethod, error) {
+	query := "SELECT " + paymentMethodColumns + " FROM employee_payment_methods WHERE employee_id = $1 ORDER BY id ASC"
+
+	items := make([]EmployeePaymentMethod, 0)
+	if err := r.db.SelectContext(ctx, &items, query, employeeID); err != nil {
+		return nil, fmt.Errorf("list employee payment methods: %w", err)
+	}
+	return items, nil
+}
+
+func (r *SQLXRepository) ListPaymentMethodsByBatchID(ctx context.Context, batchID int64) ([]EmployeePaymentMethod, error) {
+	query := `
+		SELECT ` + paymentMethodColumns + `
+		FROM employee_payment_methods
+		WHERE employee_id IN (SELECT employee_id FROM payroll_entries WHERE batch_id = $1)
+		ORDER BY employee_id ASC, id ASC
+	`
+
+	items := make([]EmployeePaymentMethod, 0)
+	if err := r.db.SelectContext(ctx, &items, query, batchID); err != nil {
+		return nil, fmt.Errorf("list payment methods by batch id: %w", err)
+	}
+	return items, nil
+}
+
+type sqlxTxRepository struct {
+	tx *sqlx.Tx
+}
+
+func (r *sqlxTxRepository) DeleteEntriesByBatchID(ctx context.Context, batchID int64) error {
+	query := `DELETE FROM payroll_entries WHERE batch_id = $1`
+	if _, err := r.tx.ExecContext(ctx, query, batchID); err != nil {
+		return fmt.Errorf("delete payroll entries by batch id: %w", err)
+	}
+	return nil
+}
+
+// ListActiveEmployeeSalaries returns everyone employed for at least part of the period: active staff hired
+// on or before its last day, and staff whose exit date falls inside it.
+func (r *sqlxTxRepository) ListActiveEmployeeSalaries(ctx context.Context, periodStart, periodEnd time.Time) ([]EmployeeSalary, error) {
+	query := `
+		SELECT
+			e.id AS employee_id,
+			TRIM(CONCAT(e.first_name, ' ', e.last_name)) AS employee_name,
+			e.base_salary_amount AS base_salary,
+			e.date_of_hire,
+			e.date_of_exit,
+			e.department_id,
+			d.name AS department_name
+		FROM employees e
+		LEFT JOIN departments d ON d.id = e.department_id
+		WHERE e.date_of_hire <= $2
+		  AND (e.date_of_exit IS NULL OR e.date_of_exit >= $1)
+		  AND (
+			LOWER(TRIM(e.employment_status)) = 'active'
+			OR (e.date_of_exit IS NOT NULL AND e.date_of_exit <= $2)
+		  )
+		ORDER BY e.last_name ASC, e.first_name ASC
+	`
+	items := make([]EmployeeSalary, 0)
+	if err := r.tx.SelectContext(ctx, &items, query, periodStart, periodEnd); err != nil {
+		return nil, fmt.Errorf("list active employee salaries: %w", err)
+	}
+	return items, nil
+}
+
+// ListEmployeeSalariesByIDs returns the given employees hired on or before the last day of the period,
+// whatever their employment status or exit date.
+func (r *sqlxTxRepository) ListEmployeeSalariesByIDs(ctx context.Context, employeeIDs []int64, periodEnd time.Time) ([]EmployeeSalary, error) {
+	query := `
+		SELECT
+			e.id AS employee_id,
+			TRIM(CONCAT(e.first_name, ' ', e.last_name)) AS employee_name,
+			e.base_salary_amount AS base_salary,
+			e.date_of_hire,
+			e.date_of_exit,
+			e.department_id,
+			d.name AS department_name
+		FROM employees e
+		LEFT JOIN departments d ON d.id = e.department_id
+		WHERE e.id = ANY($1)
+		  AND e.date_of_hire <= $2
+		ORDER BY e.last_name ASC, e.first_name ASC
+	`
+	items := make([]EmployeeSalary, 0)
+	if err := r.tx.SelectContext(ctx, &items, query, employeeIDs, periodEnd); err != nil {
+		return nil, fmt.Errorf("list employee salaries by ids: %w", err)
+	}
+	return items, nil
+}
+
+func (r *sqlxTxRepository) ListSalaryRates(ctx context.Context, employeeID int64) ([]SalaryRate, error) {
+	return listSalaryRates(ctx, r.tx, employeeID)
+}
+
+// ListAbsenceRecords returns approved leave of unpaid leave types and unexcused absences that overlap the
+// period. An absence is excused when an approved leave request of any type covers its date.
+func (r *sqlxTxRepository) ListAbsenceRecords(ctx context.Context, periodStart, periodEnd time.Time) ([]AbsenceRecord, error) {
+	query := `
+		SELECT
+			'leave_request' AS source_type,
+			lr.id AS source_id,
+			lr.employee_id,
+			lr.start_date,
+			lr.end_date,
+			lr.working_days AS days
+		FROM leave_requests lr
+		INNER JOIN leave_types lt ON lt.id = lr.leave_type_id
+		WHERE lr.status = 'Approved'
+		  AND lt.paid = FALSE
+		  AND lr.start_date <= $2
+		  AND lr.end_date >= $1
+		UNION ALL
+		SELECT
+			'attendance_record' AS source_type,
+			ar.id AS source_id,
+			ar.employee_id,
+			ar.attendance_date AS start_date,
+			ar.attendance_date AS end_date,
+			1 AS days
+		FROM attendance_records ar
+		WHERE ar.status = 'absent'
+		  AND ar.attendance_date BETWEEN $1 AND $2
+		  AND NOT EXISTS (
+			SELECT 1
+			FROM leave_requests lr
+			WHERE lr.employee_id = ar.employee_id
+			  AND lr.status = 'Approved'
+			  AND ar.attendance_date BETWEEN lr.start_date AND lr.end_date
+		  )
+		ORDER BY employee_id ASC, start_date ASC, source_id ASC
+	`
+	items := make([]AbsenceRecord, 0)
+	if err := r.tx.SelectContext(ctx, &items, query, periodStart, periodEnd); err != nil {
+		return nil, fmt.Errorf("list absence records: %w", err)
+	}
+	return items, nil
+}
+
+func (r *sqlxTxRepository) ListAutoApplyComponents(ctx context.Context) ([]PayComponent, error) {
+	query := `
+		SELECT id, code, name, kind, taxable, calculation_type, value, auto_apply, active, created_at, updated_at
+		FROM pay_components
+		WHERE active = TRUE AND auto_apply = TRUE
+		ORDER BY kind ASC, name ASC
+	`
+	items := make([]PayComponent, 0)
+	if err := r.tx.SelectContext(ctx, &items, query); err != nil {
+		return nil, fmt.Errorf("list auto-apply pay components: %w", err)
+	}
+	return items, nil
+}
+
+func (r *sqlxTxRepository) ListContributionSchemes(ctx context.Context) ([]ContributionScheme, error) {
+	return listContributionSchemes(ctx, r.tx, false)
+}
+
+func (r *sqlxTxRepository) ListContributionSchemeMembers(ctx context.Context) ([]ContributionSchemeMember, error) {
+	query := `
+		SELECT csm.scheme_id, csm.employee_id, TRIM(CONCAT(e.first_name, ' ', e.last_name)) AS employee_name, csm.member_number
+		FROM contribution_scheme_members csm
+		INNER JOIN employees e ON e.id = csm.employee_id
+	`
+	items := make([]ContributionSchemeMember, 0)
+	if err := r.tx.SelectContext(ctx, &items, query); err != nil {
+		return nil, fmt.Errorf("list contribution scheme members: %w", err)
+	}
+	return items, nil
+}
+
+func (r *sqlxTxRepository) CreateEntry(ctx context.Context, input EntryCreateInput) (int64, error) {
+	query := `
+		INSERT INTO payroll_entries (
+			batch_id,
+			employee_id,
+			base_salary,
+			allowances_total,
+			deductions_total,
+			tax_total,
+			gross_pay,
+			net_pay,
+			employer_contributions_total,
+			full_base_salary,
+			proration_basis,
+			payable_days,
+			period_days
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+		RETURNING id
+	`
+	var id int64
+	if err := r.tx.GetContext(
 		ctx,
+		&id,
 		query,
 		input.BatchID,
 		input.EmployeeID,
@@ -362,12 +1637,318 @@ func (r *sqlxTxRepository) CreateEntry(ctx context.Context, input EntryCreateInp
 		input.TaxTotal,
 		input.GrossPay,
 		input.NetPay,
+		input.EmployerContributionsTotal,
+		input.FullBaseSalary,
+		input.ProrationBasis,
+		input.PayableDays,
+		input.PeriodDays,
+	); err != nil {
+		return 0, fmt.Errorf("create payroll entry: %w", err)
+	}
+	return id, nil
+}
+
+func (r *sqlxTxRepository) GetEntryByID(ctx context.Context, entryID int64) (*PayrollEntry, error) {
+	return getEntryByID(ctx, r.tx, entryID)
+}
+
+func (r *sqlxTxRepository) ListEntryLines(ctx context.Context, entryID int64) ([]PayrollEntryLine, error) {
+	return listEntryLines(ctx, r.tx, entryID)
+}
+
+func (r *sqlxTxRepository) ListEntriesByBatchID(ctx context.Context, batchID int64) ([]PayrollEntry, error) {
+	return listEntriesByBatchID(ctx, r.tx, batchID)
+}
+
+func (r *sqlxTxRepository) ListEntryLinesByBatchID(ctx context.Context, batchID int64) ([]PayrollEntryLine, error) {
+	return listEntryLinesByBatchID(ctx, r.tx, batchID)
+}
+
+func (r *sqlxTxRepository) ListEntryLineSourcesByBatchID(ctx context.Context, batchID int64) ([]PayrollEntryLineSource, error) {
+	return listEntryLineSourcesByBatchID(ctx, r.tx, batchID)
+}
+
+func (r *sqlxTxRepository) CreateEntryLine(ctx context.Context, input EntryLineCreateInput) error {
+	query := `
+		INSERT INTO payroll_entry_lines (entry_id, component_id, scheme_id, code, name, kind, taxable, amount, employer_amount, quantity, rate, loan_id, arrears_month)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+		RETURNING id
+	`
+	var lineID int64
+	if err := r.tx.GetContext(ctx, &lineID, query, input.EntryID, input.ComponentID, input.SchemeID, input.Code, input.Name, input.Kind, input.Taxable, input.Amount, input.EmployerAmount, input.Quantity, input.Rate, input.LoanID, input.ArrearsMonth); err != nil {
+		return fmt.Errorf("create payroll entry line: %w", err)
+	}
+
+	sourceQuery := `
+		INSERT INTO payroll_entry_line_sources (line_id, source_type, source_id, days)
+		VALUES ($1, $2, $3, $4)
+	`
+	for _, source := range input.Sources {
+		if _, err := r.tx.ExecContext(ctx, sourceQuery, lineID, source.SourceType, source.SourceID, source.Days); err != nil {
+			return fmt.Errorf("create payroll entry line source: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *sqlxTxRepository) DeleteEntryLine(ctx context.Context, lineID int64) error {
+	query := `DELETE FROM payroll_entry_lines WHERE id = $1`
+	if _, err := r.tx.ExecContext(ctx, query, lineID); err != nil {
+		return fmt.Errorf("delete payroll entry line: %w", err)
+	}
+	return nil
+}
+
+func (r *sqlxTxRepository) UpdateEntryLineAmounts(ctx context.Context, lineID int64, amount, employerAmount money.Amount) error {
+	query := `UPDATE payroll_entry_lines SET amount = $2, employer_amount = $3 WHERE id = $1`
+	if _, err := r.tx.ExecContext(ctx, query, lineID, amount, employerAmount); err != nil {
+		return fmt.Errorf("update payroll entry line amounts: %w", err)
+	}
+	return nil
+}
+
+func (r *sqlxTxRepository) UpdateEntryTotals(ctx context.Context, entryID int64, input EntryTotalsInput) error {
+	query := `
+		UPDATE payroll_entries
+		SET
+			allowances_total = $2,
+			deductions_total = $3,
+			tax_total = $4,
+			gross_pay = $5,
+			net_pay = $6,
+			employer_contributions_total = $7,
+			updated_at = NOW()
+		WHERE id = $1
+	`
+	if _, err := r.tx.ExecContext(ctx, query, entryID, input.AllowancesTotal, input.DeductionsTotal, input.TaxTotal, input.GrossPay, input.NetPay, input.EmployerContributionsTotal); err != nil {
+		return fmt.Errorf("update payroll entry totals: %w", err)
+	}
+	return nil
+}
+
+func (r *sqlxTxRepository) DeleteEmployeePaymentMethods(ctx context.Context, employeeID int64) error {
+	query := `DELETE FROM employee_payment_methods WHERE employee_id = $1`
+	if _, err := r.tx.ExecContext(ctx, query, employeeID); err != nil {
+		return fmt.Errorf("delete employee payment methods: %w", err)
+	}
+	return nil
+}
+
+func (r *sqlxTxRepository) CreateEmployeePaymentMethod(ctx context.Context, employeeID int64, input EmployeePaymentMethodInput) error {
+	query := `
+		INSERT INTO employee_payment_methods (
+			employee_id,
+			method,
+			bank_name,
+			bank_code,
+			branch,
+			account_name,
+			account_number,
+			provider,
+			msisdn,
+			split_percent
+		)
+		VALUES ($1, $2, NULLIF($3, ''), NULLIF($4, ''), NULLIF($5, ''), NULLIF($6, ''), NULLIF($7, ''), NULLIF($8, ''), NULLIF($9, ''), $10)
+	`
+	if _, err := r.tx.ExecContext(
+		ctx,
+		query,
+		employeeID,
+		input.Method,
+		input.BankName,
+		input.BankCode,
+		input.Branch,
+		input.AccountName,
+		input.AccountNumber,
+		input.Provider,
+		input.MSISDN,
+		input.SplitPercent,
 	); err != nil {
-		return fmt.Errorf("create payroll entry: %w", err)
+		if isForeignKeyViolation(err) {
+			return ErrNotFound
+		}
+		return fmt.Errorf("create employee payment method: %w", err)
 	}
 	return nil
 }
 
+func getEntryByID(ctx context.Context, q sqlx.QueryerContext, entryID int64) (*PayrollEntry, error) {
+	query := `
+		SELECT
+			pe.id,
+			pe.batch_id,
+			pe.employee_id,
+			TRIM(CONCAT(e.first_name, ' ', e.last_name)) AS employee_name,
+			pe.base_salary,
+			pe.allowances_total,
+			pe.deductions_total,
+			pe.tax_total,
+			pe.gross_pay,
+			pe.net_pay,
+			pe.employer_contributions_total,
+			pe.full_base_salary,
+			pe.proration_basis,
+			pe.payable_days,
+			pe.period_days,
+			pe.created_at,
+			pe.updated_at
+		FROM payroll_entries pe
+		INNER JOIN employees e ON e.id = pe.employee_id
+		WHERE pe.id = $1
+	`
+
+	var entry PayrollEntry
+	if err := sqlx.GetContext(ctx, q, &entry, query, entryID); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get payroll entry by id: %w", err)
+	}
+	return &entry, nil
+}
+
+// listSalaryRates returns the salary history of one employee or, with employeeID 0, everyone, oldest first.
+func listSalaryRates(ctx context.Context, q sqlx.QueryerContext, employeeID int64) ([]SalaryRate, error) {
+	query := `
+		SELECT employee_id, amount, effective_from, created_at
+		FROM employee_salary_history
+		WHERE $1::BIGINT = 0 OR employee_id = $1
+		ORDER BY employee_id ASC, effective_from ASC
+	`
+
+	items := make([]SalaryRate, 0)
+	if err := sqlx.SelectContext(ctx, q, &items, query, employeeID); err != nil {
+		return nil, fmt.Errorf("list salary rates: %w", err)
+	}
+	return items, nil
+}
+
+func listEntryLines(ctx context.Context, q sqlx.QueryerContext, entryID int64) ([]PayrollEntryLine, error) {
+	query := `
+		SELECT id, entry_id, component_id, scheme_id, code, name, kind, taxable, amount, employer_amount, created_at,
+			quantity, rate, loan_id, arrears_month
+		FROM payroll_entry_lines
+		WHERE entry_id = $1
+		ORDER BY kind ASC, name ASC, id ASC
+	`
+
+	items := make([]PayrollEntryLine, 0)
+	if err := sqlx.SelectContext(ctx, q, &items, query, entryID); err != nil {
+		return nil, fmt.Errorf("list payroll entry lines: %w", err)
+	}
+	return items, nil
+}
+
+func listEntriesByBatchID(ctx context.Context, q sqlx.QueryerContext, batchID int64) ([]PayrollEntry, error) {
+	query := `
+		SELECT
+			pe.id,
+			pe.batch_id,
+			pe.employee_id,
+			TRIM(CONCAT(e.first_name, ' ', e.last_name)) AS employee_name,
+			pe.base_salary,
+			pe.allowances_total,
+			pe.deductions_total,
+			pe.tax_total,
+			pe.gross_pay,
+			pe.net_pay,
+			pe.employer_contributions_total,
+			pe.full_base_salary,
+			pe.proration_basis,
+			pe.payable_days,
+			pe.period_days,
+			pe.created_at,
+			pe.updated_at
+		FROM payroll_entries pe
+		INNER JOIN employees e ON e.id = pe.employee_id
+		WHERE pe.batch_id = $1
+		ORDER BY e.last_name ASC, e.first_name ASC
+	`
+
+	items := make([]PayrollEntry, 0)
+	if err := sqlx.SelectContext(ctx, q, &items, query, batchID); err != nil {
+		return nil, fmt.Errorf("list payroll entries: %w", err)
+	}
+
+	return items, nil
+}
+
+func listEntryLinesByBatchID(ctx context.Context, q sqlx.QueryerContext, batchID int64) ([]PayrollEntryLine, error) {
+	query := `
+		SELECT
+			pel.id,
+			pel.entry_id,
+			pel.component_id,
+			pel.scheme_id,
+			pel.code,
+			pel.name,
+			pel.kind,
+			pel.taxable,
+			pel.amount,
+			pel.employer_amount,
+			pel.created_at,
+			pel.quantity,
+			pel.rate,
+			pel.loan_id,
+			pel.arrears_month
+		FROM payroll_entry_lines pel
+		INNER JOIN payroll_entries pe ON pe.id = pel.entry_id
+		WHERE pe.batch_id = $1
+		ORDER BY pel.entry_id ASC, pel.kind ASC, pel.name ASC, pel.id ASC
+	`
+
+	items := make([]PayrollEntryLine, 0)
+	if err := sqlx.SelectContext(ctx, q, &items, query, batchID); err != nil {
+		return nil, fmt.Errorf("list payroll entry lines by batch id: %w", err)
+	}
+	return items, nil
+}
+
+func listEntryLineSourcesByBatchID(ctx context.Context, q sqlx.QueryerContext, batchID int64) ([]PayrollEntryLineSource, error) {
+	query := `
+		SELECT pels.id, pels.line_id, pels.source_type, pels.source_id, pels.days
+		FROM payroll_entry_line_sources pels
+		INNER JOIN payroll_entry_lines pel ON pel.id = pels.line_id
+		INNER JOIN payroll_entries pe ON pe.id = pel.entry_id
+		WHERE pe.batch_id = $1
+		ORDER BY pels.line_id ASC, pels.source_type ASC, pels.source_id ASC
+	`
+
+	items := make([]PayrollEntryLineSource, 0)
+	if err := sqlx.SelectContext(ctx, q, &items, query, batchID); err != nil {
+		return nil, fmt.Errorf("list payroll entry line sources by batch id: %w", err)
+	}
+	return items, nil
+}
+
+const paymentMethodColumns = `id, employee_id, method, bank_name, bank_code, branch, account_name, account_number, provider, msisdn, split_percent, created_at, updated_at`
+
+const contributionSchemeColumns = `id, code, name, employee_rate, employer_rate, base, members_only, active, created_at, updated_at`
+
+func listContributionSchemes(ctx context.Context, q sqlx.QueryerContext, activeOnly bool) ([]ContributionScheme, error) {
+	query := "SELECT " + contributionSchemeColumns + " FROM contribution_schemes"
+	args := make([]any, 0)
+	if activeOnly {
+		query += " WHERE active = $1"
+		args = append(args, true)
+	}
+	query += " ORDER BY code ASC"
+
+	items := make([]ContributionScheme, 0)
+	if err := sqlx.SelectContext(ctx, q, &items, query, args...); err != nil {
+		return nil, fmt.Errorf("list contribution schemes: %w", err)
+	}
+	return items, nil
+}
+
+func isForeignKeyViolation(err error) bool {
+	pgErr := &pgconn.PgError{}
+	if errors.As(err, &pgErr) {
+		return pgErr.Code == "23503"
+	}
+	return false
+}
+
 func isUniqueViolation(err error) bool {
 	pgErr := &pgconn.PgError{}
 	if errors.As(err, &pgErr) {
@@ -375,3 +1956,205 @@ func isUniqueViolation(err error) bool {
 	}
 	return false
 }
+
+const loanColumns = `
+	l.id, l.employee_id, TRIM(CONCAT(e.first_name, ' ', e.last_name)) AS employee_name, l.loan_type, l.description,
+	l.principal, l.interest_rate,
+	l.total_repayable, l.installments,
+	l.installment_amount, l.start_month,
+	l.outstanding_balance, l.status, l.created_by, l.created_at, l.updated_at
+`
+
+const loanRepaymentColumns = `
+	id, loan_id, batch_id, entry_id, month, amount,
+	balance_after, created_at
+`
+
+func (r *SQLXRepository) ListLoans(ctx context.Context, filter ListLoansFilter) ([]EmployeeLoan, error) {
+	query := `
+		SELECT ` + loanColumns + `
+		FROM employee_loans l
+		INNER JOIN employees e ON e.id = l.employee_id
+		WHERE ($1 = 0 OR l.employee_id = $1)
+		  AND ($2 = '' OR l.status = $2)
+		ORDER BY l.created_at DESC, l.id DESC
+	`
+
+	items := make([]EmployeeLoan, 0)
+	if err := r.db.SelectContext(ctx, &items, query, filter.EmployeeID, filter.Status); err != nil {
+		return nil, fmt.Errorf("list employee loans: %w", err)
+	}
+	return items, nil
+}
+
+func (r *SQLXRepository) GetLoanByID(ctx context.Context, id int64) (*EmployeeLoan, error) {
+	query := `
+		SELECT ` + loanColumns + `
+		FROM employee_loans l
+		INNER JOIN employees e ON e.id = l.employee_id
+		WHERE l.id = $1
+	`
+
+	var item EmployeeLoan
+	if err := r.db.GetContext(ctx, &item, query, id); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get employee loan by id: %w", err)
+	}
+	return &item, nil
+}
+
+func (r *SQLXRepository) CreateLoan(ctx context.Context, input LoanInsertInput) (*EmployeeLoan, error) {
+	query := `
+		INSERT INTO employee_loans (
+			employee_id, loan_type, description, principal, interest_rate, total_repayable,
+			installments, installment_amount, start_month, outstanding_balance, created_by
+		)
+		VALUES ($1, $2, NULLIF($3, ''), $4, $5, $6, $7, $8, $9, $6, $10)
+		RETURNING id
+	`
+
+	var id int64
+	if err := r.db.GetContext(
+		ctx,
+		&id,
+		query,
+		input.EmployeeID,
+		input.LoanType,
+		input.Description,
+		input.Principal,
+		input.InterestRate,
+		input.TotalRepayable,
+		input.Installments,
+		input.InstallmentAmount,
+		input.StartMonth,
+		input.CreatedBy,
+	); err != nil {
+		if isForeignKeyViolation(err) {
+			return nil, fmt.Errorf("%w: employee does not exist", ErrValidation)
+		}
+		return nil, fmt.Errorf("create employee loan: %w", err)
+	}
+	return r.GetLoanByID(ctx, id)
+}
+
+func (r *SQLXRepository) SetLoanStatus(ctx context.Context, id int64, status string) (*EmployeeLoan, error) {
+	query := `UPDATE employee_loans SET status = $2, updated_at = NOW() WHERE id = $1`
+	result, err := r.db.ExecContext(ctx, query, id, status)
+	if err != nil {
+		return nil, fmt.Errorf("set employee loan status: %w", err)
+	}
+	if affected, err := result.RowsAffected(); err == nil && affected == 0 {
+		return nil, nil
+	}
+	return r.GetLoanByID(ctx, id)
+}
+
+func (r *SQLXRepository) ListLoanRepayments(ctx context.Context, loanID int64) ([]LoanRepayment, error) {
+	query := `
+		SELECT ` + loanRepaymentColumns + `
+		FROM employee_loan_repayments
+		WHERE loan_id = $1
+		ORDER BY month ASC, id ASC
+	`
+
+	items := make([]LoanRepayment, 0)
+	if err := r.db.SelectContext(ctx, &items, query, loanID); err != nil {
+		return nil, fmt.Errorf("list employee loan repayments: %w", err)
+	}
+	return items, nil
+}
+
+// ListRecoverableLoans returns active loans with a balance left whose repayments have started by month. The
+// outstanding balance is net of recoveries already deducted in unlocked batches of other months, so a month
+// generated before the previous one locks does not take the same installment again.
+func (r *sqlxTxRepository) ListRecoverableLoans(ctx context.Context, month string) ([]EmployeeLoan, error) {
+	query := `
+		SELECT
+			l.id, l.employee_id, TRIM(CONCAT(e.first_name, ' ', e.last_name)) AS employee_name, l.loan_type, l.description,
+			l.principal, l.interest_rate,
+			l.total_repayable, l.installments,
+			l.installment_amount, l.start_month,
+			l.outstanding_balance - COALESCE(pending.amount, 0) AS outstanding_balance,
+			l.status, l.created_by, l.created_at, l.updated_at
+		FROM employee_loans l
+		INNER JOIN employees e ON e.id = l.employee_id
+		LEFT JOIN (
+			SELECT pel.loan_id, SUM(pel.amount) AS amount
+			FROM payroll_entry_lines pel
+			INNER JOIN payroll_entries pe ON pe.id = pel.entry_id
+			INNER JOIN payroll_batches b ON b.id = pe.batch_id
+			WHERE pel.loan_id IS NOT NULL
+			  AND pel.amount > 0
+			  AND b.status <> $2
+			  AND b.month <> $1
+			GROUP BY pel.loan_id
+		) pending ON pending.loan_id = l.id
+		WHERE l.status = 'active'
+		  AND l.outstanding_balance - COALESCE(pending.amount, 0) > 0
+		  AND l.start_month <= $1
+		ORDER BY l.employee_id ASC, l.start_month ASC, l.id ASC
+	`
+
+	items := make([]EmployeeLoan, 0)
+	if err := r.tx.SelectContext(ctx, &items, query, month, StatusLocked); err != nil {
+		return nil, fmt.Errorf("list recoverable loans: %w", err)
+	}
+	return items, nil
+}
+
+func (r *sqlxTxRepository) ListLoanRecoveries(ctx context.Context, batchID int64) ([]LoanRecovery, error) {
+	query := `
+		SELECT pel.loan_id, pel.entry_id, pe.employee_id, SUM(pel.amount) AS amount
+		FROM payroll_entry_lines pel
+		INNER JOIN payroll_entries pe ON pe.id = pel.entry_id
+		WHERE pe.batch_id = $1 AND pel.loan_id IS NOT NULL
+		GROUP BY pel.loan_id, pel.entry_id, pe.employee_id
+		HAVING SUM(pel.amount) <> 0
+		ORDER BY pel.loan_id ASC
+	`
+
+	items := make([]LoanRecovery, 0)
+	if err := r.tx.SelectContext(ctx, &items, query, batchID); err != nil {
+		return nil, fmt.Errorf("list loan recoveries: %w", err)
+	}
+	return items, nil
+}
+
+// ApplyLoanRepayment reduces the loan balance by the recovered amount, settles the loan once nothing is
+// left, and records the repayment in the loan ledger. A recovery larger than the outstanding balance is
+// rejected rather than floored at zero. A negative amount comes from a reversal batch: it restores the
+// balance and reopens a settled loan.
+func (r *sqlxTxRepository) ApplyLoanRepayment(ctx context.Context, batch PayrollBatch, recovery LoanRecovery) (*LoanRepayment, error) {
+	updateQuery := `
+		UPDATE employee_loans
+		SET outstanding_balance = LEAST(outstanding_balance - $2, total_repayable),
+			status = CASE
+				WHEN outstanding_balance - $2 <= 0 THEN 'settled'
+				WHEN status = 'settled' THEN 'active'
+				ELSE status
+			END,
+			updated_at = NOW()
+		WHERE id = $1
+		  AND outstanding_balance >= $2
+		RETURNING outstanding_balance
+	`
+	var balance money.Amount
+	if err := r.tx.GetContext(ctx, &balance, updateQuery, recovery.LoanID, recovery.Amount); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("%w: loan %d recovery of %s exceeds its outstanding balance; regenerate the batch", ErrValidation, recovery.LoanID, recovery.Amount)
+		}
+		return nil, fmt.Errorf("apply loan repayment: %w", err)
+	}
+
+	insertQuery := `
+		INSERT INTO employee_loan_repayments (loan_id, batch_id, entry_id, month, amount, balance_after)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING ` + loanRepaymentColumns
+	var repayment LoanRepayment
+	if err := r.tx.GetContext(ctx, &repayment, insertQuery, recovery.LoanID, batch.ID, recovery.EntryID, batch.Month, recovery.Amount, balance); err != nil {
+		return nil, fmt.Errorf("record loan repayment: %w", err)
+	}
+	return &repayment, nil
+}
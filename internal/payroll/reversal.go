@@ -0,0 +1,181 @@
+package payroll
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"hrpro/internal/models"
+)
+
+// ReopenPayrollBatch sends an approved batch back to Draft so it can be corrected. The approval chain restarts.
+func (s *Service) ReopenPayrollBatch(ctx context.Context, claims *models.Claims, batchID int64, reason string) (*PayrollBatch, error) {
+	if claims == nil {
+		return nil, ErrForbidden
+	}
+	if batchID <= 0 {
+		return nil, fmt.Errorf("%w: batch id must be positive", ErrValidation)
+	}
+	reason, err := normalizeApprovalComment(reason)
+	if err != nil {
+		return nil, err
+	}
+	if reason == "" {
+		return nil, fmt.Errorf("%w: a reason is required to reopen a batch", ErrValidation)
+	}
+
+	batch, err := s.repository.GetBatchByID(ctx, batchID)
+	if err != nil {
+		return nil, err
+	}
+	if batch == nil {
+		return nil, ErrNotFound
+	}
+	if batch.Status != StatusApproved {
+		return nil, fmt.Errorf("%w: only approved batches can be reopened; reverse a locked batch instead", ErrInvalidTransition)
+	}
+	policy, err := s.resolveApprovalPolicy(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lastStep := policy.Steps[len(policy.Steps)-1]
+
+	var updated *PayrollBatch
+	err = s.repository.WithTx(ctx, func(tx TxRepository) error {
+		if err := tx.CreateBatchApproval(ctx, BatchApprovalInput{
+			BatchID:    batchID,
+			StepNumber: len(policy.Steps),
+			StepName:   lastStep.Name,
+			Action:     ApprovalActionReopened,
+			UserID:     claims.UserID,
+			Comment:    reason,
+		}); err != nil {
+			return err
+		}
+		updated, err = tx.SetBatchDraft(ctx, batchID)
+		if err != nil {
+			return err
+		}
+		if updated == nil {
+			return ErrNotFound
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.audit.RecordAuditEvent(ctx, claimsUserID(claims), "payroll.batch.reopen", stringPtr("payroll_batch"), &updated.ID, map[string]any{
+		"month":       updated.Month,
+		"status":      updated.Status,
+		"reason":      reason,
+		"approved_by": batch.ApprovedBy,
+		"approved_at": batch.ApprovedAt,
+	})
+	return updated, nil
+}
+
+// ReversePayrollBatch creates a Draft correction batch whose entries and lines are the negatives of a locked
+// batch, leaving the locked batch untouched. The reversal is approved and locked like any other batch.
+func (s *Service) ReversePayrollBatch(ctx context.Context, claims *models.Claims, batchID int64, reason string) (*PayrollBatch, error) {
+	if claims == nil {
+		return nil, ErrForbidden
+	}
+	if batchID <= 0 {
+		return nil, fmt.Errorf("%w: batch id must be positive", ErrValidation)
+	}
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, fmt.Errorf("%w: a reason is required to reverse a batch", ErrValidation)
+	}
+	if len(reason) > 150 {
+		return nil, fmt.Errorf("%w: reason is too long", ErrValidation)
+	}
+
+	batch, err := s.repository.GetBatchByID(ctx, batchID)
+	if err != nil {
+		return nil, err
+	}
+	if batch == nil {
+		return nil, ErrNotFound
+	}
+	if batch.Status != StatusLocked {
+		return nil, fmt.Errorf("%w: only locked batches can be reversed; reopen an approved batch instead", ErrInvalidTransition)
+	}
+	if batch.ReversesBatchID != nil {
+		return nil, fmt.Errorf("%w: a reversal batch cannot be reversed", ErrInvalidTransition)
+	}
+	existing, err := s.repository.GetReversalBatch(ctx, batchID)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, fmt.Errorf("%w: batch was already reversed by batch %d", ErrInvalidTransition, existing.ID)
+	}
+	entries, err := s.listEntriesWithLines(ctx, batchID)
+	if err != nil {
+		return nil, err
+	}
+
+	description := fmt.Sprintf("Reversal of batch %d: %s", batch.ID, reason)
+	var reversal *PayrollBatch
+	err = s.repository.WithTx(ctx, func(tx TxRepository) error {
+		reversal, err = tx.CreateReversalBatch(ctx, *batch, description, claims.UserID)
+		if err != nil {
+			return err
+		}
+		for _, entry := range entries {
+			entryID, err := tx.CreateEntry(ctx, EntryCreateInput{
+				BatchID:                    reversal.ID,
+				EmployeeID:                 entry.EmployeeID,
+				BaseSalary:                 -entry.BaseSalary,
+				AllowancesTotal:            -entry.AllowancesTotal,
+				DeductionsTotal:            -entry.DeductionsTotal,
+				TaxTotal:                   -entry.TaxTotal,
+				GrossPay:                   -entry.GrossPay,
+				NetPay:                     -entry.NetPay,
+				EmployerContributionsTotal: -entry.EmployerContributionsTotal,
+			})
+			if err != nil {
+				return err
+			}
+			for _, line := range entry.Lines {
+				if err := tx.CreateEntryLine(ctx, entryLineCreateInput(entryID, reversalLine(line))); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.audit.RecordAuditEvent(ctx, claimsUserID(claims), "payroll.batch.reverse", stringPtr("payroll_batch"), &batch.ID, map[string]any{
+		"month":             batch.Month,
+		"batch_type":        batch.BatchType,
+		"reversal_batch_id": reversal.ID,
+		"entries":           len(entries),
+		"reason":            reason,
+	})
+	return reversal, nil
+}
+
+// requireEditable allows entry changes only in Draft batches that are not reversals, which must offset their
+// locked batch exactly.
+func requireEditable(batch PayrollBatch) error {
+	if batch.Status != StatusDraft {
+		return ErrImmutableBatch
+	}
+	if batch.ReversesBatchID != nil {
+		return fmt.Errorf("%w: reversal batches cannot be changed", ErrImmutableBatch)
+	}
+	return nil
+}
+
+// reversalLine negates a line. A loan recovery keeps its loan, so locking the reversal restores the balance;
+// unpaid-day sources stay with the locked batch.
+func reversalLine(line PayrollEntryLine) PayrollEntryLine {
+	line.Amount = -line.Amount
+	line.EmployerAmount = -line.EmployerAmount
+	line.Sources = nil
+	return line
+}
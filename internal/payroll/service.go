@@ -5,29 +5,58 @@ import (
 	"context"
 	"encoding/csv"
 	"fmt"
-	"math"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"hrpro/internal/audit"
 	"hrpro/internal/models"
+	"hrpro/internal/money"
 )
 
-var payrollMonthPattern = regexp.MustCompile(`^\d{4}-\d{2}$`)
+var (
+	payrollMonthPattern  = regexp.MustCompile(`^\d{4}-\d{2}$`)
+	componentCodePattern = regexp.MustCompile(`^[A-Z0-9_-]{2,40}$`)
+)
 
 type Service struct {
 	repository Repository
 	audit      audit.Recorder
 	formatter  FormattingProvider
+	taxRules   TaxRulesProvider
+	policy     PolicyProvider
+	holidays   HolidayProvider
+
+	paymentFormats map[string]PaymentFileFormat
 }
 
 type FormattingProvider interface {
 	GetPayrollFormatting(ctx context.Context) (symbol string, decimals int, rounding bool, err error)
 }
 
+type TaxRulesProvider interface {
+	GetPayrollTaxTables(ctx context.Context) ([]TaxTable, error)
+}
+
+type PolicyProvider interface {
+	GetPayrollProrationBasis(ctx context.Context) (string, error)
+	GetPayrollVariancePolicy(ctx context.Context) (VariancePolicy, error)
+	GetPayrollApprovalPolicy(ctx context.Context) (ApprovalPolicy, error)
+}
+
+// HolidayProvider supplies the public holidays that do not count as working days.
+type HolidayProvider interface {
+	HolidayDates(ctx context.Context, from, to time.Time) ([]time.Time, error)
+}
+
 func NewService(repository Repository) *Service {
-	return &Service{repository: repository, audit: audit.NewNoopRecorder()}
+	return &Service{
+		repository:     repository,
+		audit:          audit.NewNoopRecorder(),
+		paymentFormats: defaultPaymentFileFormats(),
+	}
 }
 
 func (s *Service) SetAuditRecorder(recorder audit.Recorder) {
@@ -42,6 +71,18 @@ func (s *Service) SetFormattingProvider(provider FormattingProvider) {
 	s.formatter = provider
 }
 
+func (s *Service) SetTaxRulesProvider(provider TaxRulesProvider) {
+	s.taxRules = provider
+}
+
+func (s *Service) SetPolicyProvider(provider PolicyProvider) {
+	s.policy = provider
+}
+
+func (s *Service) SetHolidayProvider(provider HolidayProvider) {
+	s.holidays = provider
+}
+
 func (s *Service) ListPayrollBatches(ctx context.Context, filter ListBatchesFilter) (*ListBatchesResult, error) {
 	if filter.Status != "" && !isAllowedStatus(filter.Status) {
 		return nil, fmt.Errorf("%w: invalid payroll status", ErrValidation)
@@ -60,23 +101,99 @@ func (s *Service) ListPayrollBatches(ctx context.Context, filter ListBatchesFilt
 	}, nil
 }
 
+func (s *Service) ListPayComponents(ctx context.Context, activeOnly bool) ([]PayComponent, error) {
+	return s.repository.ListPayComponents(ctx, activeOnly)
+}
+
+func (s *Service) CreatePayComponent(ctx context.Context, input PayComponentUpsertInput) (*PayComponent, error) {
+	normalized, err := normalizePayComponentInput(input)
+	if err != nil {
+		return nil, err
+	}
+	item, err := s.repository.CreatePayComponent(ctx, normalized)
+	if err != nil {
+		return nil, err
+	}
+	s.audit.RecordAuditEvent(ctx, nil, "payroll.component.create", stringPtr("pay_component"), &item.ID, map[string]any{
+		"code": item.Code,
+		"kind": item.Kind,
+	})
+	return item, nil
+}
+
+func (s *Service) UpdatePayComponent(ctx context.Context, id int64, input PayComponentUpsertInput) (*PayComponent, error) {
+	if id <= 0 {
+		return nil, fmt.Errorf("%w: component id must be positive", ErrValidation)
+	}
+	normalized, err := normalizePayComponentInput(input)
+	if err != nil {
+		return nil, err
+	}
+	item, err := s.repository.UpdatePayComponent(ctx, id, normalized)
+	if err != nil {
+		return nil, err
+	}
+	if item == nil {
+		return nil, ErrNotFound
+	}
+	s.audit.RecordAuditEvent(ctx, nil, "payroll.component.update", stringPtr("pay_component"), &item.ID, map[string]any{
+		"code": item.Code,
+		"kind": item.Kind,
+	})
+	return item, nil
+}
+
+func (s *Service) SetPayComponentActive(ctx context.Context, id int64, active bool) (*PayComponent, error) {
+	if id <= 0 {
+		return nil, fmt.Errorf("%w: component id must be positive", ErrValidation)
+	}
+	item, err := s.repository.SetPayComponentActive(ctx, id, active)
+	if err != nil {
+		return nil, err
+	}
+	if item == nil {
+		return nil, ErrNotFound
+	}
+	s.audit.RecordAuditEvent(ctx, nil, "payroll.component.set_active", stringPtr("pay_component"), &item.ID, map[string]any{
+		"code":   item.Code,
+		"active": item.Active,
+	})
+	return item, nil
+}
+
 func (s *Service) CreatePayrollBatch(ctx context.Context, claims *models.Claims, input CreateBatchInput) (*PayrollBatch, error) {
 	if claims == nil {
 		return nil, ErrForbidden
 	}
 
-	month := strings.TrimSpace(input.Month)
-	if !payrollMonthPattern.MatchString(month) {
-		return nil, fmt.Errorf("%w: month must be in YYYY-MM format", ErrValidation)
+	normalized, err := normalizeCreateBatchInput(input)
+	if err != nil {
+		return nil, err
+	}
+	for _, componentID := range normalized.ComponentIDs {
+		component, err := s.repository.GetPayComponentByID(ctx, componentID)
+		if err != nil {
+			return nil, err
+		}
+		if component == nil || !component.Active {
+			return nil, fmt.Errorf("%w: pay component %d is not an active component", ErrValidation, componentID)
+		}
 	}
 
-	batch, err := s.repository.CreateBatch(ctx, month, claims.UserID)
+	batch, err := s.repository.CreateBatch(ctx, normalized, claims.UserID)
 	if err != nil {
 		return nil, err
 	}
-	s.audit.RecordAuditEvent(ctx, claimsUserID(claims), "payroll.batch.create", stringPtr("payroll_batch"), &batch.ID, map[string]any{
-		"month": month,
-	})
+	metadata := map[string]any{
+		"month":      batch.Month,
+		"batch_type": batch.BatchType,
+	}
+	if batch.BatchType != BatchTypeRegular {
+		metadata["description"] = batch.Description
+		metadata["employee_ids"] = normalized.EmployeeIDs
+		metadata["component_ids"] = normalized.ComponentIDs
+	}
+	s.audit.RecordAuditEvent(ctx, claimsUserID(claims), "payroll.batch.create", stringPtr("payroll_batch"), &batch.ID, metadata)
 
 	return batch, nil
 }
@@ -94,12 +211,52 @@ func (s *Service) GetPayrollBatch(ctx context.Context, batchID int64) (*PayrollB
 		return nil, ErrNotFound
 	}
 
-	entries, err := s.repository.ListEntriesByBatchID(ctx, batchID)
+	entries, err := s.listEntriesWithLines(ctx, batchID)
+	if err != nil {
+		return nil, err
+	}
+	selection, err := s.repository.GetBatchSelection(ctx, batchID)
+	if err != nil {
+		return nil, err
+	}
+	policy, err := s.resolveApprovalPolicy(ctx)
+	if err != nil {
+		return nil, err
+	}
+	approval, err := s.approvalProgress(ctx, *batch, policy.Steps)
+	if err != nil {
+		return nil, err
+	}
+	taxTable, err := s.resolveTaxTable(ctx, batch.Month)
 	if err != nil {
 		return nil, err
 	}
 
-	return &PayrollBatchDetail{Batch: *batch, Entries: entries}, nil
+	detail := &PayrollBatchDetail{
+		Batch:        *batch,
+		Entries:      entries,
+		EmployeeIDs:  selection.EmployeeIDs,
+		ComponentIDs: selection.ComponentIDs,
+		Approval:     approval,
+		TaxComputed:  taxTable != nil,
+	}
+	if batch.Status == StatusLocked {
+		reversal, err := s.repository.GetReversalBatch(ctx, batchID)
+		if err != nil {
+			return nil, err
+		}
+		if reversal != nil {
+			detail.ReversedByBatchID = &reversal.ID
+		}
+	}
+	return detail, nil
+}
+
+func (s *Service) GetPayrollEntry(ctx context.Context, entryID int64) (*PayrollEntry, error) {
+	if entryID <= 0 {
+		return nil, fmt.Errorf("%w: entry id must be positive", ErrValidation)
+	}
+	return s.getEntryWithLines(ctx, entryID)
 }
 
 func (s *Service) GeneratePayrollEntries(ctx context.Context, batchID int64) error {
@@ -114,36 +271,78 @@ func (s *Service) GeneratePayrollEntries(ctx context.Context, batchID int64) err
 	if batch == nil {
 		return ErrNotFound
 	}
-	if batch.Status != StatusDraft {
-		return ErrImmutableBatch
+	if err := requireEditable(*batch); err != nil {
+		return err
+	}
+
+	taxTable, err := s.resolveTaxTable(ctx, batch.Month)
+	if err != nil {
+		return err
+	}
+	prorationBasis, err := s.resolveProrationBasis(ctx)
+	if err != nil {
+		return err
+	}
+	decimals, err := s.calculationDecimals(ctx)
+	if err != nil {
+		return err
+	}
+	monthToDate, err := s.monthToDate(ctx, *batch)
+	if err != nil {
+		return err
+	}
+	if batch.BatchType != BatchTypeRegular {
+		return s.generateOffCycleEntries(ctx, *batch, taxTable, decimals, monthToDate)
+	}
+	periodStart, periodEnd, err := MonthBounds(batch.Month)
+	if err != nil {
+		return err
+	}
+	calendar, err := s.workCalendar(ctx, periodStart, periodEnd)
+	if err != nil {
+		return err
 	}
 
 	entriesGenerated := 0
+	entriesProrated := 0
+	entriesWithAbsences := 0
+	loanRecoveries := 0
 	err = s.repository.WithTx(ctx, func(tx TxRepository) error {
 		if err := tx.DeleteEntriesByBatchID(ctx, batchID); err != nil {
 			return err
 		}
 
-		employees, err := tx.ListActiveEmployeeSalaries(ctx)
+		data, err := loadRegularGenerationData(ctx, tx, batch.Month, periodStart, periodEnd, calendar)
 		if err != nil {
 			return err
 		}
-
-		for _, employee := range employees {
-			grossPay, netPay := CalculateTotals(employee.BaseSalary, 0, 0, 0)
-			if err := tx.CreateEntry(ctx, EntryCreateInput{
-				BatchID:         batchID,
-				EmployeeID:      employee.EmployeeID,
-				BaseSalary:      employee.BaseSalary,
-				AllowancesTotal: 0,
-				DeductionsTotal: 0,
-				TaxTotal:        0,
-				GrossPay:        grossPay,
-				NetPay:          netPay,
-			}); err != nil {
+		for _, employee := range data.employees {
+			generated, err := computeRegularEntry(batch.Month, prorationBasis, data, employee, taxTable, monthToDate[employee.EmployeeID], decimals)
+			if err != nil {
+				return err
+			}
+			if generated == nil {
+				continue
+			}
+			input := generated.input
+			input.BatchID = batchID
+			entryID, err := tx.CreateEntry(ctx, input)
+			if err != nil {
 				return err
 			}
+			for _, line := range generated.lines {
+				if err := tx.CreateEntryLine(ctx, entryLineCreateInput(entryID, line)); err != nil {
+					return err
+				}
+			}
 			entriesGenerated++
+			if input.PayableDays != nil {
+				entriesProrated++
+			}
+			if generated.withAbsences {
+				entriesWithAbsences++
+			}
+			loanRecoveries += generated.loanRecoveries
 		}
 
 		return nil
@@ -151,18 +350,146 @@ func (s *Service) GeneratePayrollEntries(ctx context.Context, batchID int64) err
 	if err != nil {
 		return err
 	}
-	s.audit.RecordAuditEvent(ctx, nil, "payroll.batch.generate", stringPtr("payroll_batch"), &batchID, map[string]any{
-		"month":             batch.Month,
-		"entries_generated": entriesGenerated,
-	})
+	metadata := map[string]any{
+		"month":                 batch.Month,
+		"entries_generated":     entriesGenerated,
+		"entries_prorated":      entriesProrated,
+		"entries_with_absences": entriesWithAbsences,
+		"loan_recoveries":       loanRecoveries,
+		"proration_basis":       prorationBasis,
+	}
+	if taxTable != nil {
+		metadata["tax_table_effective_from"] = taxTable.EffectiveFrom
+	}
+	s.audit.RecordAuditEvent(ctx, nil, "payroll.batch.generate", stringPtr("payroll_batch"), &batchID, metadata)
 	return nil
 }
 
+// regularGenerationData is everything the regular batch pipeline reads for one payroll month.
+type regularGenerationData struct {
+	employees          []EmployeeSalary
+	components         []PayComponent
+	schemes            []ContributionScheme
+	memberships        map[int64]map[int64]bool
+	absencesByEmployee map[int64][]AbsenceRecord
+	loansByEmployee    map[int64][]EmployeeLoan
+	rates              map[int64][]SalaryRate
+	calendar           WorkCalendar
+}
+
+// generatedEntry is a computed regular entry and its lines before it is stored.
+type generatedEntry struct {
+	input          EntryCreateInput
+	lines          []PayrollEntryLine
+	withAbsences   bool
+	loanRecoveries int
+}
+
+func loadRegularGenerationData(ctx context.Context, tx TxRepository, month string, periodStart, periodEnd time.Time, calendar WorkCalendar) (*regularGenerationData, error) {
+	employees, err := tx.ListActiveEmployeeSalaries(ctx, periodStart, periodEnd)
+	if err != nil {
+		return nil, err
+	}
+	components, err := tx.ListAutoApplyComponents(ctx)
+	if err != nil {
+		return nil, err
+	}
+	schemes, err := tx.ListContributionSchemes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	members, err := tx.ListContributionSchemeMembers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	absences, err := tx.ListAbsenceRecords(ctx, periodStart, periodEnd)
+	if err != nil {
+		return nil, err
+	}
+	absencesByEmployee := make(map[int64][]AbsenceRecord)
+	for _, record := range absences {
+		absencesByEmployee[record.EmployeeID] = append(absencesByEmployee[record.EmployeeID], record)
+	}
+	loans, err := tx.ListRecoverableLoans(ctx, month)
+	if err != nil {
+		return nil, err
+	}
+	loansByEmployee := make(map[int64][]EmployeeLoan)
+	for _, loan := range loans {
+		loansByEmployee[loan.EmployeeID] = append(loansByEmployee[loan.EmployeeID], loan)
+	}
+	salaryRates, err := tx.ListSalaryRates(ctx, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return &regularGenerationData{
+		employees:          employees,
+		components:         components,
+		schemes:            schemes,
+		memberships:        contributionMemberships(members),
+		absencesByEmployee: absencesByEmployee,
+		loansByEmployee:    loansByEmployee,
+		rates:              salaryRatesByEmployee(salaryRates),
+		calendar:           calendar,
+	}, nil
+}
+
+// computeRegularEntry runs the regular calculation pipeline for one employee: prorated base salary,
+// auto-apply components, absence deductions, contributions, tax and loan recoveries. It returns nil when the
+// employee has no payable days in the month.
+func computeRegularEntry(month, prorationBasis string, data *regularGenerationData, employee EmployeeSalary, taxTable *TaxTable, monthToDate MonthToDate, decimals int) (*generatedEntry, error) {
+	baseSalary, fullBaseSalary, proration, err := PeriodBaseSalary(month, prorationBasis, data.calendar, employee, data.rates[employee.EmployeeID])
+	if err != nil {
+		return nil, err
+	}
+	if proration.PayableDays == 0 {
+		return nil, nil
+	}
+	baseSalary = baseSalary.Round(decimals)
+
+	generated := &generatedEntry{lines: make([]PayrollEntryLine, 0, len(data.components)+len(data.schemes))}
+	for _, component := range data.components {
+		generated.lines = append(generated.lines, newComponentLine(component, CalculateComponentAmount(component, baseSalary).Round(decimals)))
+	}
+	absenceLines, err := AbsenceLines(month, prorationBasis, data.calendar, employee, proration.PeriodDays, baseSalary, data.absencesByEmployee[employee.EmployeeID])
+	if err != nil {
+		return nil, err
+	}
+	roundLines(absenceLines, decimals)
+	generated.withAbsences = len(absenceLines) > 0
+	generated.lines = append(generated.lines, absenceLines...)
+	generated.lines = append(generated.lines, contributionLines(data.schemes, data.memberships, employee.EmployeeID, baseSalary, generated.lines, decimals)...)
+	totals := calculateEntryTotals(baseSalary, generated.lines, taxTable, 0, monthToDate, decimals)
+	if recoveries := loanLines(data.loansByEmployee[employee.EmployeeID], totals.NetPay); len(recoveries) > 0 {
+		generated.lines = append(generated.lines, recoveries...)
+		totals = calculateEntryTotals(baseSalary, generated.lines, taxTable, 0, monthToDate, decimals)
+		generated.loanRecoveries = len(recoveries)
+	}
+	generated.input = EntryCreateInput{
+		EmployeeID:                 employee.EmployeeID,
+		BaseSalary:                 baseSalary,
+		AllowancesTotal:            totals.AllowancesTotal,
+		DeductionsTotal:            totals.DeductionsTotal,
+		TaxTotal:                   totals.TaxTotal,
+		GrossPay:                   totals.GrossPay,
+		NetPay:                     totals.NetPay,
+		EmployerContributionsTotal: totals.EmployerContributionsTotal,
+	}
+	if !proration.Full() {
+		generated.input.FullBaseSalary = &fullBaseSalary
+		generated.input.ProrationBasis = &proration.Basis
+		generated.input.PayableDays = &proration.PayableDays
+		generated.input.PeriodDays = &proration.PeriodDays
+	}
+	return generated, nil
+}
+
 func (s *Service) UpdatePayrollEntryAmounts(ctx context.Context, entryID int64, input UpdateEntryAmountsInput) (*PayrollEntry, error) {
 	if entryID <= 0 {
 		return nil, fmt.Errorf("%w: entry id must be positive", ErrValidation)
 	}
-	if input.AllowancesTotal < 0 || input.DeductionsTotal < 0 || input.TaxTotal < 0 {
+	if input.AllowancesTotal < 0 || input.DeductionsTotal < 0 || (input.TaxTotal != nil && *input.TaxTotal < 0) {
 		return nil, fmt.Errorf("%w: amounts must be non-negative", ErrValidation)
 	}
 
@@ -173,8 +500,8 @@ func (s *Service) UpdatePayrollEntryAmounts(ctx context.Context, entryID int64,
 	if batch == nil {
 		return nil, ErrNotFound
 	}
-	if batch.Status != StatusDraft {
-		return nil, ErrImmutableBatch
+	if err := requireEditable(*batch); err != nil {
+		return nil, err
 	}
 
 	detail, err := s.GetPayrollBatch(ctx, batch.ID)
@@ -192,9 +519,34 @@ func (s *Service) UpdatePayrollEntryAmounts(ctx context.Context, entryID int64,
 	if existing == nil {
 		return nil, ErrNotFound
 	}
+	if len(existing.Lines) > 0 {
+		allowancesTotal, deductionsTotal := CalculateLineTotals(existing.Lines)
+		if input.AllowancesTotal != allowancesTotal || input.DeductionsTotal != deductionsTotal {
+			return nil, fmt.Errorf("%w: allowances and deductions of an itemized entry are derived from its line items", ErrValidation)
+		}
+	}
+
+	taxTable, err := s.resolveTaxTable(ctx, batch.Month)
+	if err != nil {
+		return nil, err
+	}
+	decimals, err := s.calculationDecimals(ctx)
+	if err != nil {
+		return nil, err
+	}
+	monthToDate, err := s.monthToDate(ctx, *batch)
+	if err != nil {
+		return nil, err
+	}
+	input.AllowancesTotal = input.AllowancesTotal.Round(decimals)
+	input.DeductionsTotal = input.DeductionsTotal.Round(decimals)
+	taxTotal, err := resolveEntryTax(taxTable, existing.BaseSalary, input.AllowancesTotal, existing.Lines, input.TaxTotal, existing.TaxTotal, monthToDate[existing.EmployeeID], decimals)
+	if err != nil {
+		return nil, err
+	}
 
-	grossPay, netPay := CalculateTotals(existing.BaseSalary, input.AllowancesTotal, input.DeductionsTotal, input.TaxTotal)
-	updated, err := s.repository.UpdateEntryAmounts(ctx, entryID, input.AllowancesTotal, input.DeductionsTotal, input.TaxTotal, grossPay, netPay)
+	grossPay, netPay := CalculateTotals(existing.BaseSalary, input.AllowancesTotal, input.DeductionsTotal, taxTotal)
+	updated, err := s.repository.UpdateEntryAmounts(ctx, entryID, input.AllowancesTotal, input.DeductionsTotal, taxTotal, grossPay, netPay)
 	if err != nil {
 		return nil, err
 	}
@@ -211,13 +563,156 @@ func (s *Service) UpdatePayrollEntryAmounts(ctx context.Context, entryID int64,
 	return updated, nil
 }
 
-func (s *Service) ApprovePayrollBatch(ctx context.Context, claims *models.Claims, batchID int64) (*PayrollBatch, error) {
+func (s *Service) AddPayrollEntryLine(ctx context.Context, entryID int64, input AddEntryLineInput) (*PayrollEntry, error) {
+	if entryID <= 0 {
+		return nil, fmt.Errorf("%w: entry id must be positive", ErrValidation)
+	}
+	if input.ComponentID <= 0 {
+		return nil, fmt.Errorf("%w: component id must be positive", ErrValidation)
+	}
+	if input.Amount != nil && *input.Amount < 0 {
+		return nil, fmt.Errorf("%w: amount must be non-negative", ErrValidation)
+	}
+
+	batch, err := s.requireDraftEntryBatch(ctx, entryID)
+	if err != nil {
+		return nil, err
+	}
+	taxTable, err := s.resolveTaxTable(ctx, batch.Month)
+	if err != nil {
+		return nil, err
+	}
+	decimals, err := s.calculationDecimals(ctx)
+	if err != nil {
+		return nil, err
+	}
+	monthToDate, err := s.monthToDate(ctx, *batch)
+	if err != nil {
+		return nil, err
+	}
+
+	component, err := s.repository.GetPayComponentByID(ctx, input.ComponentID)
+	if err != nil {
+		return nil, err
+	}
+	if component == nil {
+		return nil, ErrNotFound
+	}
+	if !component.Active {
+		return nil, fmt.Errorf("%w: pay component is inactive", ErrValidation)
+	}
+
+	var line PayrollEntryLine
+	err = s.repository.WithTx(ctx, func(tx TxRepository) error {
+		entry, err := tx.GetEntryByID(ctx, entryID)
+		if err != nil {
+			return err
+		}
+		if entry == nil {
+			return ErrNotFound
+		}
+
+		amount := CalculateComponentAmount(*component, entry.BaseSalary)
+		if input.Amount != nil {
+			amount = *input.Amount
+		}
+		amount = amount.Round(decimals)
+		line = newComponentLine(*component, amount)
+		if err := tx.CreateEntryLine(ctx, entryLineCreateInput(entryID, line)); err != nil {
+			return err
+		}
+		return recalculateEntry(ctx, tx, *entry, taxTable, monthToDate[entry.EmployeeID], decimals)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := s.getEntryWithLines(ctx, entryID)
+	if err != nil {
+		return nil, err
+	}
+	s.audit.RecordAuditEvent(ctx, nil, "payroll.entry.line.add", stringPtr("payroll_entry"), &updated.ID, map[string]any{
+		"batch_id":     updated.BatchID,
+		"employee_id":  updated.EmployeeID,
+		"component_id": component.ID,
+		"code":         line.Code,
+		"amount":       line.Amount,
+	})
+	return updated, nil
+}
+
+func (s *Service) RemovePayrollEntryLine(ctx context.Context, lineID int64) (*PayrollEntry, error) {
+	if lineID <= 0 {
+		return nil, fmt.Errorf("%w: line id must be positive", ErrValidation)
+	}
+
+	line, err := s.repository.GetEntryLineByID(ctx, lineID)
+	if err != nil {
+		return nil, err
+	}
+	if line == nil {
+		return nil, ErrNotFound
+	}
+	batch, err := s.requireDraftEntryBatch(ctx, line.EntryID)
+	if err != nil {
+		return nil, err
+	}
+	taxTable, err := s.resolveTaxTable(ctx, batch.Month)
+	if err != nil {
+		return nil, err
+	}
+	decimals, err := s.calculationDecimals(ctx)
+	if err != nil {
+		return nil, err
+	}
+	monthToDate, err := s.monthToDate(ctx, *batch)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.repository.WithTx(ctx, func(tx TxRepository) error {
+		entry, err := tx.GetEntryByID(ctx, line.EntryID)
+		if err != nil {
+			return err
+		}
+		if entry == nil {
+			return ErrNotFound
+		}
+		if err := tx.DeleteEntryLine(ctx, lineID); err != nil {
+			return err
+		}
+		return recalculateEntry(ctx, tx, *entry, taxTable, monthToDate[entry.EmployeeID], decimals)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := s.getEntryWithLines(ctx, line.EntryID)
+	if err != nil {
+		return nil, err
+	}
+	s.audit.RecordAuditEvent(ctx, nil, "payroll.entry.line.remove", stringPtr("payroll_entry"), &updated.ID, map[string]any{
+		"batch_id":    updated.BatchID,
+		"employee_id": updated.EmployeeID,
+		"code":        line.Code,
+		"amount":      line.Amount,
+	})
+	return updated, nil
+}
+
+// ApprovePayrollBatch signs off the next step of the approval chain. The batch becomes Approved when the last
+// step is signed.
+func (s *Service) ApprovePayrollBatch(ctx context.Context, claims *models.Claims, batchID int64, comment string) (*PayrollBatch, error) {
 	if claims == nil {
 		return nil, ErrForbidden
 	}
 	if batchID <= 0 {
 		return nil, fmt.Errorf("%w: batch id must be positive", ErrValidation)
 	}
+	comment, err := normalizeApprovalComment(comment)
+	if err != nil {
+		return nil, err
+	}
 
 	batch, err := s.repository.GetBatchByID(ctx, batchID)
 	if err != nil {
@@ -229,17 +724,66 @@ func (s *Service) ApprovePayrollBatch(ctx context.Context, claims *models.Claims
 	if batch.Status != StatusDraft {
 		return nil, ErrInvalidTransition
 	}
+	if err := s.requireVarianceReview(ctx, *batch); err != nil {
+		return nil, err
+	}
+	if err := s.requireCurrentPAYE(ctx, *batch); err != nil {
+		return nil, err
+	}
 
-	updated, err := s.repository.SetBatchApproved(ctx, batchID, claims.UserID)
+	policy, err := s.resolveApprovalPolicy(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if updated == nil {
-		return nil, ErrNotFound
+	progress, err := s.approvalProgress(ctx, *batch, policy.Steps)
+	if err != nil {
+		return nil, err
+	}
+	stepNumber := progress.NextStep
+	step := policy.Steps[stepNumber-1]
+	if !stepAllowsRole(step, claims.Role) {
+		return nil, fmt.Errorf("%w: %q must be approved by %s", ErrApprovalStepRole, step.Name, strings.Join(step.Roles, " or "))
+	}
+	if !policy.AllowSelfApproval {
+		if err := checkSegregationOfDuties(*batch, progress, claims.UserID); err != nil {
+			return nil, err
+		}
+	}
+
+	final := stepNumber == len(policy.Steps)
+	updated := batch
+	err = s.repository.WithTx(ctx, func(tx TxRepository) error {
+		if err := tx.CreateBatchApproval(ctx, BatchApprovalInput{
+			BatchID:    batchID,
+			StepNumber: stepNumber,
+			StepName:   step.Name,
+			Action:     ApprovalActionApproved,
+			UserID:     claims.UserID,
+			Comment:    comment,
+		}); err != nil {
+			return err
+		}
+		if !final {
+			return nil
+		}
+		updated, err = tx.SetBatchApproved(ctx, batchID, claims.UserID)
+		if err != nil {
+			return err
+		}
+		if updated == nil {
+			return ErrNotFound
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 	s.audit.RecordAuditEvent(ctx, claimsUserID(claims), "payroll.batch.approve", stringPtr("payroll_batch"), &updated.ID, map[string]any{
-		"month":  updated.Month,
-		"status": updated.Status,
+		"month":       updated.Month,
+		"status":      updated.Status,
+		"step_number": stepNumber,
+		"step_name":   step.Name,
+		"steps":       len(policy.Steps),
 	})
 	return updated, nil
 }
@@ -260,17 +804,97 @@ func (s *Service) LockPayrollBatch(ctx context.Context, batchID int64) (*Payroll
 		return nil, ErrInvalidTransition
 	}
 
-	updated, err := s.repository.SetBatchLocked(ctx, batchID)
+	basis, err := s.resolveProrationBasis(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if updated == nil {
-		return nil, ErrNotFound
+	periodStart, periodEnd, err := MonthBounds(batch.Month)
+	if err != nil {
+		return nil, err
+	}
+	calendar, err := s.workCalendar(ctx, periodStart, periodEnd)
+	if err != nil {
+		return nil, err
+	}
+	_, decimals := s.payrollFormatting(ctx)
+	var reversedCharges []ProjectCharge
+	if batch.ReversesBatchID != nil {
+		reversedCharges, err = s.repository.ListProjectCharges(ctx, *batch.ReversesBatchID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Loan balances, project charges and the integrity seal move together with the lock so a batch is never
+	// locked without them. They are worked out from entries read after the lock, so an edit that lands between
+	// approval and lock cannot leave the seal describing amounts other than the ones locked.
+	var updated *PayrollBatch
+	repayments := make([]LoanRepayment, 0)
+	var charges []ProjectCharge
+	err = s.repository.WithTx(ctx, func(tx TxRepository) error {
+		updated, err = tx.SetBatchLocked(ctx, batchID)
+		if err != nil {
+			return err
+		}
+		if updated == nil {
+			return ErrNotFound
+		}
+		entries, err := readEntriesWithLines(ctx, tx, batchID)
+		if err != nil {
+			return err
+		}
+		hash, manifest, err := sealBatch(*updated, entries)
+		if err != nil {
+			return err
+		}
+		if err := tx.SetBatchIntegritySeal(ctx, batchID, hash, manifest); err != nil {
+			return err
+		}
+		updated.IntegrityHash = &hash
+		recoveries, err := tx.ListLoanRecoveries(ctx, batchID)
+		if err != nil {
+			return err
+		}
+		for _, recovery := range recoveries {
+			repayment, err := tx.ApplyLoanRepayment(ctx, *updated, recovery)
+			if err != nil {
+				return err
+			}
+			repayments = append(repayments, *repayment)
+		}
+		charges, err = lockedProjectCharges(ctx, tx, *updated, entries, reversedCharges, basis, calendar, decimals)
+		if err != nil {
+			return err
+		}
+		for _, charge := range charges {
+			if err := tx.CreateProjectCharge(ctx, charge); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 	s.audit.RecordAuditEvent(ctx, nil, "payroll.batch.lock", stringPtr("payroll_batch"), &updated.ID, map[string]any{
-		"month":  updated.Month,
-		"status": updated.Status,
+		"month":           updated.Month,
+		"status":          updated.Status,
+		"loan_recoveries": len(repayments),
+		"project_charges": len(charges),
+		"integrity_hash":  *updated.IntegrityHash,
 	})
+	for _, repayment := range repayments {
+		action := "payroll.loan.recover"
+		if repayment.Amount < 0 {
+			action = "payroll.loan.restore"
+		}
+		s.audit.RecordAuditEvent(ctx, nil, action, stringPtr("employee_loan"), &repayment.LoanID, map[string]any{
+			"batch_id":      repayment.BatchID,
+			"month":         repayment.Month,
+			"amount":        repayment.Amount,
+			"balance_after": repayment.BalanceAfter,
+		})
+	}
 	return updated, nil
 }
 
@@ -290,35 +914,38 @@ func (s *Service) ExportPayrollBatchCSV(ctx context.Context, batchID int64) (*CS
 		return nil, ErrExportNotAllowed
 	}
 
-	entries, err := s.repository.ListEntriesByBatchID(ctx, batchID)
+	entries, err := s.listEntriesWithLines(ctx, batchID)
 	if err != nil {
 		return nil, err
 	}
-
-	symbol := ""
-	decimals := 2
-	rounding := false
-	if s.formatter != nil {
-		formattedSymbol, formattedDecimals, formattedRounding, providerErr := s.formatter.GetPayrollFormatting(ctx)
-		if providerErr == nil {
-			symbol = formattedSymbol
-			decimals = formattedDecimals
-			rounding = formattedRounding
-		}
+	lineColumns := collectLineColumns(entries)
+	integrity, err := s.verifyBatches(ctx, []int64{batchID})
+	if err != nil {
+		return nil, err
 	}
 
+	symbol, decimals := s.payrollFormatting(ctx)
+
 	var buf bytes.Buffer
 	writer := csv.NewWriter(&buf)
-	if err := writer.Write([]string{
+	header := []string{
 		"Employee ID",
 		"Employee Name",
 		"Base Salary",
+	}
+	for _, column := range lineColumns {
+		header = append(header, column.Name)
+	}
+	header = append(header,
 		"Allowances",
 		"Deductions",
 		"Tax",
 		"Gross Pay",
 		"Net Pay",
-	}); err != nil {
+		"Employer Contributions",
+		"Integrity",
+	)
+	if err := writer.Write(header); err != nil {
 		return nil, fmt.Errorf("write payroll csv header: %w", err)
 	}
 
@@ -326,13 +953,24 @@ func (s *Service) ExportPayrollBatchCSV(ctx context.Context, batchID int64) (*CS
 		record := []string{
 			strconv.FormatInt(entry.EmployeeID, 10),
 			entry.EmployeeName,
-			formatMoney(entry.BaseSalary, decimals, symbol, rounding),
-			formatMoney(entry.AllowancesTotal, decimals, symbol, rounding),
-			formatMoney(entry.DeductionsTotal, decimals, symbol, rounding),
-			formatMoney(entry.TaxTotal, decimals, symbol, rounding),
-			formatMoney(entry.GrossPay, decimals, symbol, rounding),
-			formatMoney(entry.NetPay, decimals, symbol, rounding),
+			entry.BaseSalary.Format(decimals, symbol),
+		}
+		amountsByCode := make(map[string]money.Amount, len(entry.Lines))
+		for _, line := range entry.Lines {
+			amountsByCode[line.Code] += line.Amount
+		}
+		for _, column := range lineColumns {
+			record = append(record, amountsByCode[column.Code].Format(decimals, symbol))
 		}
+		record = append(record,
+			entry.AllowancesTotal.Format(decimals, symbol),
+			entry.DeductionsTotal.Format(decimals, symbol),
+			entry.TaxTotal.Format(decimals, symbol),
+			entry.GrossPay.Format(decimals, symbol),
+			entry.NetPay.Format(decimals, symbol),
+			entry.EmployerContributionsTotal.Format(decimals, symbol),
+			entryIntegrityStatus(integrity, entry.ID),
+		)
 		if err := writer.Write(record); err != nil {
 			return nil, fmt.Errorf("write payroll csv record: %w", err)
 		}
@@ -350,32 +988,305 @@ func (s *Service) ExportPayrollBatchCSV(ctx context.Context, batchID int64) (*CS
 	}, nil
 }
 
-func isAllowedStatus(status string) bool {
-	return status == StatusDraft || status == StatusApproved || status == StatusLocked
+func (s *Service) requireDraftEntryBatch(ctx context.Context, entryID int64) (*PayrollBatch, error) {
+	batch, err := s.repository.GetBatchByEntryID(ctx, entryID)
+	if err != nil {
+		return nil, err
+	}
+	if batch == nil {
+		return nil, ErrNotFound
+	}
+	if err := requireEditable(*batch); err != nil {
+		return nil, err
+	}
+	return batch, nil
+}
+
+// batchEntryReader reads the entries of a batch with their lines, either directly or inside a transaction.
+type batchEntryReader interface {
+	ListEntriesByBatchID(ctx context.Context, batchID int64) ([]PayrollEntry, error)
+	ListEntryLinesByBatchID(ctx context.Context, batchID int64) ([]PayrollEntryLine, error)
+	ListEntryLineSourcesByBatchID(ctx context.Context, batchID int64) ([]PayrollEntryLineSource, error)
+}
+
+func (s *Service) listEntriesWithLines(ctx context.Context, batchID int64) ([]PayrollEntry, error) {
+	return readEntriesWithLines(ctx, s.repository, batchID)
+}
+
+func readEntriesWithLines(ctx context.Context, reader batchEntryReader, batchID int64) ([]PayrollEntry, error) {
+	entries, err := reader.ListEntriesByBatchID(ctx, batchID)
+	if err != nil {
+		return nil, err
+	}
+	lines, err := reader.ListEntryLinesByBatchID(ctx, batchID)
+	if err != nil {
+		return nil, err
+	}
+	sources, err := reader.ListEntryLineSourcesByBatchID(ctx, batchID)
+	if err != nil {
+		return nil, err
+	}
+	attachLineSources(lines, sources)
+
+	linesByEntry := make(map[int64][]PayrollEntryLine, len(entries))
+	for _, line := range lines {
+		linesByEntry[line.EntryID] = append(linesByEntry[line.EntryID], line)
+	}
+	for i := range entries {
+		entries[i].Lines = linesByEntry[entries[i].ID]
+		if entries[i].Lines == nil {
+			entries[i].Lines = []PayrollEntryLine{}
+		}
+	}
+	return entries, nil
+}
+
+func (s *Service) getEntryWithLines(ctx context.Context, entryID int64) (*PayrollEntry, error) {
+	entry, err := s.repository.GetEntryByID(ctx, entryID)
+	if err != nil {
+		return nil, err
+	}
+	if entry == nil {
+		return nil, ErrNotFound
+	}
+	lines, err := s.repository.ListEntryLinesByEntryID(ctx, entryID)
+	if err != nil {
+		return nil, err
+	}
+	sources, err := s.repository.ListEntryLineSourcesByEntryID(ctx, entryID)
+	if err != nil {
+		return nil, err
+	}
+	attachLineSources(lines, sources)
+	entry.Lines = lines
+	return entry, nil
+}
+
+func attachLineSources(lines []PayrollEntryLine, sources []PayrollEntryLineSource) {
+	if len(sources) == 0 {
+		return
+	}
+	sourcesByLine := make(map[int64][]PayrollEntryLineSource, len(sources))
+	for _, source := range sources {
+		sourcesByLine[source.LineID] = append(sourcesByLine[source.LineID], source)
+	}
+	for i := range lines {
+		lines[i].Sources = sourcesByLine[lines[i].ID]
+	}
+}
+
+// payrollFormatting reads money formatting from settings, falling back to plain two-decimal output.
+func (s *Service) payrollFormatting(ctx context.Context) (symbol string, decimals int) {
+	decimals = money.Decimals
+	if s.formatter != nil {
+		formattedSymbol, formattedDecimals, _, err := s.formatter.GetPayrollFormatting(ctx)
+		if err == nil {
+			return formattedSymbol, formattedDecimals
+		}
+	}
+	return symbol, decimals
+}
+
+// calculationDecimals is the precision payroll amounts are calculated at: the display decimals when rounding
+// is enabled in the payroll display settings, otherwise cents. Every line, tax and total is held at this
+// precision so batch totals equal the sum of the rounded entries.
+func (s *Service) calculationDecimals(ctx context.Context) (int, error) {
+	if s.formatter == nil {
+		return money.Decimals, nil
+	}
+	_, decimals, rounding, err := s.formatter.GetPayrollFormatting(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if !rounding || decimals < 0 || decimals > money.Decimals {
+		return money.Decimals, nil
+	}
+	return decimals, nil
 }
 
-func formatMoney(value float64, decimals int, symbol string, rounding bool) string {
-	if decimals < 0 || decimals > 6 {
-		decimals = 2
+// resolveTaxTable returns the PAYE table in effect for month, or nil when tax is keyed in manually.
+func (s *Service) resolveTaxTable(ctx context.Context, month string) (*TaxTable, error) {
+	if s.taxRules == nil {
+		return nil, nil
+	}
+	tables, err := s.taxRules.GetPayrollTaxTables(ctx)
+	if err != nil {
+		return nil, err
 	}
-	if rounding {
-		factor := pow10(decimals)
-		value = math.Round(value*factor) / factor
+	return SelectTaxTable(tables, month), nil
+}
+
+// workCalendar reads the public holidays between from and to. Without a holiday provider only weekends are
+// non-working days.
+func (s *Service) workCalendar(ctx context.Context, from, to time.Time) (WorkCalendar, error) {
+	if s.holidays == nil {
+		return WorkCalendar{}, nil
 	}
-	formatted := strconv.FormatFloat(value, 'f', decimals, 64)
-	symbol = strings.TrimSpace(symbol)
-	if symbol == "" {
-		return formatted
+	holidays, err := s.holidays.HolidayDates(ctx, from, to)
+	if err != nil {
+		return WorkCalendar{}, err
 	}
-	return symbol + " " + formatted
+	return NewWorkCalendar(holidays), nil
 }
 
-func pow10(decimals int) float64 {
-	result := 1.0
-	for i := 0; i < decimals; i++ {
-		result *= 10
+// resolveProrationBasis reads the proration basis from settings, defaulting to working days.
+func (s *Service) resolveProrationBasis(ctx context.Context) (string, error) {
+	if s.policy == nil {
+		return ProrationWorkingDays, nil
 	}
-	return result
+	basis, err := s.policy.GetPayrollProrationBasis(ctx)
+	if err != nil {
+		return "", err
+	}
+	return NormalizeProrationBasis(basis)
+}
+
+func recalculateEntry(ctx context.Context, tx TxRepository, entry PayrollEntry, taxTable *TaxTable, monthToDate MonthToDate, decimals int) error {
+	lines, err := tx.ListEntryLines(ctx, entry.ID)
+	if err != nil {
+		return err
+	}
+	if err := refreshContributionLines(ctx, tx, entry.BaseSalary, lines, decimals); err != nil {
+		return err
+	}
+	return tx.UpdateEntryTotals(ctx, entry.ID, calculateEntryTotals(entry.BaseSalary, lines, taxTable, entry.TaxTotal, monthToDate, decimals))
+}
+
+// calculateEntryTotals derives every stored entry total from the base salary and line items. Lines are
+// already rounded to decimals, so only tax needs rounding for the totals to add up exactly.
+func calculateEntryTotals(baseSalary money.Amount, lines []PayrollEntryLine, taxTable *TaxTable, manualTax money.Amount, monthToDate MonthToDate, decimals int) EntryTotalsInput {
+	allowancesTotal, deductionsTotal := CalculateLineTotals(lines)
+	taxTotal := calculateEntryTax(taxTable, baseSalary, allowancesTotal, lines, manualTax, monthToDate, decimals)
+	grossPay, netPay := CalculateTotals(baseSalary, allowancesTotal, deductionsTotal, taxTotal)
+	return EntryTotalsInput{
+		AllowancesTotal:            allowancesTotal,
+		DeductionsTotal:            deductionsTotal,
+		TaxTotal:                   taxTotal,
+		EmployerContributionsTotal: CalculateEmployerContributions(lines),
+		GrossPay:                   grossPay,
+		NetPay:                     netPay,
+	}
+}
+
+// calculateEntryTax applies PAYE when a tax table is in effect and otherwise keeps the manual amount. PAYE is
+// worked out on the month-to-date taxable pay, less the tax already deducted by the month's other batches.
+func calculateEntryTax(taxTable *TaxTable, baseSalary, allowancesTotal money.Amount, lines []PayrollEntryLine, manualTax money.Amount, monthToDate MonthToDate, decimals int) money.Amount {
+	if taxTable == nil {
+		return manualTax
+	}
+	taxablePay := monthToDate.TaxablePay + CalculateTaxablePay(baseSalary, allowancesTotal, lines)
+	tax := CalculatePAYE(*taxTable, taxablePay).Round(decimals) - monthToDate.TaxTotal
+	if tax < 0 {
+		return 0
+	}
+	return tax
+}
+
+// resolveEntryTax works out the tax of an entry whose amounts are set by hand. Under a tax table the tax is PAYE
+// and a submitted tax must match it; without one the submitted tax is used, or currentTax when none is given.
+func resolveEntryTax(taxTable *TaxTable, baseSalary, allowancesTotal money.Amount, lines []PayrollEntryLine, submittedTax *money.Amount, currentTax money.Amount, monthToDate MonthToDate, decimals int) (money.Amount, error) {
+	manualTax := currentTax
+	if submittedTax != nil {
+		manualTax = submittedTax.Round(decimals)
+	}
+	tax := calculateEntryTax(taxTable, baseSalary, allowancesTotal, lines, manualTax, monthToDate, decimals)
+	if taxTable != nil && submittedTax != nil && manualTax != tax {
+		return 0, fmt.Errorf("%w: tax is worked out by PAYE as %s, not %s", ErrValidation, tax.Format(decimals, ""), manualTax.Format(decimals, ""))
+	}
+	return tax, nil
+}
+
+func newComponentLine(component PayComponent, amount money.Amount) PayrollEntryLine {
+	componentID := component.ID
+	return PayrollEntryLine{
+		ComponentID: &componentID,
+		Code:        component.Code,
+		Name:        component.Name,
+		Kind:        component.Kind,
+		Taxable:     component.Taxable,
+		Amount:      amount,
+	}
+}
+
+func entryLineCreateInput(entryID int64, line PayrollEntryLine) EntryLineCreateInput {
+	return EntryLineCreateInput{
+		EntryID:        entryID,
+		ComponentID:    line.ComponentID,
+		SchemeID:       line.SchemeID,
+		Code:           line.Code,
+		Name:           line.Name,
+		Kind:           line.Kind,
+		Taxable:        line.Taxable,
+		Amount:         line.Amount,
+		EmployerAmount: line.EmployerAmount,
+		Quantity:       line.Quantity,
+		Rate:           line.Rate,
+		LoanID:         line.LoanID,
+		ArrearsMonth:   line.ArrearsMonth,
+		Sources:        line.Sources,
+	}
+}
+
+type lineColumn struct {
+	Code string
+	Name string
+	Kind string
+}
+
+// collectLineColumns lists the distinct line items in a batch, earnings before deductions.
+func collectLineColumns(entries []PayrollEntry) []lineColumn {
+	seen := make(map[string]bool)
+	columns := make([]lineColumn, 0)
+	for _, entry := range entries {
+		for _, line := range entry.Lines {
+			if seen[line.Code] {
+				continue
+			}
+			seen[line.Code] = true
+			columns = append(columns, lineColumn{Code: line.Code, Name: line.Name, Kind: line.Kind})
+		}
+	}
+	sort.SliceStable(columns, func(i, j int) bool {
+		if columns[i].Kind != columns[j].Kind {
+			return columns[i].Kind == ComponentKindEarning
+		}
+		return columns[i].Name < columns[j].Name
+	})
+	return columns
+}
+
+func normalizePayComponentInput(input PayComponentUpsertInput) (PayComponentUpsertInput, error) {
+	input.Code = strings.ToUpper(strings.TrimSpace(input.Code))
+	input.Name = strings.TrimSpace(input.Name)
+	input.Kind = strings.ToLower(strings.TrimSpace(input.Kind))
+	input.CalculationType = strings.ToLower(strings.TrimSpace(input.CalculationType))
+
+	if !componentCodePattern.MatchString(input.Code) {
+		return PayComponentUpsertInput{}, fmt.Errorf("%w: component code must be 2-40 letters, numbers, '_' or '-'", ErrValidation)
+	}
+	if input.Name == "" {
+		return PayComponentUpsertInput{}, fmt.Errorf("%w: component name is required", ErrValidation)
+	}
+	if len(input.Name) > 120 {
+		return PayComponentUpsertInput{}, fmt.Errorf("%w: component name is too long", ErrValidation)
+	}
+	if input.Kind != ComponentKindEarning && input.Kind != ComponentKindDeduction {
+		return PayComponentUpsertInput{}, fmt.Errorf("%w: component kind must be earning or deduction", ErrValidation)
+	}
+	if input.CalculationType != CalculationFixed && input.CalculationType != CalculationPercentage {
+		return PayComponentUpsertInput{}, fmt.Errorf("%w: calculation type must be fixed or percentage", ErrValidation)
+	}
+	if input.Value < 0 {
+		return PayComponentUpsertInput{}, fmt.Errorf("%w: component value must be non-negative", ErrValidation)
+	}
+	if input.CalculationType == CalculationPercentage && input.Value > 100 {
+		return PayComponentUpsertInput{}, fmt.Errorf("%w: percentage must be between 0 and 100", ErrValidation)
+	}
+	return input, nil
+}
+
+func isAllowedStatus(status string) bool {
+	return status == StatusDraft || status == StatusApproved || status == StatusLocked
 }
 
 func claimsUserID(claims *models.Claims) *int64 {
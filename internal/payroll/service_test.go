@@ -3,18 +3,42 @@ package payroll
 import (
 	"context"
 	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
 	"hrpro/internal/models"
+	"hrpro/internal/money"
 )
 
 type fakeRepository struct {
 	batches         map[int64]*PayrollBatch
 	entriesByBatch  map[int64][]PayrollEntry
 	entryToBatch    map[int64]int64
+	linesByEntry    map[int64][]PayrollEntryLine
+	components      map[int64]*PayComponent
+	schemes         map[int64]*ContributionScheme
+	schemeMembers   []ContributionSchemeMember
+	paymentMethods  []EmployeePaymentMethod
 	activeEmployees []EmployeeSalary
+	absences        []AbsenceRecord
+	loans           []EmployeeLoan
+	loanRepayments  []LoanRepayment
+	selections      map[int64]*BatchSelection
+	salaryRates     []SalaryRate
+	approvals       []PayrollBatchApproval
+	glMappings      []GLAccountMapping
+	departments     []EmployeeDepartment
+	projects        []Project
+	allocations     []EmployeeProjectAllocation
+	projectCharges  []ProjectCharge
+	manifests       map[int64]string
 	failEmployeeID  int64
+	// beforeTx runs as a transaction opens, standing in for a write committed by another request.
+	beforeTx func()
 }
 
 type captureAuditRecorder struct {
@@ -25,9 +49,18 @@ func (c *captureAuditRecorder) RecordAuditEvent(_ context.Context, _ *int64, act
 	c.actions = append(c.actions, action)
 }
 
-func (f *fakeRepository) CreateBatch(_ context.Context, month string, createdBy int64) (*PayrollBatch, error) {
-	batch := &PayrollBatch{ID: int64(len(f.batches) + 1), Month: month, Status: StatusDraft, CreatedBy: createdBy, CreatedAt: time.Now().UTC()}
+func (f *fakeRepository) CreateBatch(_ context.Context, input CreateBatchInput, createdBy int64) (*PayrollBatch, error) {
+	for _, existing := range f.batches {
+		if input.BatchType == BatchTypeRegular && existing.Month == input.Month && (existing.BatchType == "" || existing.BatchType == BatchTypeRegular) {
+			return nil, ErrDuplicateMonth
+		}
+	}
+	batch := &PayrollBatch{ID: int64(len(f.batches) + 1), Month: input.Month, BatchType: input.BatchType, Description: input.Description, Status: StatusDraft, CreatedBy: createdBy, CreatedAt: time.Now().UTC()}
 	f.batches[batch.ID] = batch
+	if f.selections == nil {
+		f.selections = map[int64]*BatchSelection{}
+	}
+	f.selections[batch.ID] = &BatchSelection{EmployeeIDs: input.EmployeeIDs, ComponentIDs: input.ComponentIDs}
 	return batch, nil
 }
 
@@ -39,15 +72,116 @@ func (f *fakeRepository) ListBatches(_ context.Context, _ ListBatchesFilter) ([]
 	return items, int64(len(items)), 1, 10, nil
 }
 
+// GetBatchByID defaults the batch type to regular like the column default, so fixtures can leave it out.
 func (f *fakeRepository) GetBatchByID(_ context.Context, batchID int64) (*PayrollBatch, error) {
 	batch := f.batches[batchID]
 	if batch == nil {
 		return nil, nil
 	}
 	copyBatch := *batch
+	if copyBatch.BatchType == "" {
+		copyBatch.BatchType = BatchTypeRegular
+	}
 	return &copyBatch, nil
 }
 
+func (f *fakeRepository) GetRegularBatchByMonth(_ context.Context, month string) (*PayrollBatch, error) {
+	for id, batch := range f.batches {
+		if batch.Month == month && (batch.BatchType == "" || batch.BatchType == BatchTypeRegular) {
+			return f.GetBatchByID(context.Background(), id)
+		}
+	}
+	return nil, nil
+}
+
+func (f *fakeRepository) GetBatchSelection(_ context.Context, batchID int64) (*BatchSelection, error) {
+	if selection := f.selections[batchID]; selection != nil {
+		return &BatchSelection{EmployeeIDs: selection.EmployeeIDs, ComponentIDs: selection.ComponentIDs}, nil
+	}
+	return &BatchSelection{EmployeeIDs: []int64{}, ComponentIDs: []int64{}}, nil
+}
+
+func (f *fakeRepository) ListMonthToDateEntries(_ context.Context, month string, excludeBatchID int64) ([]PayrollEntry, error) {
+	items := make([]PayrollEntry, 0)
+	for id, batch := range f.batches {
+		if id == excludeBatchID || batch.Month != month || (batch.Status != StatusApproved && batch.Status != StatusLocked) {
+			continue
+		}
+		for _, entry := range f.entriesByBatch[id] {
+			entry.Lines = f.linesByEntry[entry.ID]
+			items = append(items, entry)
+		}
+	}
+	return items, nil
+}
+
+func (f *fakeRepository) ListSalaryRates(_ context.Context, employeeID int64) ([]SalaryRate, error) {
+	items := make([]SalaryRate, 0, len(f.salaryRates))
+	for _, rate := range f.salaryRates {
+		if employeeID == 0 || rate.EmployeeID == employeeID {
+			items = append(items, rate)
+		}
+	}
+	return items, nil
+}
+
+func (f *fakeRepository) ListLockedBasePay(_ context.Context, beforeMonth string, employeeID int64) ([]LockedBasePay, error) {
+	employees := make(map[int64]EmployeeSalary, len(f.activeEmployees))
+	for _, employee := range f.activeEmployees {
+		employees[employee.EmployeeID] = employee
+	}
+	arrearsPaid := make(map[string]money.Amount)
+	for batchID, entries := range f.entriesByBatch {
+		batch := f.batches[batchID]
+		if batch == nil || (batch.Status != StatusApproved && batch.Status != StatusLocked) {
+			continue
+		}
+		for _, entry := range entries {
+			for _, line := range f.linesByEntry[entry.ID] {
+				if line.Code == LineCodeArrears && line.ArrearsMonth != nil {
+					arrearsPaid[fmt.Sprintf("%d/%s", entry.EmployeeID, *line.ArrearsMonth)] += line.Amount
+				}
+			}
+		}
+	}
+
+	items := make([]LockedBasePay, 0)
+	for batchID, entries := range f.entriesByBatch {
+		batch := f.batches[batchID]
+		if batch == nil || batch.Status != StatusLocked || (batch.BatchType != "" && batch.BatchType != BatchTypeRegular) || batch.Month >= beforeMonth {
+			continue
+		}
+		for _, entry := range entries {
+			if employeeID != 0 && entry.EmployeeID != employeeID {
+				continue
+			}
+			employee := employees[entry.EmployeeID]
+			item := LockedBasePay{
+				EmployeeID:     entry.EmployeeID,
+				EmployeeName:   employee.EmployeeName,
+				BatchID:        batchID,
+				Month:          batch.Month,
+				BaseSalary:     entry.BaseSalary,
+				FullBaseSalary: entry.FullBaseSalary,
+				ArrearsPaid:    arrearsPaid[fmt.Sprintf("%d/%s", entry.EmployeeID, batch.Month)],
+				DateOfHire:     employee.DateOfHire,
+				DateOfExit:     employee.DateOfExit,
+			}
+			if batch.LockedAt != nil {
+				item.LockedAt = *batch.LockedAt
+			}
+			items = append(items, item)
+		}
+	}
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].EmployeeID != items[j].EmployeeID {
+			return items[i].EmployeeID < items[j].EmployeeID
+		}
+		return items[i].Month < items[j].Month
+	})
+	return items, nil
+}
+
 func (f *fakeRepository) GetBatchByEntryID(_ context.Context, entryID int64) (*PayrollBatch, error) {
 	batchID, ok := f.entryToBatch[entryID]
 	if !ok {
@@ -63,222 +197,3420 @@ func (f *fakeRepository) ListEntriesByBatchID(_ context.Context, batchID int64)
 	return cloned, nil
 }
 
-func (f *fakeRepository) UpdateEntryAmounts(_ context.Context, entryID int64, allowancesTotal, deductionsTotal, taxTotal, grossPay, netPay float64) (*PayrollEntry, error) {
-	batchID := f.entryToBatch[entryID]
-	items := f.entriesByBatch[batchID]
-	for i := range items {
-		if items[i].ID == entryID {
-			items[i].AllowancesTotal = allowancesTotal
-			items[i].DeductionsTotal = deductionsTotal
-			items[i].TaxTotal = taxTotal
-			items[i].GrossPay = grossPay
-			items[i].NetPay = netPay
-			f.entriesByBatch[batchID] = items
-			entry := items[i]
-			return &entry, nil
+func (f *fakeRepository) GetEntryByID(_ context.Context, entryID int64) (*PayrollEntry, error) {
+	batchID, ok := f.entryToBatch[entryID]
+	if !ok {
+		return nil, nil
+	}
+	for _, entry := range f.entriesByBatch[batchID] {
+		if entry.ID == entryID {
+			copyEntry := entry
+			return &copyEntry, nil
 		}
 	}
 	return nil, nil
 }
 
-func (f *fakeRepository) SetBatchApproved(_ context.Context, batchID int64, approvedBy int64) (*PayrollBatch, error) {
-	batch := f.batches[batchID]
-	if batch == nil {
-		return nil, nil
+func (f *fakeRepository) ListEntryLinesByBatchID(_ context.Context, batchID int64) ([]PayrollEntryLine, error) {
+	items := make([]PayrollEntryLine, 0)
+	for _, entry := range f.entriesByBatch[batchID] {
+		items = append(items, f.linesByEntry[entry.ID]...)
 	}
-	now := time.Now().UTC()
-	batch.Status = StatusApproved
-	batch.ApprovedBy = &approvedBy
-	batch.ApprovedAt = &now
-	copyBatch := *batch
-	return &copyBatch, nil
+	return items, nil
 }
 
-func (f *fakeRepository) SetBatchLocked(_ context.Context, batchID int64) (*PayrollBatch, error) {
-	batch := f.batches[batchID]
-	if batch == nil {
-		return nil, nil
+func (f *fakeRepository) ListEntryLinesByEntryID(_ context.Context, entryID int64) ([]PayrollEntryLine, error) {
+	items := make([]PayrollEntryLine, len(f.linesByEntry[entryID]))
+	copy(items, f.linesByEntry[entryID])
+	return items, nil
+}
+
+func (f *fakeRepository) ListEntryLineSourcesByBatchID(ctx context.Context, batchID int64) ([]PayrollEntryLineSource, error) {
+	lines, _ := f.ListEntryLinesByBatchID(ctx, batchID)
+	return collectLineSources(lines), nil
+}
+
+func (f *fakeRepository) ListEntryLineSourcesByEntryID(_ context.Context, entryID int64) ([]PayrollEntryLineSource, error) {
+	return collectLineSources(f.linesByEntry[entryID]), nil
+}
+
+func collectLineSources(lines []PayrollEntryLine) []PayrollEntryLineSource {
+	items := make([]PayrollEntryLineSource, 0)
+	for _, line := range lines {
+		items = append(items, line.Sources...)
 	}
-	now := time.Now().UTC()
-	batch.Status = StatusLocked
-	batch.LockedAt = &now
-	copyBatch := *batch
-	return &copyBatch, nil
+	return items
 }
 
-func (f *fakeRepository) WithTx(ctx context.Context, fn func(tx TxRepository) error) error {
-	staged := make(map[int64][]PayrollEntry, len(f.entriesByBatch))
-	for batchID, entries := range f.entriesByBatch {
-		cloned := make([]PayrollEntry, len(entries))
-		copy(cloned, entries)
-		staged[batchID] = cloned
+func (f *fakeRepository) GetEntryLineByID(_ context.Context, lineID int64) (*PayrollEntryLine, error) {
+	for _, lines := range f.linesByEntry {
+		for _, line := range lines {
+			if line.ID == lineID {
+				copyLine := line
+				return &copyLine, nil
+			}
+		}
 	}
+	return nil, nil
+}
 
-	tx := &fakeTxRepository{parent: f, stagedEntriesByBatch: staged}
-	if err := fn(tx); err != nil {
-		return err
+func (f *fakeRepository) ListPayComponents(_ context.Context, activeOnly bool) ([]PayComponent, error) {
+	items := make([]PayComponent, 0, len(f.components))
+	for _, component := range f.components {
+		if activeOnly && !component.Active {
+			continue
+		}
+		items = append(items, *component)
 	}
+	return items, nil
+}
 
-	f.entriesByBatch = tx.stagedEntriesByBatch
-	f.entryToBatch = tx.stagedEntryToBatch
-	return nil
+func (f *fakeRepository) GetPayComponentByID(_ context.Context, id int64) (*PayComponent, error) {
+	component := f.components[id]
+	if component == nil {
+		return nil, nil
+	}
+	copyComponent := *component
+	return &copyComponent, nil
 }
 
-type fakeTxRepository struct {
-	parent               *fakeRepository
-	stagedEntriesByBatch map[int64][]PayrollEntry
-	stagedEntryToBatch   map[int64]int64
-	nextID               int64
+func (f *fakeRepository) CreatePayComponent(_ context.Context, input PayComponentUpsertInput) (*PayComponent, error) {
+	if f.components == nil {
+		f.components = map[int64]*PayComponent{}
+	}
+	for _, component := range f.components {
+		if component.Code == input.Code {
+			return nil, ErrDuplicateComponent
+		}
+	}
+	component := &PayComponent{
+		ID:              int64(len(f.components) + 1),
+		Code:            input.Code,
+		Name:            input.Name,
+		Kind:            input.Kind,
+		Taxable:         input.Taxable,
+		CalculationType: input.CalculationType,
+		Value:           input.Value,
+		AutoApply:       input.AutoApply,
+		Active:          true,
+	}
+	f.components[component.ID] = component
+	copyComponent := *component
+	return &copyComponent, nil
 }
 
-func (f *fakeTxRepository) ensureState() {
-	if f.stagedEntryToBatch != nil {
-		return
+func (f *fakeRepository) UpdatePayComponent(_ context.Context, id int64, input PayComponentUpsertInput) (*PayComponent, error) {
+	component := f.components[id]
+	if component == nil {
+		return nil, nil
 	}
-	f.stagedEntryToBatch = make(map[int64]int64, len(f.parent.entryToBatch))
-	for entryID, batchID := range f.parent.entryToBatch {
-		f.stagedEntryToBatch[entryID] = batchID
+	component.Code = input.Code
+	component.Name = input.Name
+	component.Kind = input.Kind
+	component.Taxable = input.Taxable
+	component.CalculationType = input.CalculationType
+	component.Value = input.Value
+	component.AutoApply = input.AutoApply
+	copyComponent := *component
+	return &copyComponent, nil
+}
+
+func (f *fakeRepository) SetPayComponentActive(_ context.Context, id int64, active bool) (*PayComponent, error) {
+	component := f.components[id]
+	if component == nil {
+		return nil, nil
 	}
-	var maxID int64
-	for entryID := range f.stagedEntryToBatch {
-		if entryID > maxID {
-			maxID = entryID
+	component.Active = active
+	copyComponent := *component
+	return &copyComponent, nil
+}
+
+func (f *fakeRepository) ListContributionSchemes(_ context.Context, activeOnly bool) ([]ContributionScheme, error) {
+	items := make([]ContributionScheme, 0, len(f.schemes))
+	for id := int64(1); id <= int64(len(f.schemes)); id++ {
+		scheme := f.schemes[id]
+		if scheme == nil || (activeOnly && !scheme.Active) {
+			continue
 		}
+		items = append(items, *scheme)
 	}
-	f.nextID = maxID + 1
+	return items, nil
 }
 
-func (f *fakeTxRepository) DeleteEntriesByBatchID(_ context.Context, batchID int64) error {
-	f.ensureState()
-	for entryID, mappedBatchID := range f.stagedEntryToBatch {
-		if mappedBatchID == batchID {
-			delete(f.stagedEntryToBatch, entryID)
+func (f *fakeRepository) GetContributionSchemeByID(_ context.Context, id int64) (*ContributionScheme, error) {
+	scheme := f.schemes[id]
+	if scheme == nil {
+		return nil, nil
+	}
+	copyScheme := *scheme
+	return &copyScheme, nil
+}
+
+func (f *fakeRepository) CreateContributionScheme(_ context.Context, input ContributionSchemeUpsertInput) (*ContributionScheme, error) {
+	if f.schemes == nil {
+		f.schemes = map[int64]*ContributionScheme{}
+	}
+	for _, scheme := range f.schemes {
+		if scheme.Code == input.Code {
+			return nil, ErrDuplicateScheme
 		}
 	}
-	f.stagedEntriesByBatch[batchID] = []PayrollEntry{}
-	return nil
+	scheme := &ContributionScheme{
+		ID:           int64(len(f.schemes) + 1),
+		Code:         input.Code,
+		Name:         input.Name,
+		EmployeeRate: input.EmployeeRate,
+		EmployerRate: input.EmployerRate,
+		Base:         input.Base,
+		MembersOnly:  input.MembersOnly,
+		Active:       true,
+	}
+	f.schemes[scheme.ID] = scheme
+	copyScheme := *scheme
+	return &copyScheme, nil
 }
 
-func (f *fakeTxRepository) ListActiveEmployeeSalaries(_ context.Context) ([]EmployeeSalary, error) {
-	items := make([]EmployeeSalary, len(f.parent.activeEmployees))
-	copy(items, f.parent.activeEmployees)
-	return items, nil
+func (f *fakeRepository) UpdateContributionScheme(_ context.Context, id int64, input ContributionSchemeUpsertInput) (*ContributionScheme, error) {
+	scheme := f.schemes[id]
+	if scheme == nil {
+		return nil, nil
+	}
+	scheme.Code = input.Code
+	scheme.Name = input.Name
+	scheme.EmployeeRate = input.EmployeeRate
+	scheme.EmployerRate = input.EmployerRate
+	scheme.Base = input.Base
+	scheme.MembersOnly = input.MembersOnly
+	copyScheme := *scheme
+	return &copyScheme, nil
 }
 
-func (f *fakeTxRepository) CreateEntry(_ context.Context, input EntryCreateInput) error {
-	f.ensureState()
-	if f.parent.failEmployeeID != 0 && input.EmployeeID == f.parent.failEmployeeID {
-		return errors.New("forced create error")
+func (f *fakeRepository) SetContributionSchemeActive(_ context.Context, id int64, active bool) (*ContributionScheme, error) {
+	scheme := f.schemes[id]
+	if scheme == nil {
+		return nil, nil
 	}
-	entry := PayrollEntry{
-		ID:              f.nextID,
-		BatchID:         input.BatchID,
-		EmployeeID:      input.EmployeeID,
-		BaseSalary:      input.BaseSalary,
-		AllowancesTotal: input.AllowancesTotal,
-		DeductionsTotal: input.DeductionsTotal,
-		TaxTotal:        input.TaxTotal,
-		GrossPay:        input.GrossPay,
-		NetPay:          input.NetPay,
+	scheme.Active = active
+	copyScheme := *scheme
+	return &copyScheme, nil
+}
+
+func (f *fakeRepository) ListContributionSchemeMembers(_ context.Context, schemeID int64) ([]ContributionSchemeMember, error) {
+	items := make([]ContributionSchemeMember, 0)
+	for _, member := range f.schemeMembers {
+		if member.SchemeID == schemeID {
+			items = append(items, member)
+		}
 	}
-	f.nextID++
-	f.stagedEntriesByBatch[input.BatchID] = append(f.stagedEntriesByBatch[input.BatchID], entry)
-	f.stagedEntryToBatch[entry.ID] = input.BatchID
-	return nil
+	return items, nil
 }
 
-func TestApprovePayrollBatchRequiresDraft(t *testing.T) {
-	repo := &fakeRepository{
-		batches: map[int64]*PayrollBatch{1: {ID: 1, Status: StatusApproved}},
+func (f *fakeRepository) UpsertContributionSchemeMember(_ context.Context, schemeID int64, employeeID int64, memberNumber string) (*ContributionSchemeMember, error) {
+	for i := range f.schemeMembers {
+		if f.schemeMembers[i].SchemeID == schemeID && f.schemeMembers[i].EmployeeID == employeeID {
+			f.schemeMembers[i].MemberNumber = memberNumber
+			member := f.schemeMembers[i]
+			return &member, nil
+		}
 	}
-	service := NewService(repo)
+	member := ContributionSchemeMember{SchemeID: schemeID, EmployeeID: employeeID, MemberNumber: memberNumber}
+	f.schemeMembers = append(f.schemeMembers, member)
+	return &member, nil
+}
 
-	_, err := service.ApprovePayrollBatch(context.Background(), &models.Claims{UserID: 1, Role: "Admin"}, 1)
-	if !errors.Is(err, ErrInvalidTransition) {
-		t.Fatalf("expected ErrInvalidTransition, got %v", err)
+func (f *fakeRepository) DeleteContributionSchemeMember(_ context.Context, schemeID int64, employeeID int64) (bool, error) {
+	for i := range f.schemeMembers {
+		if f.schemeMembers[i].SchemeID == schemeID && f.schemeMembers[i].EmployeeID == employeeID {
+			f.schemeMembers = append(f.schemeMembers[:i], f.schemeMembers[i+1:]...)
+			return true, nil
+		}
 	}
+	return false, nil
 }
 
-func TestLockPayrollBatchRequiresApproved(t *testing.T) {
-	repo := &fakeRepository{
-		batches: map[int64]*PayrollBatch{1: {ID: 1, Status: StatusDraft}},
+func (f *fakeRepository) ListContributionSchedule(_ context.Context, batchID int64, schemeID int64) ([]ContributionScheduleRow, error) {
+	items := make([]ContributionScheduleRow, 0)
+	for _, entry := range f.entriesByBatch[batchID] {
+		row := ContributionScheduleRow{EmployeeID: entry.EmployeeID, EmployeeName: entry.EmployeeName, BaseSalary: entry.BaseSalary, GrossPay: entry.GrossPay}
+		found := false
+		for _, line := range f.linesByEntry[entry.ID] {
+			if line.SchemeID != nil && *line.SchemeID == schemeID {
+				row.EmployeeAmount += line.Amount
+				row.EmployerAmount += line.EmployerAmount
+				found = true
+			}
+		}
+		for _, member := range f.schemeMembers {
+			if member.SchemeID == schemeID && member.EmployeeID == entry.EmployeeID {
+				number := member.MemberNumber
+				row.MemberNumber = &number
+			}
+		}
+		if found {
+			items = append(items, row)
+		}
 	}
-	service := NewService(repo)
+	return items, nil
+}
 
-	_, err := service.LockPayrollBatch(context.Background(), 1)
-	if !errors.Is(err, ErrInvalidTransition) {
-		t.Fatalf("expected ErrInvalidTransition, got %v", err)
+func (f *fakeRepository) ListEmployeePaymentMethods(_ context.Context, employeeID int64) ([]EmployeePaymentMethod, error) {
+	items := make([]EmployeePaymentMethod, 0)
+	for _, method := range f.paymentMethods {
+		if method.EmployeeID == employeeID {
+			items = append(items, method)
+		}
 	}
+	return items, nil
 }
 
-func TestUpdatePayrollEntryAmountsRequiresDraftBatch(t *testing.T) {
-	repo := &fakeRepository{
-		batches: map[int64]*PayrollBatch{10: {ID: 10, Status: StatusLocked}},
-		entriesByBatch: map[int64][]PayrollEntry{
-			10: {{ID: 44, BatchID: 10, BaseSalary: 1000}},
-		},
-		entryToBatch: map[int64]int64{44: 10},
+func (f *fakeRepository) ListGLAccountMappings(_ context.Context) ([]GLAccountMapping, error) {
+	return append([]GLAccountMapping(nil), f.glMappings...), nil
+}
+
+func (f *fakeRepository) CreateGLAccountMapping(_ context.Context, input GLAccountMappingInput) (*GLAccountMapping, error) {
+	for _, existing := range f.glMappings {
+		if existing.Category == input.Category && sameOptionalID(existing.ComponentID, input.ComponentID) && sameOptionalID(existing.SchemeID, input.SchemeID) && sameOptionalID(existing.DepartmentID, input.DepartmentID) {
+			return nil, ErrDuplicateGLMapping
+		}
 	}
-	service := NewService(repo)
+	item := GLAccountMapping{
+		ID:           int64(len(f.glMappings) + 1),
+		Category:     input.Category,
+		ComponentID:  input.ComponentID,
+		SchemeID:     input.SchemeID,
+		DepartmentID: input.DepartmentID,
+		AccountCode:  input.AccountCode,
+		AccountName:  input.AccountName,
+	}
+	f.glMappings = append(f.glMappings, item)
+	return &item, nil
+}
 
-	_, err := service.UpdatePayrollEntryAmounts(context.Background(), 44, UpdateEntryAmountsInput{
-		AllowancesTotal: 100,
-		DeductionsTotal: 20,
-		TaxTotal:        10,
-	})
-	if !errors.Is(err, ErrImmutableBatch) {
-		t.Fatalf("expected ErrImmutableBatch, got %v", err)
+func (f *fakeRepository) UpdateGLAccountMapping(_ context.Context, id int64, input GLAccountMappingInput) (*GLAccountMapping, error) {
+	for i := range f.glMappings {
+		if f.glMappings[i].ID == id {
+			f.glMappings[i].Category = input.Category
+			f.glMappings[i].ComponentID = input.ComponentID
+			f.glMappings[i].SchemeID = input.SchemeID
+			f.glMappings[i].DepartmentID = input.DepartmentID
+			f.glMappings[i].AccountCode = input.AccountCode
+			f.glMappings[i].AccountName = input.AccountName
+			item := f.glMappings[i]
+			return &item, nil
+		}
 	}
+	return nil, nil
 }
 
-func TestGeneratePayrollEntriesRollsBackOnInsertFailure(t *testing.T) {
-	repo := &fakeRepository{
-		batches: map[int64]*PayrollBatch{7: {ID: 7, Status: StatusDraft}},
-		entriesByBatch: map[int64][]PayrollEntry{
-			7: {{ID: 99, BatchID: 7, EmployeeID: 55, BaseSalary: 500}},
-		},
-		entryToBatch: map[int64]int64{99: 7},
-		activeEmployees: []EmployeeSalary{
-			{EmployeeID: 101, EmployeeName: "A", BaseSalary: 1200},
-			{EmployeeID: 202, EmployeeName: "B", BaseSalary: 1500},
-		},
-		failEmployeeID: 202,
+func (f *fakeRepository) DeleteGLAccountMapping(_ context.Context, id int64) (bool, error) {
+	for i := range f.glMappings {
+		if f.glMappings[i].ID == id {
+			f.glMappings = append(f.glMappings[:i], f.glMappings[i+1:]...)
+			return true, nil
+		}
 	}
-	service := NewService(repo)
+	return false, nil
+}
 
-	err := service.GeneratePayrollEntries(context.Background(), 7)
-	if err == nil {
-		t.Fatal("expected generation error")
+func (f *fakeRepository) ListBatchEmployeeDepartments(_ context.Context, batchID int64) ([]EmployeeDepartment, error) {
+	items := make([]EmployeeDepartment, 0)
+	for _, entry := range f.entriesByBatch[batchID] {
+		for _, department := range f.departments {
+			if department.EmployeeID == entry.EmployeeID {
+				items = append(items, department)
+			}
+		}
 	}
+	return items, nil
+}
 
-	entries := repo.entriesByBatch[7]
-	if len(entries) != 1 || entries[0].ID != 99 {
-		t.Fatalf("expected rollback to preserve existing entries, got %#v", entries)
+func (f *fakeRepository) ListProjects(_ context.Context, activeOnly bool) ([]Project, error) {
+	items := make([]Project, 0, len(f.projects))
+	for _, project := range f.projects {
+		if activeOnly && !project.Active {
+			continue
+		}
+		items = append(items, project)
 	}
+	return items, nil
+}
 
-	if repo.entryToBatch[99] != 7 {
-		t.Fatalf("expected original entry mapping to remain after rollback")
+func (f *fakeRepository) GetProjectByID(_ context.Context, id int64) (*Project, error) {
+	for _, project := range f.projects {
+		if project.ID == id {
+			item := project
+			return &item, nil
+		}
 	}
+	return nil, nil
 }
 
-func TestCreatePayrollBatchRecordsAuditEvent(t *testing.T) {
-	repo := &fakeRepository{
-		batches:        map[int64]*PayrollBatch{},
-		entriesByBatch: map[int64][]PayrollEntry{},
-		entryToBatch:   map[int64]int64{},
+func (f *fakeRepository) CreateProject(_ context.Context, input ProjectUpsertInput) (*Project, error) {
+	for _, existing := range f.projects {
+		if existing.Code == input.Code {
+			return nil, ErrDuplicateProject
+		}
 	}
-	service := NewService(repo)
-	recorder := &captureAuditRecorder{}
-	service.SetAuditRecorder(recorder)
+	item := Project{ID: int64(len(f.projects) + 1), Code: input.Code, Name: input.Name, Donor: input.Donor, Active: true}
+	f.projects = append(f.projects, item)
+	return &item, nil
+}
 
-	_, err := service.CreatePayrollBatch(context.Background(), &models.Claims{UserID: 99, Role: "Admin"}, CreateBatchInput{
-		Month: "2026-02",
-	})
-	if err != nil {
-		t.Fatalf("expected no error, got %v", err)
+func (f *fakeRepository) UpdateProject(_ context.Context, id int64, input ProjectUpsertInput) (*Project, error) {
+	for i := range f.projects {
+		if f.projects[i].ID != id && f.projects[i].Code == input.Code {
+			return nil, ErrDuplicateProject
+		}
+	}
+	for i := range f.projects {
+		if f.projects[i].ID == id {
+			f.projects[i].Code = input.Code
+			f.projects[i].Name = input.Name
+			f.projects[i].Donor = input.Donor
+			item := f.projects[i]
+			return &item, nil
+		}
 	}
+	return nil, nil
+}
 
-	if len(recorder.actions) != 1 || recorder.actions[0] != "payroll.batch.create" {
-		t.Fatalf("expected payroll.batch.create audit action, got %v", recorder.actions)
+func (f *fakeRepository) SetProjectActive(_ context.Context, id int64, active bool) (*Project, error) {
+	for i := range f.projects {
+		if f.projects[i].ID == id {
+			f.projects[i].Active = active
+			item := f.projects[i]
+			return &item, nil
+		}
+	}
+	return nil, nil
+}
+
+func (f *fakeRepository) ListEmployeeProjectAllocations(_ context.Context, employeeID int64) ([]EmployeeProjectAllocation, error) {
+	items := make([]EmployeeProjectAllocation, 0)
+	for _, allocation := range f.allocations {
+		if allocation.EmployeeID == employeeID {
+			items = append(items, allocation)
+		}
+	}
+	return items, nil
+}
+
+func (f *fakeRepository) ListProjectCharges(_ context.Context, batchID int64) ([]ProjectCharge, error) {
+	items := make([]ProjectCharge, 0)
+	for _, charge := range f.projectCharges {
+		if charge.BatchID == batchID {
+			items = append(items, charge)
+		}
+	}
+	return items, nil
+}
+
+func (f *fakeRepository) ListLockedEntriesForPeriod(_ context.Context, fromMonth, toMonth string) ([]AnnualTaxEntry, error) {
+	batchIDs := make([]int64, 0, len(f.batches))
+	for id, batch := range f.batches {
+		if batch.Status == StatusLocked && batch.Month >= fromMonth && batch.Month <= toMonth {
+			batchIDs = append(batchIDs, id)
+		}
+	}
+	sort.Slice(batchIDs, func(i, j int) bool { return batchIDs[i] < batchIDs[j] })
+	items := make([]AnnualTaxEntry, 0)
+	for _, id := range batchIDs {
+		for _, entry := range f.entriesByBatch[id] {
+			items = append(items, AnnualTaxEntry{PayrollEntry: entry, Month: f.batches[id].Month})
+		}
+	}
+	return items, nil
+}
+
+func (f *fakeRepository) ListLockedEntryLinesForPeriod(ctx context.Context, fromMonth, toMonth string) ([]PayrollEntryLine, error) {
+	entries, _ := f.ListLockedEntriesForPeriod(ctx, fromMonth, toMonth)
+	items := make([]PayrollEntryLine, 0)
+	for _, entry := range entries {
+		items = append(items, f.linesByEntry[entry.ID]...)
+	}
+	return items, nil
+}
+
+func (f *fakeRepository) ListProjectChargingRows(_ context.Context, month string) ([]ProjectChargingRow, error) {
+	type rowKey struct {
+		projectID  int64
+		employeeID int64
+	}
+	rows := make(map[rowKey]*ProjectChargingRow)
+	keys := make([]rowKey, 0)
+	for _, charge := range f.projectCharges {
+		batch := f.batches[charge.BatchID]
+		if batch == nil || batch.Month != month || batch.Status != StatusLocked {
+			continue
+		}
+		key := rowKey{employeeID: charge.EmployeeID}
+		if charge.ProjectID != nil {
+			key.projectID = *charge.ProjectID
+		}
+		row := rows[key]
+		if row == nil {
+			row = &ProjectChargingRow{ProjectID: charge.ProjectID, EmployeeID: charge.EmployeeID}
+			for _, project := range f.projects {
+				if charge.ProjectID != nil && project.ID == *charge.ProjectID {
+					row.ProjectCode, row.ProjectName, row.Donor = project.Code, project.Name, project.Donor
+				}
+			}
+			for _, employee := range f.activeEmployees {
+				if employee.EmployeeID == charge.EmployeeID {
+					row.EmployeeName = employee.EmployeeName
+				}
+			}
+			rows[key] = row
+			keys = append(keys, key)
+		}
+		row.GrossAmount += charge.GrossAmount
+		row.EmployerAmount += charge.EmployerAmount
+	}
+	sort.SliceStable(keys, func(i, j int) bool {
+		left, right := rows[keys[i]], rows[keys[j]]
+		if (left.ProjectID == nil) != (right.ProjectID == nil) {
+			return right.ProjectID == nil
+		}
+		if left.ProjectCode != right.ProjectCode {
+			return left.ProjectCode < right.ProjectCode
+		}
+		return left.EmployeeID < right.EmployeeID
+	})
+	items := make([]ProjectChargingRow, 0, len(keys))
+	for _, key := range keys {
+		if row := rows[key]; row.GrossAmount != 0 || row.EmployerAmount != 0 {
+			items = append(items, *row)
+		}
+	}
+	return items, nil
+}
+
+func sameOptionalID(left, right *int64) bool {
+	if left == nil || right == nil {
+		return left == nil && right == nil
+	}
+	return *left == *right
+}
+
+func (f *fakeRepository) ListPaymentMethodsByBatchID(_ context.Context, batchID int64) ([]EmployeePaymentMethod, error) {
+	items := make([]EmployeePaymentMethod, 0)
+	for _, entry := range f.entriesByBatch[batchID] {
+		for _, method := range f.paymentMethods {
+			if method.EmployeeID == entry.EmployeeID {
+				items = append(items, method)
+			}
+		}
+	}
+	return items, nil
+}
+
+func (f *fakeRepository) UpdateEntryAmounts(_ context.Context, entryID int64, allowancesTotal, deductionsTotal, taxTotal, grossPay, netPay money.Amount) (*PayrollEntry, error) {
+	batchID := f.entryToBatch[entryID]
+	items := f.entriesByBatch[batchID]
+	for i := range items {
+		if items[i].ID == entryID {
+			items[i].AllowancesTotal = allowancesTotal
+			items[i].DeductionsTotal = deductionsTotal
+			items[i].TaxTotal = taxTotal
+			items[i].GrossPay = grossPay
+			items[i].NetPay = netPay
+			f.entriesByBatch[batchID] = items
+			entry := items[i]
+			return &entry, nil
+		}
+	}
+	return nil, nil
+}
+
+func (f *fakeRepository) ListBatchApprovals(_ context.Context, batchID int64) ([]PayrollBatchApproval, error) {
+	items := make([]PayrollBatchApproval, 0)
+	for _, approval := range f.approvals {
+		if approval.BatchID == batchID {
+			items = append(items, approval)
+		}
+	}
+	return items, nil
+}
+
+func (f *fakeRepository) GetReversalBatch(_ context.Context, batchID int64) (*PayrollBatch, error) {
+	for _, batch := range f.batches {
+		if batch.ReversesBatchID != nil && *batch.ReversesBatchID == batchID {
+			copyBatch := *batch
+			return &copyBatch, nil
+		}
+	}
+	return nil, nil
+}
+
+func (f *fakeTxRepository) CreateReversalBatch(_ context.Context, source PayrollBatch, description string, createdBy int64) (*PayrollBatch, error) {
+	var maxID int64
+	for id := range f.parent.batches {
+		if id > maxID {
+			maxID = id
+		}
+	}
+	sourceID := source.ID
+	batch := &PayrollBatch{
+		ID:              maxID + 1,
+		Month:           source.Month,
+		BatchType:       BatchTypeCorrection,
+		Description:     description,
+		Status:          StatusDraft,
+		CreatedBy:       createdBy,
+		CreatedAt:       time.Now().UTC(),
+		ReversesBatchID: &sourceID,
+	}
+	f.parent.batches[batch.ID] = batch
+	copyBatch := *batch
+	return &copyBatch, nil
+}
+
+func (f *fakeTxRepository) CreateBatchApproval(_ context.Context, input BatchApprovalInput) error {
+	userID := input.UserID
+	f.parent.approvals = append(f.parent.approvals, PayrollBatchApproval{
+		ID:         int64(len(f.parent.approvals) + 1),
+		BatchID:    input.BatchID,
+		StepNumber: input.StepNumber,
+		StepName:   input.StepName,
+		Action:     input.Action,
+		UserID:     &userID,
+		Comment:    input.Comment,
+		CreatedAt:  time.Now().UTC(),
+	})
+	return nil
+}
+
+func (f *fakeTxRepository) SetBatchDraft(_ context.Context, batchID int64) (*PayrollBatch, error) {
+	batch := f.parent.batches[batchID]
+	if batch == nil {
+		return nil, nil
+	}
+	batch.Status = StatusDraft
+	batch.ApprovedBy = nil
+	batch.ApprovedAt = nil
+	copyBatch := *batch
+	return &copyBatch, nil
+}
+
+func (f *fakeTxRepository) SetBatchApproved(_ context.Context, batchID int64, approvedBy int64) (*PayrollBatch, error) {
+	batch := f.parent.batches[batchID]
+	if batch == nil {
+		return nil, nil
+	}
+	now := time.Now().UTC()
+	batch.Status = StatusApproved
+	batch.ApprovedBy = &approvedBy
+	batch.ApprovedAt = &now
+	copyBatch := *batch
+	return &copyBatch, nil
+}
+
+func (f *fakeRepository) SetBatchVarianceReviewed(_ context.Context, batchID int64, reviewedBy int64) (*PayrollBatch, error) {
+	batch := f.batches[batchID]
+	if batch == nil {
+		return nil, nil
+	}
+	now := time.Now().UTC()
+	batch.VarianceReviewedBy = &reviewedBy
+	batch.VarianceReviewedAt = &now
+	copyBatch := *batch
+	return &copyBatch, nil
+}
+
+func (f *fakeTxRepository) SetBatchLocked(_ context.Context, batchID int64) (*PayrollBatch, error) {
+	batch := f.parent.batches[batchID]
+	if batch == nil {
+		return nil, nil
+	}
+	now := time.Now().UTC()
+	batch.Status = StatusLocked
+	batch.LockedAt = &now
+	copyBatch := *batch
+	return &copyBatch, nil
+}
+
+func (f *fakeTxRepository) SetBatchIntegritySeal(_ context.Context, batchID int64, hash, manifest string) error {
+	batch := f.parent.batches[batchID]
+	if batch == nil {
+		return nil
+	}
+	batch.IntegrityHash = &hash
+	if f.parent.manifests == nil {
+		f.parent.manifests = map[int64]string{}
+	}
+	f.parent.manifests[batchID] = manifest
+	return nil
+}
+
+func (f *fakeRepository) ListBatchIntegritySeals(_ context.Context, batchIDs []int64) ([]BatchIntegritySeal, error) {
+	items := make([]BatchIntegritySeal, 0, len(batchIDs))
+	for _, id := range batchIDs {
+		batch := f.batches[id]
+		if batch == nil {
+			continue
+		}
+		seal := BatchIntegritySeal{PayrollBatch: *batch}
+		if manifest, ok := f.manifests[id]; ok {
+			seal.IntegrityManifest = &manifest
+		}
+		items = append(items, seal)
+	}
+	return items, nil
+}
+
+func (f *fakeRepository) ListEntriesByBatchIDs(ctx context.Context, batchIDs []int64) ([]PayrollEntry, error) {
+	items := make([]PayrollEntry, 0)
+	for _, id := range batchIDs {
+		entries, _ := f.ListEntriesByBatchID(ctx, id)
+		items = append(items, entries...)
+	}
+	return items, nil
+}
+
+func (f *fakeRepository) ListEntryLinesByBatchIDs(ctx context.Context, batchIDs []int64) ([]PayrollEntryLine, error) {
+	items := make([]PayrollEntryLine, 0)
+	for _, id := range batchIDs {
+		lines, _ := f.ListEntryLinesByBatchID(ctx, id)
+		items = append(items, lines...)
+	}
+	return items, nil
+}
+
+func (f *fakeRepository) ListLoans(_ context.Context, filter ListLoansFilter) ([]EmployeeLoan, error) {
+	items := make([]EmployeeLoan, 0, len(f.loans))
+	for _, loan := range f.loans {
+		if (filter.EmployeeID == 0 || loan.EmployeeID == filter.EmployeeID) && (filter.Status == "" || loan.Status == filter.Status) {
+			items = append(items, loan)
+		}
+	}
+	return items, nil
+}
+
+func (f *fakeRepository) GetLoanByID(_ context.Context, id int64) (*EmployeeLoan, error) {
+	for _, loan := range f.loans {
+		if loan.ID == id {
+			copyLoan := loan
+			return &copyLoan, nil
+		}
+	}
+	return nil, nil
+}
+
+func (f *fakeRepository) CreateLoan(_ context.Context, input LoanInsertInput) (*EmployeeLoan, error) {
+	loan := EmployeeLoan{
+		ID:                 int64(len(f.loans) + 1),
+		EmployeeID:         input.EmployeeID,
+		LoanType:           input.LoanType,
+		Principal:          input.Principal,
+		InterestRate:       input.InterestRate,
+		TotalRepayable:     input.TotalRepayable,
+		Installments:       input.Installments,
+		InstallmentAmount:  input.InstallmentAmount,
+		StartMonth:         input.StartMonth,
+		OutstandingBalance: input.TotalRepayable,
+		Status:             LoanStatusActive,
+		CreatedBy:          input.CreatedBy,
+	}
+	f.loans = append(f.loans, loan)
+	return &loan, nil
+}
+
+func (f *fakeRepository) SetLoanStatus(_ context.Context, id int64, status string) (*EmployeeLoan, error) {
+	for i := range f.loans {
+		if f.loans[i].ID == id {
+			f.loans[i].Status = status
+			copyLoan := f.loans[i]
+			return &copyLoan, nil
+		}
+	}
+	return nil, nil
+}
+
+func (f *fakeRepository) ListLoanRepayments(_ context.Context, loanID int64) ([]LoanRepayment, error) {
+	items := make([]LoanRepayment, 0)
+	for _, repayment := range f.loanRepayments {
+		if repayment.LoanID == loanID {
+			items = append(items, repayment)
+		}
+	}
+	return items, nil
+}
+
+func (f *fakeRepository) WithTx(ctx context.Context, fn func(tx TxRepository) error) error {
+	if f.beforeTx != nil {
+		f.beforeTx()
+	}
+	staged := make(map[int64][]PayrollEntry, len(f.entriesByBatch))
+	for batchID, entries := range f.entriesByBatch {
+		cloned := make([]PayrollEntry, len(entries))
+		copy(cloned, entries)
+		staged[batchID] = cloned
+	}
+
+	stagedLines := make(map[int64][]PayrollEntryLine, len(f.linesByEntry))
+	for entryID, lines := range f.linesByEntry {
+		cloned := make([]PayrollEntryLine, len(lines))
+		copy(cloned, lines)
+		stagedLines[entryID] = cloned
+	}
+
+	tx := &fakeTxRepository{parent: f, stagedEntriesByBatch: staged, stagedLinesByEntry: stagedLines}
+	if err := fn(tx); err != nil {
+		return err
+	}
+
+	tx.ensureState()
+	f.entriesByBatch = tx.stagedEntriesByBatch
+	f.entryToBatch = tx.stagedEntryToBatch
+	f.linesByEntry = tx.stagedLinesByEntry
+	return nil
+}
+
+type fakeTxRepository struct {
+	parent               *fakeRepository
+	stagedEntriesByBatch map[int64][]PayrollEntry
+	stagedEntryToBatch   map[int64]int64
+	stagedLinesByEntry   map[int64][]PayrollEntryLine
+	nextID               int64
+	nextLineID           int64
+}
+
+func (f *fakeTxRepository) ensureState() {
+	if f.stagedEntryToBatch != nil {
+		return
+	}
+	f.stagedEntryToBatch = make(map[int64]int64, len(f.parent.entryToBatch))
+	for entryID, batchID := range f.parent.entryToBatch {
+		f.stagedEntryToBatch[entryID] = batchID
+	}
+	var maxID int64
+	for entryID := range f.stagedEntryToBatch {
+		if entryID > maxID {
+			maxID = entryID
+		}
+	}
+	f.nextID = maxID + 1
+
+	var maxLineID int64
+	for _, lines := range f.stagedLinesByEntry {
+		for _, line := range lines {
+			if line.ID > maxLineID {
+				maxLineID = line.ID
+			}
+		}
+	}
+	f.nextLineID = maxLineID + 1
+}
+
+func (f *fakeTxRepository) DeleteEntriesByBatchID(_ context.Context, batchID int64) error {
+	f.ensureState()
+	for entryID, mappedBatchID := range f.stagedEntryToBatch {
+		if mappedBatchID == batchID {
+			delete(f.stagedEntryToBatch, entryID)
+			delete(f.stagedLinesByEntry, entryID)
+		}
+	}
+	f.stagedEntriesByBatch[batchID] = []PayrollEntry{}
+	return nil
+}
+
+func (f *fakeTxRepository) ListActiveEmployeeSalaries(_ context.Context, periodStart, periodEnd time.Time) ([]EmployeeSalary, error) {
+	items := make([]EmployeeSalary, 0, len(f.parent.activeEmployees))
+	for _, employee := range f.parent.activeEmployees {
+		if employee.DateOfHire.After(periodEnd) || (employee.DateOfExit != nil && employee.DateOfExit.Before(periodStart)) {
+			continue
+		}
+		items = append(items, employee)
+	}
+	return items, nil
+}
+
+func (f *fakeTxRepository) ListEmployeeSalariesByIDs(_ context.Context, employeeIDs []int64, periodEnd time.Time) ([]EmployeeSalary, error) {
+	wanted := make(map[int64]bool, len(employeeIDs))
+	for _, id := range employeeIDs {
+		wanted[id] = true
+	}
+	items := make([]EmployeeSalary, 0, len(employeeIDs))
+	for _, employee := range f.parent.activeEmployees {
+		if employee.DateOfHire.After(periodEnd) || !wanted[employee.EmployeeID] {
+			continue
+		}
+		items = append(items, employee)
+	}
+	return items, nil
+}
+
+func (f *fakeTxRepository) ListSalaryRates(ctx context.Context, employeeID int64) ([]SalaryRate, error) {
+	return f.parent.ListSalaryRates(ctx, employeeID)
+}
+
+func (f *fakeTxRepository) ListAbsenceRecords(_ context.Context, periodStart, periodEnd time.Time) ([]AbsenceRecord, error) {
+	items := make([]AbsenceRecord, 0, len(f.parent.absences))
+	for _, record := range f.parent.absences {
+		if record.StartDate.After(periodEnd) || record.EndDate.Before(periodStart) {
+			continue
+		}
+		items = append(items, record)
+	}
+	return items, nil
+}
+
+func (f *fakeTxRepository) ListRecoverableLoans(_ context.Context, month string) ([]EmployeeLoan, error) {
+	pending := make(map[int64]money.Amount)
+	for batchID, entries := range f.stagedEntriesByBatch {
+		batch := f.parent.batches[batchID]
+		if batch == nil || batch.Status == StatusLocked || batch.Month == month {
+			continue
+		}
+		for _, entry := range entries {
+			for _, line := range f.stagedLinesByEntry[entry.ID] {
+				if line.LoanID != nil && line.Amount > 0 {
+					pending[*line.LoanID] += line.Amount
+				}
+			}
+		}
+	}
+	items := make([]EmployeeLoan, 0)
+	for _, loan := range f.parent.loans {
+		loan.OutstandingBalance -= pending[loan.ID]
+		if loan.Status == LoanStatusActive && loan.OutstandingBalance > 0 && loan.StartMonth <= month {
+			items = append(items, loan)
+		}
+	}
+	return items, nil
+}
+
+func (f *fakeTxRepository) ListLoanRecoveries(_ context.Context, batchID int64) ([]LoanRecovery, error) {
+	items := make([]LoanRecovery, 0)
+	for _, entry := range f.stagedEntriesByBatch[batchID] {
+		for _, line := range f.stagedLinesByEntry[entry.ID] {
+			if line.LoanID != nil && line.Amount != 0 {
+				items = append(items, LoanRecovery{LoanID: *line.LoanID, EntryID: entry.ID, EmployeeID: entry.EmployeeID, Amount: line.Amount})
+			}
+		}
+	}
+	return items, nil
+}
+
+func (f *fakeTxRepository) ApplyLoanRepayment(_ context.Context, batch PayrollBatch, recovery LoanRecovery) (*LoanRepayment, error) {
+	for i := range f.parent.loans {
+		loan := &f.parent.loans[i]
+		if loan.ID != recovery.LoanID {
+			continue
+		}
+		if recovery.Amount > loan.OutstandingBalance {
+			return nil, fmt.Errorf("%w: loan %d recovery of %s exceeds its outstanding balance; regenerate the batch", ErrValidation, loan.ID, recovery.Amount)
+		}
+		loan.OutstandingBalance = loan.OutstandingBalance - recovery.Amount
+		if loan.OutstandingBalance <= 0 {
+			loan.Status = LoanStatusSettled
+		} else if loan.Status == LoanStatusSettled {
+			loan.Status = LoanStatusActive
+		}
+		entryID := recovery.EntryID
+		repayment := LoanRepayment{
+			ID:           int64(len(f.parent.loanRepayments) + 1),
+			LoanID:       loan.ID,
+			BatchID:      batch.ID,
+			EntryID:      &entryID,
+			Month:        batch.Month,
+			Amount:       recovery.Amount,
+			BalanceAfter: loan.OutstandingBalance,
+		}
+		f.parent.loanRepayments = append(f.parent.loanRepayments, repayment)
+		return &repayment, nil
+	}
+	return nil, ErrNotFound
+}
+
+func (f *fakeTxRepository) ListAutoApplyComponents(_ context.Context) ([]PayComponent, error) {
+	items := make([]PayComponent, 0)
+	for id := int64(1); id <= int64(len(f.parent.components)); id++ {
+		component := f.parent.components[id]
+		if component != nil && component.Active && component.AutoApply {
+			items = append(items, *component)
+		}
+	}
+	return items, nil
+}
+
+func (f *fakeTxRepository) ListContributionSchemes(ctx context.Context) ([]ContributionScheme, error) {
+	return f.parent.ListContributionSchemes(ctx, false)
+}
+
+func (f *fakeTxRepository) ListContributionSchemeMembers(_ context.Context) ([]ContributionSchemeMember, error) {
+	items := make([]ContributionSchemeMember, len(f.parent.schemeMembers))
+	copy(items, f.parent.schemeMembers)
+	return items, nil
+}
+
+func (f *fakeTxRepository) CreateEntry(_ context.Context, input EntryCreateInput) (int64, error) {
+	f.ensureState()
+	if f.parent.failEmployeeID != 0 && input.EmployeeID == f.parent.failEmployeeID {
+		return 0, errors.New("forced create error")
+	}
+	entry := PayrollEntry{
+		ID:              f.nextID,
+		BatchID:         input.BatchID,
+		EmployeeID:      input.EmployeeID,
+		BaseSalary:      input.BaseSalary,
+		AllowancesTotal: input.AllowancesTotal,
+		DeductionsTotal: input.DeductionsTotal,
+		TaxTotal:        input.TaxTotal,
+		GrossPay:        input.GrossPay,
+		NetPay:          input.NetPay,
+
+		EmployerContributionsTotal: input.EmployerContributionsTotal,
+		FullBaseSalary:             input.FullBaseSalary,
+		ProrationBasis:             input.ProrationBasis,
+		PayableDays:                input.PayableDays,
+		PeriodDays:                 input.PeriodDays,
+	}
+	f.nextID++
+	f.stagedEntriesByBatch[input.BatchID] = append(f.stagedEntriesByBatch[input.BatchID], entry)
+	f.stagedEntryToBatch[entry.ID] = input.BatchID
+	return entry.ID, nil
+}
+
+func (f *fakeTxRepository) GetEntryByID(_ context.Context, entryID int64) (*PayrollEntry, error) {
+	f.ensureState()
+	batchID, ok := f.stagedEntryToBatch[entryID]
+	if !ok {
+		return nil, nil
+	}
+	for _, entry := range f.stagedEntriesByBatch[batchID] {
+		if entry.ID == entryID {
+			copyEntry := entry
+			return &copyEntry, nil
+		}
+	}
+	return nil, nil
+}
+
+func (f *fakeTxRepository) ListEntryLines(_ context.Context, entryID int64) ([]PayrollEntryLine, error) {
+	items := make([]PayrollEntryLine, len(f.stagedLinesByEntry[entryID]))
+	copy(items, f.stagedLinesByEntry[entryID])
+	return items, nil
+}
+
+func (f *fakeTxRepository) ListEntriesByBatchID(_ context.Context, batchID int64) ([]PayrollEntry, error) {
+	items := make([]PayrollEntry, len(f.stagedEntriesByBatch[batchID]))
+	copy(items, f.stagedEntriesByBatch[batchID])
+	return items, nil
+}
+
+func (f *fakeTxRepository) ListEntryLinesByBatchID(_ context.Context, batchID int64) ([]PayrollEntryLine, error) {
+	items := make([]PayrollEntryLine, 0)
+	for _, entry := range f.stagedEntriesByBatch[batchID] {
+		items = append(items, f.stagedLinesByEntry[entry.ID]...)
+	}
+	return items, nil
+}
+
+func (f *fakeTxRepository) ListEntryLineSourcesByBatchID(ctx context.Context, batchID int64) ([]PayrollEntryLineSource, error) {
+	lines, _ := f.ListEntryLinesByBatchID(ctx, batchID)
+	return collectLineSources(lines), nil
+}
+
+func (f *fakeTxRepository) CreateEntryLine(_ context.Context, input EntryLineCreateInput) error {
+	f.ensureState()
+	line := PayrollEntryLine{
+		ID:             f.nextLineID,
+		EntryID:        input.EntryID,
+		ComponentID:    input.ComponentID,
+		SchemeID:       input.SchemeID,
+		Code:           input.Code,
+		Name:           input.Name,
+		Kind:           input.Kind,
+		Taxable:        input.Taxable,
+		Amount:         input.Amount,
+		EmployerAmount: input.EmployerAmount,
+		Quantity:       input.Quantity,
+		Rate:           input.Rate,
+		LoanID:         input.LoanID,
+		ArrearsMonth:   input.ArrearsMonth,
+	}
+	for _, source := range input.Sources {
+		source.LineID = line.ID
+		line.Sources = append(line.Sources, source)
+	}
+	f.nextLineID++
+	f.stagedLinesByEntry[input.EntryID] = append(f.stagedLinesByEntry[input.EntryID], line)
+	return nil
+}
+
+func (f *fakeTxRepository) DeleteEntryLine(_ context.Context, lineID int64) error {
+	for entryID, lines := range f.stagedLinesByEntry {
+		kept := make([]PayrollEntryLine, 0, len(lines))
+		for _, line := range lines {
+			if line.ID != lineID {
+				kept = append(kept, line)
+			}
+		}
+		f.stagedLinesByEntry[entryID] = kept
+	}
+	return nil
+}
+
+func (f *fakeTxRepository) UpdateEntryLineAmounts(_ context.Context, lineID int64, amount, employerAmount money.Amount) error {
+	for entryID, lines := range f.stagedLinesByEntry {
+		for i := range lines {
+			if lines[i].ID == lineID {
+				lines[i].Amount = amount
+				lines[i].EmployerAmount = employerAmount
+			}
+		}
+		f.stagedLinesByEntry[entryID] = lines
+	}
+	return nil
+}
+
+func (f *fakeTxRepository) UpdateEntryTotals(_ context.Context, entryID int64, input EntryTotalsInput) error {
+	f.ensureState()
+	batchID := f.stagedEntryToBatch[entryID]
+	items := f.stagedEntriesByBatch[batchID]
+	for i := range items {
+		if items[i].ID == entryID {
+			items[i].AllowancesTotal = input.AllowancesTotal
+			items[i].DeductionsTotal = input.DeductionsTotal
+			items[i].TaxTotal = input.TaxTotal
+			items[i].GrossPay = input.GrossPay
+			items[i].NetPay = input.NetPay
+			items[i].EmployerContributionsTotal = input.EmployerContributionsTotal
+		}
+	}
+	return nil
+}
+
+func (f *fakeTxRepository) DeleteEmployeePaymentMethods(_ context.Context, employeeID int64) error {
+	kept := make([]EmployeePaymentMethod, 0, len(f.parent.paymentMethods))
+	for _, method := range f.parent.paymentMethods {
+		if method.EmployeeID != employeeID {
+			kept = append(kept, method)
+		}
+	}
+	f.parent.paymentMethods = kept
+	return nil
+}
+
+func (f *fakeTxRepository) CreateEmployeePaymentMethod(_ context.Context, employeeID int64, input EmployeePaymentMethodInput) error {
+	method := EmployeePaymentMethod{
+		ID:           int64(len(f.parent.paymentMethods) + 1),
+		EmployeeID:   employeeID,
+		Method:       input.Method,
+		SplitPercent: input.SplitPercent,
+	}
+	if input.BankName != "" {
+		method.BankName = stringPtr(input.BankName)
+		method.AccountNumber = stringPtr(input.AccountNumber)
+	}
+	if input.Provider != "" {
+		method.Provider = stringPtr(input.Provider)
+		method.MSISDN = stringPtr(input.MSISDN)
+	}
+	f.parent.paymentMethods = append(f.parent.paymentMethods, method)
+	return nil
+}
+
+func (f *fakeTxRepository) DeleteEmployeeProjectAllocations(_ context.Context, employeeID int64) error {
+	kept := make([]EmployeeProjectAllocation, 0, len(f.parent.allocations))
+	for _, allocation := range f.parent.allocations {
+		if allocation.EmployeeID != employeeID {
+			kept = append(kept, allocation)
+		}
+	}
+	f.parent.allocations = kept
+	return nil
+}
+
+func (f *fakeTxRepository) CreateEmployeeProjectAllocation(_ context.Context, employeeID int64, input ProjectAllocationInsert) error {
+	allocation := EmployeeProjectAllocation{
+		ID:         int64(len(f.parent.allocations) + 1),
+		EmployeeID: employeeID,
+		ProjectID:  input.ProjectID,
+		Percent:    input.Percent,
+		StartDate:  input.StartDate,
+		EndDate:    input.EndDate,
+	}
+	for _, project := range f.parent.projects {
+		if project.ID == input.ProjectID {
+			allocation.ProjectCode, allocation.ProjectName = project.Code, project.Name
+		}
+	}
+	f.parent.allocations = append(f.parent.allocations, allocation)
+	return nil
+}
+
+func (f *fakeTxRepository) ListProjectAllocations(_ context.Context, periodStart, periodEnd time.Time) ([]EmployeeProjectAllocation, error) {
+	items := make([]EmployeeProjectAllocation, 0)
+	for _, allocation := range f.parent.allocations {
+		if allocation.StartDate.After(periodEnd) || (allocation.EndDate != nil && allocation.EndDate.Before(periodStart)) {
+			continue
+		}
+		items = append(items, allocation)
+	}
+	return items, nil
+}
+
+func (f *fakeTxRepository) CreateProjectCharge(_ context.Context, charge ProjectCharge) error {
+	charge.ID = int64(len(f.parent.projectCharges) + 1)
+	f.parent.projectCharges = append(f.parent.projectCharges, charge)
+	return nil
+}
+
+func TestApprovePayrollBatchRequiresDraft(t *testing.T) {
+	repo := &fakeRepository{
+		batches: map[int64]*PayrollBatch{1: {ID: 1, Status: StatusApproved}},
+	}
+	service := NewService(repo)
+
+	_, err := service.ApprovePayrollBatch(context.Background(), &models.Claims{UserID: 1, Role: "Admin"}, 1, "")
+	if !errors.Is(err, ErrInvalidTransition) {
+		t.Fatalf("expected ErrInvalidTransition, got %v", err)
+	}
+}
+
+func TestLockPayrollBatchRequiresApproved(t *testing.T) {
+	repo := &fakeRepository{
+		batches: map[int64]*PayrollBatch{1: {ID: 1, Status: StatusDraft}},
+	}
+	service := NewService(repo)
+
+	_, err := service.LockPayrollBatch(context.Background(), 1)
+	if !errors.Is(err, ErrInvalidTransition) {
+		t.Fatalf("expected ErrInvalidTransition, got %v", err)
+	}
+}
+
+func TestUpdatePayrollEntryAmountsRequiresDraftBatch(t *testing.T) {
+	repo := &fakeRepository{
+		batches: map[int64]*PayrollBatch{10: {ID: 10, Status: StatusLocked}},
+		entriesByBatch: map[int64][]PayrollEntry{
+			10: {{ID: 44, BatchID: 10, BaseSalary: money.FromUnits(1000)}},
+		},
+		entryToBatch: map[int64]int64{44: 10},
+	}
+	service := NewService(repo)
+
+	_, err := service.UpdatePayrollEntryAmounts(context.Background(), 44, UpdateEntryAmountsInput{
+		AllowancesTotal: money.FromUnits(100),
+		DeductionsTotal: money.FromUnits(20),
+	})
+	if !errors.Is(err, ErrImmutableBatch) {
+		t.Fatalf("expected ErrImmutableBatch, got %v", err)
+	}
+}
+
+func TestGeneratePayrollEntriesRollsBackOnInsertFailure(t *testing.T) {
+	repo := &fakeRepository{
+		batches: map[int64]*PayrollBatch{7: {ID: 7, Month: "2026-02", Status: StatusDraft}},
+		entriesByBatch: map[int64][]PayrollEntry{
+			7: {{ID: 99, BatchID: 7, EmployeeID: 55, BaseSalary: money.FromUnits(500)}},
+		},
+		entryToBatch: map[int64]int64{99: 7},
+		activeEmployees: []EmployeeSalary{
+			{EmployeeID: 101, EmployeeName: "A", BaseSalary: money.FromUnits(1200)},
+			{EmployeeID: 202, EmployeeName: "B", BaseSalary: money.FromUnits(1500)},
+		},
+		failEmployeeID: 202,
+	}
+	service := NewService(repo)
+
+	err := service.GeneratePayrollEntries(context.Background(), 7)
+	if err == nil {
+		t.Fatal("expected generation error")
+	}
+
+	entries := repo.entriesByBatch[7]
+	if len(entries) != 1 || entries[0].ID != 99 {
+		t.Fatalf("expected rollback to preserve existing entries, got %#v", entries)
+	}
+
+	if repo.entryToBatch[99] != 7 {
+		t.Fatalf("expected original entry mapping to remain after rollback")
+	}
+}
+
+func TestCreatePayrollBatchRecordsAuditEvent(t *testing.T) {
+	repo := &fakeRepository{
+		batches:        map[int64]*PayrollBatch{},
+		entriesByBatch: map[int64][]PayrollEntry{},
+		entryToBatch:   map[int64]int64{},
+	}
+	service := NewService(repo)
+	recorder := &captureAuditRecorder{}
+	service.SetAuditRecorder(recorder)
+
+	_, err := service.CreatePayrollBatch(context.Background(), &models.Claims{UserID: 99, Role: "Admin"}, CreateBatchInput{
+		Month: "2026-02",
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if len(recorder.actions) != 1 || recorder.actions[0] != "payroll.batch.create" {
+		t.Fatalf("expected payroll.batch.create audit action, got %v", recorder.actions)
+	}
+}
+
+func TestGeneratePayrollEntriesAppliesAutoComponents(t *testing.T) {
+	repo := &fakeRepository{
+		batches:        map[int64]*PayrollBatch{3: {ID: 3, Month: "2026-02", Status: StatusDraft}},
+		entriesByBatch: map[int64][]PayrollEntry{},
+		entryToBatch:   map[int64]int64{},
+		components: map[int64]*PayComponent{
+			1: {ID: 1, Code: "HOUSING", Name: "Housing Allowance", Kind: ComponentKindEarning, Taxable: true, CalculationType: CalculationPercentage, Value: 10, AutoApply: true, Active: true},
+			2: {ID: 2, Code: "SACCO", Name: "SACCO", Kind: ComponentKindDeduction, CalculationType: CalculationFixed, Value: 20000, AutoApply: true, Active: true},
+			3: {ID: 3, Code: "BONUS", Name: "Bonus", Kind: ComponentKindEarning, CalculationType: CalculationFixed, Value: 50000, AutoApply: false, Active: true},
+		},
+		activeEmployees: []EmployeeSalary{
+			{EmployeeID: 101, EmployeeName: "A", BaseSalary: money.FromUnits(1000000)},
+		},
+	}
+	service := NewService(repo)
+
+	if err := service.GeneratePayrollEntries(context.Background(), 3); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	detail, err := service.GetPayrollBatch(context.Background(), 3)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(detail.Entries) != 1 {
+		t.Fatalf("expected 1 entry, got %d", len(detail.Entries))
+	}
+	entry := detail.Entries[0]
+	if len(entry.Lines) != 2 {
+		t.Fatalf("expected 2 auto-applied lines, got %#v", entry.Lines)
+	}
+	if entry.AllowancesTotal != money.FromUnits(100000) || entry.DeductionsTotal != money.FromUnits(20000) {
+		t.Fatalf("expected allowances 100000 and deductions 20000, got %s and %s", entry.AllowancesTotal, entry.DeductionsTotal)
+	}
+	if entry.GrossPay != money.FromUnits(1100000) || entry.NetPay != money.FromUnits(1080000) {
+		t.Fatalf("expected gross 1100000 and net 1080000, got %s and %s", entry.GrossPay, entry.NetPay)
+	}
+}
+
+func TestAddAndRemovePayrollEntryLineRecalculatesTotals(t *testing.T) {
+	repo := &fakeRepository{
+		batches: map[int64]*PayrollBatch{5: {ID: 5, Status: StatusDraft}},
+		entriesByBatch: map[int64][]PayrollEntry{
+			5: {{ID: 50, BatchID: 5, EmployeeID: 7, BaseSalary: money.FromUnits(800000), GrossPay: money.FromUnits(800000), NetPay: money.FromUnits(800000)}},
+		},
+		entryToBatch: map[int64]int64{50: 5},
+		linesByEntry: map[int64][]PayrollEntryLine{},
+		components: map[int64]*PayComponent{
+			1: {ID: 1, Code: "TRANSPORT", Name: "Transport Allowance", Kind: ComponentKindEarning, CalculationType: CalculationFixed, Value: 120000, Active: true},
+		},
+	}
+	service := NewService(repo)
+	recorder := &captureAuditRecorder{}
+	service.SetAuditRecorder(recorder)
+
+	entry, err := service.AddPayrollEntryLine(context.Background(), 50, AddEntryLineInput{ComponentID: 1})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(entry.Lines) != 1 || entry.AllowancesTotal != money.FromUnits(120000) || entry.NetPay != money.FromUnits(920000) {
+		t.Fatalf("expected itemized transport allowance to roll up, got %#v", entry)
+	}
+
+	_, err = service.UpdatePayrollEntryAmounts(context.Background(), 50, UpdateEntryAmountsInput{AllowancesTotal: money.FromUnits(1), DeductionsTotal: 0})
+	if !errors.Is(err, ErrValidation) {
+		t.Fatalf("expected ErrValidation for manual totals on itemized entry, got %v", err)
+	}
+
+	entry, err = service.RemovePayrollEntryLine(context.Background(), entry.Lines[0].ID)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(entry.Lines) != 0 || entry.AllowancesTotal != 0 || entry.NetPay != money.FromUnits(800000) {
+		t.Fatalf("expected totals to reset after removing line, got %#v", entry)
+	}
+
+	if len(recorder.actions) != 2 || recorder.actions[0] != "payroll.entry.line.add" || recorder.actions[1] != "payroll.entry.line.remove" {
+		t.Fatalf("expected line add/remove audit actions, got %v", recorder.actions)
+	}
+}
+
+func TestCreatePayComponentValidatesInput(t *testing.T) {
+	service := NewService(&fakeRepository{components: map[int64]*PayComponent{}})
+
+	_, err := service.CreatePayComponent(context.Background(), PayComponentUpsertInput{
+		Code:            "HOUSING",
+		Name:            "Housing",
+		Kind:            ComponentKindEarning,
+		CalculationType: CalculationPercentage,
+		Value:           150,
+	})
+	if !errors.Is(err, ErrValidation) {
+		t.Fatalf("expected ErrValidation for percentage above 100, got %v", err)
+	}
+
+	created, err := service.CreatePayComponent(context.Background(), PayComponentUpsertInput{
+		Code:            " housing ",
+		Name:            "Housing",
+		Kind:            "Earning",
+		CalculationType: "fixed",
+		Value:           250000,
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if created.Code != "HOUSING" || created.Kind != ComponentKindEarning {
+		t.Fatalf("expected normalized code and kind, got %#v", created)
+	}
+}
+
+type fakeTaxRules struct {
+	tables []TaxTable
+}
+
+func (f fakeTaxRules) GetPayrollTaxTables(context.Context) ([]TaxTable, error) {
+	return f.tables, nil
+}
+
+func TestGeneratePayrollEntriesAppliesEffectivePAYE(t *testing.T) {
+	repo := &fakeRepository{
+		batches: map[int64]*PayrollBatch{
+			1: {ID: 1, Month: "2025-06", Status: StatusDraft},
+			2: {ID: 2, Month: "2025-07", Status: StatusDraft},
+		},
+		entriesByBatch: map[int64][]PayrollEntry{},
+		entryToBatch:   map[int64]int64{},
+		components: map[int64]*PayComponent{
+			1: {ID: 1, Code: "HOUSING", Name: "Housing Allowance", Kind: ComponentKindEarning, Taxable: true, CalculationType: CalculationFixed, Value: 100000, AutoApply: true, Active: true},
+			2: {ID: 2, Code: "PERDIEM", Name: "Per Diem", Kind: ComponentKindEarning, Taxable: false, CalculationType: CalculationFixed, Value: 50000, AutoApply: true, Active: true},
+		},
+		activeEmployees: []EmployeeSalary{
+			{EmployeeID: 101, EmployeeName: "A", BaseSalary: money.FromUnits(900000)},
+		},
+	}
+	flatRate := TaxTable{Name: "flat", EffectiveFrom: "2025-07", Bands: []TaxBand{{Threshold: 0, Rate: 10}}}
+	service := NewService(repo)
+	service.SetTaxRulesProvider(fakeTaxRules{tables: append(DefaultTaxTables(), flatRate)})
+
+	for _, batchID := range []int64{1, 2} {
+		if err := service.GeneratePayrollEntries(context.Background(), batchID); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	}
+
+	june, err := service.GetPayrollBatch(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	// Taxable pay is 1,000,000: 25,000 + 30% of 590,000.
+	if entry := june.Entries[0]; entry.TaxTotal != money.FromUnits(202000) || entry.NetPay != money.FromUnits(848000) {
+		t.Fatalf("expected statutory PAYE 202000 and net 848000, got %s and %s", entry.TaxTotal, entry.NetPay)
+	}
+
+	july, err := service.GetPayrollBatch(context.Background(), 2)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if entry := july.Entries[0]; entry.TaxTotal != money.FromUnits(100000) {
+		t.Fatalf("expected July batch to use the newer flat table, got %s", entry.TaxTotal)
+	}
+}
+
+func TestPayrollEntryRecalculationReappliesPAYE(t *testing.T) {
+	repo := &fakeRepository{
+		batches: map[int64]*PayrollBatch{5: {ID: 5, Month: "2025-07", Status: StatusDraft}},
+		entriesByBatch: map[int64][]PayrollEntry{
+			5: {{ID: 50, BatchID: 5, EmployeeID: 7, BaseSalary: money.FromUnits(335000), TaxTotal: money.FromUnits(10000), GrossPay: money.FromUnits(335000), NetPay: money.FromUnits(325000)}},
+		},
+		entryToBatch: map[int64]int64{50: 5},
+		linesByEntry: map[int64][]PayrollEntryLine{},
+		components: map[int64]*PayComponent{
+			1: {ID: 1, Code: "TRANSPORT", Name: "Transport Allowance", Kind: ComponentKindEarning, Taxable: true, CalculationType: CalculationFixed, Value: 75000, Active: true},
+		},
+	}
+	service := NewService(repo)
+	service.SetTaxRulesProvider(fakeTaxRules{tables: DefaultTaxTables()})
+
+	entry, err := service.AddPayrollEntryLine(context.Background(), 50, AddEntryLineInput{ComponentID: 1})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if entry.TaxTotal != money.FromUnits(25000) || entry.NetPay != money.FromUnits(385000) {
+		t.Fatalf("expected PAYE 25000 and net 385000 after adding taxable line, got %s and %s", entry.TaxTotal, entry.NetPay)
+	}
+
+	manualTax := money.FromUnits(1)
+	_, err = service.UpdatePayrollEntryAmounts(context.Background(), 50, UpdateEntryAmountsInput{AllowancesTotal: money.FromUnits(75000), DeductionsTotal: 0, TaxTotal: &manualTax})
+	if !errors.Is(err, ErrValidation) {
+		t.Fatalf("expected ErrValidation for tax that differs from PAYE, got %v", err)
+	}
+	payeTax := money.FromUnits(25000)
+	entry, err = service.UpdatePayrollEntryAmounts(context.Background(), 50, UpdateEntryAmountsInput{AllowancesTotal: money.FromUnits(75000), DeductionsTotal: 0, TaxTotal: &payeTax})
+	if err != nil {
+		t.Fatalf("expected tax equal to PAYE to be accepted, got %v", err)
+	}
+	if entry.TaxTotal != payeTax {
+		t.Fatalf("expected PAYE 25000, got %s", entry.TaxTotal)
+	}
+	detail, err := service.GetPayrollBatch(context.Background(), 5)
+	if err != nil || !detail.TaxComputed {
+		t.Fatalf("expected the batch to report tax as computed, got %v", err)
+	}
+	service.SetTaxRulesProvider(fakeTaxRules{})
+	if detail, err = service.GetPayrollBatch(context.Background(), 5); err != nil || detail.TaxComputed {
+		t.Fatalf("expected manual tax without a tax table, got %v", err)
+	}
+}
+
+func TestGeneratePayrollEntriesAppliesContributionSchemes(t *testing.T) {
+	repo := &fakeRepository{
+		batches:        map[int64]*PayrollBatch{1: {ID: 1, Month: "2025-07", Status: StatusDraft}},
+		entriesByBatch: map[int64][]PayrollEntry{},
+		entryToBatch:   map[int64]int64{},
+		components: map[int64]*PayComponent{
+			1: {ID: 1, Code: "HOUSING", Name: "Housing Allowance", Kind: ComponentKindEarning, Taxable: true, CalculationType: CalculationFixed, Value: 200000, AutoApply: true, Active: true},
+		},
+		schemes: map[int64]*ContributionScheme{
+			1: {ID: 1, Code: "NSSF", Name: "National Social Security Fund", EmployeeRate: 5, EmployerRate: 10, Base: ContributionBaseGross, Active: true},
+			2: {ID: 2, Code: "PENSION", Name: "Staff Pension", EmployeeRate: 2, EmployerRate: 3, Base: ContributionBaseSalary, MembersOnly: true, Active: true},
+		},
+		schemeMembers: []ContributionSchemeMember{{SchemeID: 2, EmployeeID: 101, MemberNumber: "P-001"}},
+		activeEmployees: []EmployeeSalary{
+			{EmployeeID: 101, EmployeeName: "A", BaseSalary: money.FromUnits(1000000)},
+			{EmployeeID: 102, EmployeeName: "B", BaseSalary: money.FromUnits(800000)},
+		},
+	}
+	service := NewService(repo)
+
+	if err := service.GeneratePayrollEntries(context.Background(), 1); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	detail, err := service.GetPayrollBatch(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	member := detail.Entries[0]
+	// NSSF on gross 1,200,000 (60,000 / 120,000) plus pension on base 1,000,000 (20,000 / 30,000).
+	if member.DeductionsTotal != money.FromUnits(80000) || member.EmployerContributionsTotal != money.FromUnits(150000) {
+		t.Fatalf("expected deductions 80000 and employer cost 150000, got %s and %s", member.DeductionsTotal, member.EmployerContributionsTotal)
+	}
+	if member.NetPay != money.FromUnits(1120000) {
+		t.Fatalf("expected employer share to leave net pay at 1120000, got %s", member.NetPay)
+	}
+
+	nonMember := detail.Entries[1]
+	if len(nonMember.Lines) != 2 || nonMember.DeductionsTotal != money.FromUnits(50000) || nonMember.EmployerContributionsTotal != money.FromUnits(100000) {
+		t.Fatalf("expected only NSSF for non-member, got %#v", nonMember)
+	}
+}
+
+func TestContributionLinesFollowEarningChanges(t *testing.T) {
+	schemeID := int64(1)
+	repo := &fakeRepository{
+		batches: map[int64]*PayrollBatch{5: {ID: 5, Status: StatusDraft}},
+		entriesByBatch: map[int64][]PayrollEntry{
+			5: {{ID: 50, BatchID: 5, EmployeeID: 7, BaseSalary: money.FromUnits(1000000), DeductionsTotal: money.FromUnits(50000), GrossPay: money.FromUnits(1000000), NetPay: money.FromUnits(950000), EmployerContributionsTotal: money.FromUnits(100000)}},
+		},
+		entryToBatch: map[int64]int64{50: 5},
+		linesByEntry: map[int64][]PayrollEntryLine{
+			50: {{ID: 1, EntryID: 50, SchemeID: &schemeID, Code: "NSSF", Name: "NSSF", Kind: ComponentKindDeduction, Amount: money.FromUnits(50000), EmployerAmount: money.FromUnits(100000)}},
+		},
+		components: map[int64]*PayComponent{
+			1: {ID: 1, Code: "TRANSPORT", Name: "Transport Allowance", Kind: ComponentKindEarning, CalculationType: CalculationFixed, Value: 100000, Active: true},
+		},
+		schemes: map[int64]*ContributionScheme{
+			1: {ID: 1, Code: "NSSF", Name: "NSSF", EmployeeRate: 5, EmployerRate: 10, Base: ContributionBaseGross, Active: true},
+		},
+	}
+	service := NewService(repo)
+
+	entry, err := service.AddPayrollEntryLine(context.Background(), 50, AddEntryLineInput{ComponentID: 1})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if entry.DeductionsTotal != money.FromUnits(55000) || entry.EmployerContributionsTotal != money.FromUnits(110000) || entry.NetPay != money.FromUnits(1045000) {
+		t.Fatalf("expected NSSF to follow the new gross, got %#v", entry)
+	}
+}
+
+func TestExportContributionScheduleCSV(t *testing.T) {
+	schemeID := int64(1)
+	repo := &fakeRepository{
+		batches: map[int64]*PayrollBatch{5: {ID: 5, Month: "2025-07", Status: StatusLocked}},
+		entriesByBatch: map[int64][]PayrollEntry{
+			5: {{ID: 50, BatchID: 5, EmployeeID: 7, EmployeeName: "Jane Doe", BaseSalary: money.FromUnits(1000000), GrossPay: money.FromUnits(1000000)}},
+		},
+		entryToBatch: map[int64]int64{50: 5},
+		linesByEntry: map[int64][]PayrollEntryLine{
+			50: {{ID: 1, EntryID: 50, SchemeID: &schemeID, Code: "NSSF", Kind: ComponentKindDeduction, Amount: money.FromUnits(50000), EmployerAmount: money.FromUnits(100000)}},
+		},
+		schemes: map[int64]*ContributionScheme{
+			1: {ID: 1, Code: "NSSF", Name: "NSSF", EmployeeRate: 5, EmployerRate: 10, Base: ContributionBaseGross, Active: true},
+		},
+		schemeMembers: []ContributionSchemeMember{{SchemeID: 1, EmployeeID: 7, MemberNumber: "NS123"}},
+	}
+	service := NewService(repo)
+
+	export, err := service.ExportContributionScheduleCSV(context.Background(), 5, 1)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if export.Filename != "nssf_schedule_2025-07.csv" {
+		t.Fatalf("unexpected filename %q", export.Filename)
+	}
+	expected := "Member Number,Employee ID,Member Name,National ID,Contribution Period,Gross Salary,Employee Contribution (5%),Employer Contribution (10%),Total Contribution\n" +
+		"NS123,7,Jane Doe,,2025-07,1000000.00,50000.00,100000.00,150000.00\n" +
+		"TOTAL,,1 members,,2025-07,1000000.00,50000.00,100000.00,150000.00\n"
+	if export.Data != expected {
+		t.Fatalf("unexpected schedule csv:\n%s", export.Data)
+	}
+
+	repo.batches[5].Status = StatusDraft
+	if _, err := service.ExportContributionScheduleCSV(context.Background(), 5, 1); !errors.Is(err, ErrExportNotAllowed) {
+		t.Fatalf("expected ErrExportNotAllowed for draft batch, got %v", err)
+	}
+}
+
+func TestSetEmployeePaymentMethodsValidatesSplits(t *testing.T) {
+	repo := &fakeRepository{}
+	service := NewService(repo)
+
+	_, err := service.SetEmployeePaymentMethods(context.Background(), 7, []EmployeePaymentMethodInput{
+		{Method: PaymentMethodBank, BankName: "Stanbic", AccountNumber: "9030001", SplitPercent: 60},
+		{Method: PaymentMethodMobileMoney, Provider: "mtn", MSISDN: "0772123456", SplitPercent: 30},
+	})
+	if !errors.Is(err, ErrValidation) {
+		t.Fatalf("expected ErrValidation for splits not totalling 100, got %v", err)
+	}
+
+	items, err := service.SetEmployeePaymentMethods(context.Background(), 7, []EmployeePaymentMethodInput{
+		{Method: PaymentMethodBank, BankName: "Stanbic", AccountNumber: "9030 001", SplitPercent: 70},
+		{Method: PaymentMethodMobileMoney, Provider: "mtn", MSISDN: "0772123456", SplitPercent: 30},
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(items) != 2 || *items[0].AccountNumber != "9030001" || *items[1].Provider != "MTN" || *items[1].MSISDN != "+256772123456" {
+		t.Fatalf("unexpected payment methods %#v", items)
+	}
+}
+
+func TestExportPaymentFileSplitsNetPay(t *testing.T) {
+	repo := &fakeRepository{
+		batches: map[int64]*PayrollBatch{5: {ID: 5, Month: "2025-07", Status: StatusLocked}},
+		entriesByBatch: map[int64][]PayrollEntry{
+			5: {
+				{ID: 50, BatchID: 5, EmployeeID: 7, EmployeeName: "Jane Doe", NetPay: money.FromMinor(100000001)},
+				{ID: 51, BatchID: 5, EmployeeID: 8, EmployeeName: "John Okello", NetPay: money.FromUnits(500000)},
+			},
+		},
+		entryToBatch: map[int64]int64{50: 5, 51: 5},
+		paymentMethods: []EmployeePaymentMethod{
+			{ID: 1, EmployeeID: 7, Method: PaymentMethodBank, BankName: stringPtr("Stanbic"), AccountNumber: stringPtr("9030001"), SplitPercent: 70},
+			{ID: 2, EmployeeID: 7, Method: PaymentMethodMobileMoney, Provider: stringPtr("MTN"), MSISDN: stringPtr("+256772123456"), SplitPercent: 30},
+			{ID: 3, EmployeeID: 8, Method: PaymentMethodBank, BankName: stringPtr("Centenary"), AccountNumber: stringPtr("3100"), SplitPercent: 100},
+		},
+	}
+	service := NewService(repo)
+
+	bank, err := service.ExportPaymentFile(context.Background(), 5, "bank_eft_csv")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	expectedBank := "Seq,Beneficiary Name,Bank Name,Bank Code,Branch,Account Number,Amount,Reference\n" +
+		"1,Jane Doe,Stanbic,,,9030001,700000.01,Salary 2025-07\n" +
+		"2,John Okello,Centenary,,,3100,500000.00,Salary 2025-07\n" +
+		"TOTAL,2 payments,,,,,1200000.01,\n"
+	if bank.Filename != "bank_eft_csv_2025-07.csv" || bank.Data != expectedBank {
+		t.Fatalf("unexpected bank file %q:\n%s", bank.Filename, bank.Data)
+	}
+
+	momo, err := service.ExportPaymentFile(context.Background(), 5, "MTN_MOMO_CSV")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if momo.Data != "MSISDN,Amount,Name,Reason\n256772123456,300000.00,Jane Doe,Salary 2025-07\n" {
+		t.Fatalf("unexpected mobile money file:\n%s", momo.Data)
+	}
+
+	if _, err := service.ExportPaymentFile(context.Background(), 5, "AIRTEL_MONEY_CSV"); !errors.Is(err, ErrValidation) {
+		t.Fatalf("expected ErrValidation for a format without payments, got %v", err)
+	}
+
+	repo.paymentMethods = repo.paymentMethods[:2]
+	if _, err := service.ExportPaymentFile(context.Background(), 5, "BANK_EFT_CSV"); !errors.Is(err, ErrValidation) {
+		t.Fatalf("expected ErrValidation for missing payment details, got %v", err)
+	}
+
+	repo.batches[5].Status = StatusApproved
+	if _, err := service.ExportPaymentFile(context.Background(), 5, "BANK_EFT_CSV"); !errors.Is(err, ErrPaymentNotAllowed) {
+		t.Fatalf("expected ErrPaymentNotAllowed for approved batch, got %v", err)
+	}
+}
+
+func TestBuildPaymentInstructionsChecksControlTotal(t *testing.T) {
+	batch := PayrollBatch{ID: 5, Month: "2025-07", Status: StatusLocked}
+	entries := []PayrollEntry{{ID: 50, EmployeeID: 7, EmployeeName: "Jane Doe", NetPay: money.FromUnits(100)}}
+	methods := []EmployeePaymentMethod{
+		{EmployeeID: 7, Method: PaymentMethodBank, SplitPercent: 60},
+		{EmployeeID: 7, Method: PaymentMethodBank, SplitPercent: 60},
+		{EmployeeID: 7, Method: PaymentMethodMobileMoney, SplitPercent: 30},
+	}
+
+	if _, err := BuildPaymentInstructions(batch, entries, methods); !errors.Is(err, ErrControlTotal) {
+		t.Fatalf("expected ErrControlTotal for splits above 100%%, got %v", err)
+	}
+}
+
+type fakePolicy struct {
+	basis    string
+	variance VariancePolicy
+	approval ApprovalPolicy
+}
+
+func (f fakePolicy) GetPayrollProrationBasis(context.Context) (string, error) {
+	return f.basis, nil
+}
+
+func (f fakePolicy) GetPayrollVariancePolicy(context.Context) (VariancePolicy, error) {
+	return f.variance, nil
+}
+
+func (f fakePolicy) GetPayrollApprovalPolicy(context.Context) (ApprovalPolicy, error) {
+	return f.approval, nil
+}
+
+func TestGeneratePayrollEntriesProratesMidMonthHiresAndExits(t *testing.T) {
+	exit := time.Date(2025, 7, 11, 0, 0, 0, 0, time.UTC)
+	repo := &fakeRepository{
+		batches:        map[int64]*PayrollBatch{1: {ID: 1, Month: "2025-07", Status: StatusDraft}},
+		entriesByBatch: map[int64][]PayrollEntry{},
+		entryToBatch:   map[int64]int64{},
+		activeEmployees: []EmployeeSalary{
+			{EmployeeID: 101, EmployeeName: "Full", BaseSalary: money.FromUnits(2300000), DateOfHire: time.Date(2020, 1, 6, 0, 0, 0, 0, time.UTC)},
+			{EmployeeID: 102, EmployeeName: "Joiner", BaseSalary: money.FromUnits(2300000), DateOfHire: time.Date(2025, 7, 14, 0, 0, 0, 0, time.UTC)},
+			{EmployeeID: 103, EmployeeName: "Leaver", BaseSalary: money.FromUnits(2300000), DateOfHire: time.Date(2020, 1, 6, 0, 0, 0, 0, time.UTC), DateOfExit: &exit},
+			{EmployeeID: 104, EmployeeName: "Future", BaseSalary: money.FromUnits(2300000), DateOfHire: time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC)},
+		},
+	}
+	service := NewService(repo)
+	service.SetPolicyProvider(fakePolicy{basis: ProrationWorkingDays})
+
+	if err := service.GeneratePayrollEntries(context.Background(), 1); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	entries := repo.entriesByBatch[1]
+	if len(entries) != 3 {
+		t.Fatalf("expected 3 entries, got %d", len(entries))
+	}
+	if entries[0].BaseSalary != money.FromUnits(2300000) || entries[0].PayableDays != nil {
+		t.Fatalf("expected full month without proration details, got %#v", entries[0])
+	}
+	joiner := entries[1]
+	if joiner.BaseSalary != money.FromUnits(1400000) || joiner.NetPay != money.FromUnits(1400000) || *joiner.PayableDays != 14 || *joiner.PeriodDays != 23 || *joiner.FullBaseSalary != money.FromUnits(2300000) || *joiner.ProrationBasis != ProrationWorkingDays {
+		t.Fatalf("unexpected joiner proration %#v", joiner)
+	}
+	if entries[2].BaseSalary != money.FromUnits(900000) || *entries[2].PayableDays != 9 {
+		t.Fatalf("unexpected leaver proration %#v", entries[2])
+	}
+
+	service.SetPolicyProvider(fakePolicy{basis: ProrationCalendarDays})
+	if err := service.GeneratePayrollEntries(context.Background(), 1); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if joiner := repo.entriesByBatch[1][1]; *joiner.PayableDays != 18 || *joiner.PeriodDays != 31 || joiner.BaseSalary != money.FromMinor(133548387) {
+		t.Fatalf("unexpected calendar-day proration %#v", joiner)
+	}
+}
+
+type fakeFormatter struct {
+	decimals int
+	rounding bool
+}
+
+func (f fakeFormatter) GetPayrollFormatting(context.Context) (string, int, bool, error) {
+	return "UGX", f.decimals, f.rounding, nil
+}
+
+func TestGeneratePayrollEntriesRoundsSoBatchTotalsEqualSumOfEntries(t *testing.T) {
+	repo := &fakeRepository{
+		batches:        map[int64]*PayrollBatch{1: {ID: 1, Month: "2025-07", Status: StatusDraft}},
+		entriesByBatch: map[int64][]PayrollEntry{},
+		entryToBatch:   map[int64]int64{},
+		components: map[int64]*PayComponent{
+			1: {ID: 1, Code: "HOUSING", Name: "Housing Allowance", Kind: ComponentKindEarning, Taxable: true, CalculationType: CalculationPercentage, Value: 7.5, AutoApply: true, Active: true},
+		},
+		schemes: map[int64]*ContributionScheme{
+			1: {ID: 1, Code: "NSSF", Name: "National Social Security Fund", EmployeeRate: 5, EmployerRate: 10, Base: ContributionBaseGross, Active: true},
+		},
+		activeEmployees: []EmployeeSalary{
+			{EmployeeID: 101, EmployeeName: "A", BaseSalary: money.FromUnits(1234567), DateOfHire: time.Date(2020, 1, 6, 0, 0, 0, 0, time.UTC)},
+			{EmployeeID: 102, EmployeeName: "B", BaseSalary: money.FromUnits(987653), DateOfHire: time.Date(2025, 7, 14, 0, 0, 0, 0, time.UTC)},
+			{EmployeeID: 103, EmployeeName: "C", BaseSalary: money.FromMinor(55555555), DateOfHire: time.Date(2020, 1, 6, 0, 0, 0, 0, time.UTC)},
+		},
+	}
+	service := NewService(repo)
+	service.SetPolicyProvider(fakePolicy{basis: ProrationCalendarDays})
+	service.SetTaxRulesProvider(fakeTaxRules{tables: DefaultTaxTables()})
+	service.SetFormattingProvider(fakeFormatter{decimals: 0, rounding: true})
+
+	if err := service.GeneratePayrollEntries(context.Background(), 1); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	detail, err := service.GetPayrollBatch(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(detail.Entries) != 3 {
+		t.Fatalf("expected 3 entries, got %d", len(detail.Entries))
+	}
+
+	whole := func(amount money.Amount) bool { return amount.Round(0) == amount }
+	var batchNet, batchTax, batchEmployer money.Amount
+	var displayedNet int64
+	for _, entry := range detail.Entries {
+		for _, amount := range []money.Amount{entry.BaseSalary, entry.AllowancesTotal, entry.DeductionsTotal, entry.TaxTotal, entry.GrossPay, entry.NetPay, entry.EmployerContributionsTotal} {
+			if !whole(amount) {
+				t.Fatalf("expected whole-unit amounts with 0 decimals, got %#v", entry)
+			}
+		}
+		for _, line := range entry.Lines {
+			if !whole(line.Amount) || !whole(line.EmployerAmount) {
+				t.Fatalf("expected whole-unit line amounts, got %#v", line)
+			}
+		}
+		if entry.GrossPay != entry.BaseSalary+entry.AllowancesTotal || entry.NetPay != entry.GrossPay-entry.DeductionsTotal-entry.TaxTotal {
+			t.Fatalf("expected entry totals to add up exactly, got %#v", entry)
+		}
+		batchNet += entry.NetPay
+		batchTax += entry.TaxTotal
+		batchEmployer += entry.EmployerContributionsTotal
+		displayed, err := strconv.ParseInt(entry.NetPay.Format(0, ""), 10, 64)
+		if err != nil {
+			t.Fatalf("expected integer display, got %v", err)
+		}
+		displayedNet += displayed
+	}
+	if batchNet.Format(0, "") != strconv.FormatInt(displayedNet, 10) {
+		t.Fatalf("expected batch net %s to equal the sum of displayed entries %d", batchNet.Format(0, ""), displayedNet)
+	}
+	// 987,653 x 18/31 = 573,475.94 is held as 573,476 rather than drifting into the totals.
+	if joiner := detail.Entries[1]; joiner.BaseSalary != money.FromUnits(573476) {
+		t.Fatalf("expected prorated base rounded to 573476, got %s", joiner.BaseSalary)
+	}
+	if !whole(batchTax) || !whole(batchEmployer) {
+		t.Fatalf("expected whole batch totals, got tax %s and employer %s", batchTax, batchEmployer)
+	}
+}
+
+func TestGeneratePayrollEntriesDeductsUnpaidLeaveAndAbsences(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2025, 7, d, 0, 0, 0, 0, time.UTC) }
+	repo := &fakeRepository{
+		batches:        map[int64]*PayrollBatch{1: {ID: 1, Month: "2025-07", Status: StatusDraft}},
+		entriesByBatch: map[int64][]PayrollEntry{},
+		entryToBatch:   map[int64]int64{},
+		activeEmployees: []EmployeeSalary{
+			{EmployeeID: 101, EmployeeName: "Absent", BaseSalary: money.FromUnits(2300000), DateOfHire: time.Date(2020, 1, 6, 0, 0, 0, 0, time.UTC)},
+			{EmployeeID: 102, EmployeeName: "Present", BaseSalary: money.FromUnits(2300000), DateOfHire: time.Date(2020, 1, 6, 0, 0, 0, 0, time.UTC)},
+		},
+		absences: []AbsenceRecord{
+			{SourceType: LineSourceLeaveRequest, SourceID: 11, EmployeeID: 101, StartDate: time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC), EndDate: day(2), Days: 3},
+			{SourceType: LineSourceLeaveRequest, SourceID: 12, EmployeeID: 101, StartDate: day(7), EndDate: day(9), Days: 2.5},
+			{SourceType: LineSourceAttendanceRecord, SourceID: 21, EmployeeID: 101, StartDate: day(15), EndDate: day(15), Days: 1},
+			{SourceType: LineSourceAttendanceRecord, SourceID: 22, EmployeeID: 101, StartDate: day(19), EndDate: day(19), Days: 1},
+		},
+	}
+	audit := &captureAuditRecorder{}
+	service := NewService(repo)
+	service.SetAuditRecorder(audit)
+
+	if err := service.GeneratePayrollEntries(context.Background(), 1); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	entries := repo.entriesByBatch[1]
+	if len(entries) != 2 {
+		t.Fatalf("expected 2 entries, got %d", len(entries))
+	}
+	absent := entries[0]
+	if absent.DeductionsTotal != money.FromUnits(550000) || absent.GrossPay != money.FromUnits(2300000) || absent.NetPay != money.FromUnits(1750000) {
+		t.Fatalf("unexpected totals %#v", absent)
+	}
+	if entries[1].DeductionsTotal != 0 || entries[1].NetPay != money.FromUnits(2300000) {
+		t.Fatalf("expected no deductions for present employee, got %#v", entries[1])
+	}
+
+	entry, err := service.GetPayrollEntry(context.Background(), absent.ID)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	lines := map[string]PayrollEntryLine{}
+	for _, line := range entry.Lines {
+		lines[line.Code] = line
+	}
+	leave := lines[LineCodeUnpaidLeave]
+	if leave.Amount != money.FromUnits(450000) || *leave.Quantity != 4.5 || *leave.Rate != money.FromUnits(100000) || len(leave.Sources) != 2 {
+		t.Fatalf("unexpected unpaid leave line %#v", leave)
+	}
+	if leave.Sources[0].SourceID != 11 || leave.Sources[0].Days != 2 || leave.Sources[1].Days != 2.5 {
+		t.Fatalf("unexpected unpaid leave sources %#v", leave.Sources)
+	}
+	absence := lines[LineCodeAbsence]
+	if absence.Amount != money.FromUnits(100000) || len(absence.Sources) != 1 || absence.Sources[0].SourceID != 21 || absence.Sources[0].SourceType != LineSourceAttendanceRecord {
+		t.Fatalf("expected weekday absence only, got %#v", absence)
+	}
+}
+
+func TestAbsenceDeductionsReduceTaxablePayAndAreCapped(t *testing.T) {
+	componentID := int64(4)
+	lines := []PayrollEntryLine{
+		{Code: LineCodeUnpaidLeave, Kind: ComponentKindDeduction, Amount: money.FromUnits(300000)},
+		{Code: "LOAN", Kind: ComponentKindDeduction, Amount: money.FromUnits(50000), ComponentID: &componentID},
+	}
+	if taxable := CalculateTaxablePay(money.FromUnits(1000000), money.FromUnits(100000), lines); taxable != money.FromUnits(800000) {
+		t.Fatalf("expected taxable pay 800000, got %v", taxable)
+	}
+
+	employee := EmployeeSalary{EmployeeID: 1, BaseSalary: money.FromUnits(2300000), DateOfHire: time.Date(2020, 1, 6, 0, 0, 0, 0, time.UTC)}
+	records := []AbsenceRecord{{SourceType: LineSourceLeaveRequest, SourceID: 1, EmployeeID: 1, StartDate: time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC), EndDate: time.Date(2025, 7, 31, 0, 0, 0, 0, time.UTC), Days: 23}}
+	capped, err := AbsenceLines("2025-07", ProrationWorkingDays, WorkCalendar{}, employee, 23, money.FromUnits(1400000), records)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(capped) != 1 || capped[0].Amount != money.FromUnits(1400000) {
+		t.Fatalf("expected deduction capped at payable base, got %#v", capped)
+	}
+}
+
+func TestCalculateLoanTermsAndSchedule(t *testing.T) {
+	total, installment := CalculateLoanTerms(money.FromUnits(1200000), 12, 12)
+	if total != money.FromUnits(1344000) || installment != money.FromUnits(112000) {
+		t.Fatalf("expected 1344000 over 112000 installments, got %v / %v", total, installment)
+	}
+
+	total, installment = CalculateLoanTerms(money.FromUnits(1000000), 0, 3)
+	schedule, err := LoanSchedule(EmployeeLoan{TotalRepayable: total, InstallmentAmount: installment, Installments: 3, StartMonth: "2025-11"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(schedule) != 3 || schedule[0].Amount != money.FromMinor(33333333) || schedule[2].Amount != money.FromMinor(33333334) || schedule[2].BalanceAfter != 0 {
+		t.Fatalf("unexpected schedule %#v", schedule)
+	}
+	if schedule[1].Month != "2025-12" || schedule[2].Month != "2026-01" {
+		t.Fatalf("expected monthly installments across the year end, got %#v", schedule)
+	}
+}
+
+func TestCreateLoanValidation(t *testing.T) {
+	service := NewService(&fakeRepository{})
+	claims := &models.Claims{UserID: 1}
+
+	cases := []LoanCreateInput{
+		{EmployeeID: 1, LoanType: "mortgage", Principal: money.FromUnits(1000), Installments: 1, StartMonth: "2025-07"},
+		{EmployeeID: 1, Principal: 0, Installments: 1, StartMonth: "2025-07"},
+		{EmployeeID: 1, Principal: money.FromUnits(1000), Installments: 0, StartMonth: "2025-07"},
+		{EmployeeID: 1, Principal: money.FromUnits(1000), Installments: 2, StartMonth: "July"},
+	}
+	for _, input := range cases {
+		if _, err := service.CreateLoan(context.Background(), claims, input); !errors.Is(err, ErrValidation) {
+			t.Fatalf("expected validation error for %+v, got %v", input, err)
+		}
+	}
+
+	advance, err := service.CreateLoan(context.Background(), claims, LoanCreateInput{EmployeeID: 1, LoanType: "Advance", Principal: money.FromUnits(250000), StartMonth: "2025-07"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if advance.Installments != 1 || advance.InstallmentAmount != money.FromUnits(250000) || advance.OutstandingBalance != money.FromUnits(250000) {
+		t.Fatalf("expected single-installment advance, got %#v", advance)
+	}
+}
+
+func TestLoanInstallmentsAreDeductedAndRecoveredOnLock(t *testing.T) {
+	hired := time.Date(2020, 1, 6, 0, 0, 0, 0, time.UTC)
+	repo := &fakeRepository{
+		batches:        map[int64]*PayrollBatch{1: {ID: 1, Month: "2025-07", Status: StatusDraft}},
+		entriesByBatch: map[int64][]PayrollEntry{},
+		entryToBatch:   map[int64]int64{},
+		activeEmployees: []EmployeeSalary{
+			{EmployeeID: 101, EmployeeName: "Borrower", BaseSalary: money.FromUnits(1000000), DateOfHire: hired},
+			{EmployeeID: 102, EmployeeName: "Advance", BaseSalary: money.FromUnits(400000), DateOfHire: hired},
+		},
+		loans: []EmployeeLoan{
+			{ID: 1, EmployeeID: 101, LoanType: LoanTypeLoan, TotalRepayable: money.FromUnits(600000), Installments: 2, InstallmentAmount: money.FromUnits(300000), StartMonth: "2025-06", OutstandingBalance: money.FromUnits(600000), Status: LoanStatusActive},
+			{ID: 2, EmployeeID: 102, LoanType: LoanTypeAdvance, TotalRepayable: money.FromUnits(500000), Installments: 1, InstallmentAmount: money.FromUnits(500000), StartMonth: "2025-07", OutstandingBalance: money.FromUnits(500000), Status: LoanStatusActive},
+			{ID: 3, EmployeeID: 101, LoanType: LoanTypeLoan, TotalRepayable: money.FromUnits(100000), Installments: 1, InstallmentAmount: money.FromUnits(100000), StartMonth: "2025-08", OutstandingBalance: money.FromUnits(100000), Status: LoanStatusActive},
+		},
+	}
+	audit := &captureAuditRecorder{}
+	service := NewService(repo)
+	service.SetAuditRecorder(audit)
+
+	if err := service.GeneratePayrollEntries(context.Background(), 1); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	entries := repo.entriesByBatch[1]
+	if entries[0].DeductionsTotal != money.FromUnits(300000) || entries[0].NetPay != money.FromUnits(700000) {
+		t.Fatalf("expected one installment from borrower, got %#v", entries[0])
+	}
+	if entries[1].DeductionsTotal != money.FromUnits(400000) || entries[1].NetPay != 0 {
+		t.Fatalf("expected advance recovery capped at net pay, got %#v", entries[1])
+	}
+	if repo.loans[0].OutstandingBalance != money.FromUnits(600000) {
+		t.Fatalf("expected balance untouched before lock, got %v", repo.loans[0].OutstandingBalance)
+	}
+
+	repo.batches[1].Status = StatusApproved
+	if _, err := service.LockPayrollBatch(context.Background(), 1); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if repo.loans[0].OutstandingBalance != money.FromUnits(300000) || repo.loans[0].Status != LoanStatusActive {
+		t.Fatalf("unexpected loan after lock %#v", repo.loans[0])
+	}
+	if repo.loans[1].OutstandingBalance != money.FromUnits(100000) || repo.loans[2].OutstandingBalance != money.FromUnits(100000) {
+		t.Fatalf("unexpected balances after lock %#v", repo.loans)
+	}
+
+	ledger, err := service.GetLoanLedger(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(ledger.Repayments) != 1 || ledger.Repayments[0].Month != "2025-07" || ledger.Repayments[0].BalanceAfter != money.FromUnits(300000) || len(ledger.Schedule) != 2 {
+		t.Fatalf("unexpected ledger %#v", ledger)
+	}
+	recovered := 0
+	for _, action := range audit.actions {
+		if action == "payroll.loan.recover" {
+			recovered++
+		}
+	}
+	if recovered != 2 {
+		t.Fatalf("expected 2 loan recovery audit events, got %v", audit.actions)
+	}
+
+	export, err := service.ExportLoanLedgerCSV(context.Background(), 101)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !strings.Contains(export.Data, "1,101,,loan,active,2025-07,1,600000.00,300000.00,300000.00") {
+		t.Fatalf("unexpected ledger csv %q", export.Data)
+	}
+}
+
+func TestLoanInstallmentIsNotRecoveredTwiceAcrossUnlockedBatches(t *testing.T) {
+	hired := time.Date(2020, 1, 6, 0, 0, 0, 0, time.UTC)
+	loanID := int64(1)
+	julyLine := PayrollEntryLine{ID: 1, EntryID: 10, Code: LineCodeLoan, Name: "Staff loan", Kind: ComponentKindDeduction, Amount: money.FromUnits(300000), LoanID: &loanID}
+	repo := &fakeRepository{
+		batches: map[int64]*PayrollBatch{
+			1: {ID: 1, Month: "2025-07", BatchType: BatchTypeRegular, Status: StatusApproved},
+			2: {ID: 2, Month: "2025-08", BatchType: BatchTypeRegular, Status: StatusDraft},
+			3: {ID: 3, Month: "2025-09", BatchType: BatchTypeRegular, Status: StatusApproved},
+		},
+		entriesByBatch: map[int64][]PayrollEntry{
+			1: {{ID: 10, BatchID: 1, EmployeeID: 101, BaseSalary: money.FromUnits(1000000), DeductionsTotal: money.FromUnits(300000), GrossPay: money.FromUnits(1000000), NetPay: money.FromUnits(700000)}},
+			// Generated before the balance check existed, so it still recovers the installment July settles.
+			3: {{ID: 30, BatchID: 3, EmployeeID: 101, BaseSalary: money.FromUnits(1000000), DeductionsTotal: money.FromUnits(300000), GrossPay: money.FromUnits(1000000), NetPay: money.FromUnits(700000)}},
+		},
+		entryToBatch: map[int64]int64{10: 1, 30: 3},
+		linesByEntry: map[int64][]PayrollEntryLine{
+			10: {julyLine},
+		},
+		activeEmployees: []EmployeeSalary{
+			{EmployeeID: 101, EmployeeName: "Borrower", BaseSalary: money.FromUnits(1000000), DateOfHire: hired},
+		},
+		// The first of two installments was recovered in June; July still holds the last one.
+		loans: []EmployeeLoan{
+			{ID: 1, EmployeeID: 101, LoanType: LoanTypeLoan, TotalRepayable: money.FromUnits(600000), Installments: 2, InstallmentAmount: money.FromUnits(300000), StartMonth: "2025-06", OutstandingBalance: money.FromUnits(300000), Status: LoanStatusActive},
+		},
+	}
+	septemberLine := julyLine
+	septemberLine.ID, septemberLine.EntryID = 3, 30
+	repo.linesByEntry[30] = []PayrollEntryLine{septemberLine}
+	service := NewService(repo)
+
+	if err := service.GeneratePayrollEntries(context.Background(), 2); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	august := repo.entriesByBatch[2]
+	if len(august) != 1 || august[0].DeductionsTotal != 0 || len(repo.linesByEntry[august[0].ID]) != 0 {
+		t.Fatalf("expected no August recovery while July holds the last installment, got %+v", august)
+	}
+
+	if _, err := service.LockPayrollBatch(context.Background(), 1); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if repo.loans[0].OutstandingBalance != 0 || repo.loans[0].Status != LoanStatusSettled {
+		t.Fatalf("expected the loan settled by July, got %+v", repo.loans[0])
+	}
+
+	_, err := service.LockPayrollBatch(context.Background(), 3)
+	if !errors.Is(err, ErrValidation) {
+		t.Fatalf("expected the over-recovery to block the lock, got %v", err)
+	}
+	if repo.loans[0].OutstandingBalance != 0 || len(repo.loanRepayments) != 1 {
+		t.Fatalf("expected no second repayment, got %+v %+v", repo.loans[0], repo.loanRepayments)
+	}
+}
+
+func TestBuildVarianceReportFlagsJoinersLeaversAndMoves(t *testing.T) {
+	batch := PayrollBatch{ID: 2, Month: "2025-08"}
+	previous := &PayrollBatch{ID: 1, Month: "2025-07"}
+	prior := []PayrollEntry{
+		{EmployeeID: 101, EmployeeName: "Alice", BaseSalary: money.FromUnits(1000000), AllowancesTotal: money.FromUnits(100000), TaxTotal: money.FromUnits(150000), NetPay: money.FromUnits(950000)},
+		{EmployeeID: 102, EmployeeName: "Bob", BaseSalary: money.FromUnits(800000), NetPay: money.FromUnits(700000)},
+		{EmployeeID: 103, EmployeeName: "Carol", BaseSalary: money.FromUnits(500000), NetPay: money.FromUnits(450000)},
+	}
+	current := []PayrollEntry{
+		{EmployeeID: 101, EmployeeName: "Alice", BaseSalary: money.FromUnits(1000000), AllowancesTotal: money.FromUnits(250000), TaxTotal: money.FromUnits(180000), NetPay: money.FromUnits(1070000)},
+		{EmployeeID: 102, EmployeeName: "Bob", BaseSalary: money.FromUnits(800000), NetPay: money.FromUnits(720000)},
+		{EmployeeID: 104, EmployeeName: "Dan", BaseSalary: money.FromUnits(600000), NetPay: money.FromUnits(540000)},
+	}
+
+	report := BuildVarianceReport(batch, previous, current, prior, 10)
+	if report.PreviousBatchID == nil || *report.PreviousBatchID != 1 || report.PreviousMonth != "2025-07" {
+		t.Fatalf("expected previous batch 1 for 2025-07, got %+v", report)
+	}
+	if report.Joiners != 1 || report.Leavers != 1 || report.Changed != 2 || report.Flagged != 1 {
+		t.Fatalf("expected 1 joiner, 1 leaver, 2 changed, 1 flagged, got %+v", report)
+	}
+	if len(report.Rows) != 4 {
+		t.Fatalf("expected 4 rows, got %d", len(report.Rows))
+	}
+
+	rows := map[int64]VarianceRow{}
+	for _, row := range report.Rows {
+		rows[row.EmployeeID] = row
+	}
+	alice := rows[101]
+	if alice.Status != VarianceChanged || !alice.Flagged || !alice.AllowancesTotal.Flagged || alice.BaseSalary.Flagged {
+		t.Fatalf("expected alice flagged on allowances only, got %+v", alice)
+	}
+	if alice.AllowancesTotal.ChangePercent == nil || *alice.AllowancesTotal.ChangePercent != 150 {
+		t.Fatalf("expected 150%% allowance move, got %+v", alice.AllowancesTotal)
+	}
+	if bob := rows[102]; bob.Status != VarianceChanged || bob.Flagged {
+		t.Fatalf("expected bob changed below threshold, got %+v", bob)
+	}
+	if carol := rows[103]; carol.Status != VarianceLeaver || carol.NetPay.Current != 0 || carol.NetPay.Change != money.FromUnits(-450000) {
+		t.Fatalf("expected carol as leaver, got %+v", carol)
+	}
+	if dan := rows[104]; dan.Status != VarianceJoiner || dan.NetPay.ChangePercent != nil {
+		t.Fatalf("expected dan as joiner without percentage, got %+v", dan)
+	}
+	if report.Totals.NetPay.Previous != money.FromUnits(2100000) || report.Totals.NetPay.Current != money.FromUnits(2330000) {
+		t.Fatalf("expected net totals 2100000 -> 2330000, got %+v", report.Totals.NetPay)
+	}
+}
+
+func TestApprovePayrollBatchRequiresAcknowledgedVarianceReview(t *testing.T) {
+	repo := &fakeRepository{
+		batches: map[int64]*PayrollBatch{
+			1: {ID: 1, Month: "2025-07", Status: StatusLocked},
+			2: {ID: 2, Month: "2025-08", Status: StatusDraft},
+		},
+		entriesByBatch: map[int64][]PayrollEntry{
+			1: {{ID: 10, BatchID: 1, EmployeeID: 101, EmployeeName: "Alice", BaseSalary: money.FromUnits(1000000), NetPay: money.FromUnits(900000)}},
+			2: {{ID: 20, BatchID: 2, EmployeeID: 101, EmployeeName: "Alice", BaseSalary: money.FromUnits(1000000), NetPay: money.FromUnits(1200000)}},
+		},
+		entryToBatch: map[int64]int64{10: 1, 20: 2},
+	}
+	recorder := &captureAuditRecorder{}
+	service := NewService(repo)
+	service.SetAuditRecorder(recorder)
+	service.SetPolicyProvider(fakePolicy{basis: ProrationWorkingDays, variance: VariancePolicy{ThresholdPercent: 20, RequireReview: true}})
+	claims := &models.Claims{UserID: 1, Role: "Admin"}
+
+	report, err := service.GetPayrollVarianceReport(context.Background(), 2, 0)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !report.ReviewRequired || report.Flagged != 1 || report.PreviousBatchID == nil || *report.PreviousBatchID != 1 {
+		t.Fatalf("expected flagged report against batch 1, got %+v", report)
+	}
+
+	if _, err := service.ApprovePayrollBatch(context.Background(), claims, 2, ""); !errors.Is(err, ErrVarianceNotReviewed) {
+		t.Fatalf("expected ErrVarianceNotReviewed, got %v", err)
+	}
+	if _, err := service.AcknowledgePayrollVariance(context.Background(), claims, 2); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	// An entry edited after the acknowledgement needs a new review.
+	repo.entriesByBatch[2][0].UpdatedAt = repo.batches[2].VarianceReviewedAt.Add(time.Minute)
+	if _, err := service.ApprovePayrollBatch(context.Background(), claims, 2, ""); !errors.Is(err, ErrVarianceNotReviewed) {
+		t.Fatalf("expected stale review to block approval, got %v", err)
+	}
+	repo.entriesByBatch[2][0].UpdatedAt = time.Time{}
+
+	batch, err := service.ApprovePayrollBatch(context.Background(), claims, 2, "")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if batch.Status != StatusApproved {
+		t.Fatalf("expected approved batch, got %s", batch.Status)
+	}
+	if !strings.Contains(strings.Join(recorder.actions, ","), "payroll.batch.variance_acknowledge") {
+		t.Fatalf("expected variance acknowledge audit event, got %v", recorder.actions)
+	}
+}
+
+func TestApprovePayrollBatchFollowsApprovalChain(t *testing.T) {
+	repo := &fakeRepository{
+		batches:        map[int64]*PayrollBatch{1: {ID: 1, Month: "2025-08", BatchType: BatchTypeRegular, Status: StatusDraft, CreatedBy: 5}},
+		entriesByBatch: map[int64][]PayrollEntry{1: {}},
+		entryToBatch:   map[int64]int64{},
+	}
+	recorder := &captureAuditRecorder{}
+	service := NewService(repo)
+	service.SetAuditRecorder(recorder)
+	service.SetPolicyProvider(fakePolicy{basis: ProrationWorkingDays, approval: ApprovalPolicy{Steps: []ApprovalStep{
+		{Name: "Prepared", Roles: []string{"Finance Officer"}},
+		{Name: "Reviewed", Roles: []string{"hr_officer"}},
+		{Name: "Approved", Roles: []string{"admin"}},
+	}}})
+	ctx := context.Background()
+	finance := &models.Claims{UserID: 6, Role: "Finance Officer"}
+	hr := &models.Claims{UserID: 8, Role: "HR Officer"}
+	director := &models.Claims{UserID: 9, Role: "Admin"}
+
+	if _, err := service.ApprovePayrollBatch(ctx, &models.Claims{UserID: 5, Role: "Finance Officer"}, 1, ""); !errors.Is(err, ErrSelfApproval) {
+		t.Fatalf("expected creator to be blocked, got %v", err)
+	}
+	if _, err := service.ApprovePayrollBatch(ctx, hr, 1, ""); !errors.Is(err, ErrApprovalStepRole) {
+		t.Fatalf("expected HR to wait for the finance step, got %v", err)
+	}
+	batch, err := service.ApprovePayrollBatch(ctx, finance, 1, "Prepared for review")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if batch.Status != StatusDraft {
+		t.Fatalf("expected batch to stay draft until the last step, got %s", batch.Status)
+	}
+	if _, err := service.ApprovePayrollBatch(ctx, &models.Claims{UserID: 6, Role: "HR Officer"}, 1, ""); !errors.Is(err, ErrSelfApproval) {
+		t.Fatalf("expected a second step by the same user to be blocked, got %v", err)
+	}
+	if _, err := service.ApprovePayrollBatch(ctx, hr, 1, "Checked"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if _, err := service.RejectPayrollBatch(ctx, director, 1, " "); !errors.Is(err, ErrValidation) {
+		t.Fatalf("expected a rejection reason to be required, got %v", err)
+	}
+	if _, err := service.RejectPayrollBatch(ctx, director, 1, "Allowances look wrong"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	detail, err := service.GetPayrollBatch(ctx, 1)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if detail.Approval.NextStep != 1 || len(detail.Approval.History) != 3 || detail.Approval.Steps[0].Approval != nil {
+		t.Fatalf("expected rejection to restart the chain, got %+v", detail.Approval)
+	}
+
+	for _, claims := range []*models.Claims{finance, hr, director} {
+		batch, err = service.ApprovePayrollBatch(ctx, claims, 1, "")
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	}
+	if batch.Status != StatusApproved || batch.ApprovedBy == nil || *batch.ApprovedBy != 9 {
+		t.Fatalf("expected batch approved by the final approver, got %+v", batch)
+	}
+
+	batch, err = service.RejectPayrollBatch(ctx, director, 1, "Bank details changed")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if batch.Status != StatusDraft || batch.ApprovedBy != nil {
+		t.Fatalf("expected approval to be rejected back to draft, got %+v", batch)
+	}
+	if !strings.Contains(strings.Join(recorder.actions, ","), "payroll.batch.reject") {
+		t.Fatalf("expected reject audit event, got %v", recorder.actions)
+	}
+}
+
+func TestApprovePayrollBatchEntryChangeRestartsChain(t *testing.T) {
+	repo := &fakeRepository{
+		batches:        map[int64]*PayrollBatch{1: {ID: 1, Month: "2025-08", BatchType: BatchTypeRegular, Status: StatusDraft, CreatedBy: 5}},
+		entriesByBatch: map[int64][]PayrollEntry{1: {{ID: 10, BatchID: 1, EmployeeID: 101}}},
+		entryToBatch:   map[int64]int64{10: 1},
+	}
+	service := NewService(repo)
+	service.SetPolicyProvider(fakePolicy{basis: ProrationWorkingDays, approval: ApprovalPolicy{Steps: []ApprovalStep{
+		{Name: "Reviewed", Roles: []string{"hr_officer"}},
+		{Name: "Approved", Roles: []string{"admin"}},
+	}}})
+	ctx := context.Background()
+
+	if _, err := service.ApprovePayrollBatch(ctx, &models.Claims{UserID: 8, Role: "HR Officer"}, 1, ""); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	repo.entriesByBatch[1][0].UpdatedAt = time.Now().Add(time.Minute)
+	if _, err := service.ApprovePayrollBatch(ctx, &models.Claims{UserID: 9, Role: "Admin"}, 1, ""); !errors.Is(err, ErrApprovalStepRole) {
+		t.Fatalf("expected the edited batch to need the review step again, got %v", err)
+	}
+}
+
+func TestApprovePayrollBatchAllowsSelfApprovalWhenConfigured(t *testing.T) {
+	repo := &fakeRepository{
+		batches: map[int64]*PayrollBatch{1: {ID: 1, Month: "2025-08", BatchType: BatchTypeBonus, Status: StatusDraft, CreatedBy: 1}},
+	}
+	service := NewService(repo)
+	claims := &models.Claims{UserID: 1, Role: "Admin"}
+
+	if _, err := service.ApprovePayrollBatch(context.Background(), claims, 1, ""); !errors.Is(err, ErrSelfApproval) {
+		t.Fatalf("expected self-approval to be blocked by default, got %v", err)
+	}
+	service.SetPolicyProvider(fakePolicy{basis: ProrationWorkingDays, approval: ApprovalPolicy{AllowSelfApproval: true}})
+	batch, err := service.ApprovePayrollBatch(context.Background(), claims, 1, "")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if batch.Status != StatusApproved {
+		t.Fatalf("expected approved batch, got %s", batch.Status)
+	}
+}
+
+func TestReopenPayrollBatchRestartsApprovalChain(t *testing.T) {
+	approvedBy := int64(9)
+	repo := &fakeRepository{
+		batches: map[int64]*PayrollBatch{
+			1: {ID: 1, Month: "2025-08", BatchType: BatchTypeRegular, Status: StatusApproved, CreatedBy: 5, ApprovedBy: &approvedBy},
+			2: {ID: 2, Month: "2025-07", BatchType: BatchTypeRegular, Status: StatusLocked, CreatedBy: 5},
+		},
+		approvals: []PayrollBatchApproval{{ID: 1, BatchID: 1, StepNumber: 1, StepName: "Approval", Action: ApprovalActionApproved, UserID: &approvedBy, CreatedAt: time.Now().Add(-time.Hour)}},
+	}
+	recorder := &captureAuditRecorder{}
+	service := NewService(repo)
+	service.SetAuditRecorder(recorder)
+	admin := &models.Claims{UserID: 9, Role: "Admin"}
+	ctx := context.Background()
+
+	if _, err := service.ReopenPayrollBatch(ctx, admin, 1, ""); !errors.Is(err, ErrValidation) {
+		t.Fatalf("expected a reason to be required, got %v", err)
+	}
+	if _, err := service.ReopenPayrollBatch(ctx, admin, 2, "Late overtime"); !errors.Is(err, ErrInvalidTransition) {
+		t.Fatalf("expected locked batch to be refused, got %v", err)
+	}
+	batch, err := service.ReopenPayrollBatch(ctx, admin, 1, "Late overtime")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if batch.Status != StatusDraft || batch.ApprovedBy != nil {
+		t.Fatalf("expected draft batch without approver, got %+v", batch)
+	}
+	detail, err := service.GetPayrollBatch(ctx, 1)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if detail.Approval.NextStep != 1 || detail.Approval.Steps[0].Approval != nil {
+		t.Fatalf("expected approval chain to restart, got %+v", detail.Approval)
+	}
+	if !strings.Contains(strings.Join(recorder.actions, ","), "payroll.batch.reopen") {
+		t.Fatalf("expected reopen audit event, got %v", recorder.actions)
+	}
+}
+
+func TestReversePayrollBatchCreatesOffsettingCorrection(t *testing.T) {
+	loanID := int64(3)
+	arrearsMonth := "2025-06"
+	repo := &fakeRepository{
+		batches: map[int64]*PayrollBatch{
+			1: {ID: 1, Month: "2025-07", BatchType: BatchTypeRegular, Status: StatusLocked, CreatedBy: 5},
+			2: {ID: 2, Month: "2025-08", BatchType: BatchTypeRegular, Status: StatusApproved, CreatedBy: 5},
+		},
+		entriesByBatch: map[int64][]PayrollEntry{
+			1: {{ID: 10, BatchID: 1, EmployeeID: 101, BaseSalary: money.FromUnits(1000000), AllowancesTotal: money.FromUnits(150000), DeductionsTotal: money.FromUnits(50000), TaxTotal: money.FromUnits(100000), GrossPay: money.FromUnits(1150000), NetPay: money.FromUnits(1000000), EmployerContributionsTotal: money.FromUnits(100000)}},
+		},
+		entryToBatch: map[int64]int64{10: 1},
+		linesByEntry: map[int64][]PayrollEntryLine{
+			10: {
+				{ID: 1, EntryID: 10, Code: LineCodeArrears, Kind: ComponentKindEarning, Taxable: true, Amount: money.FromUnits(150000), ArrearsMonth: &arrearsMonth},
+				{ID: 2, EntryID: 10, Code: LineCodeLoan, Kind: ComponentKindDeduction, Amount: money.FromUnits(50000), LoanID: &loanID},
+			},
+		},
+	}
+	recorder := &captureAuditRecorder{}
+	service := NewService(repo)
+	service.SetAuditRecorder(recorder)
+	admin := &models.Claims{UserID: 9, Role: "Admin"}
+	ctx := context.Background()
+
+	if _, err := service.ReversePayrollBatch(ctx, admin, 2, "Wrong month"); !errors.Is(err, ErrInvalidTransition) {
+		t.Fatalf("expected approved batch to be refused, got %v", err)
+	}
+	reversal, err := service.ReversePayrollBatch(ctx, admin, 1, "Paid twice")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if reversal.Status != StatusDraft || reversal.BatchType != BatchTypeCorrection || reversal.ReversesBatchID == nil || *reversal.ReversesBatchID != 1 {
+		t.Fatalf("expected draft correction reversing batch 1, got %+v", reversal)
+	}
+	if repo.batches[1].Status != StatusLocked {
+		t.Fatalf("expected locked batch to be untouched, got %s", repo.batches[1].Status)
+	}
+
+	entries := repo.entriesByBatch[reversal.ID]
+	if len(entries) != 1 || entries[0].NetPay != money.FromUnits(-1000000) || entries[0].TaxTotal != money.FromUnits(-100000) || entries[0].EmployerContributionsTotal != money.FromUnits(-100000) {
+		t.Fatalf("expected negated entry, got %+v", entries)
+	}
+	lines := repo.linesByEntry[entries[0].ID]
+	if len(lines) != 2 || lines[0].Amount != money.FromUnits(-150000) || lines[0].ArrearsMonth == nil || lines[1].LoanID == nil || *lines[1].LoanID != loanID {
+		t.Fatalf("expected negated lines keeping the loan link, got %+v", lines)
+	}
+
+	if _, err := service.ReversePayrollBatch(ctx, admin, 1, "Again"); !errors.Is(err, ErrInvalidTransition) {
+		t.Fatalf("expected a second reversal to be refused, got %v", err)
+	}
+	if err := service.GeneratePayrollEntries(ctx, reversal.ID); !errors.Is(err, ErrImmutableBatch) {
+		t.Fatalf("expected reversal batch to be immutable, got %v", err)
+	}
+	detail, err := service.GetPayrollBatch(ctx, 1)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if detail.ReversedByBatchID == nil || *detail.ReversedByBatchID != reversal.ID {
+		t.Fatalf("expected locked batch to point at its reversal, got %+v", detail.ReversedByBatchID)
+	}
+	if !strings.Contains(strings.Join(recorder.actions, ","), "payroll.batch.reverse") {
+		t.Fatalf("expected reverse audit event, got %v", recorder.actions)
+	}
+}
+
+func TestLockingReversalRestoresRecoveredLoanBalance(t *testing.T) {
+	loanID := int64(3)
+	repo := &fakeRepository{
+		batches: map[int64]*PayrollBatch{
+			1: {ID: 1, Month: "2025-07", BatchType: BatchTypeRegular, Status: StatusLocked, CreatedBy: 5},
+		},
+		entriesByBatch: map[int64][]PayrollEntry{
+			1: {{ID: 10, BatchID: 1, EmployeeID: 101, BaseSalary: money.FromUnits(1000000), DeductionsTotal: money.FromUnits(50000), GrossPay: money.FromUnits(1000000), NetPay: money.FromUnits(950000)}},
+		},
+		entryToBatch: map[int64]int64{10: 1},
+		linesByEntry: map[int64][]PayrollEntryLine{
+			10: {{ID: 1, EntryID: 10, Code: LineCodeLoan, Kind: ComponentKindDeduction, Amount: money.FromUnits(50000), LoanID: &loanID}},
+		},
+		loans: []EmployeeLoan{
+			{ID: loanID, EmployeeID: 101, LoanType: LoanTypeLoan, TotalRepayable: money.FromUnits(100000), Installments: 2, InstallmentAmount: money.FromUnits(50000), StartMonth: "2025-06", OutstandingBalance: 0, Status: LoanStatusSettled},
+		},
+	}
+	recorder := &captureAuditRecorder{}
+	service := NewService(repo)
+	service.SetAuditRecorder(recorder)
+	ctx := context.Background()
+
+	reversal, err := service.ReversePayrollBatch(ctx, &models.Claims{UserID: 9, Role: "Admin"}, 1, "Paid twice")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	repo.batches[reversal.ID].Status = StatusApproved
+	if _, err := service.LockPayrollBatch(ctx, reversal.ID); err != nil {
+		t.Fatalf("expected reversal to lock, got %v", err)
+	}
+
+	loan := repo.loans[0]
+	if loan.OutstandingBalance != money.FromUnits(50000) || loan.Status != LoanStatusActive {
+		t.Fatalf("expected the recovery to be restored and the loan reopened, got %#v", loan)
+	}
+	if len(repo.loanRepayments) != 1 || repo.loanRepayments[0].BatchID != reversal.ID || repo.loanRepayments[0].Amount != money.FromUnits(-50000) || repo.loanRepayments[0].BalanceAfter != money.FromUnits(50000) {
+		t.Fatalf("expected a negative ledger entry for the reversal, got %#v", repo.loanRepayments)
+	}
+	if !strings.Contains(strings.Join(recorder.actions, ","), "payroll.loan.restore") {
+		t.Fatalf("expected loan restore audit event, got %v", recorder.actions)
+	}
+}
+
+func TestCreatePayrollBatchValidatesBatchTypes(t *testing.T) {
+	repo := &fakeRepository{
+		batches:        map[int64]*PayrollBatch{},
+		entriesByBatch: map[int64][]PayrollEntry{},
+		entryToBatch:   map[int64]int64{},
+		components: map[int64]*PayComponent{
+			1: {ID: 1, Code: "BONUS", Name: "Bonus", Kind: ComponentKindEarning, Taxable: true, CalculationType: CalculationFixed, Value: 500000, Active: true},
+			2: {ID: 2, Code: "OLD", Name: "Retired", Kind: ComponentKindEarning, CalculationType: CalculationFixed, Value: 1000, Active: false},
+		},
+	}
+	service := NewService(repo)
+	claims := &models.Claims{UserID: 1, Role: "Admin"}
+
+	invalid := []CreateBatchInput{
+		{Month: "2025-07", BatchType: "holiday", EmployeeIDs: []int64{101}, ComponentIDs: []int64{1}},
+		{Month: "2025-07", EmployeeIDs: []int64{101}},
+		{Month: "2025-07", BatchType: BatchTypeBonus, ComponentIDs: []int64{1}},
+		{Month: "2025-07", BatchType: BatchTypeBonus, EmployeeIDs: []int64{101}},
+		{Month: "2025-07", BatchType: BatchTypeBonus, EmployeeIDs: []int64{-1}, ComponentIDs: []int64{1}},
+		{Month: "2025-07", BatchType: BatchTypeCorrection, EmployeeIDs: []int64{101}, ComponentIDs: []int64{2}},
+	}
+	for _, input := range invalid {
+		if _, err := service.CreatePayrollBatch(context.Background(), claims, input); !errors.Is(err, ErrValidation) {
+			t.Fatalf("expected ErrValidation for %+v, got %v", input, err)
+		}
+	}
+
+	regular, err := service.CreatePayrollBatch(context.Background(), claims, CreateBatchInput{Month: "2025-07"})
+	if err != nil || regular.BatchType != BatchTypeRegular {
+		t.Fatalf("expected regular batch, got %+v / %v", regular, err)
+	}
+	if _, err := service.CreatePayrollBatch(context.Background(), claims, CreateBatchInput{Month: "2025-07", BatchType: "Regular"}); !errors.Is(err, ErrDuplicateMonth) {
+		t.Fatalf("expected ErrDuplicateMonth for a second regular batch, got %v", err)
+	}
+	for i := 0; i < 2; i++ {
+		bonus, err := service.CreatePayrollBatch(context.Background(), claims, CreateBatchInput{
+			Month:        "2025-07",
+			BatchType:    " Bonus ",
+			Description:  "13th cheque",
+			EmployeeIDs:  []int64{102, 101, 102},
+			ComponentIDs: []int64{1},
+		})
+		if err != nil {
+			t.Fatalf("expected off-cycle batch %d, got %v", i+1, err)
+		}
+		if bonus.BatchType != BatchTypeBonus || bonus.Description != "13th cheque" {
+			t.Fatalf("expected bonus batch, got %+v", bonus)
+		}
+		if selection := repo.selections[bonus.ID]; len(selection.EmployeeIDs) != 2 || selection.EmployeeIDs[0] != 101 {
+			t.Fatalf("expected deduplicated sorted employees, got %+v", selection)
+		}
+	}
+}
+
+func TestGenerateOffCycleBatchPaysSelectionWithMonthToDatePAYE(t *testing.T) {
+	hired := time.Date(2020, 1, 6, 0, 0, 0, 0, time.UTC)
+	repo := &fakeRepository{
+		batches: map[int64]*PayrollBatch{
+			1: {ID: 1, Month: "2025-07", BatchType: BatchTypeRegular, Status: StatusApproved},
+			2: {ID: 2, Month: "2025-07", BatchType: BatchTypeBonus, Status: StatusDraft},
+		},
+		entriesByBatch: map[int64][]PayrollEntry{
+			1: {{ID: 10, BatchID: 1, EmployeeID: 101, BaseSalary: money.FromUnits(1000000), TaxTotal: money.FromUnits(202000), GrossPay: money.FromUnits(1000000), NetPay: money.FromUnits(798000)}},
+		},
+		entryToBatch: map[int64]int64{10: 1},
+		components: map[int64]*PayComponent{
+			1: {ID: 1, Code: "BONUS", Name: "Performance Bonus", Kind: ComponentKindEarning, Taxable: true, CalculationType: CalculationPercentage, Value: 50, Active: true},
+			2: {ID: 2, Code: "HOUSING", Name: "Housing Allowance", Kind: ComponentKindEarning, Taxable: true, CalculationType: CalculationFixed, Value: 200000, AutoApply: true, Active: true},
+		},
+		activeEmployees: []EmployeeSalary{
+			{EmployeeID: 101, EmployeeName: "A", BaseSalary: money.FromUnits(1000000), DateOfHire: hired},
+			{EmployeeID: 102, EmployeeName: "B", BaseSalary: money.FromUnits(300000), DateOfHire: hired},
+			{EmployeeID: 103, EmployeeName: "Not selected", BaseSalary: money.FromUnits(900000), DateOfHire: hired},
+		},
+		selections: map[int64]*BatchSelection{2: {EmployeeIDs: []int64{101, 102}, ComponentIDs: []int64{1}}},
+	}
+	service := NewService(repo)
+	service.SetTaxRulesProvider(fakeTaxRules{tables: DefaultTaxTables()})
+
+	if err := service.GeneratePayrollEntries(context.Background(), 2); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	detail, err := service.GetPayrollBatch(context.Background(), 2)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(detail.Entries) != 2 || len(detail.EmployeeIDs) != 2 || len(detail.ComponentIDs) != 1 {
+		t.Fatalf("expected only the selected employees, got %+v", detail)
+	}
+
+	alice := detail.Entries[0]
+	if alice.BaseSalary != 0 || len(alice.Lines) != 1 || alice.Lines[0].Code != "BONUS" || alice.GrossPay != money.FromUnits(500000) {
+		t.Fatalf("expected a 50%% bonus line only, got %#v", alice)
+	}
+	// Month-to-date taxable pay of 1,500,000 carries PAYE of 352,000; 202,000 was deducted in the regular run.
+	if alice.TaxTotal != money.FromUnits(150000) || alice.NetPay != money.FromUnits(350000) {
+		t.Fatalf("expected month-to-date PAYE of 150000, got tax %s net %s", alice.TaxTotal, alice.NetPay)
+	}
+	// Without a regular run, a 150,000 bonus stays under the 235,000 threshold.
+	if bob := detail.Entries[1]; bob.TaxTotal != 0 || bob.NetPay != money.FromUnits(150000) {
+		t.Fatalf("expected untaxed bonus for B, got %#v", bob)
+	}
+
+	// A draft regular batch does not count towards month-to-date tax.
+	repo.batches[1].Status = StatusDraft
+	if err := service.GeneratePayrollEntries(context.Background(), 2); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if alice := repo.entriesByBatch[2][0]; alice.TaxTotal != money.FromUnits(52000) {
+		t.Fatalf("expected bonus taxed on its own, got %s", alice.TaxTotal)
+	}
+}
+
+func TestApprovePayrollBatchRejectsPAYEStaleAgainstAnotherDraftOfTheMonth(t *testing.T) {
+	hired := time.Date(2020, 1, 6, 0, 0, 0, 0, time.UTC)
+	repo := &fakeRepository{
+		batches: map[int64]*PayrollBatch{
+			1: {ID: 1, Month: "2025-07", BatchType: BatchTypeRegular, Status: StatusDraft},
+			2: {ID: 2, Month: "2025-07", BatchType: BatchTypeBonus, Status: StatusDraft},
+		},
+		components: map[int64]*PayComponent{
+			1: {ID: 1, Code: "BONUS", Name: "Performance Bonus", Kind: ComponentKindEarning, Taxable: true, CalculationType: CalculationPercentage, Value: 50, Active: true},
+		},
+		activeEmployees: []EmployeeSalary{
+			{EmployeeID: 101, EmployeeName: "A", BaseSalary: money.FromUnits(1000000), DateOfHire: hired},
+		},
+		selections: map[int64]*BatchSelection{2: {EmployeeIDs: []int64{101}, ComponentIDs: []int64{1}}},
+	}
+	service := NewService(repo)
+	service.SetTaxRulesProvider(fakeTaxRules{tables: DefaultTaxTables()})
+	claims := &models.Claims{UserID: 1, Role: "Admin"}
+
+	// Both batches are drafted before either is approved, so each works out PAYE on its own pay.
+	for _, batchID := range []int64{1, 2} {
+		if err := service.GeneratePayrollEntries(context.Background(), batchID); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	}
+	if bonus := repo.entriesByBatch[2][0]; bonus.TaxTotal != money.FromUnits(52000) {
+		t.Fatalf("expected bonus taxed on its own, got %s", bonus.TaxTotal)
+	}
+
+	if _, err := service.ApprovePayrollBatch(context.Background(), claims, 1, ""); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if _, err := service.ApprovePayrollBatch(context.Background(), claims, 2, ""); !errors.Is(err, ErrValidation) {
+		t.Fatalf("expected stale PAYE to block approval, got %v", err)
+	}
+	if repo.batches[2].Status != StatusDraft {
+		t.Fatalf("expected bonus batch to stay in draft, got %s", repo.batches[2].Status)
+	}
+
+	// Regenerating picks up the approved regular run: 1,500,000 carries 352,000, of which 202,000 is withheld.
+	if err := service.GeneratePayrollEntries(context.Background(), 2); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if bonus := repo.entriesByBatch[2][0]; bonus.TaxTotal != money.FromUnits(150000) {
+		t.Fatalf("expected month-to-date PAYE of 150000, got %s", bonus.TaxTotal)
+	}
+	if _, err := service.ApprovePayrollBatch(context.Background(), claims, 2, ""); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func TestGenerateArrearsBatchPaysBackDatedIncreaseForLockedMonths(t *testing.T) {
+	hired := time.Date(2020, 1, 6, 0, 0, 0, 0, time.UTC)
+	julyLocked := time.Date(2025, 8, 1, 9, 0, 0, 0, time.UTC)
+	augustLocked := time.Date(2025, 9, 1, 9, 0, 0, 0, time.UTC)
+	repo := &fakeRepository{
+		batches: map[int64]*PayrollBatch{
+			1: {ID: 1, Month: "2025-07", BatchType: BatchTypeRegular, Status: StatusLocked, LockedAt: &julyLocked},
+			2: {ID: 2, Month: "2025-08", BatchType: BatchTypeRegular, Status: StatusLocked, LockedAt: &augustLocked},
+			3: {ID: 3, Month: "2025-09", BatchType: BatchTypeArrears, Status: StatusDraft},
+		},
+		entriesByBatch: map[int64][]PayrollEntry{
+			1: {{ID: 10, BatchID: 1, EmployeeID: 101, BaseSalary: money.FromUnits(1000000)}},
+			2: {{ID: 20, BatchID: 2, EmployeeID: 101, BaseSalary: money.FromUnits(1000000)}},
+		},
+		entryToBatch: map[int64]int64{10: 1, 20: 2},
+		activeEmployees: []EmployeeSalary{
+			{EmployeeID: 101, EmployeeName: "A", BaseSalary: money.FromUnits(1200000), DateOfHire: hired},
+		},
+		// The increase from 1 August was recorded after August was locked, so August is owed 200,000; July
+		// was paid at the rate in effect and is unaffected.
+		salaryRates: []SalaryRate{
+			{EmployeeID: 101, Amount: money.FromUnits(1000000), EffectiveFrom: hired, CreatedAt: hired},
+			{EmployeeID: 101, Amount: money.FromUnits(1200000), EffectiveFrom: time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC), CreatedAt: time.Date(2025, 9, 10, 0, 0, 0, 0, time.UTC)},
+		},
+		selections: map[int64]*BatchSelection{3: {EmployeeIDs: []int64{101}}},
+	}
+	service := NewService(repo)
+	service.SetTaxRulesProvider(fakeTaxRules{tables: DefaultTaxTables()})
+
+	arrears, err := service.CalculateRetroArrears(context.Background(), "2025-09", 0)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(arrears) != 1 || arrears[0].Month != "2025-08" || arrears[0].DueBaseSalary != money.FromUnits(1200000) || arrears[0].Arrears != money.FromUnits(200000) {
+		t.Fatalf("expected 200000 arrears for 2025-08 only, got %+v", arrears)
+	}
+
+	if err := service.GeneratePayrollEntries(context.Background(), 3); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	entries := repo.entriesByBatch[3]
+	if len(entries) != 1 || entries[0].BaseSalary != 0 || entries[0].GrossPay != money.FromUnits(200000) {
+		t.Fatalf("expected one arrears entry of 200000, got %+v", entries)
+	}
+	lines := repo.linesByEntry[entries[0].ID]
+	if len(lines) != 1 || lines[0].Code != LineCodeArrears || !lines[0].Taxable || lines[0].ArrearsMonth == nil || *lines[0].ArrearsMonth != "2025-08" {
+		t.Fatalf("expected a taxable arrears line for 2025-08, got %+v", lines)
+	}
+
+	// Once the arrears batch is approved the month is settled.
+	repo.batches[3].Status = StatusApproved
+	arrears, err = service.CalculateRetroArrears(context.Background(), "2025-10", 101)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(arrears) != 0 {
+		t.Fatalf("expected no outstanding arrears, got %+v", arrears)
+	}
+}
+
+func TestGenerateCorrectionBatchPaysSelectedEmployeesWhoHaveLeft(t *testing.T) {
+	hired := time.Date(2020, 1, 6, 0, 0, 0, 0, time.UTC)
+	exited := time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC)
+	repo := &fakeRepository{
+		batches: map[int64]*PayrollBatch{
+			1: {ID: 1, Month: "2025-09", BatchType: BatchTypeCorrection, Status: StatusDraft},
+			2: {ID: 2, Month: "2025-09", BatchType: BatchTypeBonus, Status: StatusDraft},
+		},
+		components: map[int64]*PayComponent{
+			1: {ID: 1, Code: "REFUND", Name: "Overdeduction refund", Kind: ComponentKindEarning, CalculationType: CalculationFixed, Value: 50000, Active: true},
+		},
+		activeEmployees: []EmployeeSalary{
+			{EmployeeID: 101, EmployeeName: "A", BaseSalary: money.FromUnits(1000000), DateOfHire: hired},
+			{EmployeeID: 102, EmployeeName: "Left in June", BaseSalary: money.FromUnits(800000), DateOfHire: hired, DateOfExit: &exited},
+		},
+		selections: map[int64]*BatchSelection{
+			1: {EmployeeIDs: []int64{101, 102}, ComponentIDs: []int64{1}},
+			2: {EmployeeIDs: []int64{101, 102}, ComponentIDs: []int64{1}},
+		},
+	}
+	service := NewService(repo)
+
+	if err := service.GeneratePayrollEntries(context.Background(), 1); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if entries := repo.entriesByBatch[1]; len(entries) != 2 || entries[1].EmployeeID != 102 || entries[1].GrossPay != money.FromUnits(50000) {
+		t.Fatalf("expected the correction to pay the employee who left, got %+v", entries)
+	}
+
+	// A bonus still goes only to staff employed in the month.
+	if err := service.GeneratePayrollEntries(context.Background(), 2); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if entries := repo.entriesByBatch[2]; len(entries) != 1 || entries[0].EmployeeID != 101 {
+		t.Fatalf("expected the bonus to skip the employee who left, got %+v", entries)
+	}
+}
+
+func TestGeneratePayrollEntriesUsesSalaryHistoryRate(t *testing.T) {
+	hired := time.Date(2020, 1, 6, 0, 0, 0, 0, time.UTC)
+	repo := &fakeRepository{
+		batches: map[int64]*PayrollBatch{1: {ID: 1, Month: "2025-07", Status: StatusDraft}},
+		activeEmployees: []EmployeeSalary{
+			{EmployeeID: 101, EmployeeName: "A", BaseSalary: money.FromUnits(2760000), DateOfHire: hired},
+		},
+		salaryRates: []SalaryRate{
+			{EmployeeID: 101, Amount: money.FromUnits(2300000), EffectiveFrom: hired, CreatedAt: hired},
+			{EmployeeID: 101, Amount: money.FromUnits(2760000), EffectiveFrom: time.Date(2025, 7, 16, 0, 0, 0, 0, time.UTC), CreatedAt: hired},
+		},
+	}
+	service := NewService(repo)
+
+	if err := service.GeneratePayrollEntries(context.Background(), 1); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	entry := repo.entriesByBatch[1][0]
+	if entry.BaseSalary != money.FromUnits(2540000) || entry.FullBaseSalary != nil {
+		t.Fatalf("expected base salary split at the 16 July increase, got %s (full %v)", entry.BaseSalary, entry.FullBaseSalary)
+	}
+}
+
+func journalTestMappings() []GLAccountMapping {
+	operations := int64(2)
+	housing := int64(3)
+	return []GLAccountMapping{
+		{ID: 1, Category: GLCategorySalaryExpense, AccountCode: "6000", AccountName: "Salaries"},
+		{ID: 2, Category: GLCategorySalaryExpense, DepartmentID: &operations, AccountCode: "6100", AccountName: "Salaries - Operations"},
+		{ID: 3, Category: GLCategoryEarning, ComponentID: &housing, AccountCode: "6010", AccountName: "Housing allowance"},
+		{ID: 4, Category: GLCategoryEmployerContributionExpense, AccountCode: "6200", AccountName: "Employer NSSF"},
+		{ID: 5, Category: GLCategoryTaxPayable, AccountCode: "2100", AccountName: "PAYE payable"},
+		{ID: 6, Category: GLCategoryContributionPayable, AccountCode: "2200", AccountName: "NSSF payable"},
+		{ID: 7, Category: GLCategoryDeduction, AccountCode: "2300", AccountName: "Other deductions"},
+		{ID: 8, Category: GLCategoryNetPayClearing, AccountCode: "2400", AccountName: "Net pay clearing"},
+	}
+}
+
+func TestExportPayrollJournalPostsBalancedJournalByDepartment(t *testing.T) {
+	schemeID := int64(1)
+	housing := int64(3)
+	loanID := int64(9)
+	finance, operations := int64(1), int64(2)
+	financeName, operationsName := "Finance", "Operations"
+	financeCentre, operationsCentre := "CC-100", "CC-200"
+	repo := &fakeRepository{
+		batches: map[int64]*PayrollBatch{5: {ID: 5, Month: "2025-07", Status: StatusLocked}},
+		entriesByBatch: map[int64][]PayrollEntry{
+			5: {
+				{ID: 50, BatchID: 5, EmployeeID: 7, BaseSalary: money.FromUnits(1000000), AllowancesTotal: money.FromUnits(200000), DeductionsTotal: money.FromUnits(110000), TaxTotal: money.FromUnits(150000), GrossPay: money.FromUnits(1200000), NetPay: money.FromUnits(940000), EmployerContributionsTotal: money.FromUnits(120000)},
+				{ID: 51, BatchID: 5, EmployeeID: 8, BaseSalary: money.FromUnits(500000), TaxTotal: money.FromUnits(50000), GrossPay: money.FromUnits(500000), NetPay: money.FromUnits(450000)},
+			},
+		},
+		entryToBatch: map[int64]int64{50: 5, 51: 5},
+		linesByEntry: map[int64][]PayrollEntryLine{
+			50: {
+				{ID: 1, EntryID: 50, ComponentID: &housing, Code: "HOUSING", Kind: ComponentKindEarning, Amount: money.FromUnits(200000)},
+				{ID: 2, EntryID: 50, SchemeID: &schemeID, Code: "NSSF", Kind: ComponentKindDeduction, Amount: money.FromUnits(60000), EmployerAmount: money.FromUnits(120000)},
+				{ID: 3, EntryID: 50, LoanID: &loanID, Code: LineCodeLoan, Kind: ComponentKindDeduction, Amount: money.FromUnits(50000)},
+			},
+		},
+		departments: []EmployeeDepartment{
+			{EmployeeID: 7, DepartmentID: &finance, DepartmentName: &financeName, CostCentre: &financeCentre},
+			{EmployeeID: 8, DepartmentID: &operations, DepartmentName: &operationsName, CostCentre: &operationsCentre},
+		},
+		glMappings: journalTestMappings(),
+	}
+	service := NewService(repo)
+	audit := &captureAuditRecorder{}
+	service.SetAuditRecorder(audit)
+
+	journal, err := service.GetPayrollJournal(context.Background(), 5)
+	if err != nil {
+		t.Fatalf("expected journal, got %v", err)
+	}
+	if journal.TotalDebit != money.FromUnits(1820000) || journal.TotalCredit != journal.TotalDebit {
+		t.Fatalf("expected balanced journal of 1820000, got debit %s credit %s", journal.TotalDebit, journal.TotalCredit)
+	}
+
+	export, err := service.ExportPayrollJournal(context.Background(), 5, "import")
+	if err != nil {
+		t.Fatalf("expected import export, got %v", err)
+	}
+	if export.Filename != "payroll-journal-2025-07-5-import.csv" {
+		t.Fatalf("unexpected filename %q", export.Filename)
+	}
+	expected := "JournalNumber,JournalDate,AccountCode,AccountName,Description,Debit,Credit,CostCentre,Department\n" +
+		"PAYROLL-5,2025-07-31,6000,Salaries,Payroll 2025-07 regular,1000000.00,0.00,CC-100,Finance\n" +
+		"PAYROLL-5,2025-07-31,6010,Housing allowance,Payroll 2025-07 regular,200000.00,0.00,CC-100,Finance\n" +
+		"PAYROLL-5,2025-07-31,6100,Salaries - Operations,Payroll 2025-07 regular,500000.00,0.00,CC-200,Operations\n" +
+		"PAYROLL-5,2025-07-31,6200,Employer NSSF,Payroll 2025-07 regular,120000.00,0.00,CC-100,Finance\n" +
+		"PAYROLL-5,2025-07-31,2100,PAYE payable,Payroll 2025-07 regular,0.00,200000.00,,\n" +
+		"PAYROLL-5,2025-07-31,2200,NSSF payable,Payroll 2025-07 regular,0.00,180000.00,,\n" +
+		"PAYROLL-5,2025-07-31,2300,Other deductions,Payroll 2025-07 regular,0.00,50000.00,,\n" +
+		"PAYROLL-5,2025-07-31,2400,Net pay clearing,Payroll 2025-07 regular,0.00,1390000.00,,\n"
+	if export.Data != expected {
+		t.Fatalf("unexpected journal import:\n%s", export.Data)
+	}
+
+	export, err = service.ExportPayrollJournal(context.Background(), 5, "csv")
+	if err != nil {
+		t.Fatalf("expected csv export, got %v", err)
+	}
+	if !strings.HasSuffix(export.Data, ",Total,,,1820000.00,1820000.00\n") {
+		t.Fatalf("expected totals row, got:\n%s", export.Data)
+	}
+	if audit.actions[len(audit.actions)-1] != "payroll.batch.journal_export" {
+		t.Fatalf("expected journal export audit, got %v", audit.actions)
+	}
+
+	repo.batches[5].Status = StatusApproved
+	if _, err := service.ExportPayrollJournal(context.Background(), 5, "csv"); !errors.Is(err, ErrJournalNotAllowed) {
+		t.Fatalf("expected ErrJournalNotAllowed for approved batch, got %v", err)
+	}
+}
+
+func TestBuildPayrollJournalFallbacksAndMissingMappings(t *testing.T) {
+	transport := int64(4)
+	reverses := int64(5)
+	batch := PayrollBatch{ID: 6, Month: "2025-07", BatchType: BatchTypeCorrection, Status: StatusLocked, ReversesBatchID: &reverses}
+	entries := []PayrollEntry{{
+		ID: 60, EmployeeID: 7, BaseSalary: money.FromUnits(-1000), AllowancesTotal: money.FromUnits(-100), GrossPay: money.FromUnits(-1100), NetPay: money.FromUnits(-1100),
+		Lines: []PayrollEntryLine{{ComponentID: &transport, Code: "TRANSPORT", Kind: ComponentKindEarning, Amount: money.FromUnits(-100)}},
+	}}
+	mappings := journalTestMappings()
+
+	journal, err := BuildPayrollJournal(batch, entries, nil, mappings)
+	if err != nil {
+		t.Fatalf("expected journal, got %v", err)
+	}
+	if journal.Reference != "PAYROLL-6-REV-5" || len(journal.Lines) != 2 {
+		t.Fatalf("unexpected journal %#v", journal)
+	}
+	// The unmapped earning falls back to salary expense, and the reversal credits expense and debits clearing.
+	if journal.Lines[0].AccountCode != "2400" || journal.Lines[0].Debit != money.FromUnits(1100) {
+		t.Fatalf("expected net pay clearing debit, got %#v", journal.Lines[0])
+	}
+	if journal.Lines[1].AccountCode != "6000" || journal.Lines[1].Credit != money.FromUnits(1100) {
+		t.Fatalf("expected salary expense credit, got %#v", journal.Lines[1])
+	}
+
+	_, err = BuildPayrollJournal(batch, entries, nil, mappings[:7])
+	if !errors.Is(err, ErrValidation) || !strings.Contains(err.Error(), GLCategoryNetPayClearing) {
+		t.Fatalf("expected missing net pay clearing mapping, got %v", err)
+	}
+}
+
+func TestCreateGLAccountMappingValidatesTargets(t *testing.T) {
+	repo := &fakeRepository{}
+	service := NewService(repo)
+	componentID := int64(3)
+
+	if _, err := service.CreateGLAccountMapping(context.Background(), GLAccountMappingInput{Category: GLCategoryTaxPayable, ComponentID: &componentID, AccountCode: "2100"}); !errors.Is(err, ErrValidation) {
+		t.Fatalf("expected ErrValidation for component on tax payable, got %v", err)
+	}
+	if _, err := service.CreateGLAccountMapping(context.Background(), GLAccountMappingInput{Category: "payables", AccountCode: "2100"}); !errors.Is(err, ErrValidation) {
+		t.Fatalf("expected ErrValidation for unknown category, got %v", err)
+	}
+	item, err := service.CreateGLAccountMapping(context.Background(), GLAccountMappingInput{Category: " Earning ", ComponentID: &componentID, AccountCode: " 6010 "})
+	if err != nil {
+		t.Fatalf("expected mapping, got %v", err)
+	}
+	if item.Category != GLCategoryEarning || item.AccountCode != "6010" {
+		t.Fatalf("unexpected mapping %#v", item)
+	}
+	if _, err := service.CreateGLAccountMapping(context.Background(), GLAccountMappingInput{Category: GLCategoryEarning, ComponentID: &componentID, AccountCode: "6011"}); !errors.Is(err, ErrDuplicateGLMapping) {
+		t.Fatalf("expected ErrDuplicateGLMapping, got %v", err)
+	}
+}
+
+func TestSetEmployeeProjectAllocationsValidatesTotals(t *testing.T) {
+	repo := &fakeRepository{
+		projects: []Project{
+			{ID: 1, Code: "MAL-01", Name: "Malaria Surveillance", Active: true},
+			{ID: 2, Code: "HIV-02", Name: "HIV Reporting", Active: true},
+		},
+	}
+	service := NewService(repo)
+	audit := &captureAuditRecorder{}
+	service.SetAuditRecorder(audit)
+
+	_, err := service.SetEmployeeProjectAllocations(context.Background(), 7, []ProjectAllocationInput{
+		{ProjectID: 1, Percent: 60, StartDate: "2026-01-01"},
+		{ProjectID: 2, Percent: 50, StartDate: "2026-03-16", EndDate: "2026-06-30"},
+	})
+	if !errors.Is(err, ErrValidation) {
+		t.Fatalf("expected ErrValidation for allocations over 100%%, got %v", err)
+	}
+	_, err = service.SetEmployeeProjectAllocations(context.Background(), 7, []ProjectAllocationInput{{ProjectID: 9, Percent: 10, StartDate: "2026-01-01"}})
+	if !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound for unknown project, got %v", err)
+	}
+	_, err = service.SetEmployeeProjectAllocations(context.Background(), 7, []ProjectAllocationInput{{ProjectID: 1, Percent: 10, StartDate: "2026-03-01", EndDate: "2026-02-01"}})
+	if !errors.Is(err, ErrValidation) {
+		t.Fatalf("expected ErrValidation for end before start, got %v", err)
+	}
+
+	items, err := service.SetEmployeeProjectAllocations(context.Background(), 7, []ProjectAllocationInput{
+		{ProjectID: 1, Percent: 60, StartDate: "2026-01-01", EndDate: "2026-03-15"},
+		{ProjectID: 2, Percent: 100, StartDate: "2026-03-16"},
+		{ProjectID: 1, Percent: 33.333, StartDate: "2026-01-01", EndDate: "2026-01-31"},
+	})
+	if err != nil {
+		t.Fatalf("expected allocations, got %v", err)
+	}
+	if len(items) != 3 || items[1].Percent != 33.33 || items[2].ProjectCode != "HIV-02" {
+		t.Fatalf("unexpected allocations %#v", items)
+	}
+	if audit.actions[len(audit.actions)-1] != "payroll.project_allocation.set" {
+		t.Fatalf("expected allocation audit, got %v", audit.actions)
+	}
+}
+
+func TestLockPayrollBatchChargesCostToProjects(t *testing.T) {
+	malaria, hiv := int64(1), int64(2)
+	repo := &fakeRepository{
+		batches: map[int64]*PayrollBatch{1: {ID: 1, Month: "2026-03", BatchType: BatchTypeRegular, Status: StatusApproved}},
+		entriesByBatch: map[int64][]PayrollEntry{
+			1: {
+				{ID: 50, BatchID: 1, EmployeeID: 7, GrossPay: money.FromUnits(2200), EmployerContributionsTotal: money.FromUnits(220)},
+				{ID: 51, BatchID: 1, EmployeeID: 8, GrossPay: money.FromUnits(1000), EmployerContributionsTotal: money.FromUnits(100)},
+			},
+		},
+		entryToBatch: map[int64]int64{50: 1, 51: 1},
+		activeEmployees: []EmployeeSalary{
+			{EmployeeID: 7, EmployeeName: "Amina Okello", DateOfHire: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)},
+			{EmployeeID: 8, EmployeeName: "Brian Mwangi", DateOfHire: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)},
+		},
+		projects: []Project{
+			{ID: malaria, Code: "MAL-01", Name: "Malaria Surveillance", Donor: "Global Fund", Active: true},
+			{ID: hiv, Code: "HIV-02", Name: "HIV Reporting", Donor: "PEPFAR", Active: true},
+		},
+		allocations: []EmployeeProjectAllocation{
+			{ID: 1, EmployeeID: 7, ProjectID: malaria, Percent: 50, StartDate: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)},
+			{ID: 2, EmployeeID: 7, ProjectID: hiv, Percent: 40, StartDate: time.Date(2026, 3, 16, 0, 0, 0, 0, time.UTC)},
+		},
+	}
+	service := NewService(repo)
+
+	if _, err := service.LockPayrollBatch(context.Background(), 1); err != nil {
+		t.Fatalf("expected lock, got %v", err)
+	}
+	// March 2026 has 22 working days; the HIV allocation covers the last 12 of them.
+	if len(repo.projectCharges) != 4 {
+		t.Fatalf("expected 4 project charges, got %#v", repo.projectCharges)
+	}
+
+	export, err := service.ExportProjectChargingCSV(context.Background(), "2026-03", 0)
+	if err != nil {
+		t.Fatalf("expected charging export, got %v", err)
+	}
+	if export.Filename != "project-charging-2026-03.csv" {
+		t.Fatalf("unexpected filename %q", export.Filename)
+	}
+	expected := "Project Code,Project Name,Donor,Employee ID,Employee Name,Share %,Gross Pay,Employer Contributions,Total Cost\n" +
+		"HIV-02,HIV Reporting,PEPFAR,7,Amina Okello,21.82,480.00,48.00,528.00\n" +
+		"HIV-02,Subtotal,PEPFAR,,1 employees,,480.00,48.00,528.00\n" +
+		"MAL-01,Malaria Surveillance,Global Fund,7,Amina Okello,50.00,1100.00,110.00,1210.00\n" +
+		"MAL-01,Subtotal,Global Fund,,1 employees,,1100.00,110.00,1210.00\n" +
+		"UNALLOCATED,Unallocated,,7,Amina Okello,28.18,620.00,62.00,682.00\n" +
+		"UNALLOCATED,Unallocated,,8,Brian Mwangi,100.00,1000.00,100.00,1100.00\n" +
+		"UNALLOCATED,Subtotal,,,2 employees,,1620.00,162.00,1782.00\n" +
+		"TOTAL,,,,,,3200.00,320.00,3520.00\n"
+	if export.Data != expected {
+		t.Fatalf("unexpected charging csv:\n%s", export.Data)
+	}
+
+	report, err := service.GetProjectChargingReport(context.Background(), "2026-03", hiv)
+	if err != nil {
+		t.Fatalf("expected project report, got %v", err)
+	}
+	if len(report.Rows) != 1 || report.TotalCost != money.FromUnits(528) || report.Rows[0].SharePercent != 21.82 {
+		t.Fatalf("unexpected project report %#v", report)
+	}
+
+	// A reversal offsets the original charges even after the allocations change.
+	reverses := int64(1)
+	repo.allocations = nil
+	repo.batches[2] = &PayrollBatch{ID: 2, Month: "2026-03", BatchType: BatchTypeCorrection, Status: StatusApproved, ReversesBatchID: &reverses}
+	repo.entriesByBatch[2] = []PayrollEntry{
+		{ID: 60, BatchID: 2, EmployeeID: 7, GrossPay: money.FromUnits(-2200), EmployerContributionsTotal: money.FromUnits(-220)},
+		{ID: 61, BatchID: 2, EmployeeID: 8, GrossPay: money.FromUnits(-1000), EmployerContributionsTotal: money.FromUnits(-100)},
+	}
+	repo.entryToBatch[60], repo.entryToBatch[61] = 2, 2
+	if _, err := service.LockPayrollBatch(context.Background(), 2); err != nil {
+		t.Fatalf("expected reversal lock, got %v", err)
+	}
+	report, err = service.GetProjectChargingReport(context.Background(), "2026-03", 0)
+	if err != nil {
+		t.Fatalf("expected report after reversal, got %v", err)
+	}
+	if len(report.Rows) != 0 || report.TotalCost != 0 {
+		t.Fatalf("expected reversal to net out every project, got %#v", report)
+	}
+}
+
+func TestImportPayrollEntryAmountsReportsRowErrorsWithoutChanges(t *testing.T) {
+	repo := &fakeRepository{
+		batches: map[int64]*PayrollBatch{3: {ID: 3, Month: "2026-04", Status: StatusDraft}},
+		entriesByBatch: map[int64][]PayrollEntry{
+			3: {
+				{ID: 30, BatchID: 3, EmployeeID: 7, BaseSalary: money.FromUnits(1000), GrossPay: money.FromUnits(1000), NetPay: money.FromUnits(1000)},
+				{ID: 31, BatchID: 3, EmployeeID: 8, BaseSalary: money.FromUnits(2000), GrossPay: money.FromUnits(2000), NetPay: money.FromUnits(2000)},
+			},
+		},
+		entryToBatch: map[int64]int64{30: 3, 31: 3},
+	}
+	service := NewService(repo)
+	audit := &captureAuditRecorder{}
+	service.SetAuditRecorder(audit)
+
+	data := "Employee ID,Employee Name,Allowances,Deductions,Tax\n" +
+		"7,Amina,100,20,5\n" +
+		"9,Unknown,10,0,0\n" +
+		"7,Amina again,1,1,1\n" +
+		"8,Brian,abc,0,0\n" +
+		"x,Nobody,1,1,1\n"
+	result, err := service.ImportPayrollEntryAmounts(context.Background(), 3, data)
+	if err != nil {
+		t.Fatalf("expected row errors in the result, got %v", err)
+	}
+	if result.Rows != 5 || result.Updated != 0 || len(result.Errors) != 4 {
+		t.Fatalf("unexpected import result %#v", result)
+	}
+	rows := []int{result.Errors[0].Row, result.Errors[1].Row, result.Errors[2].Row, result.Errors[3].Row}
+	if fmt.Sprint(rows) != "[3 4 5 6]" {
+		t.Fatalf("expected errors on rows 3-6, got %v", result.Errors)
+	}
+	if repo.entriesByBatch[3][0].AllowancesTotal != 0 {
+		t.Fatalf("expected no entry to change, got %#v", repo.entriesByBatch[3][0])
+	}
+	if len(audit.actions) != 0 {
+		t.Fatalf("expected no audit event for a rejected import, got %v", audit.actions)
+	}
+
+	if _, err := service.ImportPayrollEntryAmounts(context.Background(), 3, "Employee Name,Allowances\nAmina,1\n"); !errors.Is(err, ErrValidation) {
+		t.Fatalf("expected ErrValidation without an Employee ID column, got %v", err)
+	}
+	repo.batches[3].Status = StatusApproved
+	if _, err := service.ImportPayrollEntryAmounts(context.Background(), 3, "Employee ID,Allowances\n7,1\n"); !errors.Is(err, ErrImmutableBatch) {
+		t.Fatalf("expected ErrImmutableBatch, got %v", err)
+	}
+}
+
+func TestImportPayrollEntryAmountsUpdatesEntriesInOneTransaction(t *testing.T) {
+	repo := &fakeRepository{
+		batches: map[int64]*PayrollBatch{3: {ID: 3, Month: "2026-04", Status: StatusDraft}},
+		entriesByBatch: map[int64][]PayrollEntry{
+			3: {
+				{ID: 30, BatchID: 3, EmployeeID: 7, BaseSalary: money.FromUnits(1000), GrossPay: money.FromUnits(1000), NetPay: money.FromUnits(1000)},
+				{ID: 31, BatchID: 3, EmployeeID: 8, BaseSalary: money.FromUnits(2000), TaxTotal: money.FromUnits(50), GrossPay: money.FromUnits(2000), NetPay: money.FromUnits(1950)},
+				{ID: 32, BatchID: 3, EmployeeID: 9, BaseSalary: money.FromUnits(3000), GrossPay: money.FromUnits(3000), NetPay: money.FromUnits(3000)},
+			},
+		},
+		entryToBatch: map[int64]int64{30: 3, 31: 3, 32: 3},
+	}
+	service := NewService(repo)
+	audit := &captureAuditRecorder{}
+	service.SetAuditRecorder(audit)
+
+	data := "\ufeffemployee id,Allowances,Deductions,Tax\n" +
+		"7,\"1,500.00\",200,100\n" +
+		"8,250.5,,\n" +
+		"\n" +
+		"9,0,0,0\n"
+	result, err := service.ImportPayrollEntryAmounts(context.Background(), 3, data)
+	if err != nil {
+		t.Fatalf("expected import, got %v", err)
+	}
+	if result.Rows != 3 || result.Updated != 2 || result.Unchanged != 1 || len(result.Errors) != 0 {
+		t.Fatalf("unexpected import result %#v", result)
+	}
+	first, second := repo.entriesByBatch[3][0], repo.entriesByBatch[3][1]
+	if first.AllowancesTotal != money.FromUnits(1500) || first.GrossPay != money.FromUnits(2500) || first.NetPay != money.FromUnits(2200) {
+		t.Fatalf("unexpected first entry %#v", first)
+	}
+	if second.AllowancesTotal != money.FromMinor(25050) || second.TaxTotal != money.FromUnits(50) || second.NetPay != money.FromMinor(220050) {
+		t.Fatalf("expected blank cells to keep amounts, got %#v", second)
+	}
+	if len(audit.actions) != 1 || audit.actions[0] != "payroll.batch.import_amounts" {
+		t.Fatalf("expected one import audit event, got %v", audit.actions)
+	}
+}
+
+func TestImportPayrollEntryAmountsAdjustsGeneratedEntry(t *testing.T) {
+	repo := &fakeRepository{
+		batches:        map[int64]*PayrollBatch{1: {ID: 1, Month: "2025-07", Status: StatusDraft}},
+		entriesByBatch: map[int64][]PayrollEntry{},
+		entryToBatch:   map[int64]int64{},
+		schemes: map[int64]*ContributionScheme{
+			1: {ID: 1, Code: "NSSF", Name: "NSSF", EmployeeRate: 5, EmployerRate: 10, Base: ContributionBaseGross, Active: true},
+		},
+		activeEmployees: []EmployeeSalary{{EmployeeID: 101, EmployeeName: "A", BaseSalary: money.FromUnits(1000000)}},
+	}
+	service := NewService(repo)
+	service.SetTaxRulesProvider(fakeTaxRules{tables: DefaultTaxTables()})
+	ctx := context.Background()
+
+	if err := service.GeneratePayrollEntries(ctx, 1); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	generated := repo.entriesByBatch[1][0]
+	if generated.DeductionsTotal != money.FromUnits(50000) || len(repo.linesByEntry[generated.ID]) != 1 {
+		t.Fatalf("expected a generated entry with an NSSF line, got %#v", generated)
+	}
+
+	result, err := service.ImportPayrollEntryAmounts(ctx, 1, "Employee ID,Allowances,Deductions,Tax\n101,200000,,1\n")
+	if err != nil {
+		t.Fatalf("expected row errors in the result, got %v", err)
+	}
+	if len(result.Errors) != 1 || result.Errors[0].Row != 2 || !strings.Contains(result.Errors[0].Message, "PAYE") || result.Updated != 0 {
+		t.Fatalf("expected tax that differs from PAYE to be rejected, got %#v", result)
+	}
+	if current := repo.entriesByBatch[1][0]; current.AllowancesTotal != 0 || current.NetPay != generated.NetPay || len(repo.linesByEntry[generated.ID]) != 1 {
+		t.Fatalf("expected a rejected import to change nothing, got %#v", repo.entriesByBatch[1][0])
+	}
+
+	// Gross becomes 1,200,000: NSSF follows to 60,000 and the extra 10,000 of deductions goes on an adjustment line.
+	paye := CalculatePAYE(*SelectTaxTable(DefaultTaxTables(), "2025-07"), money.FromUnits(1200000))
+	data := fmt.Sprintf("Employee ID,Allowances,Deductions,Tax\n101,200000,60000,%s\n", paye)
+	result, err = service.ImportPayrollEntryAmounts(ctx, 1, data)
+	if err != nil || len(result.Errors) != 0 || result.Updated != 1 {
+		t.Fatalf("expected the import to apply, got %#v, %v", result, err)
+	}
+	entry := repo.entriesByBatch[1][0]
+	if entry.AllowancesTotal != money.FromUnits(200000) || entry.DeductionsTotal != money.FromUnits(70000) || entry.EmployerContributionsTotal != money.FromUnits(120000) {
+		t.Fatalf("unexpected totals after import %#v", entry)
+	}
+	if entry.TaxTotal != paye || entry.NetPay != money.FromUnits(1130000)-paye {
+		t.Fatalf("expected PAYE %s on the new gross, got %#v", paye, entry)
+	}
+	adjustments := map[string]money.Amount{}
+	for _, line := range repo.linesByEntry[entry.ID] {
+		if line.Code == LineCodeImportAdjustment {
+			adjustments[line.Kind] = line.Amount
+		}
+	}
+	if len(adjustments) != 2 || adjustments[ComponentKindEarning] != money.FromUnits(200000) || adjustments[ComponentKindDeduction] != money.FromUnits(10000) {
+		t.Fatalf("expected import adjustment lines, got %v", repo.linesByEntry[entry.ID])
+	}
+
+	// Deductions can come back down to the deduction lines, but not below them.
+	result, err = service.ImportPayrollEntryAmounts(ctx, 1, "Employee ID,Deductions\n101,40000\n")
+	if err != nil || len(result.Errors) != 1 || !strings.Contains(result.Errors[0].Message, "deduction lines") {
+		t.Fatalf("expected deductions below the lines to be rejected, got %#v, %v", result, err)
+	}
+	result, err = service.ImportPayrollEntryAmounts(ctx, 1, "Employee ID,Deductions\n101,60000\n")
+	if err != nil || len(result.Errors) != 0 || result.Updated != 1 {
+		t.Fatalf("expected the deduction adjustment to be cleared, got %#v, %v", result, err)
+	}
+	for _, line := range repo.linesByEntry[entry.ID] {
+		if line.Code == LineCodeImportAdjustment && line.Kind == ComponentKindDeduction {
+			t.Fatalf("expected the deduction adjustment line to be removed, got %#v", line)
+		}
+	}
+}
+
+func TestGetAnnualTaxSummaryAggregatesLockedFiscalYear(t *testing.T) {
+	nssf := int64(3)
+	reverses := int64(2)
+	entry := func(id, batchID, employeeID int64, name string, base, allowances, tax, contribution int64) PayrollEntry {
+		gross := money.FromUnits(base + allowances)
+		return PayrollEntry{
+			ID: id, BatchID: batchID, EmployeeID: employeeID, EmployeeName: name,
+			BaseSalary: money.FromUnits(base), AllowancesTotal: money.FromUnits(allowances),
+			DeductionsTotal: money.FromUnits(contribution), TaxTotal: money.FromUnits(tax), GrossPay: gross,
+			NetPay: gross - money.FromUnits(tax+contribution),
+		}
+	}
+	nssfLine := func(entryID, employee, employer int64) PayrollEntryLine {
+		return PayrollEntryLine{EntryID: entryID, SchemeID: &nssf, Code: "NSSF", Name: "NSSF", Kind: ComponentKindDeduction, Amount: money.FromUnits(employee), EmployerAmount: money.FromUnits(employer)}
+	}
+	repo := &fakeRepository{
+		batches: map[int64]*PayrollBatch{
+			1: {ID: 1, Month: "2025-06", BatchType: BatchTypeRegular, Status: StatusLocked},
+			2: {ID: 2, Month: "2025-07", BatchType: BatchTypeRegular, Status: StatusLocked},
+			3: {ID: 3, Month: "2025-07", BatchType: BatchTypeCorrection, Status: StatusLocked, ReversesBatchID: &reverses},
+			4: {ID: 4, Month: "2025-08", BatchType: BatchTypeRegular, Status: StatusLocked},
+			5: {ID: 5, Month: "2025-09", BatchType: BatchTypeRegular, Status: StatusApproved},
+			6: {ID: 6, Month: "2026-06", BatchType: BatchTypeRegular, Status: StatusLocked},
+		},
+		entriesByBatch: map[int64][]PayrollEntry{
+			1: {entry(10, 1, 7, "Amina Okello", 1000, 0, 100, 50)},
+			2: {entry(20, 2, 7, "Amina Okello", 1000, 200, 130, 50), entry(21, 2, 8, "Brian Mwangi", 800, 0, 60, 40)},
+			4: {entry(40, 4, 7, "Amina Okello", 1000, 0, 100, 50)},
+			5: {entry(50, 5, 7, "Amina Okello", 1000, 0, 100, 50)},
+			6: {entry(60, 6, 7, "Amina Okello", 1000, 0, 100, 50)},
+		},
+		linesByEntry: map[int64][]PayrollEntryLine{
+			20: {
+				{EntryID: 20, Code: "MEAL", Name: "Meal Allowance", Kind: ComponentKindEarning, Taxable: false, Amount: money.FromUnits(200)},
+				nssfLine(20, 50, 100),
+			},
+			21: {nssfLine(21, 40, 80)},
+			40: {nssfLine(40, 50, 100)},
+			60: {nssfLine(60, 50, 100)},
+		},
+	}
+	// Brian's July entry is reversed in full, so Brian has nothing to certify.
+	reversal := entry(30, 3, 8, "Brian Mwangi", 800, 0, 60, 40)
+	reversal.BaseSalary, reversal.GrossPay, reversal.TaxTotal, reversal.DeductionsTotal, reversal.NetPay =
+		-reversal.BaseSalary, -reversal.GrossPay, -reversal.TaxTotal, -reversal.DeductionsTotal, -reversal.NetPay
+	repo.entriesByBatch[3] = []PayrollEntry{reversal}
+	repo.linesByEntry[30] = []PayrollEntryLine{reversalLine(nssfLine(30, 40, 80))}
+	service := NewService(repo)
+
+	if _, err := service.GetAnnualTaxSummary(context.Background(), 2025, 13); !errors.Is(err, ErrValidation) {
+		t.Fatalf("expected validation error for start month, got %v", err)
+	}
+
+	summary, err := service.GetAnnualTaxSummary(context.Background(), 2025, 7)
+	if err != nil {
+		t.Fatalf("expected annual summary, got %v", err)
+	}
+	if summary.Label != "2025/26" || summary.FromMonth != "2025-07" || summary.ToMonth != "2026-06" {
+		t.Fatalf("unexpected period %#v", summary)
+	}
+	if len(summary.Schemes) != 1 || summary.Schemes[0].Code != "NSSF" {
+		t.Fatalf("unexpected scheme columns %#v", summary.Schemes)
+	}
+	if len(summary.Employees) != 1 {
+		t.Fatalf("expected the reversed employee to net out, got %#v", summary.Employees)
+	}
+	amina := summary.Employees[0]
+	if amina.EmployeeID != 7 || amina.Months != 3 {
+		t.Fatalf("unexpected employee totals %#v", amina)
+	}
+	if amina.GrossPay != money.FromUnits(3200) || amina.TaxablePay != money.FromUnits(3000) || amina.TaxTotal != money.FromUnits(330) || amina.NetPay != money.FromUnits(2720) {
+		t.Fatalf("unexpected employee amounts %#v", amina)
+	}
+	if amina.Contributions[0].EmployeeAmount != money.FromUnits(150) || amina.Contributions[0].EmployerAmount != money.FromUnits(300) {
+		t.Fatalf("unexpected contributions %#v", amina.Contributions)
+	}
+	if summary.GrossPay != money.FromUnits(3200) || summary.TaxTotal != money.FromUnits(330) {
+		t.Fatalf("unexpected summary totals %#v", summary)
+	}
+
+	calendar, err := service.GetAnnualTaxSummary(context.Background(), 2025, 0)
+	if err != nil {
+		t.Fatalf("expected calendar summary, got %v", err)
+	}
+	if calendar.Label != "2025" || calendar.FromMonth != "2025-01" || len(calendar.Employees) != 1 || calendar.Employees[0].Months != 3 {
+		t.Fatalf("unexpected calendar summary %#v", calendar)
+	}
+}
+
+func TestLockPayrollBatchSealsIntegrityAndVerifyReportsDrift(t *testing.T) {
+	repo := &fakeRepository{
+		batches: map[int64]*PayrollBatch{
+			1: {ID: 1, Month: "2026-04", BatchType: BatchTypeRegular, Status: StatusApproved},
+			2: {ID: 2, Month: "2026-03", BatchType: BatchTypeRegular, Status: StatusLocked},
+		},
+		entriesByBatch: map[int64][]PayrollEntry{
+			1: {
+				{ID: 10, BatchID: 1, EmployeeID: 7, EmployeeName: "Amina Okello", BaseSalary: money.FromUnits(1000), GrossPay: money.FromUnits(1000), NetPay: money.FromUnits(900), TaxTotal: money.FromUnits(100)},
+				{ID: 11, BatchID: 1, EmployeeID: 8, EmployeeName: "Brian Mwangi", BaseSalary: money.FromUnits(800), GrossPay: money.FromUnits(800), NetPay: money.FromUnits(800)},
+			},
+		},
+		entryToBatch: map[int64]int64{10: 1, 11: 1},
+		linesByEntry: map[int64][]PayrollEntryLine{
+			10: {{ID: 100, EntryID: 10, Code: "PAYE", Name: "PAYE", Kind: ComponentKindDeduction, Amount: money.FromUnits(100)}},
+		},
+	}
+	audit := &captureAuditRecorder{}
+	service := NewService(repo)
+	service.SetAuditRecorder(audit)
+
+	locked, err := service.LockPayrollBatch(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("expected lock, got %v", err)
+	}
+	if locked.IntegrityHash == nil || len(*locked.IntegrityHash) != 64 {
+		t.Fatalf("expected a sha-256 integrity hash, got %#v", locked.IntegrityHash)
+	}
+
+	report, err := service.VerifyPayrollBatchIntegrity(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("expected verification, got %v", err)
+	}
+	if report.Status != IntegrityVerified || report.ComputedHash != *locked.IntegrityHash || len(report.Drift) != 0 {
+		t.Fatalf("expected verified batch, got %#v", report)
+	}
+
+	// Renaming an employee does not break the seal; changing an amount does.
+	repo.entriesByBatch[1][1].EmployeeName = "Brian M. Mwangi"
+	repo.linesByEntry[10][0].Amount = money.FromUnits(10)
+	report, err = service.VerifyPayrollBatchIntegrity(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("expected verification, got %v", err)
+	}
+	if report.Status != IntegrityDrift || report.HeaderDrift || len(report.DriftedEntryIDs) != 1 || report.DriftedEntryIDs[0] != 10 {
+		t.Fatalf("expected drift on entry 10, got %#v", report)
+	}
+	if len(report.Drift) != 1 || report.Drift[0] != "entry 10 (employee 7) changed" {
+		t.Fatalf("unexpected drift %v", report.Drift)
+	}
+
+	export, err := service.ExportPayrollBatchCSV(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("expected export, got %v", err)
+	}
+	rows := strings.Split(strings.TrimSpace(export.Data), "\n")
+	if !strings.HasSuffix(rows[0], ",Integrity") || !strings.HasSuffix(rows[1], ",Drift") || !strings.HasSuffix(rows[2], ",Verified") {
+		t.Fatalf("unexpected integrity column:\n%s", export.Data)
+	}
+
+	// Header changes and removed entries taint the whole batch.
+	repo.batches[1].Description = "edited"
+	repo.entriesByBatch[1] = repo.entriesByBatch[1][:1]
+	report, err = service.VerifyPayrollBatchIntegrity(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("expected verification, got %v", err)
+	}
+	if !report.HeaderDrift || strings.Join(report.Drift, "; ") != "batch header changed; entry 10 (employee 7) changed; entry 11 was removed" {
+		t.Fatalf("unexpected drift %#v", report)
+	}
+
+	statuses, err := service.PayrollBatchIntegrityStatuses(context.Background(), []int64{1, 2})
+	if err != nil {
+		t.Fatalf("expected statuses, got %v", err)
+	}
+	if statuses[1] != IntegrityDrift || statuses[2] != IntegrityUnsealed {
+		t.Fatalf("unexpected statuses %v", statuses)
+	}
+	if len(audit.actions) != 4 || audit.actions[0] != "payroll.batch.lock" || audit.actions[3] != "payroll.batch.verify_integrity" {
+		t.Fatalf("unexpected audit actions %v", audit.actions)
+	}
+}
+
+func TestLockPayrollBatchSealsEntriesReadInsideTheLock(t *testing.T) {
+	repo := &fakeRepository{
+		batches: map[int64]*PayrollBatch{
+			1: {ID: 1, Month: "2026-04", BatchType: BatchTypeRegular, Status: StatusApproved},
+		},
+		entriesByBatch: map[int64][]PayrollEntry{
+			1: {{ID: 10, BatchID: 1, EmployeeID: 7, EmployeeName: "Amina Okello", BaseSalary: money.FromUnits(1000), GrossPay: money.FromUnits(1000), NetPay: money.FromUnits(900), TaxTotal: money.FromUnits(100)}},
+		},
+		entryToBatch: map[int64]int64{10: 1},
+		linesByEntry: map[int64][]PayrollEntryLine{
+			10: {{ID: 100, EntryID: 10, Code: "PAYE", Name: "PAYE", Kind: ComponentKindDeduction, Amount: money.FromUnits(100)}},
+		},
+	}
+	// The line changes after the service has loaded the batch but before the lock commits.
+	repo.beforeTx = func() {
+		repo.linesByEntry[10][0].Amount = money.FromUnits(120)
+		repo.beforeTx = nil
+	}
+	service := NewService(repo)
+
+	if _, err := service.LockPayrollBatch(context.Background(), 1); err != nil {
+		t.Fatalf("expected lock, got %v", err)
+	}
+	report, err := service.VerifyPayrollBatchIntegrity(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("expected verification, got %v", err)
+	}
+	if report.Status != IntegrityVerified || len(report.Drift) != 0 {
+		t.Fatalf("expected the seal to cover the locked amounts, got %#v", report)
+	}
+}
+
+func TestSimulatePayrollAppliesOverridesWithoutWriting(t *testing.T) {
+	finance := int64(10)
+	repo := &fakeRepository{
+		batches: map[int64]*PayrollBatch{1: {ID: 1, Month: "2025-07", Status: StatusLocked}},
+		entriesByBatch: map[int64][]PayrollEntry{
+			1: {
+				{ID: 11, BatchID: 1, EmployeeID: 101, EmployeeName: "A", BaseSalary: money.FromUnits(1000000), AllowancesTotal: money.FromUnits(200000), DeductionsTotal: money.FromUnits(60000), GrossPay: money.FromUnits(1200000), NetPay: money.FromUnits(1140000), EmployerContributionsTotal: money.FromUnits(120000)},
+				{ID: 12, BatchID: 1, EmployeeID: 102, EmployeeName: "B", BaseSalary: money.FromUnits(800000), AllowancesTotal: money.FromUnits(200000), DeductionsTotal: money.FromUnits(50000), GrossPay: money.FromUnits(1000000), NetPay: money.FromUnits(950000), EmployerContributionsTotal: money.FromUnits(100000)},
+			},
+		},
+		entryToBatch: map[int64]int64{11: 1, 12: 1},
+		components: map[int64]*PayComponent{
+			1: {ID: 1, Code: "HOUSING", Name: "Housing Allowance", Kind: ComponentKindEarning, Taxable: true, CalculationType: CalculationFixed, Value: 200000, AutoApply: true, Active: true},
+		},
+		schemes: map[int64]*ContributionScheme{
+			1: {ID: 1, Code: "NSSF", Name: "National Social Security Fund", EmployeeRate: 5, EmployerRate: 10, Base: ContributionBaseGross, Active: true},
+		},
+		activeEmployees: []EmployeeSalary{
+			{EmployeeID: 101, EmployeeName: "A", BaseSalary: money.FromUnits(1000000), DepartmentID: &finance},
+			{EmployeeID: 102, EmployeeName: "B", BaseSalary: money.FromUnits(800000)},
+		},
+	}
+	service := NewService(repo)
+	audit := &captureAuditRecorder{}
+	service.SetAuditRecorder(audit)
+
+	simulation, err := service.SimulatePayroll(context.Background(), PayrollSimulationInput{
+		CompareBatchID:    1,
+		SalaryAdjustments: []SimulationSalaryAdjustment{{DepartmentID: &finance, Percent: 10}},
+		SchemeOverrides:   []SimulationSchemeOverride{{SchemeID: 1, EmployeeRate: 6, EmployerRate: 10}},
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if simulation.Month != "2025-07" || simulation.AdjustedEmployees != 1 || len(simulation.Entries) != 2 {
+		t.Fatalf("unexpected simulation %#v", simulation)
+	}
+	// A: base 1,100,000 + housing 200,000, NSSF 6% of 1,300,000. B keeps the base but pays 6% NSSF.
+	raised := simulation.Entries[0]
+	if raised.BaseSalary != money.FromUnits(1100000) || raised.DeductionsTotal != money.FromUnits(78000) || raised.NetPay != money.FromUnits(1222000) {
+		t.Fatalf("unexpected raised entry %#v", raised)
+	}
+	if other := simulation.Entries[1]; other.BaseSalary != money.FromUnits(800000) || other.NetPay != money.FromUnits(940000) {
+		t.Fatalf("unexpected unadjusted entry %#v", other)
+	}
+
+	comparison := simulation.Comparison
+	if comparison.PreviousBatchID == nil || *comparison.PreviousBatchID != 1 || comparison.Changed != 2 {
+		t.Fatalf("expected both employees to change against batch 1, got %#v", comparison)
+	}
+	if comparison.Totals.NetPay.Previous != money.FromUnits(2090000) || comparison.Totals.NetPay.Current != money.FromUnits(2162000) {
+		t.Fatalf("unexpected net pay totals %#v", comparison.Totals.NetPay)
+	}
+	if simulation.GrossPay.Change != money.FromUnits(100000) || simulation.EmployerContributions.Change != money.FromUnits(10000) {
+		t.Fatalf("unexpected gross %#v or employer contributions %#v", simulation.GrossPay, simulation.EmployerContributions)
+	}
+
+	if len(repo.entriesByBatch) != 1 || len(repo.entriesByBatch[1]) != 2 || repo.activeEmployees[0].BaseSalary != money.FromUnits(1000000) || repo.schemes[1].EmployeeRate != 5 {
+		t.Fatal("expected the simulation to leave payroll data untouched")
+	}
+	if len(audit.actions) != 1 || audit.actions[0] != "payroll.simulation.run" {
+		t.Fatalf("unexpected audit actions %v", audit.actions)
+	}
+
+	_, err = service.SimulatePayroll(context.Background(), PayrollSimulationInput{Month: "2025-07", ComponentOverrides: []SimulationComponentOverride{{ComponentID: 9, Value: 1000}}})
+	if !errors.Is(err, ErrValidation) {
+		t.Fatalf("expected validation error for a component outside the run, got %v", err)
 	}
 }
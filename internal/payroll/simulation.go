@@ -0,0 +1,300 @@
+package payroll
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// errSimulationRollback ends the read-only transaction of a simulation so nothing it touched is committed.
+var errSimulationRollback = errors.New("roll back payroll simulation")
+
+// SimulatePayroll runs the regular generation pipeline for a month in memory with salary adjustments and
+// component, contribution or tax overrides applied, and compares the result with a real batch. No batch,
+// entry or line is written.
+func (s *Service) SimulatePayroll(ctx context.Context, input PayrollSimulationInput) (*PayrollSimulation, error) {
+	input.Month = strings.TrimSpace(input.Month)
+	if input.CompareBatchID < 0 {
+		return nil, fmt.Errorf("%w: compare batch id must be positive", ErrValidation)
+	}
+	if input.Month == "" && input.CompareBatchID == 0 {
+		return nil, fmt.Errorf("%w: month or compare batch is required", ErrValidation)
+	}
+	if input.Month != "" && !payrollMonthPattern.MatchString(input.Month) {
+		return nil, fmt.Errorf("%w: month must be in YYYY-MM format", ErrValidation)
+	}
+	adjustments, err := normalizeSalaryAdjustments(input.SalaryAdjustments)
+	if err != nil {
+		return nil, err
+	}
+	for _, override := range input.ComponentOverrides {
+		if override.Value < 0 {
+			return nil, fmt.Errorf("%w: component override value must be non-negative", ErrValidation)
+		}
+	}
+	for _, override := range input.SchemeOverrides {
+		if override.EmployeeRate < 0 || override.EmployeeRate > 100 || override.EmployerRate < 0 || override.EmployerRate > 100 {
+			return nil, fmt.Errorf("%w: contribution rates must be between 0 and 100", ErrValidation)
+		}
+	}
+
+	var compare *PayrollBatch
+	if input.CompareBatchID > 0 {
+		compare, err = s.repository.GetBatchByID(ctx, input.CompareBatchID)
+		if err != nil {
+			return nil, err
+		}
+		if compare == nil {
+			return nil, ErrNotFound
+		}
+		if input.Month == "" {
+			input.Month = compare.Month
+		}
+	}
+	regular, err := s.repository.GetRegularBatchByMonth(ctx, input.Month)
+	if err != nil {
+		return nil, err
+	}
+	if compare == nil {
+		compare = regular
+	}
+
+	taxTable, err := s.resolveTaxTable(ctx, input.Month)
+	if err != nil {
+		return nil, err
+	}
+	if len(input.TaxBands) > 0 {
+		tables, err := NormalizeTaxTables([]TaxTable{{Name: "Simulated PAYE", EffectiveFrom: input.Month, Bands: input.TaxBands}})
+		if err != nil {
+			return nil, err
+		}
+		taxTable = &tables[0]
+	}
+	prorationBasis, err := s.resolveProrationBasis(ctx)
+	if err != nil {
+		return nil, err
+	}
+	decimals, err := s.calculationDecimals(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// The simulation stands in for the regular batch of the month, so only other batches count as already paid.
+	current := PayrollBatch{Month: input.Month, BatchType: BatchTypeRegular}
+	if regular != nil {
+		current.ID = regular.ID
+	}
+	monthToDate, err := s.monthToDate(ctx, current)
+	if err != nil {
+		return nil, err
+	}
+	periodStart, periodEnd, err := MonthBounds(input.Month)
+	if err != nil {
+		return nil, err
+	}
+	calendar, err := s.workCalendar(ctx, periodStart, periodEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	simulation := &PayrollSimulation{
+		Month:          input.Month,
+		ProrationBasis: prorationBasis,
+		Entries:        make([]PayrollEntry, 0),
+	}
+	if taxTable != nil {
+		simulation.TaxTableName = taxTable.Name
+	}
+	err = s.repository.WithTx(ctx, func(tx TxRepository) error {
+		data, err := loadRegularGenerationData(ctx, tx, input.Month, periodStart, periodEnd, calendar)
+		if err != nil {
+			return err
+		}
+		if err := applyComponentOverrides(data, input.ComponentOverrides); err != nil {
+			return err
+		}
+		if err := applySchemeOverrides(data, input.SchemeOverrides); err != nil {
+			return err
+		}
+		simulation.AdjustedEmployees = applySalaryAdjustments(data, adjustments)
+
+		for _, employee := range data.employees {
+			generated, err := computeRegularEntry(input.Month, prorationBasis, data, employee, taxTable, monthToDate[employee.EmployeeID], decimals)
+			if err != nil {
+				return err
+			}
+			if generated == nil {
+				continue
+			}
+			simulation.Entries = append(simulation.Entries, simulatedEntry(employee, *generated))
+		}
+		return errSimulationRollback
+	})
+	if err != nil && !errors.Is(err, errSimulationRollback) {
+		return nil, err
+	}
+
+	var compared []PayrollEntry
+	if compare != nil {
+		compared, err = s.repository.ListEntriesByBatchID(ctx, compare.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	policy, err := s.resolveVariancePolicy(ctx)
+	if err != nil {
+		return nil, err
+	}
+	simulation.Comparison = BuildVarianceReport(current, compare, simulation.Entries, compared, policy.ThresholdPercent)
+	simulation.Comparison.BatchID = 0
+
+	var simulatedTotal, comparedTotal PayrollEntry
+	for _, entry := range simulation.Entries {
+		simulatedTotal.GrossPay += entry.GrossPay
+		simulatedTotal.EmployerContributionsTotal += entry.EmployerContributionsTotal
+	}
+	for _, entry := range compared {
+		comparedTotal.GrossPay += entry.GrossPay
+		comparedTotal.EmployerContributionsTotal += entry.EmployerContributionsTotal
+	}
+	simulation.GrossPay = newVarianceFigure(comparedTotal.GrossPay, simulatedTotal.GrossPay, policy.ThresholdPercent)
+	simulation.EmployerContributions = newVarianceFigure(comparedTotal.EmployerContributionsTotal, simulatedTotal.EmployerContributionsTotal, policy.ThresholdPercent)
+
+	metadata := map[string]any{
+		"month":               input.Month,
+		"salary_adjustments":  len(adjustments),
+		"component_overrides": len(input.ComponentOverrides),
+		"scheme_overrides":    len(input.SchemeOverrides),
+		"tax_override":        len(input.TaxBands) > 0,
+		"entries_simulated":   len(simulation.Entries),
+	}
+	if compare != nil {
+		metadata["compare_batch_id"] = compare.ID
+	}
+	s.audit.RecordAuditEvent(ctx, nil, "payroll.simulation.run", nil, nil, metadata)
+	return simulation, nil
+}
+
+// normalizeSalaryAdjustments rejects out-of-range percentages and a department adjusted twice. A 0% department
+// adjustment is kept so it can exempt the department from a company-wide one.
+func normalizeSalaryAdjustments(adjustments []SimulationSalaryAdjustment) ([]SimulationSalaryAdjustment, error) {
+	seen := make(map[int64]struct{}, len(adjustments))
+	result := make([]SimulationSalaryAdjustment, 0, len(adjustments))
+	for _, adjustment := range adjustments {
+		if adjustment.Percent < -100 || adjustment.Percent > 100 {
+			return nil, fmt.Errorf("%w: salary adjustment must be between -100 and 100 percent", ErrValidation)
+		}
+		key := int64(0)
+		if adjustment.DepartmentID != nil {
+			if *adjustment.DepartmentID <= 0 {
+				return nil, fmt.Errorf("%w: department id must be positive", ErrValidation)
+			}
+			key = *adjustment.DepartmentID
+		}
+		if _, ok := seen[key]; ok {
+			return nil, fmt.Errorf("%w: only one salary adjustment per department is allowed", ErrValidation)
+		}
+		seen[key] = struct{}{}
+		result = append(result, adjustment)
+	}
+	return result, nil
+}
+
+// applySalaryAdjustments scales the base salary and salary history of every employee matched by an
+// adjustment. A department adjustment wins over the company-wide one. It returns the employees adjusted.
+func applySalaryAdjustments(data *regularGenerationData, adjustments []SimulationSalaryAdjustment) int {
+	if len(adjustments) == 0 {
+		return 0
+	}
+	byDepartment := make(map[int64]float64, len(adjustments))
+	companyWide, hasCompanyWide := 0.0, false
+	for _, adjustment := range adjustments {
+		if adjustment.DepartmentID == nil {
+			companyWide, hasCompanyWide = adjustment.Percent, true
+			continue
+		}
+		byDepartment[*adjustment.DepartmentID] = adjustment.Percent
+	}
+
+	adjusted := 0
+	for i, employee := range data.employees {
+		percent, ok := companyWide, hasCompanyWide
+		if employee.DepartmentID != nil {
+			if departmentPercent, found := byDepartment[*employee.DepartmentID]; found {
+				percent, ok = departmentPercent, true
+			}
+		}
+		if !ok || percent == 0 {
+			continue
+		}
+		data.employees[i].BaseSalary += employee.BaseSalary.Percent(percent)
+		if rates := data.rates[employee.EmployeeID]; len(rates) > 0 {
+			scaled := make([]SalaryRate, len(rates))
+			for j, rate := range rates {
+				rate.Amount += rate.Amount.Percent(percent)
+				scaled[j] = rate
+			}
+			data.rates[employee.EmployeeID] = scaled
+		}
+		adjusted++
+	}
+	return adjusted
+}
+
+func applyComponentOverrides(data *regularGenerationData, overrides []SimulationComponentOverride) error {
+	for _, override := range overrides {
+		found := false
+		for i := range data.components {
+			if data.components[i].ID != override.ComponentID {
+				continue
+			}
+			if data.components[i].CalculationType == CalculationPercentage && override.Value > 100 {
+				return fmt.Errorf("%w: percentage of component %s must be at most 100", ErrValidation, data.components[i].Code)
+			}
+			data.components[i].Value = override.Value
+			found = true
+		}
+		if !found {
+			return fmt.Errorf("%w: component %d is not an active auto-apply component", ErrValidation, override.ComponentID)
+		}
+	}
+	return nil
+}
+
+func applySchemeOverrides(data *regularGenerationData, overrides []SimulationSchemeOverride) error {
+	for _, override := range overrides {
+		found := false
+		for i := range data.schemes {
+			if data.schemes[i].ID != override.SchemeID {
+				continue
+			}
+			data.schemes[i].EmployeeRate = override.EmployeeRate
+			data.schemes[i].EmployerRate = override.EmployerRate
+			found = true
+		}
+		if !found {
+			return fmt.Errorf("%w: contribution scheme %d is not active", ErrValidation, override.SchemeID)
+		}
+	}
+	return nil
+}
+
+func simulatedEntry(employee EmployeeSalary, generated generatedEntry) PayrollEntry {
+	input := generated.input
+	return PayrollEntry{
+		EmployeeID:                 employee.EmployeeID,
+		EmployeeName:               employee.EmployeeName,
+		BaseSalary:                 input.BaseSalary,
+		AllowancesTotal:            input.AllowancesTotal,
+		DeductionsTotal:            input.DeductionsTotal,
+		TaxTotal:                   input.TaxTotal,
+		GrossPay:                   input.GrossPay,
+		NetPay:                     input.NetPay,
+		EmployerContributionsTotal: input.EmployerContributionsTotal,
+		FullBaseSalary:             input.FullBaseSalary,
+		ProrationBasis:             input.ProrationBasis,
+		PayableDays:                input.PayableDays,
+		PeriodDays:                 input.PeriodDays,
+		Lines:                      generated.lines,
+	}
+}
@@ -0,0 +1,132 @@
+package payroll
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"hrpro/internal/money"
+)
+
+// DefaultTaxTableEffectiveFrom is the month the built-in Uganda PAYE table applies from.
+const DefaultTaxTableEffectiveFrom = "2000-01"
+
+// TaxBand taxes the part of monthly taxable pay above Threshold, up to the next band's threshold, at Rate percent.
+type TaxBand struct {
+	Threshold money.Amount `json:"threshold"`
+	Rate      float64      `json:"rate"`
+}
+
+// TaxTable is a set of progressive monthly bands that applies to payroll months from EffectiveFrom (YYYY-MM) onwards.
+type TaxTable struct {
+	Name          string    `json:"name"`
+	EffectiveFrom string    `json:"effectiveFrom"`
+	Bands         []TaxBand `json:"bands"`
+}
+
+// DefaultTaxTables returns the statutory Uganda resident PAYE monthly bands.
+func DefaultTaxTables() []TaxTable {
+	return []TaxTable{
+		{
+			Name:          "Uganda PAYE (resident)",
+			EffectiveFrom: DefaultTaxTableEffectiveFrom,
+			Bands: []TaxBand{
+				{Threshold: 0, Rate: 0},
+				{Threshold: money.FromUnits(235000), Rate: 10},
+				{Threshold: money.FromUnits(335000), Rate: 20},
+				{Threshold: money.FromUnits(410000), Rate: 30},
+				{Threshold: money.FromUnits(10000000), Rate: 40},
+			},
+		},
+	}
+}
+
+// NormalizeTaxTables validates tax tables and returns them ordered by effective month.
+func NormalizeTaxTables(tables []TaxTable) ([]TaxTable, error) {
+	if len(tables) == 0 {
+		return nil, fmt.Errorf("%w: at least one tax table is required", ErrValidation)
+	}
+
+	result := make([]TaxTable, 0, len(tables))
+	seen := make(map[string]struct{}, len(tables))
+	for _, table := range tables {
+		table.Name = strings.TrimSpace(table.Name)
+		table.EffectiveFrom = strings.TrimSpace(table.EffectiveFrom)
+		if table.Name == "" {
+			return nil, fmt.Errorf("%w: tax table name is required", ErrValidation)
+		}
+		if !payrollMonthPattern.MatchString(table.EffectiveFrom) {
+			return nil, fmt.Errorf("%w: tax table effective month must be YYYY-MM", ErrValidation)
+		}
+		if _, ok := seen[table.EffectiveFrom]; ok {
+			return nil, fmt.Errorf("%w: more than one tax table is effective from %s", ErrValidation, table.EffectiveFrom)
+		}
+		seen[table.EffectiveFrom] = struct{}{}
+
+		if len(table.Bands) == 0 {
+			return nil, fmt.Errorf("%w: tax table %s has no bands", ErrValidation, table.EffectiveFrom)
+		}
+		bands := append([]TaxBand(nil), table.Bands...)
+		sort.SliceStable(bands, func(i, j int) bool { return bands[i].Threshold < bands[j].Threshold })
+		if bands[0].Threshold != 0 {
+			return nil, fmt.Errorf("%w: first tax band must start at 0", ErrValidation)
+		}
+		for i, band := range bands {
+			if band.Rate < 0 || band.Rate > 100 {
+				return nil, fmt.Errorf("%w: tax band rate must be between 0 and 100", ErrValidation)
+			}
+			if i > 0 && band.Threshold == bands[i-1].Threshold {
+				return nil, fmt.Errorf("%w: tax band thresholds must be unique", ErrValidation)
+			}
+		}
+		table.Bands = bands
+		result = append(result, table)
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i].EffectiveFrom < result[j].EffectiveFrom })
+	return result, nil
+}
+
+// SelectTaxTable returns the latest table effective on or before month, or nil when none applies.
+func SelectTaxTable(tables []TaxTable, month string) *TaxTable {
+	var selected *TaxTable
+	for i := range tables {
+		if tables[i].EffectiveFrom > month {
+			continue
+		}
+		if selected == nil || tables[i].EffectiveFrom > selected.EffectiveFrom {
+			selected = &tables[i]
+		}
+	}
+	return selected
+}
+
+// CalculateTaxablePay is the earned base salary plus earnings, less any earning lines flagged as non-taxable.
+func CalculateTaxablePay(baseSalary, allowancesTotal money.Amount, lines []PayrollEntryLine) money.Amount {
+	taxablePay := EarnedBaseSalary(baseSalary, lines) + allowancesTotal
+	for _, line := range lines {
+		if line.Kind == ComponentKindEarning && !line.Taxable {
+			taxablePay -= line.Amount
+		}
+	}
+	if taxablePay < 0 {
+		return 0
+	}
+	return taxablePay
+}
+
+// CalculatePAYE applies the progressive bands of table to monthly taxable pay.
+func CalculatePAYE(table TaxTable, taxablePay money.Amount) money.Amount {
+	var tax money.Amount
+	for i, band := range table.Bands {
+		if taxablePay <= band.Threshold {
+			break
+		}
+		upper := taxablePay
+		if i+1 < len(table.Bands) && table.Bands[i+1].Threshold < upper {
+			upper = table.Bands[i+1].Threshold
+		}
+		tax += (upper - band.Threshold).Percent(band.Rate)
+	}
+	return tax
+}
@@ -0,0 +1,86 @@
+package payroll
+
+import (
+	"errors"
+	"testing"
+
+	"hrpro/internal/money"
+)
+
+func TestCalculatePAYEBandBoundaries(t *testing.T) {
+	table := DefaultTaxTables()[0]
+
+	cases := []struct {
+		taxablePay int64
+		expected   int64
+	}{
+		{taxablePay: 0, expected: 0},
+		{taxablePay: 235000, expected: 0},
+		{taxablePay: 235010, expected: 1},
+		{taxablePay: 335000, expected: 10000},
+		{taxablePay: 335010, expected: 10002},
+		{taxablePay: 410000, expected: 25000},
+		{taxablePay: 410010, expected: 25003},
+		{taxablePay: 1000000, expected: 202000},
+		{taxablePay: 10000000, expected: 2902000},
+		{taxablePay: 10000010, expected: 2902004},
+	}
+
+	for _, tc := range cases {
+		if tax := CalculatePAYE(table, money.FromUnits(tc.taxablePay)); tax != money.FromUnits(tc.expected) {
+			t.Fatalf("expected PAYE %d for %d, got %s", tc.expected, tc.taxablePay, tax)
+		}
+	}
+}
+
+func TestCalculateTaxablePayExcludesNonTaxableEarnings(t *testing.T) {
+	lines := []PayrollEntryLine{
+		{Code: "HOUSING", Kind: ComponentKindEarning, Taxable: true, Amount: money.FromUnits(300000)},
+		{Code: "PERDIEM", Kind: ComponentKindEarning, Taxable: false, Amount: money.FromUnits(100000)},
+		{Code: "SACCO", Kind: ComponentKindDeduction, Amount: money.FromUnits(50000)},
+	}
+
+	if taxable := CalculateTaxablePay(money.FromUnits(1000000), money.FromUnits(400000), lines); taxable != money.FromUnits(1300000) {
+		t.Fatalf("expected taxable pay 1300000, got %s", taxable)
+	}
+}
+
+func TestSelectTaxTableUsesLatestEffectiveTable(t *testing.T) {
+	tables := []TaxTable{
+		{Name: "old", EffectiveFrom: "2020-07"},
+		{Name: "new", EffectiveFrom: "2025-07"},
+	}
+
+	if table := SelectTaxTable(tables, "2019-12"); table != nil {
+		t.Fatalf("expected no table before first effective month, got %q", table.Name)
+	}
+	if table := SelectTaxTable(tables, "2025-06"); table == nil || table.Name != "old" {
+		t.Fatalf("expected old table for 2025-06, got %+v", table)
+	}
+	if table := SelectTaxTable(tables, "2025-07"); table == nil || table.Name != "new" {
+		t.Fatalf("expected new table for 2025-07, got %+v", table)
+	}
+}
+
+func TestNormalizeTaxTablesValidatesBands(t *testing.T) {
+	_, err := NormalizeTaxTables([]TaxTable{{
+		Name:          "broken",
+		EffectiveFrom: "2025-07",
+		Bands:         []TaxBand{{Threshold: money.FromUnits(100000), Rate: 10}},
+	}})
+	if !errors.Is(err, ErrValidation) {
+		t.Fatalf("expected validation error for band not starting at 0, got %v", err)
+	}
+
+	tables, err := NormalizeTaxTables([]TaxTable{{
+		Name:          " Uganda ",
+		EffectiveFrom: "2025-07",
+		Bands:         []TaxBand{{Threshold: money.FromUnits(335000), Rate: 20}, {Threshold: 0, Rate: 0}, {Threshold: money.FromUnits(235000), Rate: 10}},
+	}})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if tables[0].Name != "Uganda" || tables[0].Bands[1].Threshold != money.FromUnits(235000) {
+		t.Fatalf("expected trimmed name and sorted bands, got %+v", tables[0])
+	}
+}
@@ -1,6 +1,10 @@
 package payroll
 
-import "time"
+import (
+	"time"
+
+	"hrpro/internal/money"
+)
 
 const (
 	StatusDraft    = "Draft"
@@ -8,37 +12,320 @@ const (
 	StatusLocked   = "Locked"
 )
 
+// Regular batches pay the monthly salary run; the other types are off-cycle runs for selected employees and
+// components, and any number of them can exist for a month.
+const (
+	BatchTypeRegular    = "regular"
+	BatchTypeBonus      = "bonus"
+	BatchTypeArrears    = "arrears"
+	BatchTypeCorrection = "correction"
+)
+
+// Integrity statuses reported by VerifyPayrollBatchIntegrity.
+const (
+	IntegrityVerified  = "Verified"
+	IntegrityDrift     = "Drift"
+	IntegrityUnsealed  = "Unsealed"
+	IntegrityNotLocked = "Not Locked"
+)
+
+const (
+	ComponentKindEarning   = "earning"
+	ComponentKindDeduction = "deduction"
+)
+
+const (
+	CalculationFixed      = "fixed"
+	CalculationPercentage = "percentage"
+)
+
+const (
+	ContributionBaseGross  = "gross"
+	ContributionBaseSalary = "base_salary"
+)
+
+const (
+	ProrationWorkingDays  = "working_days"
+	ProrationCalendarDays = "calendar_days"
+)
+
+// Deduction lines for unpaid days carry these codes and the leave/attendance records they came from.
+const (
+	LineCodeUnpaidLeave = "UNPAID_LEAVE"
+	LineCodeAbsence     = "ABSENCE"
+
+	LineSourceLeaveRequest     = "leave_request"
+	LineSourceAttendanceRecord = "attendance_record"
+)
+
+const (
+	LoanTypeLoan    = "loan"
+	LoanTypeAdvance = "advance"
+
+	LoanStatusActive    = "active"
+	LoanStatusSettled   = "settled"
+	LoanStatusCancelled = "cancelled"
+
+	LineCodeLoan    = "LOAN"
+	LineCodeAdvance = "ADVANCE"
+)
+
+// Arrears batches pay back-dated salary increases on lines with this code, one per month owed.
+const LineCodeArrears = "ARREARS"
+
+// An amounts import books changed allowances or deductions of an itemized entry on a line with this code, one
+// per kind. The earning line is taxable.
+const LineCodeImportAdjustment = "IMPORT_ADJ"
+
+const (
+	VarianceJoiner    = "joiner"
+	VarianceLeaver    = "leaver"
+	VarianceChanged   = "changed"
+	VarianceUnchanged = "unchanged"
+
+	DefaultVarianceThresholdPercent = 10.0
+)
+
+// Each step of the approval chain is signed off with an approved record; a rejected or reopened record sends
+// the batch back to Draft and restarts the chain.
+const (
+	ApprovalActionApproved = "approved"
+	ApprovalActionRejected = "rejected"
+	ApprovalActionReopened = "reopened"
+)
+
+// GL account mapping categories. A journal posting uses the most specific mapping of its category, falling
+// back from earning and employer contribution expense to salary expense and from loan receivable to deduction.
+const (
+	GLCategorySalaryExpense               = "salary_expense"
+	GLCategoryEarning                     = "earning"
+	GLCategoryEmployerContributionExpense = "employer_contribution_expense"
+	GLCategoryTaxPayable                  = "tax_payable"
+	GLCategoryContributionPayable         = "contribution_payable"
+	GLCategoryDeduction                   = "deduction"
+	GLCategoryLoanReceivable              = "loan_receivable"
+	GLCategoryNetPayClearing              = "net_pay_clearing"
+)
+
+const (
+	JournalFormatCSV    = "csv"
+	JournalFormatImport = "import"
+)
+
+const (
+	PaymentMethodBank        = "bank"
+	PaymentMethodMobileMoney = "mobile_money"
+)
+
 type PayrollBatch struct {
-	ID         int64      `db:"id" json:"id"`
-	Month      string     `db:"month" json:"month"`
-	Status     string     `db:"status" json:"status"`
-	CreatedBy  int64      `db:"created_by" json:"createdBy"`
-	CreatedAt  time.Time  `db:"created_at" json:"createdAt"`
-	ApprovedBy *int64     `db:"approved_by" json:"approvedBy,omitempty"`
-	ApprovedAt *time.Time `db:"approved_at" json:"approvedAt,omitempty"`
-	LockedAt   *time.Time `db:"locked_at" json:"lockedAt,omitempty"`
+	ID          int64      `db:"id" json:"id"`
+	Month       string     `db:"month" json:"month"`
+	BatchType   string     `db:"batch_type" json:"batchType"`
+	Description string     `db:"description" json:"description"`
+	Status      string     `db:"status" json:"status"`
+	CreatedBy   int64      `db:"created_by" json:"createdBy"`
+	CreatedAt   time.Time  `db:"created_at" json:"createdAt"`
+	ApprovedBy  *int64     `db:"approved_by" json:"approvedBy,omitempty"`
+	ApprovedAt  *time.Time `db:"approved_at" json:"approvedAt,omitempty"`
+	LockedAt    *time.Time `db:"locked_at" json:"lockedAt,omitempty"`
+
+	VarianceReviewedBy *int64     `db:"variance_reviewed_by" json:"varianceReviewedBy,omitempty"`
+	VarianceReviewedAt *time.Time `db:"variance_reviewed_at" json:"varianceReviewedAt,omitempty"`
+
+	// ReversesBatchID is set on a correction batch that offsets a locked batch.
+	ReversesBatchID *int64 `db:"reverses_batch_id" json:"reversesBatchId,omitempty"`
+
+	// IntegrityHash seals the header and entries of a locked batch; see VerifyPayrollBatchIntegrity.
+	IntegrityHash *string `db:"integrity_hash" json:"integrityHash,omitempty"`
 }
 
 type PayrollEntry struct {
+	ID              int64        `db:"id" json:"id"`
+	BatchID         int64        `db:"batch_id" json:"batchId"`
+	EmployeeID      int64        `db:"employee_id" json:"employeeId"`
+	EmployeeName    string       `db:"employee_name" json:"employeeName"`
+	BaseSalary      money.Amount `db:"base_salary" json:"baseSalary"`
+	AllowancesTotal money.Amount `db:"allowances_total" json:"allowancesTotal"`
+	DeductionsTotal money.Amount `db:"deductions_total" json:"deductionsTotal"`
+	TaxTotal        money.Amount `db:"tax_total" json:"taxTotal"`
+	GrossPay        money.Amount `db:"gross_pay" json:"grossPay"`
+	NetPay          money.Amount `db:"net_pay" json:"netPay"`
+	CreatedAt       time.Time    `db:"created_at" json:"createdAt"`
+	UpdatedAt       time.Time    `db:"updated_at" json:"updatedAt"`
+
+	EmployerContributionsTotal money.Amount `db:"employer_contributions_total" json:"employerContributionsTotal"`
+
+	// Proration is only recorded when the employee was not employed for the whole month.
+	FullBaseSalary *money.Amount `db:"full_base_salary" json:"fullBaseSalary,omitempty"`
+	ProrationBasis *string       `db:"proration_basis" json:"prorationBasis,omitempty"`
+	PayableDays    *int          `db:"payable_days" json:"payableDays,omitempty"`
+	PeriodDays     *int          `db:"period_days" json:"periodDays,omitempty"`
+
+	Lines []PayrollEntryLine `db:"-" json:"lines"`
+}
+
+type PayComponent struct {
 	ID              int64     `db:"id" json:"id"`
-	BatchID         int64     `db:"batch_id" json:"batchId"`
-	EmployeeID      int64     `db:"employee_id" json:"employeeId"`
-	EmployeeName    string    `db:"employee_name" json:"employeeName"`
-	BaseSalary      float64   `db:"base_salary" json:"baseSalary"`
-	AllowancesTotal float64   `db:"allowances_total" json:"allowancesTotal"`
-	DeductionsTotal float64   `db:"deductions_total" json:"deductionsTotal"`
-	TaxTotal        float64   `db:"tax_total" json:"taxTotal"`
-	GrossPay        float64   `db:"gross_pay" json:"grossPay"`
-	NetPay          float64   `db:"net_pay" json:"netPay"`
+	Code            string    `db:"code" json:"code"`
+	Name            string    `db:"name" json:"name"`
+	Kind            string    `db:"kind" json:"kind"`
+	Taxable         bool      `db:"taxable" json:"taxable"`
+	CalculationType string    `db:"calculation_type" json:"calculationType"`
+	Value           float64   `db:"value" json:"value"`
+	AutoApply       bool      `db:"auto_apply" json:"autoApply"`
+	Active          bool      `db:"active" json:"active"`
 	CreatedAt       time.Time `db:"created_at" json:"createdAt"`
 	UpdatedAt       time.Time `db:"updated_at" json:"updatedAt"`
 }
 
+type PayComponentUpsertInput struct {
+	Code            string  `json:"code"`
+	Name            string  `json:"name"`
+	Kind            string  `json:"kind"`
+	Taxable         bool    `json:"taxable"`
+	CalculationType string  `json:"calculationType"`
+	Value           float64 `json:"value"`
+	AutoApply       bool    `json:"autoApply"`
+}
+
+type PayrollEntryLine struct {
+	ID             int64        `db:"id" json:"id"`
+	EntryID        int64        `db:"entry_id" json:"entryId"`
+	ComponentID    *int64       `db:"component_id" json:"componentId,omitempty"`
+	SchemeID       *int64       `db:"scheme_id" json:"schemeId,omitempty"`
+	Code           string       `db:"code" json:"code"`
+	Name           string       `db:"name" json:"name"`
+	Kind           string       `db:"kind" json:"kind"`
+	Taxable        bool         `db:"taxable" json:"taxable"`
+	Amount         money.Amount `db:"amount" json:"amount"`
+	EmployerAmount money.Amount `db:"employer_amount" json:"employerAmount"`
+	CreatedAt      time.Time    `db:"created_at" json:"createdAt"`
+
+	Quantity *float64                 `db:"quantity" json:"quantity,omitempty"`
+	Rate     *money.Amount            `db:"rate" json:"rate,omitempty"`
+	LoanID   *int64                   `db:"loan_id" json:"loanId,omitempty"`
+	Sources  []PayrollEntryLineSource `db:"-" json:"sources,omitempty"`
+
+	ArrearsMonth *string `db:"arrears_month" json:"arrearsMonth,omitempty"`
+}
+
+// PayrollEntryLineSource links a deduction line to the leave request or attendance record behind it.
+type PayrollEntryLineSource struct {
+	ID         int64   `db:"id" json:"id"`
+	LineID     int64   `db:"line_id" json:"lineId"`
+	SourceType string  `db:"source_type" json:"sourceType"`
+	SourceID   int64   `db:"source_id" json:"sourceId"`
+	Days       float64 `db:"days" json:"days"`
+}
+
+type ContributionScheme struct {
+	ID           int64     `db:"id" json:"id"`
+	Code         string    `db:"code" json:"code"`
+	Name         string    `db:"name" json:"name"`
+	EmployeeRate float64   `db:"employee_rate" json:"employeeRate"`
+	EmployerRate float64   `db:"employer_rate" json:"employerRate"`
+	Base         string    `db:"base" json:"base"`
+	MembersOnly  bool      `db:"members_only" json:"membersOnly"`
+	Active       bool      `db:"active" json:"active"`
+	CreatedAt    time.Time `db:"created_at" json:"createdAt"`
+	UpdatedAt    time.Time `db:"updated_at" json:"updatedAt"`
+}
+
+type ContributionSchemeUpsertInput struct {
+	Code         string  `json:"code"`
+	Name         string  `json:"name"`
+	EmployeeRate float64 `json:"employeeRate"`
+	EmployerRate float64 `json:"employerRate"`
+	Base         string  `json:"base"`
+	MembersOnly  bool    `json:"membersOnly"`
+}
+
+type ContributionSchemeMember struct {
+	SchemeID     int64  `db:"scheme_id" json:"schemeId"`
+	EmployeeID   int64  `db:"employee_id" json:"employeeId"`
+	EmployeeName string `db:"employee_name" json:"employeeName"`
+	MemberNumber string `db:"member_number" json:"memberNumber"`
+}
+
+type SetContributionSchemeMemberInput struct {
+	EmployeeID   int64  `json:"employeeId"`
+	MemberNumber string `json:"memberNumber"`
+}
+
+type ContributionScheduleRow struct {
+	EmployeeID     int64        `db:"employee_id"`
+	EmployeeName   string       `db:"employee_name"`
+	NationalID     *string      `db:"national_id"`
+	MemberNumber   *string      `db:"member_number"`
+	BaseSalary     money.Amount `db:"base_salary"`
+	GrossPay       money.Amount `db:"gross_pay"`
+	EmployeeAmount money.Amount `db:"employee_amount"`
+	EmployerAmount money.Amount `db:"employer_amount"`
+}
+
+type EmployeePaymentMethod struct {
+	ID            int64     `db:"id" json:"id"`
+	EmployeeID    int64     `db:"employee_id" json:"employeeId"`
+	Method        string    `db:"method" json:"method"`
+	BankName      *string   `db:"bank_name" json:"bankName,omitempty"`
+	BankCode      *string   `db:"bank_code" json:"bankCode,omitempty"`
+	Branch        *string   `db:"branch" json:"branch,omitempty"`
+	AccountName   *string   `db:"account_name" json:"accountName,omitempty"`
+	AccountNumber *string   `db:"account_number" json:"accountNumber,omitempty"`
+	Provider      *string   `db:"provider" json:"provider,omitempty"`
+	MSISDN        *string   `db:"msisdn" json:"msisdn,omitempty"`
+	SplitPercent  float64   `db:"split_percent" json:"splitPercent"`
+	CreatedAt     time.Time `db:"created_at" json:"createdAt"`
+	UpdatedAt     time.Time `db:"updated_at" json:"updatedAt"`
+}
+
+type EmployeePaymentMethodInput struct {
+	Method        string  `json:"method"`
+	BankName      string  `json:"bankName"`
+	BankCode      string  `json:"bankCode"`
+	Branch        string  `json:"branch"`
+	AccountName   string  `json:"accountName"`
+	AccountNumber string  `json:"accountNumber"`
+	Provider      string  `json:"provider"`
+	MSISDN        string  `json:"msisdn"`
+	SplitPercent  float64 `json:"splitPercent"`
+}
+
+// PaymentInstruction is one transfer to one destination: an entry's net pay times the split percent of a payment method.
+type PaymentInstruction struct {
+	EntryID       int64
+	EmployeeID    int64
+	EmployeeName  string
+	Method        string
+	BankName      string
+	BankCode      string
+	Branch        string
+	AccountName   string
+	AccountNumber string
+	Provider      string
+	MSISDN        string
+	Amount        money.Amount
+	Reference     string
+}
+
+type PaymentFileFormatInfo struct {
+	Code     string `json:"code"`
+	Name     string `json:"name"`
+	Method   string `json:"method"`
+	Provider string `json:"provider,omitempty"`
+}
+
+type AddEntryLineInput struct {
+	ComponentID int64         `json:"componentId"`
+	Amount      *money.Amount `json:"amount"`
+}
+
 type ListBatchesFilter struct {
-	Month    string `json:"month"`
-	Status   string `json:"status"`
-	Page     int    `json:"page"`
-	PageSize int    `json:"pageSize"`
+	Month     string `json:"month"`
+	BatchType string `json:"batchType"`
+	Status    string `json:"status"`
+	Page      int    `json:"page"`
+	PageSize  int    `json:"pageSize"`
 }
 
 type ListBatchesResult struct {
@@ -51,22 +338,551 @@ type ListBatchesResult struct {
 type PayrollBatchDetail struct {
 	Batch   PayrollBatch   `json:"batch"`
 	Entries []PayrollEntry `json:"entries"`
+
+	// EmployeeIDs and ComponentIDs are the selection of an off-cycle batch; both are empty for regular batches.
+	EmployeeIDs  []int64 `json:"employeeIds"`
+	ComponentIDs []int64 `json:"componentIds"`
+
+	Approval ApprovalProgress `json:"approval"`
+
+	// ReversedByBatchID is the correction batch that reverses this locked batch, if any.
+	ReversedByBatchID *int64 `json:"reversedByBatchId,omitempty"`
+
+	// TaxComputed is true when a tax table applies to the batch month, so entry tax is PAYE and not entered by hand.
+	TaxComputed bool `json:"taxComputed"`
 }
 
+// CreateBatchInput creates a regular batch when BatchType is empty. Off-cycle batches pay only the selected
+// components to the selected employees.
 type CreateBatchInput struct {
-	Month string `json:"month"`
+	Month        string  `json:"month"`
+	BatchType    string  `json:"batchType"`
+	Description  string  `json:"description"`
+	EmployeeIDs  []int64 `json:"employeeIds"`
+	ComponentIDs []int64 `json:"componentIds"`
+}
+
+// BatchSelection is the employees and pay components an off-cycle batch is generated for.
+type BatchSelection struct {
+	EmployeeIDs  []int64
+	ComponentIDs []int64
+}
+
+// MonthToDate is what an employee was already paid and taxed in the other approved or locked batches of a
+// month. PAYE bands apply to the month as a whole, so each batch is taxed on the month-to-date taxable pay
+// less the tax already deducted.
+type MonthToDate struct {
+	TaxablePay money.Amount
+	TaxTotal   money.Amount
+}
+
+// SalaryRate is a monthly base salary from the employee salary history, in effect from EffectiveFrom until
+// the next rate.
+type SalaryRate struct {
+	EmployeeID    int64        `db:"employee_id"`
+	Amount        money.Amount `db:"amount"`
+	EffectiveFrom time.Time    `db:"effective_from"`
+	CreatedAt     time.Time    `db:"created_at"`
+}
+
+// LockedBasePay is the base salary a locked regular batch paid an employee for a month, with the arrears
+// already paid for that month by approved or locked arrears batches.
+type LockedBasePay struct {
+	EmployeeID     int64         `db:"employee_id"`
+	EmployeeName   string        `db:"employee_name"`
+	BatchID        int64         `db:"batch_id"`
+	Month          string        `db:"month"`
+	LockedAt       time.Time     `db:"locked_at"`
+	BaseSalary     money.Amount  `db:"base_salary"`
+	FullBaseSalary *money.Amount `db:"full_base_salary"`
+	ArrearsPaid    money.Amount  `db:"arrears_paid"`
+	DateOfHire     time.Time     `db:"date_of_hire"`
+	DateOfExit     *time.Time    `db:"date_of_exit"`
 }
 
+// RetroArrears is the base salary still owed for a locked month after a back-dated salary change.
+type RetroArrears struct {
+	EmployeeID     int64        `json:"employeeId"`
+	EmployeeName   string       `json:"employeeName"`
+	Month          string       `json:"month"`
+	BatchID        int64        `json:"batchId"`
+	PaidBaseSalary money.Amount `json:"paidBaseSalary"`
+	DueBaseSalary  money.Amount `json:"dueBaseSalary"`
+	ArrearsPaid    money.Amount `json:"arrearsPaid"`
+	Arrears        money.Amount `json:"arrears"`
+}
+
+// UpdateEntryAmountsInput sets the totals of an entry without line items. TaxTotal is only needed when no tax table
+// applies to the batch month; otherwise tax is PAYE, and a TaxTotal that differs from it is rejected.
 type UpdateEntryAmountsInput struct {
-	AllowancesTotal float64 `json:"allowancesTotal"`
-	DeductionsTotal float64 `json:"deductionsTotal"`
-	TaxTotal        float64 `json:"taxTotal"`
+	AllowancesTotal money.Amount  `json:"allowancesTotal"`
+	DeductionsTotal money.Amount  `json:"deductionsTotal"`
+	TaxTotal        *money.Amount `json:"taxTotal,omitempty"`
+}
+
+// EntryAmountsImportResult reports a CSV import of entry amounts. When Errors is not empty nothing was applied.
+type EntryAmountsImportResult struct {
+	BatchID   int64                 `json:"batchId"`
+	Rows      int                   `json:"rows"`
+	Updated   int                   `json:"updated"`
+	Unchanged int                   `json:"unchanged"`
+	Errors    []EntryImportRowError `json:"errors"`
+}
+
+// EntryImportRowError is a problem with one CSV row. Row counts the header as row 1, as spreadsheets do.
+type EntryImportRowError struct {
+	Row        int    `json:"row"`
+	EmployeeID int64  `json:"employeeId,omitempty"`
+	Message    string `json:"message"`
 }
 
 type EmployeeSalary struct {
-	EmployeeID   int64   `db:"employee_id"`
-	EmployeeName string  `db:"employee_name"`
-	BaseSalary   float64 `db:"base_salary"`
+	EmployeeID     int64        `db:"employee_id"`
+	EmployeeName   string       `db:"employee_name"`
+	BaseSalary     money.Amount `db:"base_salary"`
+	DateOfHire     time.Time    `db:"date_of_hire"`
+	DateOfExit     *time.Time   `db:"date_of_exit"`
+	DepartmentID   *int64       `db:"department_id"`
+	DepartmentName *string      `db:"department_name"`
+}
+
+type EmployeeLoan struct {
+	ID                 int64        `db:"id" json:"id"`
+	EmployeeID         int64        `db:"employee_id" json:"employeeId"`
+	EmployeeName       string       `db:"employee_name" json:"employeeName"`
+	LoanType           string       `db:"loan_type" json:"loanType"`
+	Description        *string      `db:"description" json:"description,omitempty"`
+	Principal          money.Amount `db:"principal" json:"principal"`
+	InterestRate       float64      `db:"interest_rate" json:"interestRate"`
+	TotalRepayable     money.Amount `db:"total_repayable" json:"totalRepayable"`
+	Installments       int          `db:"installments" json:"installments"`
+	InstallmentAmount  money.Amount `db:"installment_amount" json:"installmentAmount"`
+	StartMonth         string       `db:"start_month" json:"startMonth"`
+	OutstandingBalance money.Amount `db:"outstanding_balance" json:"outstandingBalance"`
+	Status             string       `db:"status" json:"status"`
+	CreatedBy          *int64       `db:"created_by" json:"createdBy,omitempty"`
+	CreatedAt          time.Time    `db:"created_at" json:"createdAt"`
+	UpdatedAt          time.Time    `db:"updated_at" json:"updatedAt"`
+}
+
+// LoanCreateInput describes a new loan or advance. InterestRate is a flat annual percentage charged on the
+// principal over the repayment period.
+type LoanCreateInput struct {
+	EmployeeID   int64        `json:"employeeId"`
+	LoanType     string       `json:"loanType"`
+	Description  string       `json:"description"`
+	Principal    money.Amount `json:"principal"`
+	InterestRate float64      `json:"interestRate"`
+	Installments int          `json:"installments"`
+	StartMonth   string       `json:"startMonth"`
+}
+
+type ListLoansFilter struct {
+	EmployeeID int64  `json:"employeeId"`
+	Status     string `json:"status"`
+}
+
+type LoanInstallment struct {
+	Number       int          `json:"number"`
+	Month        string       `json:"month"`
+	Amount       money.Amount `json:"amount"`
+	BalanceAfter money.Amount `json:"balanceAfter"`
+}
+
+type LoanRepayment struct {
+	ID           int64        `db:"id" json:"id"`
+	LoanID       int64        `db:"loan_id" json:"loanId"`
+	BatchID      int64        `db:"batch_id" json:"batchId"`
+	EntryID      *int64       `db:"entry_id" json:"entryId,omitempty"`
+	Month        string       `db:"month" json:"month"`
+	Amount       money.Amount `db:"amount" json:"amount"`
+	BalanceAfter money.Amount `db:"balance_after" json:"balanceAfter"`
+	CreatedAt    time.Time    `db:"created_at" json:"createdAt"`
+}
+
+// LoanLedger is the planned schedule of a loan next to the recoveries actually made by locked batches.
+type LoanLedger struct {
+	Loan       EmployeeLoan      `json:"loan"`
+	Schedule   []LoanInstallment `json:"schedule"`
+	Repayments []LoanRepayment   `json:"repayments"`
+}
+
+// LoanRecovery is a loan deduction line of a batch that is applied to the loan balance on lock.
+type LoanRecovery struct {
+	LoanID     int64        `db:"loan_id"`
+	EntryID    int64        `db:"entry_id"`
+	EmployeeID int64        `db:"employee_id"`
+	Amount     money.Amount `db:"amount"`
+}
+
+// VariancePolicy controls the comparison of a batch against the previous month before approval.
+type VariancePolicy struct {
+	ThresholdPercent float64 `json:"thresholdPercent"`
+	RequireReview    bool    `json:"requireReview"`
+}
+
+// VarianceFigure is one amount of an employee (or of the batch) in the previous and current batch.
+// ChangePercent is omitted when the previous amount is zero.
+type VarianceFigure struct {
+	Previous      money.Amount `json:"previous"`
+	Current       money.Amount `json:"current"`
+	Change        money.Amount `json:"change"`
+	ChangePercent *float64     `json:"changePercent,omitempty"`
+	Flagged       bool         `json:"flagged"`
+}
+
+type VarianceRow struct {
+	EmployeeID      int64          `json:"employeeId"`
+	EmployeeName    string         `json:"employeeName"`
+	Status          string         `json:"status"`
+	BaseSalary      VarianceFigure `json:"baseSalary"`
+	AllowancesTotal VarianceFigure `json:"allowancesTotal"`
+	DeductionsTotal VarianceFigure `json:"deductionsTotal"`
+	TaxTotal        VarianceFigure `json:"taxTotal"`
+	NetPay          VarianceFigure `json:"netPay"`
+	Flagged         bool           `json:"flagged"`
+}
+
+type VarianceTotals struct {
+	BaseSalary      VarianceFigure `json:"baseSalary"`
+	AllowancesTotal VarianceFigure `json:"allowancesTotal"`
+	DeductionsTotal VarianceFigure `json:"deductionsTotal"`
+	TaxTotal        VarianceFigure `json:"taxTotal"`
+	NetPay          VarianceFigure `json:"netPay"`
+}
+
+// VarianceReport diffs a batch against a previous batch per employee.
+type VarianceReport struct {
+	BatchID            int64          `json:"batchId"`
+	Month              string         `json:"month"`
+	PreviousBatchID    *int64         `json:"previousBatchId,omitempty"`
+	PreviousMonth      string         `json:"previousMonth"`
+	ThresholdPercent   float64        `json:"thresholdPercent"`
+	Joiners            int            `json:"joiners"`
+	Leavers            int            `json:"leavers"`
+	Changed            int            `json:"changed"`
+	Flagged            int            `json:"flagged"`
+	Totals             VarianceTotals `json:"totals"`
+	Rows               []VarianceRow  `json:"rows"`
+	ReviewRequired     bool           `json:"reviewRequired"`
+	VarianceReviewedBy *int64         `json:"varianceReviewedBy,omitempty"`
+	VarianceReviewedAt *time.Time     `json:"varianceReviewedAt,omitempty"`
+}
+
+// SimulationSalaryAdjustment changes base salaries by Percent for the employees of one department or, when
+// DepartmentID is nil, for everyone not covered by a department adjustment.
+type SimulationSalaryAdjustment struct {
+	DepartmentID *int64  `json:"departmentId,omitempty"`
+	Percent      float64 `json:"percent"`
+}
+
+// SimulationComponentOverride replaces the fixed amount or percentage of an auto-apply component.
+type SimulationComponentOverride struct {
+	ComponentID int64   `json:"componentId"`
+	Value       float64 `json:"value"`
+}
+
+// SimulationSchemeOverride replaces the rates of an active contribution scheme.
+type SimulationSchemeOverride struct {
+	SchemeID     int64   `json:"schemeId"`
+	EmployeeRate float64 `json:"employeeRate"`
+	EmployerRate float64 `json:"employerRate"`
+}
+
+// PayrollSimulationInput describes a what-if regular payroll. Month defaults to the month of the compare
+// batch, and the compare batch to the regular batch of the month. TaxBands replace the PAYE table in effect.
+type PayrollSimulationInput struct {
+	Month              string                        `json:"month"`
+	CompareBatchID     int64                         `json:"compareBatchId"`
+	SalaryAdjustments  []SimulationSalaryAdjustment  `json:"salaryAdjustments"`
+	ComponentOverrides []SimulationComponentOverride `json:"componentOverrides"`
+	SchemeOverrides    []SimulationSchemeOverride    `json:"schemeOverrides"`
+	TaxBands           []TaxBand                     `json:"taxBands"`
+}
+
+// PayrollSimulation is a regular payroll calculated in memory with the overrides applied and compared per
+// employee against a real batch: Previous figures come from the batch and Current figures from the simulation.
+type PayrollSimulation struct {
+	Month                 string         `json:"month"`
+	ProrationBasis        string         `json:"prorationBasis"`
+	TaxTableName          string         `json:"taxTableName"`
+	AdjustedEmployees     int            `json:"adjustedEmployees"`
+	Entries               []PayrollEntry `json:"entries"`
+	GrossPay              VarianceFigure `json:"grossPay"`
+	EmployerContributions VarianceFigure `json:"employerContributions"`
+	Comparison            VarianceReport `json:"comparison"`
+}
+
+// ApprovalStep is one sign-off of the approval chain. Any user holding one of Roles (normalized role names
+// such as finance_officer) may complete it.
+type ApprovalStep struct {
+	Name  string   `json:"name"`
+	Roles []string `json:"roles"`
+}
+
+// ApprovalPolicy is the ordered approval chain. Unless AllowSelfApproval is set, the creator of a batch cannot
+// approve any step and no user can approve two steps of the same round.
+type ApprovalPolicy struct {
+	Steps             []ApprovalStep `json:"steps"`
+	AllowSelfApproval bool           `json:"allowSelfApproval"`
+}
+
+// PayrollBatchApproval is a recorded approval or rejection of a step.
+type PayrollBatchApproval struct {
+	ID         int64     `db:"id" json:"id"`
+	BatchID    int64     `db:"batch_id" json:"batchId"`
+	StepNumber int       `db:"step_number" json:"stepNumber"`
+	StepName   string    `db:"step_name" json:"stepName"`
+	Action     string    `db:"action" json:"action"`
+	UserID     *int64    `db:"user_id" json:"userId,omitempty"`
+	UserName   *string   `db:"user_name" json:"userName,omitempty"`
+	Comment    string    `db:"comment" json:"comment"`
+	CreatedAt  time.Time `db:"created_at" json:"createdAt"`
+}
+
+// BatchApprovalInput records an approval or rejection of a step.
+type BatchApprovalInput struct {
+	BatchID    int64
+	StepNumber int
+	StepName   string
+	Action     string
+	UserID     int64
+	Comment    string
+}
+
+// ApprovalStepStatus is a step of the chain with the approval that signed it off in the current round.
+type ApprovalStepStatus struct {
+	StepNumber int                   `json:"stepNumber"`
+	Name       string                `json:"name"`
+	Roles      []string              `json:"roles"`
+	Approval   *PayrollBatchApproval `json:"approval,omitempty"`
+}
+
+// ApprovalProgress shows where a batch is in the approval chain. NextStep is 0 once the batch is approved.
+type ApprovalProgress struct {
+	Steps    []ApprovalStepStatus   `json:"steps"`
+	NextStep int                    `json:"nextStep"`
+	History  []PayrollBatchApproval `json:"history"`
+}
+
+// AbsenceRecord is an approved unpaid leave request or an unexcused absence that overlaps a payroll month.
+type AbsenceRecord struct {
+	SourceType string    `db:"source_type"`
+	SourceID   int64     `db:"source_id"`
+	EmployeeID int64     `db:"employee_id"`
+	StartDate  time.Time `db:"start_date"`
+	EndDate    time.Time `db:"end_date"`
+	Days       float64   `db:"days"`
+}
+
+// GLAccountMapping posts a category to a ledger account, optionally only for one pay component or contribution
+// scheme and/or one department.
+type GLAccountMapping struct {
+	ID             int64     `db:"id" json:"id"`
+	Category       string    `db:"category" json:"category"`
+	ComponentID    *int64    `db:"component_id" json:"componentId,omitempty"`
+	ComponentCode  *string   `db:"component_code" json:"componentCode,omitempty"`
+	SchemeID       *int64    `db:"scheme_id" json:"schemeId,omitempty"`
+	SchemeCode     *string   `db:"scheme_code" json:"schemeCode,omitempty"`
+	DepartmentID   *int64    `db:"department_id" json:"departmentId,omitempty"`
+	DepartmentName *string   `db:"department_name" json:"departmentName,omitempty"`
+	AccountCode    string    `db:"account_code" json:"accountCode"`
+	AccountName    string    `db:"account_name" json:"accountName"`
+	CreatedAt      time.Time `db:"created_at" json:"createdAt"`
+	UpdatedAt      time.Time `db:"updated_at" json:"updatedAt"`
+}
+
+type GLAccountMappingInput struct {
+	Category     string `json:"category"`
+	ComponentID  *int64 `json:"componentId"`
+	SchemeID     *int64 `json:"schemeId"`
+	DepartmentID *int64 `json:"departmentId"`
+	AccountCode  string `json:"accountCode"`
+	AccountName  string `json:"accountName"`
+}
+
+// EmployeeDepartment is the department, and its cost centre, that an employee's payroll costs are posted to.
+type EmployeeDepartment struct {
+	EmployeeID     int64   `db:"employee_id"`
+	DepartmentID   *int64  `db:"department_id"`
+	DepartmentName *string `db:"department_name"`
+	CostCentre     *string `db:"cost_centre"`
+}
+
+// JournalLine is the net debit or credit of one account for one department, or for the whole company when
+// DepartmentID is nil.
+type JournalLine struct {
+	AccountCode    string       `json:"accountCode"`
+	AccountName    string       `json:"accountName"`
+	DepartmentID   *int64       `json:"departmentId,omitempty"`
+	DepartmentName string       `json:"departmentName"`
+	CostCentre     string       `json:"costCentre"`
+	Debit          money.Amount `json:"debit"`
+	Credit         money.Amount `json:"credit"`
+}
+
+type PayrollJournal struct {
+	BatchID     int64         `json:"batchId"`
+	Month       string        `json:"month"`
+	BatchType   string        `json:"batchType"`
+	Reference   string        `json:"reference"`
+	Date        string        `json:"date"`
+	Lines       []JournalLine `json:"lines"`
+	TotalDebit  money.Amount  `json:"totalDebit"`
+	TotalCredit money.Amount  `json:"totalCredit"`
+}
+
+// Project is a donor-funded project or grant that staff time is charged to.
+type Project struct {
+	ID        int64     `db:"id" json:"id"`
+	Code      string    `db:"code" json:"code"`
+	Name      string    `db:"name" json:"name"`
+	Donor     string    `db:"donor" json:"donor"`
+	Active    bool      `db:"active" json:"active"`
+	CreatedAt time.Time `db:"created_at" json:"createdAt"`
+	UpdatedAt time.Time `db:"updated_at" json:"updatedAt"`
+}
+
+type ProjectUpsertInput struct {
+	Code  string `json:"code"`
+	Name  string `json:"name"`
+	Donor string `json:"donor"`
+}
+
+// EmployeeProjectAllocation charges a share of an employee's cost to a project from StartDate until EndDate,
+// or indefinitely when EndDate is nil.
+type EmployeeProjectAllocation struct {
+	ID          int64      `db:"id" json:"id"`
+	EmployeeID  int64      `db:"employee_id" json:"employeeId"`
+	ProjectID   int64      `db:"project_id" json:"projectId"`
+	ProjectCode string     `db:"project_code" json:"projectCode"`
+	ProjectName string     `db:"project_name" json:"projectName"`
+	Percent     float64    `db:"percent" json:"percent"`
+	StartDate   time.Time  `db:"start_date" json:"startDate"`
+	EndDate     *time.Time `db:"end_date" json:"endDate,omitempty"`
+	CreatedAt   time.Time  `db:"created_at" json:"createdAt"`
+}
+
+// ProjectAllocationInput takes dates as YYYY-MM-DD; an empty end date leaves the allocation open.
+type ProjectAllocationInput struct {
+	ProjectID int64   `json:"projectId"`
+	Percent   float64 `json:"percent"`
+	StartDate string  `json:"startDate"`
+	EndDate   string  `json:"endDate"`
+}
+
+// ProjectAllocationInsert is a validated allocation.
+type ProjectAllocationInsert struct {
+	ProjectID int64
+	Percent   float64
+	StartDate time.Time
+	EndDate   *time.Time
+}
+
+// ProjectCharge is the share of an entry's cost charged to a project when its batch was locked. A nil
+// ProjectID is the unallocated remainder.
+type ProjectCharge struct {
+	ID             int64        `db:"id"`
+	BatchID        int64        `db:"batch_id"`
+	EntryID        int64        `db:"entry_id"`
+	EmployeeID     int64        `db:"employee_id"`
+	ProjectID      *int64       `db:"project_id"`
+	GrossAmount    money.Amount `db:"gross_amount"`
+	EmployerAmount money.Amount `db:"employer_amount"`
+}
+
+// ProjectChargingRow is the cost of one employee charged to one project in a month, over all locked batches.
+type ProjectChargingRow struct {
+	ProjectID      *int64       `db:"project_id" json:"projectId,omitempty"`
+	ProjectCode    string       `db:"project_code" json:"projectCode"`
+	ProjectName    string       `db:"project_name" json:"projectName"`
+	Donor          string       `db:"donor" json:"donor"`
+	EmployeeID     int64        `db:"employee_id" json:"employeeId"`
+	EmployeeName   string       `db:"employee_name" json:"employeeName"`
+	SharePercent   float64      `db:"-" json:"sharePercent"`
+	GrossAmount    money.Amount `db:"gross_amount" json:"grossAmount"`
+	EmployerAmount money.Amount `db:"employer_amount" json:"employerAmount"`
+	TotalCost      money.Amount `db:"-" json:"totalCost"`
+}
+
+type ProjectChargingReport struct {
+	Month          string               `json:"month"`
+	Rows           []ProjectChargingRow `json:"rows"`
+	GrossAmount    money.Amount         `json:"grossAmount"`
+	EmployerAmount money.Amount         `json:"employerAmount"`
+	TotalCost      money.Amount         `json:"totalCost"`
+}
+
+// AnnualTaxEntry is an entry of a locked batch with the month it was paid in and the employee's national ID.
+type AnnualTaxEntry struct {
+	PayrollEntry
+	Month      string  `db:"month"`
+	NationalID *string `db:"national_id"`
+}
+
+// AnnualTaxSummary totals the locked payroll of a calendar or fiscal year per employee, for year-end tax
+// certificates and the employer return.
+type AnnualTaxSummary struct {
+	Year       int                  `json:"year"`
+	StartMonth int                  `json:"startMonth"`
+	Label      string               `json:"label"`
+	FromMonth  string               `json:"fromMonth"`
+	ToMonth    string               `json:"toMonth"`
+	Schemes    []AnnualSchemeColumn `json:"schemes"`
+	Employees  []AnnualEmployeeTax  `json:"employees"`
+	GrossPay   money.Amount         `json:"grossPay"`
+	TaxablePay money.Amount         `json:"taxablePay"`
+	TaxTotal   money.Amount         `json:"taxTotal"`
+	NetPay     money.Amount         `json:"netPay"`
+}
+
+type AnnualSchemeColumn struct {
+	SchemeID int64  `json:"schemeId"`
+	Code     string `json:"code"`
+	Name     string `json:"name"`
+}
+
+type AnnualEmployeeTax struct {
+	EmployeeID    int64                `json:"employeeId"`
+	EmployeeName  string               `json:"employeeName"`
+	NationalID    *string              `json:"nationalId,omitempty"`
+	Months        int                  `json:"months"`
+	GrossPay      money.Amount         `json:"grossPay"`
+	TaxablePay    money.Amount         `json:"taxablePay"`
+	TaxTotal      money.Amount         `json:"taxTotal"`
+	NetPay        money.Amount         `json:"netPay"`
+	Contributions []AnnualContribution `json:"contributions"`
+}
+
+// AnnualContribution is what an employee and the employer paid into one contribution scheme over the year.
+type AnnualContribution struct {
+	SchemeID       int64        `json:"schemeId"`
+	Code           string       `json:"code"`
+	Name           string       `json:"name"`
+	EmployeeAmount money.Amount `json:"employeeAmount"`
+	EmployerAmount money.Amount `json:"employerAmount"`
+}
+
+// BatchIntegritySeal is a batch with the per-part hashes recorded when it was locked.
+type BatchIntegritySeal struct {
+	PayrollBatch
+	IntegrityManifest *string `db:"integrity_manifest"`
+}
+
+// BatchIntegrityReport compares a batch with the seal recorded when it was locked.
+type BatchIntegrityReport struct {
+	BatchID      int64     `json:"batchId"`
+	Month        string    `json:"month"`
+	Status       string    `json:"status"`
+	StoredHash   string    `json:"storedHash"`
+	ComputedHash string    `json:"computedHash"`
+	Drift        []string  `json:"drift"`
+	CheckedAt    time.Time `json:"checkedAt"`
+
+	// HeaderDrift and DriftedEntryIDs say which parts no longer match; the whole batch is suspect when the
+	// seal itself was altered.
+	HeaderDrift     bool    `json:"headerDrift"`
+	DriftedEntryIDs []int64 `json:"driftedEntryIds"`
 }
 
 type CSVExport struct {
@@ -0,0 +1,350 @@
+package payroll
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+
+	"hrpro/internal/models"
+	"hrpro/internal/money"
+)
+
+// GetPayrollVarianceReport compares a batch with previousBatchID, or with the regular batch of the previous
+// month when previousBatchID is 0.
+func (s *Service) GetPayrollVarianceReport(ctx context.Context, batchID, previousBatchID int64) (*VarianceReport, error) {
+	if batchID <= 0 {
+		return nil, fmt.Errorf("%w: batch id must be positive", ErrValidation)
+	}
+	if previousBatchID < 0 {
+		return nil, fmt.Errorf("%w: previous batch id must be positive", ErrValidation)
+	}
+	if previousBatchID == batchID {
+		return nil, fmt.Errorf("%w: previous batch must differ from the batch", ErrValidation)
+	}
+
+	batch, err := s.repository.GetBatchByID(ctx, batchID)
+	if err != nil {
+		return nil, err
+	}
+	if batch == nil {
+		return nil, ErrNotFound
+	}
+
+	var previous *PayrollBatch
+	previousMonth := ""
+	if previousBatchID > 0 {
+		previous, err = s.repository.GetBatchByID(ctx, previousBatchID)
+		if err != nil {
+			return nil, err
+		}
+		if previous == nil {
+			return nil, ErrNotFound
+		}
+	} else {
+		start, _, err := MonthBounds(batch.Month)
+		if err != nil {
+			return nil, err
+		}
+		previousMonth = start.AddDate(0, -1, 0).Format("2006-01")
+		previous, err = s.repository.GetRegularBatchByMonth(ctx, previousMonth)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	current, err := s.repository.ListEntriesByBatchID(ctx, batch.ID)
+	if err != nil {
+		return nil, err
+	}
+	var prior []PayrollEntry
+	if previous != nil {
+		prior, err = s.repository.ListEntriesByBatchID(ctx, previous.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	policy, err := s.resolveVariancePolicy(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	report := BuildVarianceReport(*batch, previous, current, prior, policy.ThresholdPercent)
+	if previous == nil {
+		report.PreviousMonth = previousMonth
+	}
+	report.ReviewRequired = policy.RequireReview
+	report.VarianceReviewedBy = batch.VarianceReviewedBy
+	report.VarianceReviewedAt = batch.VarianceReviewedAt
+	return &report, nil
+}
+
+// AcknowledgePayrollVariance records that the variance report of a draft batch was reviewed. Editing or
+// regenerating entries afterwards makes the acknowledgement stale.
+func (s *Service) AcknowledgePayrollVariance(ctx context.Context, claims *models.Claims, batchID int64) (*PayrollBatch, error) {
+	if claims == nil {
+		return nil, ErrForbidden
+	}
+	if batchID <= 0 {
+		return nil, fmt.Errorf("%w: batch id must be positive", ErrValidation)
+	}
+	batch, err := s.repository.GetBatchByID(ctx, batchID)
+	if err != nil {
+		return nil, err
+	}
+	if batch == nil {
+		return nil, ErrNotFound
+	}
+	if batch.Status != StatusDraft {
+		return nil, ErrInvalidTransition
+	}
+	report, err := s.GetPayrollVarianceReport(ctx, batchID, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := s.repository.SetBatchVarianceReviewed(ctx, batchID, claims.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if updated == nil {
+		return nil, ErrNotFound
+	}
+	s.audit.RecordAuditEvent(ctx, claimsUserID(claims), "payroll.batch.variance_acknowledge", stringPtr("payroll_batch"), &updated.ID, map[string]any{
+		"month":             updated.Month,
+		"previous_batch_id": report.PreviousBatchID,
+		"threshold_percent": report.ThresholdPercent,
+		"joiners":           report.Joiners,
+		"leavers":           report.Leavers,
+		"flagged":           report.Flagged,
+	})
+	return updated, nil
+}
+
+func (s *Service) ExportPayrollVarianceCSV(ctx context.Context, batchID, previousBatchID int64) (*CSVExport, error) {
+	report, err := s.GetPayrollVarianceReport(ctx, batchID, previousBatchID)
+	if err != nil {
+		return nil, err
+	}
+	_, decimals := s.payrollFormatting(ctx)
+
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	header := []string{"Employee ID", "Employee Name", "Status", "Flagged"}
+	for _, name := range []string{"Base Salary", "Allowances", "Deductions", "Tax", "Net Pay"} {
+		header = append(header, name+" Previous", name+" Current", name+" Change", name+" Change %")
+	}
+	if err := writer.Write(header); err != nil {
+		return nil, fmt.Errorf("write variance csv header: %w", err)
+	}
+
+	writeFigures := func(record []string, figures ...VarianceFigure) []string {
+		for _, figure := range figures {
+			percent := ""
+			if figure.ChangePercent != nil {
+				percent = strconv.FormatFloat(*figure.ChangePercent, 'f', 2, 64)
+			}
+			record = append(record,
+				figure.Previous.Format(decimals, ""),
+				figure.Current.Format(decimals, ""),
+				figure.Change.Format(decimals, ""),
+				percent,
+			)
+		}
+		return record
+	}
+	for _, row := range report.Rows {
+		record := []string{strconv.FormatInt(row.EmployeeID, 10), row.EmployeeName, row.Status, strconv.FormatBool(row.Flagged)}
+		record = writeFigures(record, row.BaseSalary, row.AllowancesTotal, row.DeductionsTotal, row.TaxTotal, row.NetPay)
+		if err := writer.Write(record); err != nil {
+			return nil, fmt.Errorf("write variance csv record: %w", err)
+		}
+	}
+	totals := report.Totals
+	record := writeFigures([]string{"", "Total", "", ""}, totals.BaseSalary, totals.AllowancesTotal, totals.DeductionsTotal, totals.TaxTotal, totals.NetPay)
+	if err := writer.Write(record); err != nil {
+		return nil, fmt.Errorf("write variance csv totals: %w", err)
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return nil, fmt.Errorf("flush variance csv: %w", err)
+	}
+
+	return &CSVExport{
+		Filename: fmt.Sprintf("payroll-variance-%s-vs-%s.csv", report.Month, report.PreviousMonth),
+		Data:     buf.String(),
+		MimeType: "text/csv;charset=utf-8",
+	}, nil
+}
+
+// BuildVarianceReport diffs the entries of batch against those of previous per employee. Employees only in
+// the batch are joiners, employees only in the previous batch are leavers. A figure is flagged when it moves by
+// more than thresholdPercent, or appears from zero; rows of employees in both batches are flagged when any
+// figure is.
+func BuildVarianceReport(batch PayrollBatch, previous *PayrollBatch, current, prior []PayrollEntry, thresholdPercent float64) VarianceReport {
+	report := VarianceReport{
+		BatchID:          batch.ID,
+		Month:            batch.Month,
+		ThresholdPercent: thresholdPercent,
+		Rows:             make([]VarianceRow, 0, len(current)),
+	}
+	if previous != nil {
+		previousID := previous.ID
+		report.PreviousBatchID = &previousID
+		report.PreviousMonth = previous.Month
+	}
+
+	currentByEmployee, currentOrder := groupVarianceEntries(current)
+	priorByEmployee, priorOrder := groupVarianceEntries(prior)
+
+	var currentTotal, priorTotal PayrollEntry
+	addRow := func(employeeID int64) {
+		now, inCurrent := currentByEmployee[employeeID]
+		before, inPrior := priorByEmployee[employeeID]
+		row := VarianceRow{EmployeeID: employeeID, EmployeeName: now.EmployeeName}
+		if !inCurrent {
+			row.EmployeeName = before.EmployeeName
+		}
+		row.BaseSalary = newVarianceFigure(before.BaseSalary, now.BaseSalary, thresholdPercent)
+		row.AllowancesTotal = newVarianceFigure(before.AllowancesTotal, now.AllowancesTotal, thresholdPercent)
+		row.DeductionsTotal = newVarianceFigure(before.DeductionsTotal, now.DeductionsTotal, thresholdPercent)
+		row.TaxTotal = newVarianceFigure(before.TaxTotal, now.TaxTotal, thresholdPercent)
+		row.NetPay = newVarianceFigure(before.NetPay, now.NetPay, thresholdPercent)
+
+		switch {
+		case !inPrior:
+			row.Status = VarianceJoiner
+			report.Joiners++
+		case !inCurrent:
+			row.Status = VarianceLeaver
+			report.Leavers++
+		default:
+			row.Status = VarianceUnchanged
+			for _, figure := range []VarianceFigure{row.BaseSalary, row.AllowancesTotal, row.DeductionsTotal, row.TaxTotal, row.NetPay} {
+				if figure.Change != 0 {
+					row.Status = VarianceChanged
+				}
+				if figure.Flagged {
+					row.Flagged = true
+				}
+			}
+			if row.Status == VarianceChanged {
+				report.Changed++
+			}
+			if row.Flagged {
+				report.Flagged++
+			}
+		}
+		report.Rows = append(report.Rows, row)
+
+		addVarianceTotals(&currentTotal, now)
+		addVarianceTotals(&priorTotal, before)
+	}
+	for _, employeeID := range currentOrder {
+		addRow(employeeID)
+	}
+	for _, employeeID := range priorOrder {
+		if _, ok := currentByEmployee[employeeID]; !ok {
+			addRow(employeeID)
+		}
+	}
+
+	sort.SliceStable(report.Rows, func(i, j int) bool {
+		if report.Rows[i].EmployeeName != report.Rows[j].EmployeeName {
+			return report.Rows[i].EmployeeName < report.Rows[j].EmployeeName
+		}
+		return report.Rows[i].EmployeeID < report.Rows[j].EmployeeID
+	})
+	report.Totals = VarianceTotals{
+		BaseSalary:      newVarianceFigure(priorTotal.BaseSalary, currentTotal.BaseSalary, thresholdPercent),
+		AllowancesTotal: newVarianceFigure(priorTotal.AllowancesTotal, currentTotal.AllowancesTotal, thresholdPercent),
+		DeductionsTotal: newVarianceFigure(priorTotal.DeductionsTotal, currentTotal.DeductionsTotal, thresholdPercent),
+		TaxTotal:        newVarianceFigure(priorTotal.TaxTotal, currentTotal.TaxTotal, thresholdPercent),
+		NetPay:          newVarianceFigure(priorTotal.NetPay, currentTotal.NetPay, thresholdPercent),
+	}
+	return report
+}
+
+// requireVarianceReview fails unless the variance report of a regular batch was acknowledged after the last
+// change to the batch entries. Off-cycle batches have no month-on-month baseline and are not reviewed.
+func (s *Service) requireVarianceReview(ctx context.Context, batch PayrollBatch) error {
+	policy, err := s.resolveVariancePolicy(ctx)
+	if err != nil {
+		return err
+	}
+	if !policy.RequireReview || batch.BatchType != BatchTypeRegular {
+		return nil
+	}
+	if batch.VarianceReviewedAt == nil {
+		return ErrVarianceNotReviewed
+	}
+	entries, err := s.repository.ListEntriesByBatchID(ctx, batch.ID)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if entry.UpdatedAt.After(*batch.VarianceReviewedAt) {
+			return ErrVarianceNotReviewed
+		}
+	}
+	return nil
+}
+
+// resolveVariancePolicy reads the variance policy from settings, defaulting to a 10% threshold without a
+// mandatory review.
+func (s *Service) resolveVariancePolicy(ctx context.Context) (VariancePolicy, error) {
+	if s.policy == nil {
+		return VariancePolicy{ThresholdPercent: DefaultVarianceThresholdPercent}, nil
+	}
+	policy, err := s.policy.GetPayrollVariancePolicy(ctx)
+	if err != nil {
+		return VariancePolicy{}, err
+	}
+	if policy.ThresholdPercent <= 0 {
+		policy.ThresholdPercent = DefaultVarianceThresholdPercent
+	}
+	return policy, nil
+}
+
+func groupVarianceEntries(entries []PayrollEntry) (map[int64]PayrollEntry, []int64) {
+	byEmployee := make(map[int64]PayrollEntry, len(entries))
+	order := make([]int64, 0, len(entries))
+	for _, entry := range entries {
+		existing, ok := byEmployee[entry.EmployeeID]
+		if !ok {
+			order = append(order, entry.EmployeeID)
+			existing = PayrollEntry{EmployeeID: entry.EmployeeID, EmployeeName: entry.EmployeeName}
+		}
+		addVarianceTotals(&existing, entry)
+		byEmployee[entry.EmployeeID] = existing
+	}
+	return byEmployee, order
+}
+
+func addVarianceTotals(total *PayrollEntry, entry PayrollEntry) {
+	total.BaseSalary += entry.BaseSalary
+	total.AllowancesTotal += entry.AllowancesTotal
+	total.DeductionsTotal += entry.DeductionsTotal
+	total.TaxTotal += entry.TaxTotal
+	total.NetPay += entry.NetPay
+}
+
+func newVarianceFigure(previous, current money.Amount, thresholdPercent float64) VarianceFigure {
+	figure := VarianceFigure{
+		Previous: previous,
+		Current:  current,
+		Change:   current - previous,
+	}
+	if figure.Previous == 0 {
+		figure.Flagged = figure.Current != 0
+		return figure
+	}
+	percent := math.Round(figure.Change.Float64()/figure.Previous.Abs().Float64()*100*100) / 100
+	figure.ChangePercent = &percent
+	figure.Flagged = math.Abs(percent) > thresholdPercent
+	return figure
+}
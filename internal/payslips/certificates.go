@@ -0,0 +1,255 @@
+package payslips
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"hrpro/internal/models"
+	"hrpro/internal/money"
+	"hrpro/internal/payroll"
+
+	"github.com/go-pdf/fpdf"
+)
+
+// RenderTaxCertificatePDF renders the year-end tax and earnings certificate of one employee from the locked
+// payroll of the year.
+func (s *Service) RenderTaxCertificatePDF(ctx context.Context, claims *models.Claims, year, startMonth int, employeeID int64) (*FileExport, error) {
+	summary, err := s.payroll.GetAnnualTaxSummary(ctx, year, startMonth)
+	if err != nil {
+		return nil, err
+	}
+	var employee *payroll.AnnualEmployeeTax
+	for i := range summary.Employees {
+		if summary.Employees[i].EmployeeID == employeeID {
+			employee = &summary.Employees[i]
+			break
+		}
+	}
+	if employee == nil {
+		return nil, fmt.Errorf("%w: employee has no locked payroll in %s", payroll.ErrNotFound, summary.Label)
+	}
+
+	brand, err := s.resolveBranding(ctx, claims)
+	if err != nil {
+		return nil, err
+	}
+	data, err := renderTaxCertificate(brand, summary, *employee)
+	if err != nil {
+		return nil, err
+	}
+
+	s.audit.RecordAuditEvent(ctx, nil, "payroll.tax_certificate.render", stringPtr("employee"), &employee.EmployeeID, map[string]any{
+		"period": summary.Label,
+	})
+	return &FileExport{
+		Filename: taxCertificateFilename(summary, *employee),
+		Data:     data,
+		MimeType: "application/pdf",
+	}, nil
+}
+
+// RenderTaxCertificatesZip renders the certificate of every employee paid in the year into one zip archive.
+func (s *Service) RenderTaxCertificatesZip(ctx context.Context, claims *models.Claims, year, startMonth int) (*FileExport, error) {
+	summary, err := s.payroll.GetAnnualTaxSummary(ctx, year, startMonth)
+	if err != nil {
+		return nil, err
+	}
+	if len(summary.Employees) == 0 {
+		return nil, fmt.Errorf("%w: no locked payroll in %s", payroll.ErrValidation, summary.Label)
+	}
+
+	brand, err := s.resolveBranding(ctx, claims)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+	for _, employee := range summary.Employees {
+		data, err := renderTaxCertificate(brand, summary, employee)
+		if err != nil {
+			return nil, err
+		}
+		file, err := archive.Create(taxCertificateFilename(summary, employee))
+		if err != nil {
+			return nil, fmt.Errorf("add tax certificate to archive: %w", err)
+		}
+		if _, err := file.Write(data); err != nil {
+			return nil, fmt.Errorf("write tax certificate to archive: %w", err)
+		}
+	}
+	if err := archive.Close(); err != nil {
+		return nil, fmt.Errorf("close tax certificate archive: %w", err)
+	}
+
+	s.audit.RecordAuditEvent(ctx, nil, "payroll.tax_certificate.render_batch", nil, nil, map[string]any{
+		"period":       summary.Label,
+		"certificates": len(summary.Employees),
+	})
+	return &FileExport{
+		Filename: fmt.Sprintf("tax_certificates_%s.zip", periodSlug(summary)),
+		Data:     buf.Bytes(),
+		MimeType: "application/zip",
+	}, nil
+}
+
+// ExportAnnualTaxReturnCSV writes the consolidated employer return for the tax authority: one row per employee
+// with a pair of employee and employer columns per contribution scheme, and a TOTAL row.
+func (s *Service) ExportAnnualTaxReturnCSV(ctx context.Context, claims *models.Claims, year, startMonth int) (*FileExport, error) {
+	summary, err := s.payroll.GetAnnualTaxSummary(ctx, year, startMonth)
+	if err != nil {
+		return nil, err
+	}
+	brand, err := s.resolveBranding(ctx, claims)
+	if err != nil {
+		return nil, err
+	}
+	amount := func(value money.Amount) string {
+		return value.Format(brand.Decimals, "")
+	}
+
+	header := []string{"Employer", "Period From", "Period To", "Employee ID", "Employee Name", "National ID", "Months", "Gross Pay", "Taxable Pay", "PAYE"}
+	for _, scheme := range summary.Schemes {
+		header = append(header, scheme.Code+" Employee", scheme.Code+" Employer")
+	}
+	header = append(header, "Net Pay")
+
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	if err := writer.Write(header); err != nil {
+		return nil, fmt.Errorf("write tax return header: %w", err)
+	}
+	schemeTotals := make([]money.Amount, 2*len(summary.Schemes))
+	for _, employee := range summary.Employees {
+		nationalID := ""
+		if employee.NationalID != nil {
+			nationalID = *employee.NationalID
+		}
+		row := []string{
+			brand.CompanyName,
+			summary.FromMonth,
+			summary.ToMonth,
+			strconv.FormatInt(employee.EmployeeID, 10),
+			employee.EmployeeName,
+			nationalID,
+			strconv.Itoa(employee.Months),
+			amount(employee.GrossPay),
+			amount(employee.TaxablePay),
+			amount(employee.TaxTotal),
+		}
+		for i, contribution := range employee.Contributions {
+			row = append(row, amount(contribution.EmployeeAmount), amount(contribution.EmployerAmount))
+			schemeTotals[2*i] += contribution.EmployeeAmount
+			schemeTotals[2*i+1] += contribution.EmployerAmount
+		}
+		row = append(row, amount(employee.NetPay))
+		if err := writer.Write(row); err != nil {
+			return nil, fmt.Errorf("write tax return row: %w", err)
+		}
+	}
+	total := []string{"TOTAL", summary.FromMonth, summary.ToMonth, "", fmt.Sprintf("%d employees", len(summary.Employees)), "", "",
+		amount(summary.GrossPay), amount(summary.TaxablePay), amount(summary.TaxTotal)}
+	for _, value := range schemeTotals {
+		total = append(total, amount(value))
+	}
+	total = append(total, amount(summary.NetPay))
+	if err := writer.Write(total); err != nil {
+		return nil, fmt.Errorf("write tax return total: %w", err)
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return nil, fmt.Errorf("flush tax return csv: %w", err)
+	}
+
+	s.audit.RecordAuditEvent(ctx, nil, "payroll.tax_return.export", nil, nil, map[string]any{
+		"period":    summary.Label,
+		"employees": len(summary.Employees),
+	})
+	return &FileExport{
+		Filename: fmt.Sprintf("tax_return_%s.csv", periodSlug(summary)),
+		Data:     buf.Bytes(),
+		MimeType: "text/csv",
+	}, nil
+}
+
+// renderTaxCertificate lays out an A4 certificate of the year's earnings, PAYE and contributions of one
+// employee under the company header.
+func renderTaxCertificate(brand branding, summary *payroll.AnnualTaxSummary, employee payroll.AnnualEmployeeTax) ([]byte, error) {
+	pdf := fpdf.New("P", "mm", "A4", "")
+	pdf.SetMargins(pageMargin, pageMargin, pageMargin)
+	pdf.SetAutoPageBreak(true, pageMargin)
+	pdf.SetTitle(fmt.Sprintf("Tax certificate %s - %s", summary.Label, employee.EmployeeName), true)
+	pdf.SetCreator(brand.CompanyName, true)
+	pdf.AddPage()
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+	amount := func(value money.Amount) string {
+		return value.FormatGrouped(brand.Decimals, brand.Symbol)
+	}
+
+	writeCompanyHeader(pdf, brand, tr)
+
+	pdf.SetFont("Helvetica", "B", 13)
+	pdf.CellFormat(0, 8, "ANNUAL TAX AND EARNINGS CERTIFICATE - "+summary.Label, "", 1, "C", false, 0, "")
+	pdf.Ln(2)
+	pdf.SetFont("Helvetica", "", 10)
+	writePair(pdf, "Employer", tr(brand.CompanyName))
+	writePair(pdf, "Employee", tr(employee.EmployeeName))
+	writePair(pdf, "Employee ID", strconv.FormatInt(employee.EmployeeID, 10))
+	if employee.NationalID != nil && strings.TrimSpace(*employee.NationalID) != "" {
+		writePair(pdf, "National ID", tr(*employee.NationalID))
+	}
+	writePair(pdf, "Period", fmt.Sprintf("%s to %s", formatPeriod(summary.FromMonth), formatPeriod(summary.ToMonth)))
+	writePair(pdf, "Months paid", strconv.Itoa(employee.Months))
+	pdf.Ln(4)
+
+	writeSectionHeader(pdf, "Earnings and tax")
+	writeAmountRow(pdf, "Gross pay", amount(employee.GrossPay), false)
+	writeAmountRow(pdf, "Taxable pay", amount(employee.TaxablePay), false)
+	writeAmountRow(pdf, "PAYE deducted", amount(employee.TaxTotal), true)
+	pdf.Ln(3)
+
+	if len(employee.Contributions) > 0 {
+		writeSectionHeader(pdf, "Contributions")
+		for _, contribution := range employee.Contributions {
+			writeAmountRow(pdf, tr(contribution.Name)+" - employee", amount(contribution.EmployeeAmount), false)
+			writeAmountRow(pdf, tr(contribution.Name)+" - employer", amount(contribution.EmployerAmount), false)
+		}
+		pdf.Ln(3)
+	}
+
+	pdf.SetFillColor(230, 240, 250)
+	pdf.SetFont("Helvetica", "B", 12)
+	pdf.CellFormat(labelWidth, 9, "NET PAY", "1", 0, "L", true, 0, "")
+	pdf.CellFormat(amountWidth, 9, amount(employee.NetPay), "1", 1, "R", true, 0, "")
+	pdf.Ln(4)
+
+	pdf.SetFont("Helvetica", "I", 8)
+	pdf.SetTextColor(110, 110, 110)
+	pdf.MultiCell(0, 5, "Totals of locked payroll batches, net of reversals. Computer-generated certificate. Generated "+
+		time.Now().Format("2006-01-02 15:04"), "", "L", false)
+
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		return nil, fmt.Errorf("render tax certificate pdf: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func taxCertificateFilename(summary *payroll.AnnualTaxSummary, employee payroll.AnnualEmployeeTax) string {
+	name := strings.Trim(filenameSanitizer.ReplaceAllString(strings.ToLower(employee.EmployeeName), "-"), "-")
+	if name == "" {
+		return fmt.Sprintf("tax_certificate_%s_%d.pdf", periodSlug(summary), employee.EmployeeID)
+	}
+	return fmt.Sprintf("tax_certificate_%s_%d_%s.pdf", periodSlug(summary), employee.EmployeeID, name)
+}
+
+// periodSlug turns a fiscal year label such as 2025/26 into 2025-26 for filenames.
+func periodSlug(summary *payroll.AnnualTaxSummary) string {
+	return strings.ReplaceAll(summary.Label, "/", "-")
+}
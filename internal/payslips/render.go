@@ -0,0 +1,229 @@
+package payslips
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"hrpro/internal/money"
+	"hrpro/internal/payroll"
+
+	"github.com/go-pdf/fpdf"
+)
+
+const (
+	pageMargin  = 15.0
+	rowHeight   = 7.0
+	labelWidth  = 120.0
+	amountWidth = 60.0
+)
+
+// renderPayslip lays out a single A4 payslip: company header, employee block, earnings,
+// deductions, net pay and the employer contributions that are paid on top of net pay.
+func renderPayslip(brand branding, batch payroll.PayrollBatch, entry payroll.PayrollEntry) ([]byte, error) {
+	pdf := fpdf.New("P", "mm", "A4", "")
+	pdf.SetMargins(pageMargin, pageMargin, pageMargin)
+	pdf.SetAutoPageBreak(true, pageMargin)
+	pdf.SetTitle(fmt.Sprintf("Payslip %s - %s", batch.Month, entry.EmployeeName), true)
+	pdf.SetCreator(brand.CompanyName, true)
+	pdf.AddPage()
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+	amount := func(value money.Amount) string {
+		return value.FormatGrouped(brand.Decimals, brand.Symbol)
+	}
+
+	writeCompanyHeader(pdf, brand, tr)
+
+	// Title and employee block.
+	pdf.SetFont("Helvetica", "B", 13)
+	title := "PAYSLIP - " + formatPeriod(batch.Month)
+	if isOffCycle(batch) {
+		title = strings.ToUpper(batch.BatchType) + " " + title
+	}
+	pdf.CellFormat(0, 8, title, "", 1, "C", false, 0, "")
+	pdf.Ln(2)
+	pdf.SetFont("Helvetica", "", 10)
+	writePair(pdf, "Employee", tr(entry.EmployeeName))
+	writePair(pdf, "Employee ID", strconv.FormatInt(entry.EmployeeID, 10))
+	writePair(pdf, "Pay period", batch.Month)
+	writePair(pdf, "Batch", fmt.Sprintf("#%d (%s)", batch.ID, batch.Status))
+	pdf.Ln(4)
+
+	earnings, deductions, employerCosts := splitLines(entry)
+
+	// Earnings.
+	writeSectionHeader(pdf, "Earnings")
+	writeAmountRow(pdf, basicSalaryLabel(entry), amount(entry.BaseSalary), false)
+	if entry.FullBaseSalary != nil {
+		pdf.SetFont("Helvetica", "I", 8)
+		pdf.CellFormat(0, 5, "Pro-rated from monthly salary of "+amount(*entry.FullBaseSalary), "", 1, "L", false, 0, "")
+	}
+	for _, line := range earnings {
+		writeAmountRow(pdf, tr(line.Name), amount(line.Amount), false)
+	}
+	if len(earnings) == 0 && entry.AllowancesTotal != 0 {
+		writeAmountRow(pdf, "Allowances", amount(entry.AllowancesTotal), false)
+	}
+	writeAmountRow(pdf, "Gross pay", amount(entry.GrossPay), true)
+	pdf.Ln(3)
+
+	// Deductions.
+	writeSectionHeader(pdf, "Deductions")
+	for _, line := range deductions {
+		writeAmountRow(pdf, tr(lineLabel(line)), amount(line.Amount), false)
+	}
+	if len(deductions) == 0 && entry.DeductionsTotal != 0 {
+		writeAmountRow(pdf, "Deductions", amount(entry.DeductionsTotal), false)
+	}
+	writeAmountRow(pdf, "PAYE tax", amount(entry.TaxTotal), false)
+	writeAmountRow(pdf, "Total deductions", amount(entry.DeductionsTotal+entry.TaxTotal), true)
+	pdf.Ln(3)
+
+	// Net pay.
+	pdf.SetFillColor(230, 240, 250)
+	pdf.SetFont("Helvetica", "B", 12)
+	pdf.CellFormat(labelWidth, 9, "NET PAY", "1", 0, "L", true, 0, "")
+	pdf.CellFormat(amountWidth, 9, amount(entry.NetPay), "1", 1, "R", true, 0, "")
+	pdf.Ln(4)
+
+	// Employer contributions are informational; they are not deducted from the employee.
+	if len(employerCosts) > 0 {
+		writeSectionHeader(pdf, "Employer contributions (not deducted)")
+		for _, line := range employerCosts {
+			writeAmountRow(pdf, tr(line.Name), amount(line.EmployerAmount), false)
+		}
+		pdf.Ln(3)
+	}
+
+	pdf.SetFont("Helvetica", "I", 8)
+	pdf.SetTextColor(110, 110, 110)
+	pdf.CellFormat(0, 5, "Computer-generated payslip. Generated "+time.Now().Format("2006-01-02 15:04"), "", 1, "L", false, 0, "")
+
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		return nil, fmt.Errorf("render payslip pdf: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// basicSalaryLabel explains a pro-rated basic salary with the days paid, e.g. "Basic salary (14 of 23 working days)".
+func basicSalaryLabel(entry payroll.PayrollEntry) string {
+	if entry.PayableDays == nil || entry.PeriodDays == nil {
+		return "Basic salary"
+	}
+	unit := "working days"
+	if entry.ProrationBasis != nil && *entry.ProrationBasis == payroll.ProrationCalendarDays {
+		unit = "calendar days"
+	}
+	return fmt.Sprintf("Basic salary (%d of %d %s)", *entry.PayableDays, *entry.PeriodDays, unit)
+}
+
+// lineLabel adds the day count to unpaid leave and absence deductions, e.g. "Unpaid leave (2.5 days)".
+func lineLabel(line payroll.PayrollEntryLine) string {
+	if line.Quantity == nil {
+		return line.Name
+	}
+	unit := "days"
+	if *line.Quantity == 1 {
+		unit = "day"
+	}
+	return fmt.Sprintf("%s (%s %s)", line.Name, strconv.FormatFloat(*line.Quantity, 'f', -1, 64), unit)
+}
+
+func splitLines(entry payroll.PayrollEntry) (earnings []payroll.PayrollEntryLine, deductions []payroll.PayrollEntryLine, employerCosts []payroll.PayrollEntryLine) {
+	for _, line := range entry.Lines {
+		switch line.Kind {
+		case payroll.ComponentKindEarning:
+			earnings = append(earnings, line)
+		case payroll.ComponentKindDeduction:
+			deductions = append(deductions, line)
+		}
+		if line.EmployerAmount > 0 {
+			employerCosts = append(employerCosts, line)
+		}
+	}
+	return earnings, deductions, employerCosts
+}
+
+// writeCompanyHeader draws the logo, company name and contact line at the top of the page, followed by a rule.
+func writeCompanyHeader(pdf *fpdf.Fpdf, brand branding, tr func(string) string) {
+	textX := pageMargin
+	if imageType := logoImageType(brand); imageType != "" {
+		options := fpdf.ImageOptions{ImageType: imageType, ReadDpi: true}
+		pdf.RegisterImageOptionsReader("company-logo", options, bytes.NewReader(brand.Logo.Data))
+		if pdf.Ok() {
+			pdf.ImageOptions("company-logo", pageMargin, pageMargin, 0, 18, false, options, 0, "")
+			textX = pageMargin + 32
+		} else {
+			pdf.ClearError()
+		}
+	}
+	pdf.SetXY(textX, pageMargin)
+	pdf.SetFont("Helvetica", "B", 16)
+	pdf.CellFormat(0, 8, tr(brand.CompanyName), "", 1, "L", false, 0, "")
+	pdf.SetX(textX)
+	pdf.SetFont("Helvetica", "", 9)
+	pdf.CellFormat(0, 5, tr(contactLine(brand)), "", 1, "L", false, 0, "")
+	pdf.SetY(pageMargin + 22)
+	pdf.SetDrawColor(180, 180, 180)
+	pdf.Line(pageMargin, pdf.GetY(), 210-pageMargin, pdf.GetY())
+	pdf.Ln(4)
+}
+
+func writeSectionHeader(pdf *fpdf.Fpdf, title string) {
+	pdf.SetFont("Helvetica", "B", 10)
+	pdf.SetFillColor(240, 240, 240)
+	pdf.CellFormat(labelWidth+amountWidth, rowHeight, title, "1", 1, "L", true, 0, "")
+}
+
+func writeAmountRow(pdf *fpdf.Fpdf, label string, amount string, bold bool) {
+	style := ""
+	if bold {
+		style = "B"
+	}
+	pdf.SetFont("Helvetica", style, 10)
+	pdf.CellFormat(labelWidth, rowHeight, label, "LB", 0, "L", false, 0, "")
+	pdf.CellFormat(amountWidth, rowHeight, amount, "RB", 1, "R", false, 0, "")
+}
+
+func writePair(pdf *fpdf.Fpdf, label string, value string) {
+	pdf.SetFont("Helvetica", "B", 10)
+	pdf.CellFormat(35, 6, label+":", "", 0, "L", false, 0, "")
+	pdf.SetFont("Helvetica", "", 10)
+	pdf.CellFormat(0, 6, value, "", 1, "L", false, 0, "")
+}
+
+func logoImageType(brand branding) string {
+	if brand.Logo == nil || len(brand.Logo.Data) == 0 {
+		return ""
+	}
+	switch strings.ToLower(strings.TrimSpace(brand.Logo.MimeType)) {
+	case "image/png":
+		return "PNG"
+	case "image/jpeg":
+		return "JPG"
+	default:
+		// WebP logos cannot be embedded by the PDF writer; the header falls back to text only.
+		return ""
+	}
+}
+
+func contactLine(brand branding) string {
+	parts := make([]string, 0, 3)
+	for _, value := range []string{brand.SupportEmail, brand.SupportPhone, brand.SupportWebsite} {
+		if trimmed := strings.TrimSpace(value); trimmed != "" {
+			parts = append(parts, trimmed)
+		}
+	}
+	return strings.Join(parts, "  |  ")
+}
+
+func formatPeriod(month string) string {
+	parsed, err := time.Parse("2006-01", month)
+	if err != nil {
+		return month
+	}
+	return parsed.Format("January 2006")
+}
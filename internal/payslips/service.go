@@ -0,0 +1,180 @@
+package payslips
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"hrpro/internal/audit"
+	"hrpro/internal/models"
+	"hrpro/internal/payroll"
+	"hrpro/internal/settings"
+)
+
+var filenameSanitizer = regexp.MustCompile(`[^a-z0-9]+`)
+
+type Service struct {
+	payroll  PayrollReader
+	branding BrandingProvider
+	audit    audit.Recorder
+}
+
+func NewService(payrollReader PayrollReader, brandingProvider BrandingProvider) *Service {
+	return &Service{payroll: payrollReader, branding: brandingProvider, audit: audit.NewNoopRecorder()}
+}
+
+func (s *Service) SetAuditRecorder(recorder audit.Recorder) {
+	if recorder == nil {
+		s.audit = audit.NewNoopRecorder()
+		return
+	}
+	s.audit = recorder
+}
+
+// RenderPayslipPDF renders the payslip of a single entry of an approved or locked batch.
+func (s *Service) RenderPayslipPDF(ctx context.Context, claims *models.Claims, entryID int64) (*FileExport, error) {
+	entry, err := s.payroll.GetPayrollEntry(ctx, entryID)
+	if err != nil {
+		return nil, err
+	}
+	detail, err := s.payroll.GetPayrollBatch(ctx, entry.BatchID)
+	if err != nil {
+		return nil, err
+	}
+	if !isReleasable(detail.Batch) {
+		return nil, payroll.ErrExportNotAllowed
+	}
+
+	brand, err := s.resolveBranding(ctx, claims)
+	if err != nil {
+		return nil, err
+	}
+	data, err := renderPayslip(brand, detail.Batch, *entry)
+	if err != nil {
+		return nil, err
+	}
+
+	s.audit.RecordAuditEvent(ctx, nil, "payroll.payslip.render", stringPtr("payroll_entry"), &entry.ID, map[string]any{
+		"batch_id":    entry.BatchID,
+		"employee_id": entry.EmployeeID,
+	})
+	return &FileExport{
+		Filename: payslipFilename(detail.Batch, *entry),
+		Data:     data,
+		MimeType: "application/pdf",
+	}, nil
+}
+
+// RenderBatchPayslipsZip renders every payslip of an approved or locked batch into one zip archive.
+func (s *Service) RenderBatchPayslipsZip(ctx context.Context, claims *models.Claims, batchID int64) (*FileExport, error) {
+	detail, err := s.payroll.GetPayrollBatch(ctx, batchID)
+	if err != nil {
+		return nil, err
+	}
+	if !isReleasable(detail.Batch) {
+		return nil, payroll.ErrExportNotAllowed
+	}
+	if len(detail.Entries) == 0 {
+		return nil, fmt.Errorf("%w: batch has no entries", payroll.ErrValidation)
+	}
+
+	brand, err := s.resolveBranding(ctx, claims)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+	for _, entry := range detail.Entries {
+		data, err := renderPayslip(brand, detail.Batch, entry)
+		if err != nil {
+			return nil, err
+		}
+		file, err := archive.Create(payslipFilename(detail.Batch, entry))
+		if err != nil {
+			return nil, fmt.Errorf("add payslip to archive: %w", err)
+		}
+		if _, err := file.Write(data); err != nil {
+			return nil, fmt.Errorf("write payslip to archive: %w", err)
+		}
+	}
+	if err := archive.Close(); err != nil {
+		return nil, fmt.Errorf("close payslip archive: %w", err)
+	}
+
+	s.audit.RecordAuditEvent(ctx, nil, "payroll.payslip.render_batch", stringPtr("payroll_batch"), &detail.Batch.ID, map[string]any{
+		"month":    detail.Batch.Month,
+		"payslips": len(detail.Entries),
+	})
+	return &FileExport{
+		Filename: fmt.Sprintf("payslips_%s.zip", batchPeriod(detail.Batch)),
+		Data:     buf.Bytes(),
+		MimeType: "application/zip",
+	}, nil
+}
+
+func (s *Service) resolveBranding(ctx context.Context, claims *models.Claims) (branding, error) {
+	result := branding{CompanyName: settings.DefaultCompanyName, Decimals: 2}
+	if s.branding == nil {
+		return result, nil
+	}
+
+	profile, err := s.branding.GetCompanyProfile(ctx, claims)
+	if err != nil {
+		return branding{}, err
+	}
+	if strings.TrimSpace(profile.Name) != "" {
+		result.CompanyName = profile.Name
+	}
+	result.SupportEmail = profile.SupportEmail
+	result.SupportPhone = profile.SupportPhone
+	result.SupportWebsite = profile.SupportWebsite
+
+	logo, err := s.branding.GetCompanyLogo(ctx, claims)
+	switch {
+	case err == nil:
+		result.Logo = logo
+	case errors.Is(err, settings.ErrNotFound):
+	default:
+		return branding{}, err
+	}
+
+	if symbol, decimals, _, err := s.branding.GetPayrollFormatting(ctx); err == nil {
+		result.Symbol = symbol
+		result.Decimals = decimals
+	}
+	return result, nil
+}
+
+func isReleasable(batch payroll.PayrollBatch) bool {
+	return batch.Status == payroll.StatusApproved || batch.Status == payroll.StatusLocked
+}
+
+func payslipFilename(batch payroll.PayrollBatch, entry payroll.PayrollEntry) string {
+	name := strings.Trim(filenameSanitizer.ReplaceAllString(strings.ToLower(entry.EmployeeName), "-"), "-")
+	if name == "" {
+		return fmt.Sprintf("payslip_%s_%d.pdf", batchPeriod(batch), entry.EmployeeID)
+	}
+	return fmt.Sprintf("payslip_%s_%d_%s.pdf", batchPeriod(batch), entry.EmployeeID, name)
+}
+
+// batchPeriod names files after the month, suffixed with the batch type for off-cycle batches so they do not
+// collide with the regular run of the month.
+func batchPeriod(batch payroll.PayrollBatch) string {
+	if isOffCycle(batch) {
+		return batch.Month + "_" + batch.BatchType
+	}
+	return batch.Month
+}
+
+func isOffCycle(batch payroll.PayrollBatch) bool {
+	return batch.BatchType != "" && batch.BatchType != payroll.BatchTypeRegular
+}
+
+func stringPtr(value string) *string {
+	return &value
+}
@@ -0,0 +1,260 @@
+package payslips
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/color"
+	"image/png"
+	"strconv"
+	"testing"
+
+	"hrpro/internal/models"
+	"hrpro/internal/money"
+	"hrpro/internal/payroll"
+	"hrpro/internal/settings"
+)
+
+type fakePayrollReader struct {
+	detail *payroll.PayrollBatchDetail
+	annual *payroll.AnnualTaxSummary
+}
+
+func (f *fakePayrollReader) GetPayrollBatch(_ context.Context, batchID int64) (*payroll.PayrollBatchDetail, error) {
+	if f.detail == nil || f.detail.Batch.ID != batchID {
+		return nil, payroll.ErrNotFound
+	}
+	return f.detail, nil
+}
+
+func (f *fakePayrollReader) GetPayrollEntry(_ context.Context, entryID int64) (*payroll.PayrollEntry, error) {
+	for _, entry := range f.detail.Entries {
+		if entry.ID == entryID {
+			copyEntry := entry
+			return &copyEntry, nil
+		}
+	}
+	return nil, payroll.ErrNotFound
+}
+
+func (f *fakePayrollReader) GetAnnualTaxSummary(_ context.Context, year, _ int) (*payroll.AnnualTaxSummary, error) {
+	if f.annual == nil || f.annual.Year != year {
+		return &payroll.AnnualTaxSummary{Year: year, Label: strconv.Itoa(year)}, nil
+	}
+	return f.annual, nil
+}
+
+type captureAuditRecorder struct {
+	actions []string
+}
+
+func (c *captureAuditRecorder) RecordAuditEvent(_ context.Context, _ *int64, action string, _ *string, _ *int64, _ map[string]any) {
+	c.actions = append(c.actions, action)
+}
+
+type fakeBranding struct {
+	logo *settings.CompanyLogo
+}
+
+func (f *fakeBranding) GetCompanyProfile(_ context.Context, _ *models.Claims) (*settings.CompanyProfileDTO, error) {
+	return &settings.CompanyProfileDTO{Name: "HISP Uganda", SupportEmail: "hr@example.org"}, nil
+}
+
+func (f *fakeBranding) GetCompanyLogo(_ context.Context, _ *models.Claims) (*settings.CompanyLogo, error) {
+	if f.logo == nil {
+		return nil, settings.ErrNotFound
+	}
+	return f.logo, nil
+}
+
+func (f *fakeBranding) GetPayrollFormatting(_ context.Context) (string, int, bool, error) {
+	return "UGX", 0, true, nil
+}
+
+func newTestDetail(status string) *payroll.PayrollBatchDetail {
+	return &payroll.PayrollBatchDetail{
+		Batch: payroll.PayrollBatch{ID: 3, Month: "2025-07", Status: status},
+		Entries: []payroll.PayrollEntry{
+			{
+				ID: 30, BatchID: 3, EmployeeID: 7, EmployeeName: "Jane Nakato", BaseSalary: 1000000,
+				AllowancesTotal: 200000, DeductionsTotal: 60000, TaxTotal: 262000, GrossPay: 1200000, NetPay: 878000,
+				Lines: []payroll.PayrollEntryLine{
+					{Code: "HOUSING", Name: "Housing Allowance", Kind: payroll.ComponentKindEarning, Amount: 200000},
+					{Code: "NSSF", Name: "NSSF", Kind: payroll.ComponentKindDeduction, Amount: 60000, EmployerAmount: 120000},
+				},
+			},
+			{ID: 31, BatchID: 3, EmployeeID: 8, EmployeeName: "John Okello", BaseSalary: 500000, GrossPay: 500000, NetPay: 500000},
+		},
+	}
+}
+
+func testLogo(t *testing.T) *settings.CompanyLogo {
+	t.Helper()
+	img := image.NewRGBA(image.Rect(0, 0, 4, 4))
+	img.Set(1, 1, color.RGBA{R: 200, A: 255})
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatalf("encode logo: %v", err)
+	}
+	return &settings.CompanyLogo{Filename: "logo.png", MimeType: "image/png", Data: buf.Bytes()}
+}
+
+func TestRenderPayslipPDFForApprovedBatch(t *testing.T) {
+	svc := NewService(&fakePayrollReader{detail: newTestDetail(payroll.StatusApproved)}, &fakeBranding{logo: testLogo(t)})
+
+	export, err := svc.RenderPayslipPDF(context.Background(), &models.Claims{UserID: 1}, 30)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if export.MimeType != "application/pdf" || export.Filename != "payslip_2025-07_7_jane-nakato.pdf" {
+		t.Fatalf("unexpected export metadata %q %q", export.Filename, export.MimeType)
+	}
+	if !bytes.HasPrefix(export.Data, []byte("%PDF-")) {
+		t.Fatalf("expected PDF output")
+	}
+}
+
+func TestRenderPayslipRequiresApprovedOrLockedBatch(t *testing.T) {
+	svc := NewService(&fakePayrollReader{detail: newTestDetail(payroll.StatusDraft)}, &fakeBranding{})
+
+	if _, err := svc.RenderPayslipPDF(context.Background(), &models.Claims{UserID: 1}, 30); !errors.Is(err, payroll.ErrExportNotAllowed) {
+		t.Fatalf("expected ErrExportNotAllowed, got %v", err)
+	}
+	if _, err := svc.RenderBatchPayslipsZip(context.Background(), &models.Claims{UserID: 1}, 3); !errors.Is(err, payroll.ErrExportNotAllowed) {
+		t.Fatalf("expected ErrExportNotAllowed, got %v", err)
+	}
+}
+
+func TestRenderBatchPayslipsZipContainsOnePDFPerEntry(t *testing.T) {
+	svc := NewService(&fakePayrollReader{detail: newTestDetail(payroll.StatusLocked)}, &fakeBranding{})
+
+	export, err := svc.RenderBatchPayslipsZip(context.Background(), &models.Claims{UserID: 1}, 3)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if export.Filename != "payslips_2025-07.zip" || export.MimeType != "application/zip" {
+		t.Fatalf("unexpected export metadata %q %q", export.Filename, export.MimeType)
+	}
+
+	reader, err := zip.NewReader(bytes.NewReader(export.Data), int64(len(export.Data)))
+	if err != nil {
+		t.Fatalf("expected valid zip, got %v", err)
+	}
+	if len(reader.File) != 2 {
+		t.Fatalf("expected 2 payslips, got %d", len(reader.File))
+	}
+	if reader.File[1].Name != "payslip_2025-07_8_john-okello.pdf" {
+		t.Fatalf("unexpected payslip name %q", reader.File[1].Name)
+	}
+}
+
+func TestBasicSalaryLabelExplainsProration(t *testing.T) {
+	if label := basicSalaryLabel(payroll.PayrollEntry{}); label != "Basic salary" {
+		t.Fatalf("expected plain label, got %q", label)
+	}
+	basis, payable, period := payroll.ProrationWorkingDays, 14, 23
+	entry := payroll.PayrollEntry{ProrationBasis: &basis, PayableDays: &payable, PeriodDays: &period}
+	if label := basicSalaryLabel(entry); label != "Basic salary (14 of 23 working days)" {
+		t.Fatalf("unexpected label %q", label)
+	}
+}
+
+func TestLineLabelShowsAbsenceDays(t *testing.T) {
+	days := 2.5
+	if label := lineLabel(payroll.PayrollEntryLine{Name: "Unpaid leave", Quantity: &days}); label != "Unpaid leave (2.5 days)" {
+		t.Fatalf("unexpected label %q", label)
+	}
+	if label := lineLabel(payroll.PayrollEntryLine{Name: "NSSF"}); label != "NSSF" {
+		t.Fatalf("unexpected label %q", label)
+	}
+}
+
+func TestPayslipFilenameSuffixesOffCycleBatches(t *testing.T) {
+	entry := payroll.PayrollEntry{EmployeeID: 7, EmployeeName: "Jane Doe"}
+	if name := payslipFilename(payroll.PayrollBatch{Month: "2025-12", BatchType: payroll.BatchTypeRegular}, entry); name != "payslip_2025-12_7_jane-doe.pdf" {
+		t.Fatalf("unexpected regular payslip filename %q", name)
+	}
+	if name := payslipFilename(payroll.PayrollBatch{Month: "2025-12", BatchType: payroll.BatchTypeBonus}, entry); name != "payslip_2025-12_bonus_7_jane-doe.pdf" {
+		t.Fatalf("unexpected bonus payslip filename %q", name)
+	}
+}
+
+func newTestAnnualSummary() *payroll.AnnualTaxSummary {
+	nationalID := "CM90001"
+	nssf := []payroll.AnnualContribution{{SchemeID: 3, Code: "NSSF", Name: "NSSF", EmployeeAmount: money.FromUnits(60000), EmployerAmount: money.FromUnits(120000)}}
+	return &payroll.AnnualTaxSummary{
+		Year: 2025, StartMonth: 7, Label: "2025/26", FromMonth: "2025-07", ToMonth: "2026-06",
+		Schemes: []payroll.AnnualSchemeColumn{{SchemeID: 3, Code: "NSSF", Name: "NSSF"}},
+		Employees: []payroll.AnnualEmployeeTax{
+			{
+				EmployeeID: 7, EmployeeName: "Jane Nakato", NationalID: &nationalID, Months: 12,
+				GrossPay: money.FromUnits(14400000), TaxablePay: money.FromUnits(14400000), TaxTotal: money.FromUnits(3144000),
+				NetPay: money.FromUnits(10536000), Contributions: nssf,
+			},
+			{
+				EmployeeID: 8, EmployeeName: "John Okello", Months: 2, GrossPay: money.FromUnits(1000000), TaxablePay: money.FromUnits(1000000),
+				NetPay: money.FromUnits(1000000), Contributions: []payroll.AnnualContribution{{SchemeID: 3, Code: "NSSF", Name: "NSSF"}},
+			},
+		},
+		GrossPay: money.FromUnits(15400000), TaxablePay: money.FromUnits(15400000), TaxTotal: money.FromUnits(3144000), NetPay: money.FromUnits(11536000),
+	}
+}
+
+func TestRenderTaxCertificates(t *testing.T) {
+	audit := &captureAuditRecorder{}
+	svc := NewService(&fakePayrollReader{annual: newTestAnnualSummary()}, &fakeBranding{logo: testLogo(t)})
+	svc.SetAuditRecorder(audit)
+
+	export, err := svc.RenderTaxCertificatePDF(context.Background(), &models.Claims{UserID: 1}, 2025, 7, 7)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if export.MimeType != "application/pdf" || export.Filename != "tax_certificate_2025-26_7_jane-nakato.pdf" {
+		t.Fatalf("unexpected export metadata %q %q", export.Filename, export.MimeType)
+	}
+	if !bytes.HasPrefix(export.Data, []byte("%PDF-")) {
+		t.Fatalf("expected PDF output")
+	}
+	if _, err := svc.RenderTaxCertificatePDF(context.Background(), &models.Claims{UserID: 1}, 2025, 7, 99); !errors.Is(err, payroll.ErrNotFound) {
+		t.Fatalf("expected ErrNotFound for an employee without payroll, got %v", err)
+	}
+
+	archive, err := svc.RenderTaxCertificatesZip(context.Background(), &models.Claims{UserID: 1}, 2025, 7)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	reader, err := zip.NewReader(bytes.NewReader(archive.Data), int64(len(archive.Data)))
+	if err != nil {
+		t.Fatalf("expected valid zip, got %v", err)
+	}
+	if archive.Filename != "tax_certificates_2025-26.zip" || len(reader.File) != 2 {
+		t.Fatalf("unexpected archive %q with %d files", archive.Filename, len(reader.File))
+	}
+	if _, err := svc.RenderTaxCertificatesZip(context.Background(), &models.Claims{UserID: 1}, 2024, 1); !errors.Is(err, payroll.ErrValidation) {
+		t.Fatalf("expected ErrValidation for a year without payroll, got %v", err)
+	}
+	if len(audit.actions) != 2 || audit.actions[0] != "payroll.tax_certificate.render" || audit.actions[1] != "payroll.tax_certificate.render_batch" {
+		t.Fatalf("unexpected audit actions %v", audit.actions)
+	}
+}
+
+func TestExportAnnualTaxReturnCSV(t *testing.T) {
+	svc := NewService(&fakePayrollReader{annual: newTestAnnualSummary()}, &fakeBranding{})
+
+	export, err := svc.ExportAnnualTaxReturnCSV(context.Background(), &models.Claims{UserID: 1}, 2025, 7)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if export.Filename != "tax_return_2025-26.csv" || export.MimeType != "text/csv" {
+		t.Fatalf("unexpected export metadata %q %q", export.Filename, export.MimeType)
+	}
+	expected := "Employer,Period From,Period To,Employee ID,Employee Name,National ID,Months,Gross Pay,Taxable Pay,PAYE,NSSF Employee,NSSF Employer,Net Pay\n" +
+		"HISP Uganda,2025-07,2026-06,7,Jane Nakato,CM90001,12,14400000,14400000,3144000,60000,120000,10536000\n" +
+		"HISP Uganda,2025-07,2026-06,8,John Okello,,2,1000000,1000000,0,0,0,1000000\n" +
+		"TOTAL,2025-07,2026-06,,2 employees,,,15400000,15400000,3144000,60000,120000,11536000\n"
+	if string(export.Data) != expected {
+		t.Fatalf("unexpected tax return:\n%s", export.Data)
+	}
+}
@@ -0,0 +1,38 @@
+package payslips
+
+import (
+	"context"
+
+	"hrpro/internal/models"
+	"hrpro/internal/payroll"
+	"hrpro/internal/settings"
+)
+
+type FileExport struct {
+	Filename string `json:"filename"`
+	Data     []byte `json:"data"`
+	MimeType string `json:"mimeType"`
+}
+
+type PayrollReader interface {
+	GetPayrollBatch(ctx context.Context, batchID int64) (*payroll.PayrollBatchDetail, error)
+	GetPayrollEntry(ctx context.Context, entryID int64) (*payroll.PayrollEntry, error)
+	GetAnnualTaxSummary(ctx context.Context, year, startMonth int) (*payroll.AnnualTaxSummary, error)
+}
+
+type BrandingProvider interface {
+	GetCompanyProfile(ctx context.Context, claims *models.Claims) (*settings.CompanyProfileDTO, error)
+	GetCompanyLogo(ctx context.Context, claims *models.Claims) (*settings.CompanyLogo, error)
+	GetPayrollFormatting(ctx context.Context) (symbol string, decimals int, rounding bool, err error)
+}
+
+// branding is the resolved company header and money format shared by every payslip in a render.
+type branding struct {
+	CompanyName    string
+	SupportEmail   string
+	SupportPhone   string
+	SupportWebsite string
+	Logo           *settings.CompanyLogo
+	Symbol         string
+	Decimals       int
+}
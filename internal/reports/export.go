@@ -4,11 +4,13 @@ import (
 	"bytes"
 	"encoding/csv"
 	"fmt"
-	"math"
+	"sort"
 	"strings"
+
+	"hrpro/internal/money"
 )
 
-func exportEmployeeCSV(rows []EmployeeReportRow, symbol string, decimals int, rounding bool) (string, error) {
+func exportEmployeeCSV(rows []EmployeeReportRow, symbol string, decimals int) (string, error) {
 	buffer := &bytes.Buffer{}
 	writer := csv.NewWriter(buffer)
 
@@ -20,7 +22,7 @@ func exportEmployeeCSV(rows []EmployeeReportRow, symbol string, decimals int, ro
 	for _, row := range rows {
 		salary := ""
 		if row.BaseSalaryAmount != nil {
-			salary = formatCurrency(*row.BaseSalaryAmount, symbol, decimals, rounding)
+			salary = row.BaseSalaryAmount.Format(decimals, symbol)
 		}
 		record := []string{row.EmployeeName, row.DepartmentName, row.Position, row.JobDescription, row.Status, row.DateOfHire.Format("2006-01-02"), row.Phone, row.Email, salary}
 		if err := writer.Write(record); err != nil {
@@ -101,11 +103,16 @@ func exportAttendanceSummaryCSV(rows []AttendanceSummaryReportRow) (string, erro
 	return buffer.String(), nil
 }
 
-func exportPayrollBatchesCSV(rows []PayrollBatchesReportRow, symbol string, decimals int, rounding bool) (string, error) {
+func exportPayrollBatchesCSV(rows []PayrollBatchesReportRow, symbol string, decimals int) (string, error) {
 	buffer := &bytes.Buffer{}
 	writer := csv.NewWriter(buffer)
 
-	headers := []string{"month", "status", "created_at", "approved_at", "locked_at", "entries_count", "total_net_pay"}
+	components := collectPayrollComponentColumns(rows)
+	headers := []string{"month", "batch_type", "status", "created_at", "approved_at", "locked_at", "entries_count"}
+	for _, component := range components {
+		headers = append(headers, "component_"+strings.ToLower(component.Code))
+	}
+	headers = append(headers, "total_net_pay", "total_employer_contributions", "integrity_status")
 	if err := writer.Write(headers); err != nil {
 		return "", fmt.Errorf("write payroll report csv header: %w", err)
 	}
@@ -119,7 +126,19 @@ func exportPayrollBatchesCSV(rows []PayrollBatchesReportRow, symbol string, deci
 		if row.LockedAt != nil {
 			lockedAt = row.LockedAt.Format("2006-01-02")
 		}
-		record := []string{row.Month, row.Status, row.CreatedAt.Format("2006-01-02"), approvedAt, lockedAt, fmt.Sprintf("%d", row.EntriesCount), formatCurrency(row.TotalNetPay, symbol, decimals, rounding)}
+		record := []string{row.Month, row.BatchType, row.Status, row.CreatedAt.Format("2006-01-02"), approvedAt, lockedAt, fmt.Sprintf("%d", row.EntriesCount)}
+		totalsByCode := make(map[string]money.Amount, len(row.Components))
+		for _, component := range row.Components {
+			totalsByCode[component.Code] += component.Total
+		}
+		for _, component := range components {
+			record = append(record, totalsByCode[component.Code].Format(decimals, symbol))
+		}
+		record = append(record,
+			row.TotalNetPay.Format(decimals, symbol),
+			row.TotalEmployerContributions.Format(decimals, symbol),
+			row.IntegrityStatus,
+		)
 		if err := writer.Write(record); err != nil {
 			return "", fmt.Errorf("write payroll report csv row: %w", err)
 		}
@@ -133,23 +152,26 @@ func exportPayrollBatchesCSV(rows []PayrollBatchesReportRow, symbol string, deci
 	return buffer.String(), nil
 }
 
-func formatCurrency(value float64, symbol string, decimals int, rounding bool) string {
-	if decimals < 0 || decimals > 6 {
-		decimals = 2
-	}
-	if rounding {
-		factor := 1.0
-		for i := 0; i < decimals; i++ {
-			factor *= 10
+// collectPayrollComponentColumns lists the distinct line item codes across rows, earnings before deductions.
+func collectPayrollComponentColumns(rows []PayrollBatchesReportRow) []PayrollComponentTotal {
+	seen := make(map[string]bool)
+	columns := make([]PayrollComponentTotal, 0)
+	for _, row := range rows {
+		for _, component := range row.Components {
+			if seen[component.Code] {
+				continue
+			}
+			seen[component.Code] = true
+			columns = append(columns, PayrollComponentTotal{Code: component.Code, Name: component.Name, Kind: component.Kind})
 		}
-		value = math.Round(value*factor) / factor
 	}
-	amount := fmt.Sprintf("%.*f", decimals, value)
-	symbol = strings.TrimSpace(symbol)
-	if symbol == "" {
-		return amount
-	}
-	return symbol + " " + amount
+	sort.SliceStable(columns, func(i, j int) bool {
+		if columns[i].Kind != columns[j].Kind {
+			return columns[i].Kind == "earning"
+		}
+		return columns[i].Code < columns[j].Code
+	})
+	return columns
 }
 
 func exportAuditCSV(rows []AuditLogReportRow) (string, error) {
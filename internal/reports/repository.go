@@ -16,8 +16,8 @@ type Repository interface {
 	ListLeaveRequestsReport(ctx context.Context, filter LeaveRequestsFilter, dateFrom, dateTo time.Time, pager PagerInput) ([]LeaveRequestsReportRow, int64, int, int, error)
 	ListLeaveRequestsReportForExport(ctx context.Context, filter LeaveRequestsFilter, dateFrom, dateTo time.Time, maxRows int) ([]LeaveRequestsReportRow, int64, error)
 
-	ListAttendanceSummaryReport(ctx context.Context, filter AttendanceSummaryFilter, dateFrom, dateTo time.Time, totalDays int, pager PagerInput) ([]AttendanceSummaryReportRow, int64, int, int, error)
-	ListAttendanceSummaryReportForExport(ctx context.Context, filter AttendanceSummaryFilter, dateFrom, dateTo time.Time, totalDays int, maxRows int) ([]AttendanceSummaryReportRow, int64, error)
+	ListAttendanceSummaryReport(ctx context.Context, filter AttendanceSummaryFilter, dateFrom, dateTo time.Time, holidays []string, pager PagerInput) ([]AttendanceSummaryReportRow, int64, int, int, error)
+	ListAttendanceSummaryReportForExport(ctx context.Context, filter AttendanceSummaryFilter, dateFrom, dateTo time.Time, holidays []string, maxRows int) ([]AttendanceSummaryReportRow, int64, error)
 
 	ListPayrollBatchesReport(ctx context.Context, filter PayrollBatchesFilter, pager PagerInput) ([]PayrollBatchesReportRow, int64, int, int, error)
 	ListPayrollBatchesReportForExport(ctx context.Context, filter PayrollBatchesFilter, maxRows int) ([]PayrollBatchesReportRow, int64, error)
@@ -77,7 +77,7 @@ func (r *SQLXRepository) ListEmployeeReport(ctx context.Context, filter Employee
 			e.date_of_hire,
 			COALESCE(e.phone, '') AS phone,
 			COALESCE(e.email, '') AS email,
-			CAST(e.base_salary_amount AS DOUBLE PRECISION) AS base_salary_amount
+			e.base_salary_amount
 		FROM employees e
 		LEFT JOIN departments d ON d.id = e.department_id` + whereClause + `
 		ORDER BY LOWER(e.last_name) ASC, LOWER(e.first_name) ASC, e.id ASC
@@ -114,7 +114,7 @@ func (r *SQLXRepository) ListEmployeeReportForExport(ctx context.Context, filter
 			e.date_of_hire,
 			COALESCE(e.phone, '') AS phone,
 			COALESCE(e.email, '') AS email,
-			CAST(e.base_salary_amount AS DOUBLE PRECISION) AS base_salary_amount
+			e.base_salary_amount
 		FROM employees e
 		LEFT JOIN departments d ON d.id = e.department_id` + whereClause + `
 		ORDER BY LOWER(e.last_name) ASC, LOWER(e.first_name) ASC, e.id ASC
@@ -211,7 +211,7 @@ func (r *SQLXRepository) ListLeaveRequestsReportForExport(ctx context.Context, f
 	return rows, total, nil
 }
 
-func (r *SQLXRepository) ListAttendanceSummaryReport(ctx context.Context, filter AttendanceSummaryFilter, dateFrom, dateTo time.Time, totalDays int, pager PagerInput) ([]AttendanceSummaryReportRow, int64, int, int, error) {
+func (r *SQLXRepository) ListAttendanceSummaryReport(ctx context.Context, filter AttendanceSummaryFilter, dateFrom, dateTo time.Time, holidays []string, pager PagerInput) ([]AttendanceSummaryReportRow, int64, int, int, error) {
 	page, pageSize := normalizePager(pager)
 	whereClause, args := buildAttendanceEmployeeWhere(filter)
 
@@ -225,10 +225,10 @@ func (r *SQLXRepository) ListAttendanceSummaryReport(ctx context.Context, filter
 	listArgs := append([]any{}, args...)
 	fromPH := fmt.Sprintf("$%d", len(listArgs)+1)
 	toPH := fmt.Sprintf("$%d", len(listArgs)+2)
-	totalDaysPH := fmt.Sprintf("$%d", len(listArgs)+3)
+	holidaysPH := fmt.Sprintf("$%d", len(listArgs)+3)
 	limitPH := fmt.Sprintf("$%d", len(listArgs)+4)
 	offsetPH := fmt.Sprintf("$%d", len(listArgs)+5)
-	listArgs = append(listArgs, dateFrom, dateTo, totalDays, pageSize, offset)
+	listArgs = append(listArgs, dateFrom, dateTo, holidays, pageSize, offset)
 
 	query := `
 		SELECT
@@ -240,7 +240,7 @@ func (r *SQLXRepository) ListAttendanceSummaryReport(ctx context.Context, filter
 			COUNT(*) FILTER (WHERE ar.status = 'field')::INT AS field_count,
 			COUNT(*) FILTER (WHERE ar.status = 'absent')::INT AS absent_count,
 			COUNT(*) FILTER (WHERE ar.status = 'leave')::INT AS leave_count,
-			GREATEST(` + totalDaysPH + ` - COUNT(ar.id), 0)::INT AS unmarked_count
+			` + unmarkedDaysColumn(fromPH, toPH, holidaysPH) + `
 		FROM employees e
 		LEFT JOIN departments d ON d.id = e.department_id
 		LEFT JOIN attendance_records ar ON ar.employee_id = e.id
@@ -258,7 +258,7 @@ func (r *SQLXRepository) ListAttendanceSummaryReport(ctx context.Context, filter
 	return rows, total, page, pageSize, nil
 }
 
-func (r *SQLXRepository) ListAttendanceSummaryReportForExport(ctx context.Context, filter AttendanceSummaryFilter, dateFrom, dateTo time.Time, totalDays int, maxRows int) ([]AttendanceSummaryReportRow, int64, error) {
+func (r *SQLXRepository) ListAttendanceSummaryReportForExport(ctx context.Context, filter AttendanceSummaryFilter, dateFrom, dateTo time.Time, holidays []string, maxRows int) ([]AttendanceSummaryReportRow, int64, error) {
 	whereClause, args := buildAttendanceEmployeeWhere(filter)
 
 	countQuery := "SELECT COUNT(*) FROM employees e LEFT JOIN departments d ON d.id = e.department_id" + whereClause
@@ -270,9 +270,9 @@ func (r *SQLXRepository) ListAttendanceSummaryReportForExport(ctx context.Contex
 	queryArgs := append([]any{}, args...)
 	fromPH := fmt.Sprintf("$%d", len(queryArgs)+1)
 	toPH := fmt.Sprintf("$%d", len(queryArgs)+2)
-	totalDaysPH := fmt.Sprintf("$%d", len(queryArgs)+3)
+	holidaysPH := fmt.Sprintf("$%d", len(queryArgs)+3)
 	limitPH := fmt.Sprintf("$%d", len(queryArgs)+4)
-	queryArgs = append(queryArgs, dateFrom, dateTo, totalDays, maxRows)
+	queryArgs = append(queryArgs, dateFrom, dateTo, holidays, maxRows)
 
 	query := `
 		SELECT
@@ -284,7 +284,7 @@ func (r *SQLXRepository) ListAttendanceSummaryReportForExport(ctx context.Contex
 			COUNT(*) FILTER (WHERE ar.status = 'field')::INT AS field_count,
 			COUNT(*) FILTER (WHERE ar.status = 'absent')::INT AS absent_count,
 			COUNT(*) FILTER (WHERE ar.status = 'leave')::INT AS leave_count,
-			GREATEST(` + totalDaysPH + ` - COUNT(ar.id), 0)::INT AS unmarked_count
+			` + unmarkedDaysColumn(fromPH, toPH, holidaysPH) + `
 		FROM employees e
 		LEFT JOIN departments d ON d.id = e.department_id
 		LEFT JOIN attendance_records ar ON ar.employee_id = e.id
@@ -302,6 +302,31 @@ func (r *SQLXRepository) ListAttendanceSummaryReportForExport(ctx context.Contex
 	return rows, total, nil
 }
 
+// unmarkedDaysColumn counts the days in the range an employee is scheduled to work, under the schedule in effect
+// each day or the default schedule, that are not public holidays and have no attendance record.
+func unmarkedDaysColumn(fromPH, toPH, holidaysPH string) string {
+	return `(
+				SELECT COUNT(*)
+				FROM generate_series(` + fromPH + `::date, ` + toPH + `::date, INTERVAL '1 day') AS day
+				INNER JOIN work_schedule_days wsd ON wsd.weekday = EXTRACT(DOW FROM day)::INT
+					AND wsd.schedule_id = COALESCE(
+						(
+							SELECT ews.schedule_id
+							FROM employee_work_schedules ews
+							WHERE ews.employee_id = e.id AND ews.effective_from <= day::date
+							ORDER BY ews.effective_from DESC
+							LIMIT 1
+						),
+						(SELECT ws.id FROM work_schedules ws WHERE ws.is_default = TRUE LIMIT 1)
+					)
+				WHERE NOT (day::date = ANY(` + holidaysPH + `::date[]))
+					AND NOT EXISTS (
+						SELECT 1 FROM attendance_records marked
+						WHERE marked.employee_id = e.id AND marked.attendance_date = day::date
+					)
+			)::INT AS unmarked_count`
+}
+
 func (r *SQLXRepository) ListPayrollBatchesReport(ctx context.Context, filter PayrollBatchesFilter, pager PagerInput) ([]PayrollBatchesReportRow, int64, int, int, error) {
 	page, pageSize := normalizePager(pager)
 	whereClause, args := buildPayrollWhere(filter)
@@ -320,16 +345,19 @@ func (r *SQLXRepository) ListPayrollBatchesReport(ctx context.Context, filter Pa
 
 	query := `
 		SELECT
+			pb.id,
 			pb.month,
+			pb.batch_type,
 			pb.status,
 			pb.created_at,
 			pb.approved_at,
 			pb.locked_at,
 			COUNT(pe.id)::BIGINT AS entries_count,
-			COALESCE(SUM(CAST(pe.net_pay AS DOUBLE PRECISION)), 0)::DOUBLE PRECISION AS total_net_pay
+			COALESCE(SUM(pe.net_pay), 0) AS total_net_pay,
+			COALESCE(SUM(pe.employer_contributions_total), 0) AS total_employer_contributions
 		FROM payroll_batches pb
 		LEFT JOIN payroll_entries pe ON pe.batch_id = pb.id` + whereClause + `
-		GROUP BY pb.id, pb.month, pb.status, pb.created_at, pb.approved_at, pb.locked_at
+		GROUP BY pb.id, pb.month, pb.batch_type, pb.status, pb.created_at, pb.approved_at, pb.locked_at
 		ORDER BY pb.month DESC, pb.id DESC
 		LIMIT ` + limitPH + ` OFFSET ` + offsetPH
 
@@ -337,6 +365,9 @@ func (r *SQLXRepository) ListPayrollBatchesReport(ctx context.Context, filter Pa
 	if err := r.db.SelectContext(ctx, &rows, query, listArgs...); err != nil {
 		return nil, 0, 0, 0, fmt.Errorf("list payroll report rows: %w", err)
 	}
+	if err := r.attachPayrollComponentTotals(ctx, rows); err != nil {
+		return nil, 0, 0, 0, err
+	}
 
 	return rows, total, page, pageSize, nil
 }
@@ -356,16 +387,19 @@ func (r *SQLXRepository) ListPayrollBatchesReportForExport(ctx context.Context,
 
 	query := `
 		SELECT
+			pb.id,
 			pb.month,
+			pb.batch_type,
 			pb.status,
 			pb.created_at,
 			pb.approved_at,
 			pb.locked_at,
 			COUNT(pe.id)::BIGINT AS entries_count,
-			COALESCE(SUM(CAST(pe.net_pay AS DOUBLE PRECISION)), 0)::DOUBLE PRECISION AS total_net_pay
+			COALESCE(SUM(pe.net_pay), 0) AS total_net_pay,
+			COALESCE(SUM(pe.employer_contributions_total), 0) AS total_employer_contributions
 		FROM payroll_batches pb
 		LEFT JOIN payroll_entries pe ON pe.batch_id = pb.id` + whereClause + `
-		GROUP BY pb.id, pb.month, pb.status, pb.created_at, pb.approved_at, pb.locked_at
+		GROUP BY pb.id, pb.month, pb.batch_type, pb.status, pb.created_at, pb.approved_at, pb.locked_at
 		ORDER BY pb.month DESC, pb.id DESC
 		LIMIT ` + limitPH
 
@@ -373,10 +407,55 @@ func (r *SQLXRepository) ListPayrollBatchesReportForExport(ctx context.Context,
 	if err := r.db.SelectContext(ctx, &rows, query, queryArgs...); err != nil {
 		return nil, 0, fmt.Errorf("export payroll report rows: %w", err)
 	}
+	if err := r.attachPayrollComponentTotals(ctx, rows); err != nil {
+		return nil, 0, err
+	}
 
 	return rows, total, nil
 }
 
+func (r *SQLXRepository) attachPayrollComponentTotals(ctx context.Context, rows []PayrollBatchesReportRow) error {
+	if len(rows) == 0 {
+		return nil
+	}
+
+	batchIDs := make([]int64, 0, len(rows))
+	for _, row := range rows {
+		batchIDs = append(batchIDs, row.BatchID)
+	}
+
+	query := `
+		SELECT
+			pe.batch_id,
+			pel.code,
+			MAX(pel.name) AS name,
+			pel.kind,
+			COALESCE(SUM(pel.amount), 0) AS total
+		FROM payroll_entry_lines pel
+		INNER JOIN payroll_entries pe ON pe.id = pel.entry_id
+		WHERE pe.batch_id = ANY($1)
+		GROUP BY pe.batch_id, pel.code, pel.kind
+		ORDER BY pe.batch_id ASC, pel.kind ASC, name ASC
+	`
+
+	totals := make([]PayrollComponentTotal, 0)
+	if err := r.db.SelectContext(ctx, &totals, query, batchIDs); err != nil {
+		return fmt.Errorf("list payroll report component totals: %w", err)
+	}
+
+	byBatch := make(map[int64][]PayrollComponentTotal, len(rows))
+	for _, total := range totals {
+		byBatch[total.BatchID] = append(byBatch[total.BatchID], total)
+	}
+	for i := range rows {
+		rows[i].Components = byBatch[rows[i].BatchID]
+		if rows[i].Components == nil {
+			rows[i].Components = []PayrollComponentTotal{}
+		}
+	}
+	return nil
+}
+
 func (r *SQLXRepository) ListAuditLogReport(ctx context.Context, filter AuditLogFilter, dateFrom, dateTo time.Time, pager PagerInput) ([]AuditLogReportRow, int64, int, int, error) {
 	page, pageSize := normalizePager(pager)
 	whereClause, args := buildAuditWhere(filter, dateFrom, dateTo)
@@ -9,6 +9,7 @@ import (
 
 	"hrpro/internal/middleware"
 	"hrpro/internal/models"
+	"hrpro/internal/money"
 )
 
 const (
@@ -20,12 +21,24 @@ var monthPattern = regexp.MustCompile(`^\d{4}-\d{2}$`)
 type Service struct {
 	repository Repository
 	formatter  FormattingProvider
+	integrity  IntegrityProvider
+	holidays   HolidayProvider
 }
 
 type FormattingProvider interface {
 	GetPayrollFormatting(ctx context.Context) (symbol string, decimals int, rounding bool, err error)
 }
 
+// IntegrityProvider verifies locked payroll batches against the seal recorded when they were locked.
+type IntegrityProvider interface {
+	PayrollBatchIntegrityStatuses(ctx context.Context, batchIDs []int64) (map[int64]string, error)
+}
+
+// HolidayProvider supplies the public holidays, which are not counted as unmarked attendance days.
+type HolidayProvider interface {
+	HolidayDates(ctx context.Context, from, to time.Time) ([]time.Time, error)
+}
+
 func NewService(repository Repository) *Service {
 	return &Service{repository: repository}
 }
@@ -34,6 +47,14 @@ func (s *Service) SetFormattingProvider(provider FormattingProvider) {
 	s.formatter = provider
 }
 
+func (s *Service) SetIntegrityProvider(provider IntegrityProvider) {
+	s.integrity = provider
+}
+
+func (s *Service) SetHolidayProvider(provider HolidayProvider) {
+	s.holidays = provider
+}
+
 func (s *Service) ListEmployeeReport(ctx context.Context, claims *models.Claims, filter EmployeeListFilter, pager PagerInput) (*EmployeeReportListResult, error) {
 	if !canAccessEmployeeReport(claims) {
 		return nil, ErrAccessDenied
@@ -72,8 +93,8 @@ func (s *Service) ExportEmployeeReportCSV(ctx context.Context, claims *models.Cl
 		redactEmployeeSalary(rows)
 	}
 
-	symbol, decimals, rounding := s.resolveFormatting(ctx)
-	csvData, err := exportEmployeeCSV(rows, symbol, decimals, rounding)
+	symbol, decimals := s.resolveFormatting(ctx)
+	csvData, err := exportEmployeeCSV(rows, symbol, decimals)
 	if err != nil {
 		return nil, err
 	}
@@ -148,8 +169,11 @@ func (s *Service) ListAttendanceSummaryReport(ctx context.Context, claims *model
 		return nil, err
 	}
 
-	totalDays := int(dateTo.Sub(dateFrom).Hours()/24) + 1
-	rows, total, page, pageSize, err := s.repository.ListAttendanceSummaryReport(ctx, filter, dateFrom, dateTo, totalDays, pager)
+	holidays, err := s.attendanceHolidays(ctx, dateFrom, dateTo)
+	if err != nil {
+		return nil, err
+	}
+	rows, total, page, pageSize, err := s.repository.ListAttendanceSummaryReport(ctx, filter, dateFrom, dateTo, holidays, pager)
 	if err != nil {
 		return nil, err
 	}
@@ -169,8 +193,11 @@ func (s *Service) ExportAttendanceSummaryReportCSV(ctx context.Context, claims *
 		return nil, err
 	}
 
-	totalDays := int(dateTo.Sub(dateFrom).Hours()/24) + 1
-	rows, total, err := s.repository.ListAttendanceSummaryReportForExport(ctx, filter, dateFrom, dateTo, totalDays, maxExportRows)
+	holidays, err := s.attendanceHolidays(ctx, dateFrom, dateTo)
+	if err != nil {
+		return nil, err
+	}
+	rows, total, err := s.repository.ListAttendanceSummaryReportForExport(ctx, filter, dateFrom, dateTo, holidays, maxExportRows)
 	if err != nil {
 		return nil, err
 	}
@@ -199,6 +226,9 @@ func (s *Service) ListPayrollBatchesReport(ctx context.Context, claims *models.C
 	if err != nil {
 		return nil, err
 	}
+	if err := s.attachIntegrityStatuses(ctx, rows); err != nil {
+		return nil, err
+	}
 
 	return &PayrollBatchesReportListResult{Rows: rows, Pager: Pager{Page: page, PageSize: pageSize, TotalCount: total}}, nil
 }
@@ -218,9 +248,12 @@ func (s *Service) ExportPayrollBatchesReportCSV(ctx context.Context, claims *mod
 	if total > maxExportRows {
 		return nil, fmt.Errorf("%w: reduce result set below %d rows", ErrExportLimitExceeded, maxExportRows)
 	}
+	if err := s.attachIntegrityStatuses(ctx, rows); err != nil {
+		return nil, err
+	}
 
-	symbol, decimals, rounding := s.resolveFormatting(ctx)
-	csvData, err := exportPayrollBatchesCSV(rows, symbol, decimals, rounding)
+	symbol, decimals := s.resolveFormatting(ctx)
+	csvData, err := exportPayrollBatchesCSV(rows, symbol, decimals)
 	if err != nil {
 		return nil, err
 	}
@@ -232,6 +265,30 @@ func (s *Service) ExportPayrollBatchesReportCSV(ctx context.Context, claims *mod
 	}, nil
 }
 
+// attachIntegrityStatuses verifies the locked batches among rows; other batches are left without a status.
+func (s *Service) attachIntegrityStatuses(ctx context.Context, rows []PayrollBatchesReportRow) error {
+	if s.integrity == nil {
+		return nil
+	}
+	batchIDs := make([]int64, 0, len(rows))
+	for _, row := range rows {
+		if row.Status == "Locked" {
+			batchIDs = append(batchIDs, row.BatchID)
+		}
+	}
+	if len(batchIDs) == 0 {
+		return nil
+	}
+	statuses, err := s.integrity.PayrollBatchIntegrityStatuses(ctx, batchIDs)
+	if err != nil {
+		return err
+	}
+	for i := range rows {
+		rows[i].IntegrityStatus = statuses[rows[i].BatchID]
+	}
+	return nil
+}
+
 func (s *Service) ListAuditLogReport(ctx context.Context, claims *models.Claims, filter AuditLogFilter, pager PagerInput) (*AuditLogReportListResult, error) {
 	if !canAccessAuditReport(claims) {
 		return nil, ErrAccessDenied
@@ -300,18 +357,19 @@ func validateEmployeeFilter(filter EmployeeListFilter) error {
 	return nil
 }
 
-func (s *Service) resolveFormatting(ctx context.Context) (symbol string, decimals int, rounding bool) {
+// resolveFormatting reads the money display format. Amounts are always rounded half away from zero to the
+// display decimals; with rounding enabled payroll already stores them at that precision.
+func (s *Service) resolveFormatting(ctx context.Context) (symbol string, decimals int) {
 	symbol = ""
-	decimals = 2
-	rounding = false
+	decimals = money.Decimals
 	if s.formatter == nil {
-		return symbol, decimals, rounding
+		return symbol, decimals
 	}
-	formattedSymbol, formattedDecimals, formattedRounding, err := s.formatter.GetPayrollFormatting(ctx)
+	formattedSymbol, formattedDecimals, _, err := s.formatter.GetPayrollFormatting(ctx)
 	if err != nil {
-		return symbol, decimals, rounding
+		return symbol, decimals
 	}
-	return formattedSymbol, formattedDecimals, formattedRounding
+	return formattedSymbol, formattedDecimals
 }
 
 func validateLeaveFilter(filter LeaveRequestsFilter) error {
@@ -334,6 +392,23 @@ func validateLeaveFilter(filter LeaveRequestsFilter) error {
 	return nil
 }
 
+// attendanceHolidays lists the public holidays in the range. No attendance is expected on a holiday, whatever
+// the employee's work schedule.
+func (s *Service) attendanceHolidays(ctx context.Context, dateFrom, dateTo time.Time) ([]string, error) {
+	holidays := make([]string, 0)
+	if s.holidays == nil {
+		return holidays, nil
+	}
+	dates, err := s.holidays.HolidayDates(ctx, dateFrom, dateTo)
+	if err != nil {
+		return nil, err
+	}
+	for _, date := range dates {
+		holidays = append(holidays, date.Format("2006-01-02"))
+	}
+	return holidays, nil
+}
+
 func validateAttendanceFilter(filter AttendanceSummaryFilter) error {
 	if filter.DepartmentID != nil && *filter.DepartmentID <= 0 {
 		return fmt.Errorf("%w: department id must be positive", ErrValidation)
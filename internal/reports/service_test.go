@@ -9,7 +9,38 @@ import (
 	"hrpro/internal/models"
 )
 
-type fakeRepository struct{}
+type fakeRepository struct {
+	payrollRows        []PayrollBatchesReportRow
+	attendanceHolidays []string
+}
+
+type fakeHolidayProvider struct {
+	dates []time.Time
+}
+
+func (f *fakeHolidayProvider) HolidayDates(_ context.Context, from, to time.Time) ([]time.Time, error) {
+	result := make([]time.Time, 0)
+	for _, date := range f.dates {
+		if !date.Before(from) && !date.After(to) {
+			result = append(result, date)
+		}
+	}
+	return result, nil
+}
+
+type fakeIntegrityProvider struct {
+	requested []int64
+}
+
+func (f *fakeIntegrityProvider) PayrollBatchIntegrityStatuses(_ context.Context, batchIDs []int64) (map[int64]string, error) {
+	f.requested = append(f.requested, batchIDs...)
+	statuses := make(map[int64]string, len(batchIDs))
+	for _, id := range batchIDs {
+		statuses[id] = "Verified"
+	}
+	statuses[2] = "Drift"
+	return statuses, nil
+}
 
 func (f *fakeRepository) ListEmployeeReport(_ context.Context, _ EmployeeListFilter, _ PagerInput) ([]EmployeeReportRow, int64, int, int, error) {
 	return []EmployeeReportRow{}, 0, 1, 10, nil
@@ -27,20 +58,23 @@ func (f *fakeRepository) ListLeaveRequestsReportForExport(_ context.Context, _ L
 	return []LeaveRequestsReportRow{}, 0, nil
 }
 
-func (f *fakeRepository) ListAttendanceSummaryReport(_ context.Context, _ AttendanceSummaryFilter, _ time.Time, _ time.Time, _ int, _ PagerInput) ([]AttendanceSummaryReportRow, int64, int, int, error) {
+func (f *fakeRepository) ListAttendanceSummaryReport(_ context.Context, _ AttendanceSummaryFilter, _ time.Time, _ time.Time, holidays []string, _ PagerInput) ([]AttendanceSummaryReportRow, int64, int, int, error) {
+	f.attendanceHolidays = holidays
 	return []AttendanceSummaryReportRow{}, 0, 1, 10, nil
 }
 
-func (f *fakeRepository) ListAttendanceSummaryReportForExport(_ context.Context, _ AttendanceSummaryFilter, _ time.Time, _ time.Time, _ int, _ int) ([]AttendanceSummaryReportRow, int64, error) {
+func (f *fakeRepository) ListAttendanceSummaryReportForExport(_ context.Context, _ AttendanceSummaryFilter, _ time.Time, _ time.Time, _ []string, _ int) ([]AttendanceSummaryReportRow, int64, error) {
 	return []AttendanceSummaryReportRow{}, 0, nil
 }
 
 func (f *fakeRepository) ListPayrollBatchesReport(_ context.Context, _ PayrollBatchesFilter, _ PagerInput) ([]PayrollBatchesReportRow, int64, int, int, error) {
-	return []PayrollBatchesReportRow{}, 0, 1, 10, nil
+	rows := append([]PayrollBatchesReportRow{}, f.payrollRows...)
+	return rows, int64(len(rows)), 1, 10, nil
 }
 
 func (f *fakeRepository) ListPayrollBatchesReportForExport(_ context.Context, _ PayrollBatchesFilter, _ int) ([]PayrollBatchesReportRow, int64, error) {
-	return []PayrollBatchesReportRow{}, 0, nil
+	rows := append([]PayrollBatchesReportRow{}, f.payrollRows...)
+	return rows, int64(len(rows)), nil
 }
 
 func (f *fakeRepository) ListAuditLogReport(_ context.Context, _ AuditLogFilter, _ time.Time, _ time.Time, _ PagerInput) ([]AuditLogReportRow, int64, int, int, error) {
@@ -73,6 +107,26 @@ func TestDateRangeValidationRejectsInvertedRange(t *testing.T) {
 	}
 }
 
+func TestAttendanceSummaryLeavesPublicHolidaysOutOfExpectedDays(t *testing.T) {
+	repo := &fakeRepository{}
+	svc := NewService(repo)
+	svc.SetHolidayProvider(&fakeHolidayProvider{dates: []time.Time{
+		time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2026, 6, 3, 0, 0, 0, 0, time.UTC),
+	}})
+
+	_, err := svc.ListAttendanceSummaryReport(context.Background(), &models.Claims{Role: "Admin"}, AttendanceSummaryFilter{
+		DateFrom: "2026-05-01",
+		DateTo:   "2026-05-31",
+	}, PagerInput{Page: 1, PageSize: 10})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(repo.attendanceHolidays) != 1 || repo.attendanceHolidays[0] != "2026-05-01" {
+		t.Fatalf("expected only Labour Day to be left out of expected days, got %v", repo.attendanceHolidays)
+	}
+}
+
 func TestFinanceCannotAccessAuditReport(t *testing.T) {
 	svc := NewService(&fakeRepository{})
 	_, err := svc.ListAuditLogReport(context.Background(), &models.Claims{Role: "Finance Officer"}, AuditLogFilter{
@@ -108,3 +162,38 @@ func TestViewerDeniedPayrollAndAuditReports(t *testing.T) {
 		t.Fatalf("expected audit ErrAccessDenied, got %v", auditErr)
 	}
 }
+
+func TestPayrollReportShowsIntegrityOfLockedBatches(t *testing.T) {
+	created := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	repo := &fakeRepository{payrollRows: []PayrollBatchesReportRow{
+		{BatchID: 1, Month: "2026-03", BatchType: "regular", Status: "Locked", CreatedAt: created},
+		{BatchID: 2, Month: "2026-02", BatchType: "regular", Status: "Locked", CreatedAt: created},
+		{BatchID: 3, Month: "2026-04", BatchType: "regular", Status: "Draft", CreatedAt: created},
+	}}
+	integrity := &fakeIntegrityProvider{}
+	svc := NewService(repo)
+	svc.SetIntegrityProvider(integrity)
+
+	result, err := svc.ListPayrollBatchesReport(context.Background(), &models.Claims{Role: "Finance Officer"}, PayrollBatchesFilter{}, PagerInput{Page: 1, PageSize: 10})
+	if err != nil {
+		t.Fatalf("expected report, got %v", err)
+	}
+	if result.Rows[0].IntegrityStatus != "Verified" || result.Rows[1].IntegrityStatus != "Drift" || result.Rows[2].IntegrityStatus != "" {
+		t.Fatalf("unexpected integrity statuses %#v", result.Rows)
+	}
+	if len(integrity.requested) != 2 {
+		t.Fatalf("expected only locked batches to be verified, got %v", integrity.requested)
+	}
+
+	export, err := svc.ExportPayrollBatchesReportCSV(context.Background(), &models.Claims{Role: "Finance Officer"}, PayrollBatchesFilter{})
+	if err != nil {
+		t.Fatalf("expected export, got %v", err)
+	}
+	expected := "month,batch_type,status,created_at,approved_at,locked_at,entries_count,total_net_pay,total_employer_contributions,integrity_status\n" +
+		"2026-03,regular,Locked,2026-03-01,,,0,0.00,0.00,Verified\n" +
+		"2026-02,regular,Locked,2026-03-01,,,0,0.00,0.00,Drift\n" +
+		"2026-04,regular,Draft,2026-03-01,,,0,0.00,0.00,\n"
+	if export.Data != expected {
+		t.Fatalf("unexpected export:\n%s", export.Data)
+	}
+}
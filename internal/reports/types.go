@@ -1,6 +1,10 @@
 package reports
 
-import "time"
+import (
+	"time"
+
+	"hrpro/internal/money"
+)
 
 type PagerInput struct {
 	Page     int `json:"page"`
@@ -20,15 +24,15 @@ type EmployeeListFilter struct {
 }
 
 type EmployeeReportRow struct {
-	EmployeeName     string    `db:"employee_name" json:"employeeName"`
-	DepartmentName   string    `db:"department_name" json:"departmentName"`
-	Position         string    `db:"position" json:"position"`
-	JobDescription   string    `db:"job_description" json:"jobDescription"`
-	Status           string    `db:"status" json:"status"`
-	DateOfHire       time.Time `db:"date_of_hire" json:"dateOfHire"`
-	Phone            string    `db:"phone" json:"phone"`
-	Email            string    `db:"email" json:"email"`
-	BaseSalaryAmount *float64  `db:"base_salary_amount" json:"baseSalaryAmount"`
+	EmployeeName     string        `db:"employee_name" json:"employeeName"`
+	DepartmentName   string        `db:"department_name" json:"departmentName"`
+	Position         string        `db:"position" json:"position"`
+	JobDescription   string        `db:"job_description" json:"jobDescription"`
+	Status           string        `db:"status" json:"status"`
+	DateOfHire       time.Time     `db:"date_of_hire" json:"dateOfHire"`
+	Phone            string        `db:"phone" json:"phone"`
+	Email            string        `db:"email" json:"email"`
+	BaseSalaryAmount *money.Amount `db:"base_salary_amount" json:"baseSalaryAmount"`
 }
 
 type EmployeeReportListResult struct {
@@ -93,13 +97,30 @@ type PayrollBatchesFilter struct {
 }
 
 type PayrollBatchesReportRow struct {
-	Month        string     `db:"month" json:"month"`
-	Status       string     `db:"status" json:"status"`
-	CreatedAt    time.Time  `db:"created_at" json:"createdAt"`
-	ApprovedAt   *time.Time `db:"approved_at" json:"approvedAt,omitempty"`
-	LockedAt     *time.Time `db:"locked_at" json:"lockedAt,omitempty"`
-	EntriesCount int64      `db:"entries_count" json:"entriesCount"`
-	TotalNetPay  float64    `db:"total_net_pay" json:"totalNetPay"`
+	BatchID      int64        `db:"id" json:"batchId"`
+	Month        string       `db:"month" json:"month"`
+	BatchType    string       `db:"batch_type" json:"batchType"`
+	Status       string       `db:"status" json:"status"`
+	CreatedAt    time.Time    `db:"created_at" json:"createdAt"`
+	ApprovedAt   *time.Time   `db:"approved_at" json:"approvedAt,omitempty"`
+	LockedAt     *time.Time   `db:"locked_at" json:"lockedAt,omitempty"`
+	EntriesCount int64        `db:"entries_count" json:"entriesCount"`
+	TotalNetPay  money.Amount `db:"total_net_pay" json:"totalNetPay"`
+
+	TotalEmployerContributions money.Amount `db:"total_employer_contributions" json:"totalEmployerContributions"`
+
+	Components []PayrollComponentTotal `db:"-" json:"components"`
+
+	// IntegrityStatus is the verification of a locked batch against its seal; empty for other batches.
+	IntegrityStatus string `db:"-" json:"integrityStatus"`
+}
+
+type PayrollComponentTotal struct {
+	BatchID int64        `db:"batch_id" json:"-"`
+	Code    string       `db:"code" json:"code"`
+	Name    string       `db:"name" json:"name"`
+	Kind    string       `db:"kind" json:"kind"`
+	Total   money.Amount `db:"total" json:"total"`
 }
 
 type PayrollBatchesReportListResult struct {
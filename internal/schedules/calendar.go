@@ -0,0 +1,63 @@
+package schedules
+
+import (
+	"sort"
+	"time"
+)
+
+// StandardSchedule is Monday to Friday, full days of StandardHoursPerDay. It applies when no schedule is
+// configured.
+func StandardSchedule() WorkSchedule {
+	days := make([]WorkScheduleDay, 0, 5)
+	for weekday := time.Monday; weekday <= time.Friday; weekday++ {
+		days = append(days, WorkScheduleDay{Weekday: int(weekday), Fraction: 1})
+	}
+	return WorkSchedule{Name: "Standard", HoursPerDay: StandardHoursPerDay, IsDefault: true, Active: true, Days: days}
+}
+
+// DayFraction returns the part of a working day the schedule works on weekday, 0 for a day off.
+func (w WorkSchedule) DayFraction(weekday time.Weekday) float64 {
+	for _, day := range w.Days {
+		if day.Weekday == int(weekday) {
+			return day.Fraction
+		}
+	}
+	return 0
+}
+
+// WorkDays lists every date from from to to, both inclusive, with the part of a day worked under the schedule
+// in effect that date. An assignment applies from its effective date until the next one; dates before the first
+// assignment use fallback. Assignments to schedules missing from schedules are ignored.
+func WorkDays(from, to time.Time, assignments []EmployeeWorkSchedule, schedules map[int64]WorkSchedule, fallback WorkSchedule) []WorkDay {
+	from, to = truncateDate(from), truncateDate(to)
+	ordered := make([]EmployeeWorkSchedule, 0, len(assignments))
+	for _, assignment := range assignments {
+		if _, ok := schedules[assignment.ScheduleID]; ok {
+			ordered = append(ordered, assignment)
+		}
+	}
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return ordered[i].EffectiveFrom.Before(ordered[j].EffectiveFrom)
+	})
+
+	result := make([]WorkDay, 0)
+	next := 0
+	current := fallback
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		for next < len(ordered) && !truncateDate(ordered[next].EffectiveFrom).After(day) {
+			current = schedules[ordered[next].ScheduleID]
+			next++
+		}
+		result = append(result, WorkDay{Date: day, Fraction: current.DayFraction(day.Weekday()), DailyHours: current.HoursPerDay})
+	}
+	return result
+}
+
+// StandardWorkDays lists the dates from from to to under StandardSchedule.
+func StandardWorkDays(from, to time.Time) []WorkDay {
+	return WorkDays(from, to, nil, nil, StandardSchedule())
+}
+
+func truncateDate(value time.Time) time.Time {
+	return time.Date(value.Year(), value.Month(), value.Day(), 0, 0, 0, 0, time.UTC)
+}
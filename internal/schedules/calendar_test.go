@@ -0,0 +1,47 @@
+package schedules
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWorkDaysFollowAssignmentsByEffectiveDate(t *testing.T) {
+	saturdays := WorkSchedule{ID: 2, Name: "Mon-Sat", HoursPerDay: 8, Days: []WorkScheduleDay{
+		{Weekday: 1, Fraction: 1}, {Weekday: 2, Fraction: 1}, {Weekday: 3, Fraction: 1},
+		{Weekday: 4, Fraction: 1}, {Weekday: 5, Fraction: 1}, {Weekday: 6, Fraction: 0.5},
+	}}
+	partTime := WorkSchedule{ID: 3, Name: "Part-time", HoursPerDay: 4, Days: []WorkScheduleDay{
+		{Weekday: 1, Fraction: 1}, {Weekday: 3, Fraction: 1},
+	}}
+	schedules := map[int64]WorkSchedule{2: saturdays, 3: partTime}
+	assignments := []EmployeeWorkSchedule{
+		{EmployeeID: 1, ScheduleID: 3, EffectiveFrom: time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC)},
+		{EmployeeID: 1, ScheduleID: 2, EffectiveFrom: time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)},
+		{EmployeeID: 1, ScheduleID: 99, EffectiveFrom: time.Date(2026, 3, 11, 0, 0, 0, 0, time.UTC)},
+	}
+
+	// Sunday 1 March is before the first assignment and follows the standard week.
+	days := WorkDays(time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 3, 11, 0, 0, 0, 0, time.UTC), assignments, schedules, StandardSchedule())
+	if len(days) != 11 {
+		t.Fatalf("expected 11 days, got %d", len(days))
+	}
+	expected := []float64{0, 1, 1, 1, 1, 1, 0.5, 0, 1, 0, 1}
+	for i, day := range days {
+		if day.Fraction != expected[i] {
+			t.Fatalf("expected %s to be %.1f of a day, got %.1f", day.Date.Format("2006-01-02"), expected[i], day.Fraction)
+		}
+	}
+	if days[1].DailyHours != 8 || days[9].DailyHours != 4 {
+		t.Fatalf("expected daily hours of the schedule in effect, got %.1f and %.1f", days[1].DailyHours, days[9].DailyHours)
+	}
+}
+
+func TestStandardWorkDaysAreMondayToFriday(t *testing.T) {
+	total := 0.0
+	for _, day := range StandardWorkDays(time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC)) {
+		total += day.Fraction
+	}
+	if total != 22 {
+		t.Fatalf("expected 22 working days in March 2026, got %.1f", total)
+	}
+}
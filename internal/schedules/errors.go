@@ -0,0 +1,9 @@
+package schedules
+
+import "errors"
+
+var (
+	ErrValidation        = errors.New("validation failed")
+	ErrNotFound          = errors.New("work schedule not found")
+	ErrDuplicateSchedule = errors.New("work schedule already exists")
+)
@@ -0,0 +1,261 @@
+package schedules
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type Repository interface {
+	ListSchedules(ctx context.Context, activeOnly bool) ([]WorkSchedule, error)
+	GetScheduleByID(ctx context.Context, id int64) (*WorkSchedule, error)
+	CreateSchedule(ctx context.Context, schedule WorkSchedule) (*WorkSchedule, error)
+	UpdateSchedule(ctx context.Context, id int64, schedule WorkSchedule) (*WorkSchedule, error)
+	SetScheduleActive(ctx context.Context, id int64, active bool) (*WorkSchedule, error)
+	EmployeeExists(ctx context.Context, employeeID int64) (bool, error)
+	ListAssignments(ctx context.Context, employeeIDs []int64, to time.Time) ([]EmployeeWorkSchedule, error)
+	UpsertAssignment(ctx context.Context, employeeID, scheduleID int64, effectiveFrom time.Time, createdBy *int64) (*EmployeeWorkSchedule, error)
+	GetAssignmentByID(ctx context.Context, id int64) (*EmployeeWorkSchedule, error)
+	DeleteAssignment(ctx context.Context, id int64) (bool, error)
+}
+
+type SQLXRepository struct {
+	db *sqlx.DB
+}
+
+func NewRepository(db *sqlx.DB) *SQLXRepository {
+	return &SQLXRepository{db: db}
+}
+
+const scheduleColumns = `id, name, hours_per_day, is_default, active, created_at, updated_at`
+
+const assignmentSelect = `
+	SELECT ews.id, ews.employee_id, ews.schedule_id, ws.name AS schedule_name, ews.effective_from, ews.created_by, ews.created_at
+	FROM employee_work_schedules ews
+	JOIN work_schedules ws ON ws.id = ews.schedule_id
+`
+
+func (r *SQLXRepository) ListSchedules(ctx context.Context, activeOnly bool) ([]WorkSchedule, error) {
+	query := `
+		SELECT ` + scheduleColumns + `
+		FROM work_schedules
+		WHERE ($1 = FALSE OR active = TRUE)
+		ORDER BY is_default DESC, LOWER(name) ASC, id ASC
+	`
+	items := make([]WorkSchedule, 0)
+	if err := r.db.SelectContext(ctx, &items, query, activeOnly); err != nil {
+		return nil, fmt.Errorf("list work schedules: %w", err)
+	}
+	if err := r.attachDays(ctx, items); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+func (r *SQLXRepository) GetScheduleByID(ctx context.Context, id int64) (*WorkSchedule, error) {
+	query := `SELECT ` + scheduleColumns + ` FROM work_schedules WHERE id = $1`
+	var item WorkSchedule
+	if err := r.db.GetContext(ctx, &item, query, id); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get work schedule: %w", err)
+	}
+	items := []WorkSchedule{item}
+	if err := r.attachDays(ctx, items); err != nil {
+		return nil, err
+	}
+	return &items[0], nil
+}
+
+// CreateSchedule inserts a schedule and its days. A new default schedule takes over from the previous one.
+func (r *SQLXRepository) CreateSchedule(ctx context.Context, schedule WorkSchedule) (*WorkSchedule, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin create work schedule: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if schedule.IsDefault {
+		if _, err := tx.ExecContext(ctx, `UPDATE work_schedules SET is_default = FALSE, updated_at = NOW() WHERE is_default`); err != nil {
+			return nil, fmt.Errorf("clear default work schedule: %w", err)
+		}
+	}
+	query := `
+		INSERT INTO work_schedules (name, hours_per_day, is_default)
+		VALUES ($1, $2, $3)
+		RETURNING ` + scheduleColumns
+	var item WorkSchedule
+	if err := tx.GetContext(ctx, &item, query, schedule.Name, schedule.HoursPerDay, schedule.IsDefault); err != nil {
+		return nil, fmt.Errorf("create work schedule: %w", err)
+	}
+	if err := replaceScheduleDays(ctx, tx, item.ID, schedule.Days); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit create work schedule: %w", err)
+	}
+	item.Days = schedule.Days
+	return &item, nil
+}
+
+func (r *SQLXRepository) UpdateSchedule(ctx context.Context, id int64, schedule WorkSchedule) (*WorkSchedule, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin update work schedule: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if schedule.IsDefault {
+		if _, err := tx.ExecContext(ctx, `UPDATE work_schedules SET is_default = FALSE, updated_at = NOW() WHERE is_default AND id <> $1`, id); err != nil {
+			return nil, fmt.Errorf("clear default work schedule: %w", err)
+		}
+	}
+	query := `
+		UPDATE work_schedules
+		SET name = $2, hours_per_day = $3, is_default = $4, updated_at = NOW()
+		WHERE id = $1
+		RETURNING ` + scheduleColumns
+	var item WorkSchedule
+	if err := tx.GetContext(ctx, &item, query, id, schedule.Name, schedule.HoursPerDay, schedule.IsDefault); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("update work schedule: %w", err)
+	}
+	if err := replaceScheduleDays(ctx, tx, id, schedule.Days); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit update work schedule: %w", err)
+	}
+	item.Days = schedule.Days
+	return &item, nil
+}
+
+func (r *SQLXRepository) SetScheduleActive(ctx context.Context, id int64, active bool) (*WorkSchedule, error) {
+	query := `
+		UPDATE work_schedules
+		SET active = $2, updated_at = NOW()
+		WHERE id = $1
+		RETURNING ` + scheduleColumns
+	var item WorkSchedule
+	if err := r.db.GetContext(ctx, &item, query, id, active); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("set work schedule active: %w", err)
+	}
+	items := []WorkSchedule{item}
+	if err := r.attachDays(ctx, items); err != nil {
+		return nil, err
+	}
+	return &items[0], nil
+}
+
+func (r *SQLXRepository) EmployeeExists(ctx context.Context, employeeID int64) (bool, error) {
+	var exists bool
+	if err := r.db.GetContext(ctx, &exists, `SELECT EXISTS(SELECT 1 FROM employees WHERE id = $1)`, employeeID); err != nil {
+		return false, fmt.Errorf("check employee exists: %w", err)
+	}
+	return exists, nil
+}
+
+// ListAssignments lists the schedule assignments of the employees that start on or before to, oldest first.
+func (r *SQLXRepository) ListAssignments(ctx context.Context, employeeIDs []int64, to time.Time) ([]EmployeeWorkSchedule, error) {
+	query := assignmentSelect + `
+		WHERE ews.employee_id = ANY($1) AND ews.effective_from <= $2
+		ORDER BY ews.employee_id ASC, ews.effective_from ASC
+	`
+	items := make([]EmployeeWorkSchedule, 0)
+	if err := r.db.SelectContext(ctx, &items, query, employeeIDs, to); err != nil {
+		return nil, fmt.Errorf("list work schedule assignments: %w", err)
+	}
+	return items, nil
+}
+
+// UpsertAssignment assigns a schedule from effectiveFrom, replacing an assignment starting the same day.
+func (r *SQLXRepository) UpsertAssignment(ctx context.Context, employeeID, scheduleID int64, effectiveFrom time.Time, createdBy *int64) (*EmployeeWorkSchedule, error) {
+	query := `
+		INSERT INTO employee_work_schedules (employee_id, schedule_id, effective_from, created_by)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (employee_id, effective_from)
+		DO UPDATE SET schedule_id = EXCLUDED.schedule_id, created_by = EXCLUDED.created_by, created_at = NOW()
+		RETURNING id
+	`
+	var id int64
+	if err := r.db.GetContext(ctx, &id, query, employeeID, scheduleID, effectiveFrom, createdBy); err != nil {
+		return nil, fmt.Errorf("assign work schedule: %w", err)
+	}
+	return r.GetAssignmentByID(ctx, id)
+}
+
+func (r *SQLXRepository) GetAssignmentByID(ctx context.Context, id int64) (*EmployeeWorkSchedule, error) {
+	var item EmployeeWorkSchedule
+	if err := r.db.GetContext(ctx, &item, assignmentSelect+` WHERE ews.id = $1`, id); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get work schedule assignment: %w", err)
+	}
+	return &item, nil
+}
+
+func (r *SQLXRepository) DeleteAssignment(ctx context.Context, id int64) (bool, error) {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM employee_work_schedules WHERE id = $1`, id)
+	if err != nil {
+		return false, fmt.Errorf("delete work schedule assignment: %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("delete work schedule assignment rows affected: %w", err)
+	}
+	return rows > 0, nil
+}
+
+func (r *SQLXRepository) attachDays(ctx context.Context, items []WorkSchedule) error {
+	if len(items) == 0 {
+		return nil
+	}
+	ids := make([]int64, 0, len(items))
+	for _, item := range items {
+		ids = append(ids, item.ID)
+	}
+	query := `
+		SELECT schedule_id, weekday, day_fraction
+		FROM work_schedule_days
+		WHERE schedule_id = ANY($1)
+		ORDER BY schedule_id ASC, weekday ASC
+	`
+	days := make([]WorkScheduleDay, 0)
+	if err := r.db.SelectContext(ctx, &days, query, ids); err != nil {
+		return fmt.Errorf("list work schedule days: %w", err)
+	}
+	byID := make(map[int64][]WorkScheduleDay, len(items))
+	for _, day := range days {
+		byID[day.ScheduleID] = append(byID[day.ScheduleID], day)
+	}
+	for i := range items {
+		items[i].Days = byID[items[i].ID]
+		if items[i].Days == nil {
+			items[i].Days = []WorkScheduleDay{}
+		}
+	}
+	return nil
+}
+
+func replaceScheduleDays(ctx context.Context, tx *sqlx.Tx, scheduleID int64, days []WorkScheduleDay) error {
+	if _, err := tx.ExecContext(ctx, `DELETE FROM work_schedule_days WHERE schedule_id = $1`, scheduleID); err != nil {
+		return fmt.Errorf("clear work schedule days: %w", err)
+	}
+	for _, day := range days {
+		query := `INSERT INTO work_schedule_days (schedule_id, weekday, day_fraction) VALUES ($1, $2, $3)`
+		if _, err := tx.ExecContext(ctx, query, scheduleID, day.Weekday, day.Fraction); err != nil {
+			return fmt.Errorf("insert work schedule day: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,331 @@
+package schedules
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"hrpro/internal/audit"
+	"hrpro/internal/models"
+)
+
+var weekdayNames = []string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"}
+
+type Service struct {
+	repository Repository
+	audit      audit.Recorder
+}
+
+func NewService(repository Repository) *Service {
+	return &Service{repository: repository, audit: audit.NewNoopRecorder()}
+}
+
+func (s *Service) SetAuditRecorder(recorder audit.Recorder) {
+	if recorder == nil {
+		s.audit = audit.NewNoopRecorder()
+		return
+	}
+	s.audit = recorder
+}
+
+func (s *Service) ListWorkSchedules(ctx context.Context, activeOnly bool) ([]WorkSchedule, error) {
+	return s.repository.ListSchedules(ctx, activeOnly)
+}
+
+func (s *Service) CreateWorkSchedule(ctx context.Context, claims *models.Claims, input WorkScheduleUpsertInput) (*WorkSchedule, error) {
+	schedule, err := normalizeScheduleInput(input)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.ensureUniqueName(ctx, schedule.Name, nil); err != nil {
+		return nil, err
+	}
+	created, err := s.repository.CreateSchedule(ctx, schedule)
+	if err != nil {
+		return nil, err
+	}
+	s.audit.RecordAuditEvent(ctx, claimsUserID(claims), "work_schedule.create", stringPtr("work_schedule"), &created.ID, scheduleMetadata(*created))
+	return created, nil
+}
+
+// UpdateWorkSchedule changes a schedule for every date it applies to, including past ones. The default schedule
+// can only stop being the default by making another schedule the default.
+func (s *Service) UpdateWorkSchedule(ctx context.Context, claims *models.Claims, id int64, input WorkScheduleUpsertInput) (*WorkSchedule, error) {
+	if id <= 0 {
+		return nil, fmt.Errorf("%w: schedule id must be positive", ErrValidation)
+	}
+	schedule, err := normalizeScheduleInput(input)
+	if err != nil {
+		return nil, err
+	}
+	existing, err := s.repository.GetScheduleByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		return nil, ErrNotFound
+	}
+	if existing.IsDefault && !schedule.IsDefault {
+		return nil, fmt.Errorf("%w: make another schedule the default instead", ErrValidation)
+	}
+	if schedule.IsDefault && !existing.Active {
+		return nil, fmt.Errorf("%w: an inactive schedule cannot be the default", ErrValidation)
+	}
+	if err := s.ensureUniqueName(ctx, schedule.Name, &id); err != nil {
+		return nil, err
+	}
+	updated, err := s.repository.UpdateSchedule(ctx, id, schedule)
+	if err != nil {
+		return nil, err
+	}
+	if updated == nil {
+		return nil, ErrNotFound
+	}
+	s.audit.RecordAuditEvent(ctx, claimsUserID(claims), "work_schedule.update", stringPtr("work_schedule"), &updated.ID, scheduleMetadata(*updated))
+	return updated, nil
+}
+
+// SetWorkScheduleActive switches a schedule on or off for new assignments. Existing assignments keep applying.
+func (s *Service) SetWorkScheduleActive(ctx context.Context, claims *models.Claims, id int64, active bool) (*WorkSchedule, error) {
+	if id <= 0 {
+		return nil, fmt.Errorf("%w: schedule id must be positive", ErrValidation)
+	}
+	existing, err := s.repository.GetScheduleByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		return nil, ErrNotFound
+	}
+	if existing.IsDefault && !active {
+		return nil, fmt.Errorf("%w: the default schedule cannot be deactivated", ErrValidation)
+	}
+	updated, err := s.repository.SetScheduleActive(ctx, id, active)
+	if err != nil {
+		return nil, err
+	}
+	if updated == nil {
+		return nil, ErrNotFound
+	}
+	s.audit.RecordAuditEvent(ctx, claimsUserID(claims), "work_schedule.set_active", stringPtr("work_schedule"), &updated.ID, map[string]any{
+		"name":   updated.Name,
+		"active": updated.Active,
+	})
+	return updated, nil
+}
+
+// ListEmployeeWorkSchedules lists the schedules assigned to an employee, newest first.
+func (s *Service) ListEmployeeWorkSchedules(ctx context.Context, employeeID int64) ([]EmployeeWorkSchedule, error) {
+	if employeeID <= 0 {
+		return nil, fmt.Errorf("%w: employee id must be positive", ErrValidation)
+	}
+	items, err := s.repository.ListAssignments(ctx, []int64{employeeID}, time.Date(9999, time.December, 31, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].EffectiveFrom.After(items[j].EffectiveFrom)
+	})
+	return items, nil
+}
+
+// AssignWorkSchedule puts an employee on a schedule from a date until their next assignment. Assigning again on
+// the same date replaces that assignment.
+func (s *Service) AssignWorkSchedule(ctx context.Context, claims *models.Claims, input AssignWorkScheduleInput) (*EmployeeWorkSchedule, error) {
+	if input.EmployeeID <= 0 {
+		return nil, fmt.Errorf("%w: employee id must be positive", ErrValidation)
+	}
+	if input.ScheduleID <= 0 {
+		return nil, fmt.Errorf("%w: schedule id must be positive", ErrValidation)
+	}
+	effectiveFrom, err := time.Parse("2006-01-02", strings.TrimSpace(input.EffectiveFrom))
+	if err != nil {
+		return nil, fmt.Errorf("%w: effective date must be YYYY-MM-DD", ErrValidation)
+	}
+
+	exists, err := s.repository.EmployeeExists(ctx, input.EmployeeID)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, fmt.Errorf("%w: employee not found", ErrNotFound)
+	}
+	schedule, err := s.repository.GetScheduleByID(ctx, input.ScheduleID)
+	if err != nil {
+		return nil, err
+	}
+	if schedule == nil {
+		return nil, ErrNotFound
+	}
+	if !schedule.Active {
+		return nil, fmt.Errorf("%w: schedule is inactive", ErrValidation)
+	}
+
+	assignment, err := s.repository.UpsertAssignment(ctx, input.EmployeeID, input.ScheduleID, effectiveFrom, claimsUserID(claims))
+	if err != nil {
+		return nil, err
+	}
+	if assignment == nil {
+		return nil, ErrNotFound
+	}
+	s.audit.RecordAuditEvent(ctx, claimsUserID(claims), "work_schedule.assign", stringPtr("employee"), &assignment.EmployeeID, map[string]any{
+		"schedule_id":    assignment.ScheduleID,
+		"schedule_name":  assignment.ScheduleName,
+		"effective_from": assignment.EffectiveFrom.Format("2006-01-02"),
+	})
+	return assignment, nil
+}
+
+func (s *Service) DeleteEmployeeWorkSchedule(ctx context.Context, claims *models.Claims, id int64) error {
+	if id <= 0 {
+		return fmt.Errorf("%w: assignment id must be positive", ErrValidation)
+	}
+	existing, err := s.repository.GetAssignmentByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		return ErrNotFound
+	}
+	deleted, err := s.repository.DeleteAssignment(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return ErrNotFound
+	}
+	s.audit.RecordAuditEvent(ctx, claimsUserID(claims), "work_schedule.unassign", stringPtr("employee"), &existing.EmployeeID, map[string]any{
+		"schedule_id":    existing.ScheduleID,
+		"schedule_name":  existing.ScheduleName,
+		"effective_from": existing.EffectiveFrom.Format("2006-01-02"),
+	})
+	return nil
+}
+
+// EmployeeWorkDays lists the dates from from to to with the part of a day the employee is scheduled to work.
+// Leave uses it to count leave days.
+func (s *Service) EmployeeWorkDays(ctx context.Context, employeeID int64, from, to time.Time) ([]WorkDay, error) {
+	items, err := s.EmployeesWorkDays(ctx, []int64{employeeID}, from, to)
+	if err != nil {
+		return nil, err
+	}
+	return items[employeeID], nil
+}
+
+// EmployeesWorkDays is EmployeeWorkDays for several employees at once. Employees without an assignment in effect
+// follow the default schedule.
+func (s *Service) EmployeesWorkDays(ctx context.Context, employeeIDs []int64, from, to time.Time) (map[int64][]WorkDay, error) {
+	schedules, err := s.repository.ListSchedules(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[int64]WorkSchedule, len(schedules))
+	fallback := StandardSchedule()
+	for _, schedule := range schedules {
+		byID[schedule.ID] = schedule
+		if schedule.IsDefault {
+			fallback = schedule
+		}
+	}
+	assignments, err := s.repository.ListAssignments(ctx, employeeIDs, to)
+	if err != nil {
+		return nil, err
+	}
+	byEmployee := make(map[int64][]EmployeeWorkSchedule, len(employeeIDs))
+	for _, assignment := range assignments {
+		byEmployee[assignment.EmployeeID] = append(byEmployee[assignment.EmployeeID], assignment)
+	}
+
+	result := make(map[int64][]WorkDay, len(employeeIDs))
+	for _, employeeID := range employeeIDs {
+		result[employeeID] = WorkDays(from, to, byEmployee[employeeID], byID, fallback)
+	}
+	return result, nil
+}
+
+func (s *Service) ensureUniqueName(ctx context.Context, name string, excludeID *int64) error {
+	existing, err := s.repository.ListSchedules(ctx, false)
+	if err != nil {
+		return err
+	}
+	for _, item := range existing {
+		if excludeID != nil && item.ID == *excludeID {
+			continue
+		}
+		if strings.EqualFold(item.Name, name) {
+			return ErrDuplicateSchedule
+		}
+	}
+	return nil
+}
+
+func normalizeScheduleInput(input WorkScheduleUpsertInput) (WorkSchedule, error) {
+	schedule := WorkSchedule{
+		Name:        strings.TrimSpace(input.Name),
+		HoursPerDay: input.HoursPerDay,
+		IsDefault:   input.IsDefault,
+		Active:      true,
+	}
+	if schedule.Name == "" {
+		return WorkSchedule{}, fmt.Errorf("%w: name is required", ErrValidation)
+	}
+	if len(schedule.Name) > 100 {
+		return WorkSchedule{}, fmt.Errorf("%w: name is too long", ErrValidation)
+	}
+	if schedule.HoursPerDay <= 0 || schedule.HoursPerDay > 24 {
+		return WorkSchedule{}, fmt.Errorf("%w: hours per day must be more than 0 and at most 24", ErrValidation)
+	}
+	if len(input.Days) == 0 {
+		return WorkSchedule{}, fmt.Errorf("%w: at least one working day is required", ErrValidation)
+	}
+
+	seen := make(map[int]bool, len(input.Days))
+	for _, day := range input.Days {
+		if day.Weekday < 0 || day.Weekday > 6 {
+			return WorkSchedule{}, fmt.Errorf("%w: weekday must be between 0 (Sunday) and 6 (Saturday)", ErrValidation)
+		}
+		if seen[day.Weekday] {
+			return WorkSchedule{}, fmt.Errorf("%w: %s is listed twice", ErrValidation, weekdayNames[day.Weekday])
+		}
+		if day.Fraction != 1 && day.Fraction != 0.5 {
+			return WorkSchedule{}, fmt.Errorf("%w: %s must be a full (1) or half (0.5) day", ErrValidation, weekdayNames[day.Weekday])
+		}
+		seen[day.Weekday] = true
+		schedule.Days = append(schedule.Days, WorkScheduleDay{Weekday: day.Weekday, Fraction: day.Fraction})
+	}
+	sort.Slice(schedule.Days, func(i, j int) bool {
+		return schedule.Days[i].Weekday < schedule.Days[j].Weekday
+	})
+	return schedule, nil
+}
+
+func scheduleMetadata(schedule WorkSchedule) map[string]any {
+	days := make([]string, 0, len(schedule.Days))
+	for _, day := range schedule.Days {
+		label := weekdayNames[day.Weekday]
+		if day.Fraction < 1 {
+			label += " (half)"
+		}
+		days = append(days, label)
+	}
+	return map[string]any{
+		"name":          schedule.Name,
+		"hours_per_day": schedule.HoursPerDay,
+		"is_default":    schedule.IsDefault,
+		"days":          strings.Join(days, ", "),
+	}
+}
+
+func claimsUserID(claims *models.Claims) *int64 {
+	if claims == nil || claims.UserID <= 0 {
+		return nil
+	}
+	actor := claims.UserID
+	return &actor
+}
+
+func stringPtr(value string) *string {
+	return &value
+}
@@ -0,0 +1,197 @@
+package schedules
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"hrpro/internal/models"
+)
+
+type fakeRepository struct {
+	schedules   []WorkSchedule
+	assignments []EmployeeWorkSchedule
+}
+
+func (f *fakeRepository) ListSchedules(_ context.Context, activeOnly bool) ([]WorkSchedule, error) {
+	items := make([]WorkSchedule, 0, len(f.schedules))
+	for _, item := range f.schedules {
+		if !activeOnly || item.Active {
+			items = append(items, item)
+		}
+	}
+	return items, nil
+}
+
+func (f *fakeRepository) GetScheduleByID(_ context.Context, id int64) (*WorkSchedule, error) {
+	for _, item := range f.schedules {
+		if item.ID == id {
+			found := item
+			return &found, nil
+		}
+	}
+	return nil, nil
+}
+
+func (f *fakeRepository) CreateSchedule(_ context.Context, schedule WorkSchedule) (*WorkSchedule, error) {
+	if schedule.IsDefault {
+		for i := range f.schedules {
+			f.schedules[i].IsDefault = false
+		}
+	}
+	schedule.ID = int64(len(f.schedules) + 1)
+	f.schedules = append(f.schedules, schedule)
+	return &schedule, nil
+}
+
+func (f *fakeRepository) UpdateSchedule(_ context.Context, id int64, schedule WorkSchedule) (*WorkSchedule, error) {
+	for i := range f.schedules {
+		if f.schedules[i].ID == id {
+			schedule.ID = id
+			schedule.Active = f.schedules[i].Active
+			f.schedules[i] = schedule
+			return &schedule, nil
+		}
+	}
+	return nil, nil
+}
+
+func (f *fakeRepository) SetScheduleActive(_ context.Context, id int64, active bool) (*WorkSchedule, error) {
+	for i := range f.schedules {
+		if f.schedules[i].ID == id {
+			f.schedules[i].Active = active
+			item := f.schedules[i]
+			return &item, nil
+		}
+	}
+	return nil, nil
+}
+
+func (f *fakeRepository) EmployeeExists(_ context.Context, employeeID int64) (bool, error) {
+	return employeeID == 1 || employeeID == 2, nil
+}
+
+func (f *fakeRepository) ListAssignments(_ context.Context, employeeIDs []int64, to time.Time) ([]EmployeeWorkSchedule, error) {
+	items := make([]EmployeeWorkSchedule, 0)
+	for _, item := range f.assignments {
+		for _, employeeID := range employeeIDs {
+			if item.EmployeeID == employeeID && !item.EffectiveFrom.After(to) {
+				items = append(items, item)
+			}
+		}
+	}
+	return items, nil
+}
+
+func (f *fakeRepository) UpsertAssignment(_ context.Context, employeeID, scheduleID int64, effectiveFrom time.Time, createdBy *int64) (*EmployeeWorkSchedule, error) {
+	item := EmployeeWorkSchedule{ID: int64(len(f.assignments) + 1), EmployeeID: employeeID, ScheduleID: scheduleID, EffectiveFrom: effectiveFrom, CreatedBy: createdBy}
+	f.assignments = append(f.assignments, item)
+	return &item, nil
+}
+
+func (f *fakeRepository) GetAssignmentByID(_ context.Context, id int64) (*EmployeeWorkSchedule, error) {
+	for _, item := range f.assignments {
+		if item.ID == id {
+			found := item
+			return &found, nil
+		}
+	}
+	return nil, nil
+}
+
+func (f *fakeRepository) DeleteAssignment(_ context.Context, id int64) (bool, error) {
+	for i, item := range f.assignments {
+		if item.ID == id {
+			f.assignments = append(f.assignments[:i], f.assignments[i+1:]...)
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func standardFixture() *fakeRepository {
+	standard := StandardSchedule()
+	standard.ID = 1
+	standard.Name = "Standard (Mon-Fri)"
+	return &fakeRepository{schedules: []WorkSchedule{standard}}
+}
+
+func TestCreateWorkScheduleValidatesDays(t *testing.T) {
+	svc := NewService(standardFixture())
+	claims := &models.Claims{UserID: 1, Role: "Admin"}
+
+	cases := []WorkScheduleUpsertInput{
+		{Name: "No days", HoursPerDay: 8},
+		{Name: "Bad weekday", HoursPerDay: 8, Days: []WorkScheduleDay{{Weekday: 7, Fraction: 1}}},
+		{Name: "Twice", HoursPerDay: 8, Days: []WorkScheduleDay{{Weekday: 1, Fraction: 1}, {Weekday: 1, Fraction: 0.5}}},
+		{Name: "Quarter", HoursPerDay: 8, Days: []WorkScheduleDay{{Weekday: 1, Fraction: 0.25}}},
+		{Name: "Long day", HoursPerDay: 25, Days: []WorkScheduleDay{{Weekday: 1, Fraction: 1}}},
+	}
+	for _, input := range cases {
+		if _, err := svc.CreateWorkSchedule(context.Background(), claims, input); !errors.Is(err, ErrValidation) {
+			t.Fatalf("%s: expected ErrValidation, got %v", input.Name, err)
+		}
+	}
+
+	_, err := svc.CreateWorkSchedule(context.Background(), claims, WorkScheduleUpsertInput{
+		Name: "standard (mon-fri)", HoursPerDay: 8, Days: []WorkScheduleDay{{Weekday: 1, Fraction: 1}},
+	})
+	if !errors.Is(err, ErrDuplicateSchedule) {
+		t.Fatalf("expected ErrDuplicateSchedule, got %v", err)
+	}
+
+	created, err := svc.CreateWorkSchedule(context.Background(), claims, WorkScheduleUpsertInput{
+		Name: "Mon-Sat", HoursPerDay: 8, Days: []WorkScheduleDay{{Weekday: 6, Fraction: 0.5}, {Weekday: 1, Fraction: 1}},
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(created.Days) != 2 || created.Days[0].Weekday != 1 || created.Days[1].Fraction != 0.5 {
+		t.Fatalf("expected days ordered by weekday, got %#v", created.Days)
+	}
+}
+
+func TestDefaultWorkScheduleCannotBeDroppedOrDeactivated(t *testing.T) {
+	repo := standardFixture()
+	svc := NewService(repo)
+	claims := &models.Claims{UserID: 1, Role: "Admin"}
+
+	_, err := svc.UpdateWorkSchedule(context.Background(), claims, 1, WorkScheduleUpsertInput{
+		Name: "Standard (Mon-Fri)", HoursPerDay: 8, Days: []WorkScheduleDay{{Weekday: 1, Fraction: 1}},
+	})
+	if !errors.Is(err, ErrValidation) {
+		t.Fatalf("expected ErrValidation when unsetting the default, got %v", err)
+	}
+	if _, err := svc.SetWorkScheduleActive(context.Background(), claims, 1, false); !errors.Is(err, ErrValidation) {
+		t.Fatalf("expected ErrValidation when deactivating the default, got %v", err)
+	}
+}
+
+func TestEmployeesWorkDaysUseAssignmentsAndDefault(t *testing.T) {
+	repo := standardFixture()
+	repo.schedules = append(repo.schedules, WorkSchedule{ID: 2, Name: "Weekend", HoursPerDay: 6, Active: true, Days: []WorkScheduleDay{
+		{Weekday: 0, Fraction: 1}, {Weekday: 6, Fraction: 1},
+	}})
+	svc := NewService(repo)
+	claims := &models.Claims{UserID: 1, Role: "Admin"}
+
+	if _, err := svc.AssignWorkSchedule(context.Background(), claims, AssignWorkScheduleInput{EmployeeID: 2, ScheduleID: 2, EffectiveFrom: "2026-03-01"}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if _, err := svc.AssignWorkSchedule(context.Background(), claims, AssignWorkScheduleInput{EmployeeID: 3, ScheduleID: 2, EffectiveFrom: "2026-03-01"}); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound for a missing employee, got %v", err)
+	}
+
+	days, err := svc.EmployeesWorkDays(context.Background(), []int64{1, 2}, time.Date(2026, 3, 7, 0, 0, 0, 0, time.UTC), time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	// Saturday 7, Sunday 8 and Monday 9 March.
+	if days[1][0].Fraction != 0 || days[1][2].Fraction != 1 {
+		t.Fatalf("expected the default week for employee 1, got %#v", days[1])
+	}
+	if days[2][0].Fraction != 1 || days[2][1].Fraction != 1 || days[2][2].Fraction != 0 || days[2][0].DailyHours != 6 {
+		t.Fatalf("expected the weekend schedule for employee 2, got %#v", days[2])
+	}
+}
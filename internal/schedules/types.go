@@ -0,0 +1,57 @@
+package schedules
+
+import "time"
+
+// StandardHoursPerDay is the length of a working day when no schedule is configured.
+const StandardHoursPerDay = 8.0
+
+type WorkSchedule struct {
+	ID          int64             `db:"id" json:"id"`
+	Name        string            `db:"name" json:"name"`
+	HoursPerDay float64           `db:"hours_per_day" json:"hoursPerDay"`
+	IsDefault   bool              `db:"is_default" json:"isDefault"`
+	Active      bool              `db:"active" json:"active"`
+	Days        []WorkScheduleDay `db:"-" json:"days"`
+	CreatedAt   time.Time         `db:"created_at" json:"createdAt"`
+	UpdatedAt   time.Time         `db:"updated_at" json:"updatedAt"`
+}
+
+// WorkScheduleDay is a weekday worked under a schedule. Weekday counts from Sunday (0) to Saturday (6), and
+// Fraction is 1 for a full day or 0.5 for a half day. Weekdays that are not listed are days off.
+type WorkScheduleDay struct {
+	ScheduleID int64   `db:"schedule_id" json:"-"`
+	Weekday    int     `db:"weekday" json:"weekday"`
+	Fraction   float64 `db:"day_fraction" json:"fraction"`
+}
+
+type WorkScheduleUpsertInput struct {
+	Name        string            `json:"name"`
+	HoursPerDay float64           `json:"hoursPerDay"`
+	IsDefault   bool              `json:"isDefault"`
+	Days        []WorkScheduleDay `json:"days"`
+}
+
+// EmployeeWorkSchedule assigns a schedule to an employee from EffectiveFrom until the next assignment.
+type EmployeeWorkSchedule struct {
+	ID            int64     `db:"id" json:"id"`
+	EmployeeID    int64     `db:"employee_id" json:"employeeId"`
+	ScheduleID    int64     `db:"schedule_id" json:"scheduleId"`
+	ScheduleName  string    `db:"schedule_name" json:"scheduleName"`
+	EffectiveFrom time.Time `db:"effective_from" json:"effectiveFrom"`
+	CreatedBy     *int64    `db:"created_by" json:"createdBy,omitempty"`
+	CreatedAt     time.Time `db:"created_at" json:"createdAt"`
+}
+
+type AssignWorkScheduleInput struct {
+	EmployeeID    int64  `json:"employeeId"`
+	ScheduleID    int64  `json:"scheduleId"`
+	EffectiveFrom string `json:"effectiveFrom"`
+}
+
+// WorkDay is one date of an employee's work calendar. Fraction is the part of a working day the employee is
+// scheduled to work, 0 on a day off, and DailyHours the hours of a full day under the schedule in effect.
+type WorkDay struct {
+	Date       time.Time `json:"date"`
+	Fraction   float64   `json:"fraction"`
+	DailyHours float64   `json:"dailyHours"`
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -16,6 +17,7 @@ import (
 
 	"hrpro/internal/middleware"
 	"hrpro/internal/models"
+	"hrpro/internal/payroll"
 )
 
 const (
@@ -107,6 +109,13 @@ func (s *Service) UpdateSettings(ctx context.Context, claims *models.Claims, inp
 	if err := s.upsertValue(ctx, KeyPayrollDisplay, input.PayrollDisplay, claims.UserID); err != nil {
 		return nil, err
 	}
+	policy := current.PayrollPolicy
+	if strings.TrimSpace(input.PayrollPolicy.ProrationBasis) != "" {
+		policy = normalizePayrollPolicy(input.PayrollPolicy)
+	}
+	if err := s.upsertValue(ctx, KeyPayrollPolicy, policy, claims.UserID); err != nil {
+		return nil, err
+	}
 	if err := s.upsertValue(ctx, KeyPhoneDefaults, normalizePhoneDefaults(input.PhoneDefaults), claims.UserID); err != nil {
 		return nil, err
 	}
@@ -270,6 +279,85 @@ func (s *Service) GetPayrollFormatting(ctx context.Context) (symbol string, deci
 	return settingsValue.Currency.Symbol, settingsValue.PayrollDisplay.Decimals, settingsValue.PayrollDisplay.RoundingEnabled, nil
 }
 
+func (s *Service) GetPayrollTaxSettings(ctx context.Context, claims *models.Claims) (*PayrollTaxSettings, error) {
+	if claims == nil {
+		return nil, ErrForbidden
+	}
+	tables, err := s.GetPayrollTaxTables(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &PayrollTaxSettings{Tables: tables}, nil
+}
+
+func (s *Service) SavePayrollTaxSettings(ctx context.Context, claims *models.Claims, input PayrollTaxSettings) (*PayrollTaxSettings, error) {
+	if err := middleware.RequireRoles(claims, "admin"); err != nil {
+		return nil, ErrForbidden
+	}
+	tables, err := payroll.NormalizeTaxTables(input.Tables)
+	if err != nil {
+		if errors.Is(err, payroll.ErrValidation) {
+			return nil, fmt.Errorf("%w: %s", ErrValidation, strings.TrimPrefix(err.Error(), payroll.ErrValidation.Error()+": "))
+		}
+		return nil, err
+	}
+
+	value := PayrollTaxSettings{Tables: tables}
+	if err := s.upsertValue(ctx, KeyPayrollTax, value, claims.UserID); err != nil {
+		return nil, err
+	}
+	return &value, nil
+}
+
+// GetPayrollTaxTables returns the configured PAYE tables, falling back to the statutory defaults.
+func (s *Service) GetPayrollTaxTables(ctx context.Context) ([]payroll.TaxTable, error) {
+	var value PayrollTaxSettings
+	if err := s.readValue(ctx, KeyPayrollTax, &value); err != nil {
+		return nil, err
+	}
+	if len(value.Tables) == 0 {
+		return payroll.DefaultTaxTables(), nil
+	}
+	tables, err := payroll.NormalizeTaxTables(value.Tables)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid %s setting payload", ErrValidation, KeyPayrollTax)
+	}
+	return tables, nil
+}
+
+// GetPayrollProrationBasis returns how mid-month hires and exits are pro-rated.
+func (s *Service) GetPayrollProrationBasis(ctx context.Context) (string, error) {
+	settingsValue, err := s.loadSettings(ctx)
+	if err != nil {
+		return "", err
+	}
+	return settingsValue.PayrollPolicy.ProrationBasis, nil
+}
+
+// GetPayrollVariancePolicy returns the variance threshold and whether approval needs an acknowledged review.
+func (s *Service) GetPayrollVariancePolicy(ctx context.Context) (payroll.VariancePolicy, error) {
+	settingsValue, err := s.loadSettings(ctx)
+	if err != nil {
+		return payroll.VariancePolicy{}, err
+	}
+	return payroll.VariancePolicy{
+		ThresholdPercent: settingsValue.PayrollPolicy.VarianceThresholdPercent,
+		RequireReview:    settingsValue.PayrollPolicy.RequireVarianceReview,
+	}, nil
+}
+
+// GetPayrollApprovalPolicy returns the approval chain of payroll batches and whether self-approval is allowed.
+func (s *Service) GetPayrollApprovalPolicy(ctx context.Context) (payroll.ApprovalPolicy, error) {
+	settingsValue, err := s.loadSettings(ctx)
+	if err != nil {
+		return payroll.ApprovalPolicy{}, err
+	}
+	return payroll.ApprovalPolicy{
+		Steps:             settingsValue.PayrollPolicy.ApprovalSteps,
+		AllowSelfApproval: settingsValue.PayrollPolicy.AllowSelfApproval,
+	}, nil
+}
+
 func (s *Service) GetPhoneDefaults(ctx context.Context) (defaultCountryISO2 string, defaultCountryCallingCode string, err error) {
 	settingsValue, err := s.loadSettings(ctx)
 	if err != nil {
@@ -304,6 +392,9 @@ func (s *Service) loadSettings(ctx context.Context) (*SettingsDTO, error) {
 	if err := s.readValue(ctx, KeyPayrollDisplay, &result.PayrollDisplay); err != nil {
 		return nil, err
 	}
+	if err := s.readValue(ctx, KeyPayrollPolicy, &result.PayrollPolicy); err != nil {
+		return nil, err
+	}
 	if err := s.readValue(ctx, KeyPhoneDefaults, &result.PhoneDefaults); err != nil {
 		return nil, err
 	}
@@ -328,6 +419,7 @@ func (s *Service) loadSettings(ctx context.Context) (*SettingsDTO, error) {
 	if result.PayrollDisplay.Decimals < 0 || result.PayrollDisplay.Decimals > 6 {
 		result.PayrollDisplay.Decimals = DefaultPayrollDecimals
 	}
+	result.PayrollPolicy = normalizePayrollPolicy(result.PayrollPolicy)
 	result.PhoneDefaults = normalizePhoneDefaults(result.PhoneDefaults)
 	if envCountryName := strings.TrimSpace(os.Getenv(EnvDefaultCountryName)); envCountryName != "" {
 		result.PhoneDefaults.DefaultCountryName = envCountryName
@@ -447,6 +539,11 @@ func defaultSettings() SettingsDTO {
 			Decimals:        DefaultPayrollDecimals,
 			RoundingEnabled: false,
 		},
+		PayrollPolicy: PayrollPolicySettings{
+			ProrationBasis:           payroll.ProrationWorkingDays,
+			VarianceThresholdPercent: payroll.DefaultVarianceThresholdPercent,
+			ApprovalSteps:            payroll.DefaultApprovalSteps(),
+		},
 		PhoneDefaults: PhoneDefaultsSettings{
 			DefaultCountryName:        DefaultCountryName,
 			DefaultCountryISO2:        DefaultCountryISO2,
@@ -483,6 +580,15 @@ func validateUpdateInput(input UpdateSettingsInput) error {
 	if input.PayrollDisplay.Decimals < 0 || input.PayrollDisplay.Decimals > 6 {
 		return fmt.Errorf("%w: payroll decimals must be between 0 and 6", ErrValidation)
 	}
+	if _, err := payroll.NormalizeProrationBasis(input.PayrollPolicy.ProrationBasis); err != nil {
+		return fmt.Errorf("%w: proration basis must be working_days or calendar_days", ErrValidation)
+	}
+	if input.PayrollPolicy.VarianceThresholdPercent < 0 || input.PayrollPolicy.VarianceThresholdPercent > 1000 {
+		return fmt.Errorf("%w: variance threshold must be between 0 and 1000 percent", ErrValidation)
+	}
+	if _, err := payroll.NormalizeApprovalSteps(input.PayrollPolicy.ApprovalSteps); err != nil {
+		return fmt.Errorf("%w: %s", ErrValidation, strings.TrimPrefix(err.Error(), payroll.ErrValidation.Error()+": "))
+	}
 	if _, err := validatePhoneDefaults(input.PhoneDefaults); err != nil {
 		return err
 	}
@@ -611,6 +717,30 @@ func normalizeCurrencySettings(in CurrencySettings) CurrencySettings {
 	return value
 }
 
+// normalizePayrollPolicy falls back to working-day proration for empty or unknown bases, to the default
+// variance threshold when none is set and to the single-step approval chain when the chain is empty or invalid.
+func normalizePayrollPolicy(in PayrollPolicySettings) PayrollPolicySettings {
+	basis, err := payroll.NormalizeProrationBasis(in.ProrationBasis)
+	if err != nil {
+		basis = payroll.ProrationWorkingDays
+	}
+	threshold := in.VarianceThresholdPercent
+	if threshold <= 0 {
+		threshold = payroll.DefaultVarianceThresholdPercent
+	}
+	steps, err := payroll.NormalizeApprovalSteps(in.ApprovalSteps)
+	if err != nil {
+		steps = payroll.DefaultApprovalSteps()
+	}
+	return PayrollPolicySettings{
+		ProrationBasis:           basis,
+		VarianceThresholdPercent: threshold,
+		RequireVarianceReview:    in.RequireVarianceReview,
+		ApprovalSteps:            steps,
+		AllowSelfApproval:        in.AllowSelfApproval,
+	}
+}
+
 func normalizePhoneDefaults(in PhoneDefaultsSettings) PhoneDefaultsSettings {
 	value := in
 	value.DefaultCountryName = strings.TrimSpace(value.DefaultCountryName)
@@ -14,6 +14,7 @@ import (
 	"testing"
 
 	"hrpro/internal/models"
+	"hrpro/internal/payroll"
 )
 
 type fakeRepository struct {
@@ -368,3 +369,166 @@ func newHTTPResponse(statusCode int, contentType string, body []byte) *http.Resp
 		Body: io.NopCloser(bytes.NewReader(body)),
 	}
 }
+
+func TestPayrollTaxSettingsDefaultAndSave(t *testing.T) {
+	svc := NewService(newFakeRepository(), nil)
+	admin := &models.Claims{UserID: 1, Role: "admin"}
+
+	tables, err := svc.GetPayrollTaxTables(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(tables) != 1 || tables[0].EffectiveFrom != payroll.DefaultTaxTableEffectiveFrom {
+		t.Fatalf("expected default PAYE table, got %+v", tables)
+	}
+
+	_, err = svc.SavePayrollTaxSettings(context.Background(), admin, PayrollTaxSettings{
+		Tables: []payroll.TaxTable{{Name: "Bad", EffectiveFrom: "2025", Bands: []payroll.TaxBand{{Threshold: 0, Rate: 0}}}},
+	})
+	if !errors.Is(err, ErrValidation) {
+		t.Fatalf("expected validation error, got %v", err)
+	}
+
+	_, err = svc.SavePayrollTaxSettings(context.Background(), &models.Claims{UserID: 2, Role: "viewer"}, PayrollTaxSettings{Tables: payroll.DefaultTaxTables()})
+	if !errors.Is(err, ErrForbidden) {
+		t.Fatalf("expected forbidden error, got %v", err)
+	}
+
+	saved, err := svc.SavePayrollTaxSettings(context.Background(), admin, PayrollTaxSettings{
+		Tables: append(payroll.DefaultTaxTables(), payroll.TaxTable{Name: "FY2025/26", EffectiveFrom: "2025-07", Bands: []payroll.TaxBand{{Threshold: 0, Rate: 0}, {Threshold: 300000, Rate: 10}}}),
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(saved.Tables) != 2 {
+		t.Fatalf("expected 2 saved tables, got %d", len(saved.Tables))
+	}
+
+	result, err := svc.GetPayrollTaxSettings(context.Background(), admin)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(result.Tables) != 2 || result.Tables[1].EffectiveFrom != "2025-07" {
+		t.Fatalf("expected saved tables to round-trip, got %+v", result.Tables)
+	}
+}
+
+func TestPayrollProrationBasisDefaultAndSave(t *testing.T) {
+	svc := NewService(newFakeRepository(), nil)
+	admin := &models.Claims{UserID: 1, Role: "admin"}
+
+	basis, err := svc.GetPayrollProrationBasis(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if basis != payroll.ProrationWorkingDays {
+		t.Fatalf("expected default working_days basis, got %q", basis)
+	}
+
+	input := UpdateSettingsInput{
+		Company:        CompanyProfileSettingsInput{Name: "HISP"},
+		Currency:       CurrencySettings{Code: "UGX", Symbol: "UGX", Decimals: 0},
+		LunchDefaults:  LunchDefaultsSettings{PlateCostAmount: 12000, StaffContributionAmount: 4000},
+		PayrollDisplay: PayrollDisplaySettings{Decimals: 2},
+		PayrollPolicy:  PayrollPolicySettings{ProrationBasis: "fortnights"},
+	}
+	if _, err := svc.UpdateSettings(context.Background(), admin, input); !errors.Is(err, ErrValidation) {
+		t.Fatalf("expected validation error, got %v", err)
+	}
+
+	input.PayrollPolicy.ProrationBasis = "Calendar_Days"
+	result, err := svc.UpdateSettings(context.Background(), admin, input)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if result.PayrollPolicy.ProrationBasis != payroll.ProrationCalendarDays {
+		t.Fatalf("expected calendar_days basis, got %q", result.PayrollPolicy.ProrationBasis)
+	}
+	basis, err = svc.GetPayrollProrationBasis(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if basis != payroll.ProrationCalendarDays {
+		t.Fatalf("expected saved calendar_days basis, got %q", basis)
+	}
+}
+
+func TestPayrollVariancePolicyDefaultAndSave(t *testing.T) {
+	svc := NewService(newFakeRepository(), nil)
+	admin := &models.Claims{UserID: 1, Role: "admin"}
+
+	policy, err := svc.GetPayrollVariancePolicy(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if policy.ThresholdPercent != payroll.DefaultVarianceThresholdPercent || policy.RequireReview {
+		t.Fatalf("expected default 10%% threshold without review, got %+v", policy)
+	}
+
+	input := UpdateSettingsInput{
+		Company:        CompanyProfileSettingsInput{Name: "HISP"},
+		Currency:       CurrencySettings{Code: "UGX", Symbol: "UGX", Decimals: 0},
+		LunchDefaults:  LunchDefaultsSettings{PlateCostAmount: 12000, StaffContributionAmount: 4000},
+		PayrollDisplay: PayrollDisplaySettings{Decimals: 2},
+		PayrollPolicy:  PayrollPolicySettings{ProrationBasis: payroll.ProrationWorkingDays, VarianceThresholdPercent: -5},
+	}
+	if _, err := svc.UpdateSettings(context.Background(), admin, input); !errors.Is(err, ErrValidation) {
+		t.Fatalf("expected validation error, got %v", err)
+	}
+
+	input.PayrollPolicy.VarianceThresholdPercent = 25
+	input.PayrollPolicy.RequireVarianceReview = true
+	if _, err := svc.UpdateSettings(context.Background(), admin, input); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	policy, err = svc.GetPayrollVariancePolicy(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if policy.ThresholdPercent != 25 || !policy.RequireReview {
+		t.Fatalf("expected saved 25%% threshold with review, got %+v", policy)
+	}
+}
+
+func TestPayrollApprovalPolicyDefaultAndSave(t *testing.T) {
+	svc := NewService(newFakeRepository(), nil)
+	admin := &models.Claims{UserID: 1, Role: "admin"}
+
+	policy, err := svc.GetPayrollApprovalPolicy(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(policy.Steps) != 1 || policy.AllowSelfApproval {
+		t.Fatalf("expected single default step without self-approval, got %+v", policy)
+	}
+
+	input := UpdateSettingsInput{
+		Company:        CompanyProfileSettingsInput{Name: "HISP"},
+		Currency:       CurrencySettings{Code: "UGX", Symbol: "UGX", Decimals: 0},
+		LunchDefaults:  LunchDefaultsSettings{PlateCostAmount: 12000, StaffContributionAmount: 4000},
+		PayrollDisplay: PayrollDisplaySettings{Decimals: 2},
+		PayrollPolicy: PayrollPolicySettings{
+			ProrationBasis: payroll.ProrationWorkingDays,
+			ApprovalSteps:  []payroll.ApprovalStep{{Name: "Approved", Roles: []string{"director"}}},
+		},
+	}
+	if _, err := svc.UpdateSettings(context.Background(), admin, input); !errors.Is(err, ErrValidation) {
+		t.Fatalf("expected validation error for unknown role, got %v", err)
+	}
+
+	input.PayrollPolicy.ApprovalSteps = []payroll.ApprovalStep{
+		{Name: " Prepared ", Roles: []string{"Finance Officer"}},
+		{Name: "Reviewed", Roles: []string{"HR Officer"}},
+		{Name: "Approved", Roles: []string{"Admin"}},
+	}
+	if _, err := svc.UpdateSettings(context.Background(), admin, input); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	policy, err = svc.GetPayrollApprovalPolicy(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(policy.Steps) != 3 || policy.Steps[0].Name != "Prepared" || policy.Steps[1].Roles[0] != "hr_officer" {
+		t.Fatalf("expected saved three-step chain, got %+v", policy.Steps)
+	}
+}
@@ -3,6 +3,8 @@ package settings
 import (
 	"encoding/json"
 	"time"
+
+	"hrpro/internal/payroll"
 )
 
 const (
@@ -11,6 +13,8 @@ const (
 	KeyLunchDefaults  = "lunch_defaults"
 	KeyPayrollDisplay = "payroll_display"
 	KeyPhoneDefaults  = "phone_defaults"
+	KeyPayrollTax     = "payroll_tax"
+	KeyPayrollPolicy  = "payroll_policy"
 )
 
 const (
@@ -62,17 +66,33 @@ type PayrollDisplaySettings struct {
 	RoundingEnabled bool `json:"roundingEnabled"`
 }
 
+type PayrollPolicySettings struct {
+	ProrationBasis           string  `json:"prorationBasis"`
+	VarianceThresholdPercent float64 `json:"varianceThresholdPercent"`
+	RequireVarianceReview    bool    `json:"requireVarianceReview"`
+
+	// ApprovalSteps is the ordered approval chain of a payroll batch; AllowSelfApproval lifts the
+	// segregation-of-duties rule.
+	ApprovalSteps     []payroll.ApprovalStep `json:"approvalSteps"`
+	AllowSelfApproval bool                   `json:"allowSelfApproval"`
+}
+
 type PhoneDefaultsSettings struct {
 	DefaultCountryName        string `json:"defaultCountryName"`
 	DefaultCountryISO2        string `json:"defaultCountryISO2"`
 	DefaultCountryCallingCode string `json:"defaultCountryCallingCode"`
 }
 
+type PayrollTaxSettings struct {
+	Tables []payroll.TaxTable `json:"tables"`
+}
+
 type SettingsDTO struct {
 	Company        CompanyProfileSettings `json:"company"`
 	Currency       CurrencySettings       `json:"currency"`
 	LunchDefaults  LunchDefaultsSettings  `json:"lunchDefaults"`
 	PayrollDisplay PayrollDisplaySettings `json:"payrollDisplay"`
+	PayrollPolicy  PayrollPolicySettings  `json:"payrollPolicy"`
 	PhoneDefaults  PhoneDefaultsSettings  `json:"phoneDefaults"`
 }
 
@@ -90,6 +110,7 @@ type UpdateSettingsInput struct {
 	Currency       CurrencySettings            `json:"currency"`
 	LunchDefaults  LunchDefaultsSettings       `json:"lunchDefaults"`
 	PayrollDisplay PayrollDisplaySettings      `json:"payrollDisplay"`
+	PayrollPolicy  PayrollPolicySettings       `json:"payrollPolicy"`
 	PhoneDefaults  PhoneDefaultsSettings       `json:"phoneDefaults"`
 }
 
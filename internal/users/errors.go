@@ -8,4 +8,5 @@ var (
 	ErrDuplicateUsername       = errors.New("username already exists")
 	ErrCannotDeactivateSelf    = errors.New("cannot deactivate own account")
 	ErrCannotRemoveOwnAdmin    = errors.New("cannot remove own admin role")
+	ErrEmployeeAlreadyLinked   = errors.New("employee is already linked to another user")
 )
@@ -13,12 +13,18 @@ type Repository interface {
 	GetByID(ctx context.Context, id int64) (*User, error)
 	List(ctx context.Context, query ListUsersQuery) ([]User, int64, error)
 	ExistsByUsernameCaseInsensitive(ctx context.Context, username string, excludeID *int64) (bool, error)
-	Create(ctx context.Context, username, passwordHash, role string) (*User, error)
-	Update(ctx context.Context, id int64, username, role string) (*User, error)
+	Create(ctx context.Context, username, passwordHash, role string, employeeID *int64) (*User, error)
+	Update(ctx context.Context, id int64, username, role string, employeeID *int64) (*User, error)
 	UpdatePasswordHash(ctx context.Context, id int64, passwordHash string) (bool, error)
 	SetActive(ctx context.Context, id int64, active bool) (*User, error)
+	EmployeeExists(ctx context.Context, employeeID int64) (bool, error)
+	GetByEmployeeID(ctx context.Context, employeeID int64) (*User, error)
 }
 
+// userColumns selects a user with the name of the linked employee. The subquery also works in RETURNING.
+const userColumns = `id, username, role, is_active, created_at, updated_at, last_login_at, employee_id,
+	(SELECT TRIM(CONCAT(e.first_name, ' ', e.last_name)) FROM employees e WHERE e.id = users.employee_id) AS employee_name`
+
 type SQLXRepository struct {
 	db *sqlx.DB
 }
@@ -30,7 +36,7 @@ func NewRepository(db *sqlx.DB) *SQLXRepository {
 func (r *SQLXRepository) GetByID(ctx context.Context, id int64) (*User, error) {
 	var user User
 	query := `
-		SELECT id, username, role, is_active, created_at, updated_at, last_login_at
+		SELECT ` + userColumns + `
 		FROM users
 		WHERE id = $1
 	`
@@ -73,7 +79,7 @@ func (r *SQLXRepository) List(ctx context.Context, query ListUsersQuery) ([]User
 	limitPlaceholder := fmt.Sprintf("$%d", len(args)-1)
 	offsetPlaceholder := fmt.Sprintf("$%d", len(args))
 	listQuery := `
-		SELECT id, username, role, is_active, created_at, updated_at, last_login_at
+		SELECT ` + userColumns + `
 		FROM users
 		` + where + `
 		ORDER BY created_at DESC
@@ -103,28 +109,26 @@ func (r *SQLXRepository) ExistsByUsernameCaseInsensitive(ctx context.Context, us
 	return exists, nil
 }
 
-func (r *SQLXRepository) Create(ctx context.Context, username, passwordHash, role string) (*User, error) {
+func (r *SQLXRepository) Create(ctx context.Context, username, passwordHash, role string, employeeID *int64) (*User, error) {
 	var user User
 	query := `
-		INSERT INTO users (username, password_hash, role, is_active)
-		VALUES ($1, $2, $3, TRUE)
-		RETURNING id, username, role, is_active, created_at, updated_at, last_login_at
-	`
-	if err := r.db.GetContext(ctx, &user, query, username, passwordHash, role); err != nil {
+		INSERT INTO users (username, password_hash, role, is_active, employee_id)
+		VALUES ($1, $2, $3, TRUE, $4)
+		RETURNING ` + userColumns
+	if err := r.db.GetContext(ctx, &user, query, username, passwordHash, role, employeeID); err != nil {
 		return nil, fmt.Errorf("create user: %w", err)
 	}
 	return &user, nil
 }
 
-func (r *SQLXRepository) Update(ctx context.Context, id int64, username, role string) (*User, error) {
+func (r *SQLXRepository) Update(ctx context.Context, id int64, username, role string, employeeID *int64) (*User, error) {
 	var user User
 	query := `
 		UPDATE users
-		SET username = $2, role = $3, updated_at = NOW()
+		SET username = $2, role = $3, employee_id = $4, updated_at = NOW()
 		WHERE id = $1
-		RETURNING id, username, role, is_active, created_at, updated_at, last_login_at
-	`
-	if err := r.db.GetContext(ctx, &user, query, id, username, role); err != nil {
+		RETURNING ` + userColumns
+	if err := r.db.GetContext(ctx, &user, query, id, username, role, employeeID); err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
 		}
@@ -151,8 +155,7 @@ func (r *SQLXRepository) SetActive(ctx context.Context, id int64, active bool) (
 		UPDATE users
 		SET is_active = $2, updated_at = NOW()
 		WHERE id = $1
-		RETURNING id, username, role, is_active, created_at, updated_at, last_login_at
-	`
+		RETURNING ` + userColumns
 	if err := r.db.GetContext(ctx, &user, query, id, active); err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -161,3 +164,28 @@ func (r *SQLXRepository) SetActive(ctx context.Context, id int64, active bool) (
 	}
 	return &user, nil
 }
+
+func (r *SQLXRepository) EmployeeExists(ctx context.Context, employeeID int64) (bool, error) {
+	var exists bool
+	if err := r.db.GetContext(ctx, &exists, `SELECT EXISTS(SELECT 1 FROM employees WHERE id = $1)`, employeeID); err != nil {
+		return false, fmt.Errorf("check employee exists: %w", err)
+	}
+	return exists, nil
+}
+
+// GetByEmployeeID returns the user linked to an employee, or nil when the employee has no account.
+func (r *SQLXRepository) GetByEmployeeID(ctx context.Context, employeeID int64) (*User, error) {
+	var user User
+	query := `
+		SELECT ` + userColumns + `
+		FROM users
+		WHERE employee_id = $1
+	`
+	if err := r.db.GetContext(ctx, &user, query, employeeID); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get user by employee id: %w", err)
+	}
+	return &user, nil
+}
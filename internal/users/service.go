@@ -92,19 +92,23 @@ func (s *Service) CreateUser(ctx context.Context, claims *models.Claims, input C
 	if exists {
 		return nil, ErrDuplicateUsername
 	}
+	if err := s.validateEmployeeLink(ctx, input.EmployeeID, nil); err != nil {
+		return nil, err
+	}
 
 	hash, err := bcrypt.GenerateFromPassword([]byte(input.Password), bcrypt.DefaultCost)
 	if err != nil {
 		return nil, fmt.Errorf("hash password: %w", err)
 	}
 
-	user, err := s.repository.Create(ctx, username, string(hash), role)
+	user, err := s.repository.Create(ctx, username, string(hash), role, input.EmployeeID)
 	if err != nil {
 		return nil, err
 	}
 	s.audit.RecordAuditEvent(ctx, claimsUserID(claims), "user.create", stringPtr("user"), &user.ID, map[string]any{
-		"username": user.Username,
-		"role":     user.Role,
+		"username":    user.Username,
+		"role":        user.Role,
+		"employee_id": employeeIDValue(user.EmployeeID),
 	})
 	return user, nil
 }
@@ -135,8 +139,11 @@ func (s *Service) UpdateUser(ctx context.Context, claims *models.Claims, id int6
 	if claims != nil && claims.UserID == id && middleware.NormalizeRole(claims.Role) == "admin" && role != "admin" {
 		return nil, ErrCannotRemoveOwnAdmin
 	}
+	if err := s.validateEmployeeLink(ctx, input.EmployeeID, &id); err != nil {
+		return nil, err
+	}
 
-	user, err := s.repository.Update(ctx, id, username, role)
+	user, err := s.repository.Update(ctx, id, username, role, input.EmployeeID)
 	if err != nil {
 		return nil, err
 	}
@@ -144,8 +151,9 @@ func (s *Service) UpdateUser(ctx context.Context, claims *models.Claims, id int6
 		return nil, ErrNotFound
 	}
 	s.audit.RecordAuditEvent(ctx, claimsUserID(claims), "user.update", stringPtr("user"), &user.ID, map[string]any{
-		"username": user.Username,
-		"role":     user.Role,
+		"username":    user.Username,
+		"role":        user.Role,
+		"employee_id": employeeIDValue(user.EmployeeID),
 	})
 	return user, nil
 }
@@ -203,6 +211,32 @@ func (s *Service) SetUserActive(ctx context.Context, claims *models.Claims, id i
 	return user, nil
 }
 
+// validateEmployeeLink checks that the employee exists and has no other account. userID is the account being
+// updated, or nil on create.
+func (s *Service) validateEmployeeLink(ctx context.Context, employeeID *int64, userID *int64) error {
+	if employeeID == nil {
+		return nil
+	}
+	if *employeeID <= 0 {
+		return fmt.Errorf("%w: employee id must be positive", ErrValidation)
+	}
+	exists, err := s.repository.EmployeeExists(ctx, *employeeID)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("%w: employee not found", ErrValidation)
+	}
+	linked, err := s.repository.GetByEmployeeID(ctx, *employeeID)
+	if err != nil {
+		return err
+	}
+	if linked != nil && (userID == nil || linked.ID != *userID) {
+		return fmt.Errorf("%w: %s", ErrEmployeeAlreadyLinked, linked.Username)
+	}
+	return nil
+}
+
 func validateAndNormalizeRole(role string) (string, error) {
 	normalized := middleware.NormalizeRole(role)
 	if _, ok := allowedRoles[normalized]; !ok {
@@ -219,6 +253,13 @@ func claimsUserID(claims *models.Claims) *int64 {
 	return &actor
 }
 
+func employeeIDValue(employeeID *int64) any {
+	if employeeID == nil {
+		return nil
+	}
+	return *employeeID
+}
+
 func stringPtr(value string) *string {
 	return &value
 }
@@ -14,6 +14,8 @@ import (
 type fakeRepository struct {
 	existsByUsername bool
 	capturedHash     string
+	employees        map[int64]bool
+	linkedUsers      map[int64]*User
 }
 
 type captureAuditRecorder struct {
@@ -46,13 +48,13 @@ func (f *fakeRepository) ExistsByUsernameCaseInsensitive(_ context.Context, _ st
 	return f.existsByUsername, nil
 }
 
-func (f *fakeRepository) Create(_ context.Context, username, passwordHash, role string) (*User, error) {
+func (f *fakeRepository) Create(_ context.Context, username, passwordHash, role string, employeeID *int64) (*User, error) {
 	f.capturedHash = passwordHash
-	return &User{ID: 1, Username: username, Role: role, IsActive: true}, nil
+	return &User{ID: 1, Username: username, Role: role, IsActive: true, EmployeeID: employeeID}, nil
 }
 
-func (f *fakeRepository) Update(_ context.Context, id int64, username, role string) (*User, error) {
-	return &User{ID: id, Username: username, Role: role, IsActive: true}, nil
+func (f *fakeRepository) Update(_ context.Context, id int64, username, role string, employeeID *int64) (*User, error) {
+	return &User{ID: id, Username: username, Role: role, IsActive: true, EmployeeID: employeeID}, nil
 }
 
 func (f *fakeRepository) UpdatePasswordHash(_ context.Context, _ int64, _ string) (bool, error) {
@@ -63,6 +65,14 @@ func (f *fakeRepository) SetActive(_ context.Context, id int64, active bool) (*U
 	return &User{ID: id, Username: "john", Role: "viewer", IsActive: active}, nil
 }
 
+func (f *fakeRepository) EmployeeExists(_ context.Context, employeeID int64) (bool, error) {
+	return f.employees[employeeID], nil
+}
+
+func (f *fakeRepository) GetByEmployeeID(_ context.Context, employeeID int64) (*User, error) {
+	return f.linkedUsers[employeeID], nil
+}
+
 func TestCreateUserRejectsDuplicateUsername(t *testing.T) {
 	service := NewService(&fakeRepository{existsByUsername: true})
 
@@ -142,3 +152,32 @@ func TestCreateUserSucceedsWhenAuditInsertFails(t *testing.T) {
 		t.Fatalf("expected create user to succeed despite audit failure, got %v", err)
 	}
 }
+
+func TestUpdateUserValidatesEmployeeLink(t *testing.T) {
+	employeeID := int64(7)
+	repo := &fakeRepository{
+		employees:   map[int64]bool{7: true},
+		linkedUsers: map[int64]*User{7: {ID: 3, Username: "mary"}},
+	}
+	service := NewService(repo)
+	claims := &models.Claims{UserID: 1, Role: "admin"}
+
+	_, err := service.UpdateUser(context.Background(), claims, 5, UpdateUserInput{Username: "john", Role: "viewer", EmployeeID: &employeeID})
+	if !errors.Is(err, ErrEmployeeAlreadyLinked) {
+		t.Fatalf("expected employee already linked error, got %v", err)
+	}
+
+	user, err := service.UpdateUser(context.Background(), claims, 3, UpdateUserInput{Username: "mary", Role: "viewer", EmployeeID: &employeeID})
+	if err != nil {
+		t.Fatalf("expected keeping the own link to pass, got %v", err)
+	}
+	if user.EmployeeID == nil || *user.EmployeeID != 7 {
+		t.Fatalf("expected link to employee 7, got %v", user.EmployeeID)
+	}
+
+	missing := int64(99)
+	_, err = service.CreateUser(context.Background(), claims, CreateUserInput{Username: "new", Password: "password123", Role: "viewer", EmployeeID: &missing})
+	if !errors.Is(err, ErrValidation) {
+		t.Fatalf("expected validation error for unknown employee, got %v", err)
+	}
+}
@@ -10,6 +10,10 @@ type User struct {
 	CreatedAt   time.Time  `db:"created_at" json:"createdAt"`
 	UpdatedAt   time.Time  `db:"updated_at" json:"updatedAt"`
 	LastLoginAt *time.Time `db:"last_login_at" json:"lastLoginAt,omitempty"`
+
+	// EmployeeID links the account to the employee record used by self-service ("my") operations.
+	EmployeeID   *int64  `db:"employee_id" json:"employeeId,omitempty"`
+	EmployeeName *string `db:"employee_name" json:"employeeName,omitempty"`
 }
 
 type ListUsersQuery struct {
@@ -26,14 +30,17 @@ type ListUsersResult struct {
 }
 
 type CreateUserInput struct {
-	Username string `json:"username"`
-	Password string `json:"password"`
-	Role     string `json:"role"`
+	Username   string `json:"username"`
+	Password   string `json:"password"`
+	Role       string `json:"role"`
+	EmployeeID *int64 `json:"employeeId,omitempty"`
 }
 
+// UpdateUserInput replaces the employee link too; a nil EmployeeID unlinks the account.
 type UpdateUserInput struct {
-	Username string `json:"username"`
-	Role     string `json:"role"`
+	Username   string `json:"username"`
+	Role       string `json:"role"`
+	EmployeeID *int64 `json:"employeeId,omitempty"`
 }
 
 type ResetUserPasswordInput struct {